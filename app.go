@@ -0,0 +1,588 @@
+package main
+
+import (
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/database"
+	"sports-backend/injuries"
+	"sports-backend/live"
+	"sports-backend/models"
+	"sports-backend/notify"
+	"sports-backend/repositories"
+	"sports-backend/services"
+	"sports-backend/storage"
+)
+
+// app is the configuration and wiring shared by every command: the database connection,
+// repositories and services, all configured from the environment
+type app struct {
+	db             *repositories.TimeoutDB
+	devMode        bool
+	backupInterval time.Duration // 0 when scheduled backups are off
+	livePoll       time.Duration // 0 when live polling is off
+	injurySync     time.Duration // 0 when injury report syncing is off
+	store          storage.Storage
+	maxUploadBytes int64
+	trustProxy     bool // client addresses come from X-Forwarded-For
+
+	teamService             services.TeamService
+	playerService           services.PlayerService
+	playerStatsService      services.PlayerStatsService
+	gameService             services.GameService
+	highlightService        services.HighlightService
+	searchService           services.SearchService
+	venueService            services.VenueService
+	oddsService             services.OddsService
+	analyticsService        services.AnalyticsService
+	rateLimitService        services.RateLimitService
+	draftPickService        services.DraftPickService
+	externalIDService       services.ExternalIDService
+	seasonStatsService      services.SeasonStatsService
+	projectionService       services.ProjectionService
+	adpService              services.ADPService
+	scheduleStrengthService services.ScheduleStrengthService
+	ratingService           services.RatingService
+	simulationService       services.SimulationService
+	recordService           services.RecordService
+	scoringService          services.ScoringService
+	dfsService              services.DFSService
+	sportService            services.SportService
+	queryMetricsService     services.QueryMetricsService
+	schemaService           services.SchemaService
+	reconciliationService   services.ReconciliationService
+	statConflictService     services.StatConflictService
+	liveService             services.LiveService
+	injuryService           services.InjuryService
+	newsService             services.NewsService
+	advancedStatsService    services.AdvancedStatsService
+	nflverseService         services.NflverseService
+	seedService             services.SeedService
+	healthService           services.HealthService
+	jobService              services.JobService
+	importJobService        services.ImportJobService
+	exportService           services.ExportService
+	mediaService            services.MediaService
+	backupService           services.BackupService
+	eventService            services.EventService
+	scheduleChangeService   services.ScheduleChangeService
+	notificationService     services.NotificationService
+
+	// closers flush buffered writes when the command finishes, last opened first
+	closers []func()
+}
+
+// appClock is the clock every repository and service reads the time from. Contract tests stop
+// it at a fixed time so responses don't change from run to run.
+var appClock clock.Clock = clock.System
+
+// newApp connects to the database and wires the repositories and services. Invalid
+// configuration is fatal. Migrations are left to the command.
+func newApp() *app {
+	// Initialize database
+	if err := database.InitDB(); err != nil {
+		log.Fatal("Failed to initialize database:", err)
+	}
+	a := &app{closers: []func(){func() { database.CloseDB() }}}
+
+	// Size the connection pool for the driver rather than leaving database/sql unbounded
+	pool := database.DefaultPoolConfig(database.Driver)
+	pool.MaxOpenConns = nonNegativeIntEnvDefault("DB_MAX_OPEN_CONNS", pool.MaxOpenConns)
+	pool.MaxIdleConns = nonNegativeIntEnvDefault("DB_MAX_IDLE_CONNS", pool.MaxIdleConns)
+	pool.ConnMaxLifetime = durationEnv("DB_CONN_MAX_LIFETIME", pool.ConnMaxLifetime)
+	pool.ConnMaxIdleTime = durationEnv("DB_CONN_MAX_IDLE_TIME", pool.ConnMaxIdleTime)
+	database.ConfigurePool(database.DB, pool)
+
+	// Bound every repository query so one slow scan can't hold a connection indefinitely
+	queryTimeout := 5 * time.Second
+	if timeout := os.Getenv("QUERY_TIMEOUT"); timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil || duration < 0 {
+			log.Fatalf("Invalid QUERY_TIMEOUT %q: must be a duration such as 5s, or 0 for no timeout", timeout)
+		}
+		queryTimeout = duration
+	}
+
+	// Streamed queries such as exports are read as fast as the client takes them, so they get
+	// a deadline of their own
+	streamTimeout := 10 * time.Minute
+	if timeout := os.Getenv("STREAM_QUERY_TIMEOUT"); timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil || duration < 0 {
+			log.Fatalf("Invalid STREAM_QUERY_TIMEOUT %q: must be a duration such as 10m, or 0 for no timeout", timeout)
+		}
+		streamTimeout = duration
+	}
+
+	// Log queries slower than the threshold, without their argument values
+	slowQueryThreshold := 200 * time.Millisecond
+	if threshold := os.Getenv("SLOW_QUERY_THRESHOLD"); threshold != "" {
+		duration, err := time.ParseDuration(threshold)
+		if err != nil || duration < 0 {
+			log.Fatalf("Invalid SLOW_QUERY_THRESHOLD %q: must be a duration such as 200ms, or 0 to turn off the slow query log", threshold)
+		}
+		slowQueryThreshold = duration
+	}
+
+	// Retry queries that find the database locked by another write before failing the request
+	queryRetries := nonNegativeIntEnvDefault("DB_RETRIES", 3)
+	a.db = repositories.NewTimeoutDB(database.DB, queryTimeout, streamTimeout, slowQueryThreshold, queryRetries)
+
+	// Repositories and services read the time from one clock
+	clk := appClock
+
+	// Initialize repositories
+	teamRepo := repositories.NewTeamRepository(a.db, clk)
+	playerRepo := repositories.NewPlayerRepository(a.db, clk)
+	playerStatsRepo := repositories.NewPlayerStatsRepository(a.db, clk)
+	gameRepo := repositories.NewGameRepository(a.db, clk)
+	venueRepo := repositories.NewVenueRepository(a.db, clk)
+	oddsRepo := repositories.NewOddsRepository(a.db, clk)
+	analyticsRepo := repositories.NewAnalyticsRepository(a.db)
+	draftPickRepo := repositories.NewDraftPickRepository(a.db, clk)
+	externalIDRepo := repositories.NewExternalIDRepository(a.db, clk)
+	seasonStatsRepo := repositories.NewSeasonStatsRepository(a.db)
+	projectionRepo := repositories.NewProjectionRepository(a.db, clk)
+	adpRepo := repositories.NewADPRepository(a.db, clk)
+	scheduleStrengthRepo := repositories.NewScheduleStrengthRepository(a.db)
+	dfsRepo := repositories.NewDFSRepository(a.db, clk)
+	sportRepo := repositories.NewSportRepository(a.db)
+	schemaRepo := repositories.NewSchemaRepository(a.db)
+	jobRepo := repositories.NewJobRepository(a.db)
+	scheduleChangeRepo := repositories.NewScheduleChangeRepository(a.db, clk)
+	recordRepo := repositories.NewRecordRepository(a.db)
+	notificationRepo := repositories.NewNotificationRepository(a.db, clk)
+	statConflictRepo := repositories.NewStatConflictRepository(a.db, clk)
+	injuryRepo := repositories.NewInjuryRepository(a.db, clk)
+	newsRepo := repositories.NewNewsRepository(a.db, clk)
+	advancedStatsRepo := repositories.NewAdvancedStatsRepository(a.db, clk)
+
+	// State held in memory over the database, reset when a backup replaces its contents
+	var resetOnRestore []func()
+
+	// Game-day write mode: coalesce stat updates over a short window before hitting SQLite
+	if window := os.Getenv("STATS_WRITE_COALESCE_WINDOW"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if err != nil || duration <= 0 {
+			log.Fatalf("Invalid STATS_WRITE_COALESCE_WINDOW %q: must be a positive duration such as 2s", window)
+		}
+		coalescingRepo := repositories.NewCoalescingPlayerStatsRepository(playerStatsRepo, duration, clk)
+		a.closers = append(a.closers, func() {
+			if err := coalescingRepo.Close(); err != nil {
+				log.Printf("Failed to flush coalesced player stats: %v", err)
+			}
+		})
+		// Updates queued against the replaced database would overwrite the restored stat lines
+		resetOnRestore = append(resetOnRestore, func() {
+			if dropped := coalescingRepo.Discard(); dropped > 0 {
+				log.Printf("Dropped %d coalesced player stats updates queued before the restore", dropped)
+			}
+		})
+		playerStatsRepo = coalescingRepo
+		log.Printf("Player stats write coalescing enabled with a %s window", duration)
+	}
+
+	// Cache team and player lookups by ID, which nearly every write makes; 0 turns it off
+	lookupCacheTTL := 30 * time.Second
+	if ttl := os.Getenv("LOOKUP_CACHE_TTL"); ttl != "" {
+		duration, err := time.ParseDuration(ttl)
+		if err != nil || duration < 0 {
+			log.Fatalf("Invalid LOOKUP_CACHE_TTL %q: must be a duration such as 30s, or 0 to turn off the lookup cache", ttl)
+		}
+		lookupCacheTTL = duration
+	}
+	if lookupCacheTTL > 0 {
+		cachedTeamRepo := repositories.NewCachedTeamRepository(teamRepo, lookupCacheTTL, clk)
+		cachedPlayerRepo := repositories.NewCachedPlayerRepository(playerRepo, lookupCacheTTL, clk)
+		resetOnRestore = append(resetOnRestore, cachedTeamRepo.ClearCache, cachedPlayerRepo.ClearCache)
+		teamRepo, playerRepo = cachedTeamRepo, cachedPlayerRepo
+	}
+
+	// Per-position stat profiles, optionally overridden from a JSON file
+	statProfiles := services.DefaultStatProfiles()
+	if path := os.Getenv("STAT_PROFILES_FILE"); path != "" {
+		loaded, err := services.LoadStatProfiles(path)
+		if err != nil {
+			log.Fatalf("Invalid STAT_PROFILES_FILE %q: %v", path, err)
+		}
+		statProfiles = loaded
+	}
+
+	// Development mode enables endpoints that are unsafe against real data, such as loading samples
+	if dev := os.Getenv("DEV_MODE"); dev != "" {
+		parsed, err := strconv.ParseBool(dev)
+		if err != nil {
+			log.Fatalf("Invalid DEV_MODE %q: must be true or false", dev)
+		}
+		a.devMode = parsed
+	}
+
+	// Anonymous feature usage analytics, on unless ANALYTICS_DISABLED is set
+	analyticsEnabled := true
+	if disabled := os.Getenv("ANALYTICS_DISABLED"); disabled != "" {
+		parsed, err := strconv.ParseBool(disabled)
+		if err != nil {
+			log.Fatalf("Invalid ANALYTICS_DISABLED %q: must be true or false", disabled)
+		}
+		analyticsEnabled = !parsed
+	}
+
+	// Per-client request budgets a minute, separately for reads and writes; 0 for no limit
+	readPerMinute := nonNegativeIntEnv("RATE_LIMIT_READ_PER_MINUTE")
+	writePerMinute := nonNegativeIntEnv("RATE_LIMIT_WRITE_PER_MINUTE")
+	if trust := os.Getenv("TRUST_PROXY"); trust != "" {
+		parsed, err := strconv.ParseBool(trust)
+		if err != nil {
+			log.Fatalf("Invalid TRUST_PROXY %q: must be true or false", trust)
+		}
+		a.trustProxy = parsed
+	}
+
+	// Backups, exports and uploads live in storage rather than the container filesystem
+	a.store = newStorage()
+
+	// Largest team logo or player headshot upload accepted
+	a.maxUploadBytes = int64(positiveIntEnv("UPLOAD_MAX_BYTES", 5<<20))
+
+	// Database backups, optionally taken on a schedule and pruned to the newest BACKUP_RETAIN
+	backupRetain := 7
+	if retain := os.Getenv("BACKUP_RETAIN"); retain != "" {
+		n, err := strconv.Atoi(retain)
+		if err != nil || n < 0 {
+			log.Fatalf("Invalid BACKUP_RETAIN %q: must be a whole number, or 0 to keep every backup", retain)
+		}
+		backupRetain = n
+	}
+	if interval := os.Getenv("BACKUP_INTERVAL"); interval != "" {
+		duration, err := time.ParseDuration(interval)
+		if err != nil || duration < 0 {
+			log.Fatalf("Invalid BACKUP_INTERVAL %q: must be a duration such as 24h, or 0 for no scheduled backups", interval)
+		}
+		a.backupInterval = duration
+	}
+
+	// Which source's stats win when several write the same stat line
+	precedenceSpec := services.DefaultStatSourcePrecedence
+	if spec := os.Getenv("STAT_SOURCE_PRECEDENCE"); spec != "" {
+		precedenceSpec = spec
+	}
+	precedence, err := services.ParseStatPrecedence(precedenceSpec)
+	if err != nil {
+		log.Fatalf("Invalid STAT_SOURCE_PRECEDENCE %q: %v", precedenceSpec, err)
+	}
+
+	// Live scoring: games in their window are polled at a provider's feed, off unless LIVE_FEED_URL
+	// is set. Games the feed fails for are read from the LIVE_FEED_FAILOVER feeds, in order.
+	var liveProviders []live.Provider
+	if feedURL := os.Getenv("LIVE_FEED_URL"); feedURL != "" {
+		provider := "espn"
+		if name := os.Getenv("LIVE_FEED_PROVIDER"); name != "" {
+			provider = name
+		}
+		if !slices.Contains(models.ExternalIDProviders, provider) {
+			log.Fatalf("Invalid LIVE_FEED_PROVIDER %q: must be one of %v", provider, models.ExternalIDProviders)
+		}
+		feed, err := live.NewFeed(provider, feedURL)
+		if err != nil {
+			log.Fatalf("Invalid LIVE_FEED_URL: %v", err)
+		}
+		liveProviders = append(liveProviders, feed)
+
+		if failover := os.Getenv("LIVE_FEED_FAILOVER"); failover != "" {
+			for _, entry := range strings.Split(failover, ",") {
+				provider, feedURL, ok := strings.Cut(strings.TrimSpace(entry), "=")
+				if !ok || !slices.Contains(models.ExternalIDProviders, provider) {
+					log.Fatalf("Invalid LIVE_FEED_FAILOVER %q: must be provider=URL entries separated by commas, with a provider one of %v", failover, models.ExternalIDProviders)
+				}
+				feed, err := live.NewFeed(provider, feedURL)
+				if err != nil {
+					log.Fatalf("Invalid LIVE_FEED_FAILOVER %q: %v", failover, err)
+				}
+				liveProviders = append(liveProviders, feed)
+			}
+		}
+
+		a.livePoll = 15 * time.Second
+		if interval := os.Getenv("LIVE_POLL_INTERVAL"); interval != "" {
+			duration, err := time.ParseDuration(interval)
+			if err != nil || duration <= 0 {
+				log.Fatalf("Invalid LIVE_POLL_INTERVAL %q: must be a positive duration such as 15s", interval)
+			}
+			a.livePoll = duration
+		}
+	}
+
+	// Injury reports: the official report of the week of the next game is synced from a
+	// provider's feed, off unless INJURY_FEED_URL is set. Reports can always be posted.
+	var injuryProvider injuries.Provider
+	if feedURL := os.Getenv("INJURY_FEED_URL"); feedURL != "" {
+		provider := "gsis"
+		if name := os.Getenv("INJURY_FEED_PROVIDER"); name != "" {
+			provider = name
+		}
+		if !slices.Contains(models.ExternalIDProviders, provider) {
+			log.Fatalf("Invalid INJURY_FEED_PROVIDER %q: must be one of %v", provider, models.ExternalIDProviders)
+		}
+		injuryProvider, err = injuries.NewFeed(provider, feedURL)
+		if err != nil {
+			log.Fatalf("Invalid INJURY_FEED_URL: %v", err)
+		}
+
+		a.injurySync = time.Hour
+		if interval := os.Getenv("INJURY_SYNC_INTERVAL"); interval != "" {
+			duration, err := time.ParseDuration(interval)
+			if err != nil || duration <= 0 {
+				log.Fatalf("Invalid INJURY_SYNC_INTERVAL %q: must be a positive duration such as 1h", interval)
+			}
+			a.injurySync = duration
+		}
+	}
+
+	// Background jobs: a fixed worker pool behind a bounded queue. Jobs are mostly writes and
+	// SQLite takes one writer at a time, so a single worker is the default.
+	jobWorkers := positiveIntEnv("JOB_WORKERS", 1)
+	jobQueueSize := positiveIntEnv("JOB_QUEUE_SIZE", 16)
+
+	// Initialize services
+	a.teamService = services.NewTeamService(teamRepo, externalIDRepo)
+	a.playerService = services.NewPlayerService(playerRepo, teamRepo, draftPickRepo, externalIDRepo, playerStatsRepo, clk)
+	a.playerStatsService = services.NewPlayerStatsService(playerStatsRepo, playerRepo, gameRepo, sportRepo, statProfiles)
+	a.gameService = services.NewGameService(gameRepo, teamRepo, venueRepo, oddsRepo, externalIDRepo, playerStatsRepo, clk)
+	a.highlightService = services.NewHighlightService(playerStatsRepo, gameRepo)
+	a.searchService = services.NewSearchService(playerRepo, teamRepo)
+	a.venueService = services.NewVenueService(venueRepo)
+	a.oddsService = services.NewOddsService(oddsRepo, gameRepo, clk)
+	a.eventService = services.NewEventService(clk)
+	a.scheduleChangeService = services.NewScheduleChangeService(scheduleChangeRepo, gameRepo, a.eventService)
+	if liveProviders != nil {
+		a.liveService = services.NewLiveService(liveProviders, gameRepo, externalIDRepo, playerStatsRepo, playerRepo, sportRepo, statConflictRepo, statProfiles, precedence, a.eventService, clk)
+		a.closers = append(a.closers, func() { a.liveService.Close() })
+	}
+	a.injuryService = services.NewInjuryService(injuryProvider, injuryRepo, playerRepo, gameRepo, externalIDRepo, a.eventService, clk)
+	a.closers = append(a.closers, func() { a.injuryService.Close() })
+	a.newsService = services.NewNewsService(newsRepo, playerRepo, teamRepo)
+	a.draftPickService = services.NewDraftPickService(draftPickRepo, teamRepo, playerRepo, clk)
+	a.externalIDService = services.NewExternalIDService(externalIDRepo, playerRepo, teamRepo, gameRepo)
+	a.seasonStatsService = services.NewSeasonStatsService(seasonStatsRepo, playerRepo)
+	a.projectionService = services.NewProjectionService(projectionRepo, playerRepo, playerStatsRepo)
+	a.adpService = services.NewADPService(adpRepo, playerRepo)
+	a.scheduleStrengthService = services.NewScheduleStrengthService(scheduleStrengthRepo, teamRepo, gameRepo)
+	a.ratingService = services.NewRatingService(gameRepo, teamRepo, oddsRepo)
+	a.simulationService = services.NewSimulationService(gameRepo, teamRepo, clk)
+	a.recordService = services.NewRecordService(recordRepo, gameRepo, teamRepo)
+	a.scoringService = services.NewScoringService(playerStatsRepo, playerRepo)
+	a.dfsService = services.NewDFSService(dfsRepo, playerRepo)
+	a.sportService = services.NewSportService(sportRepo)
+	a.queryMetricsService = services.NewQueryMetricsService(a.db)
+	a.schemaService = services.NewSchemaService(schemaRepo)
+	a.reconciliationService = services.NewReconciliationService(gameRepo, playerRepo, playerStatsRepo)
+	a.statConflictService = services.NewStatConflictService(statConflictRepo, gameRepo, precedence)
+	a.advancedStatsService = services.NewAdvancedStatsService(advancedStatsRepo, playerRepo, gameRepo)
+	a.nflverseService = services.NewNflverseService(externalIDRepo, playerRepo, gameRepo, playerStatsRepo, statConflictRepo, advancedStatsRepo, precedence)
+	a.rateLimitService = services.NewRateLimitService(readPerMinute, writePerMinute, clk)
+	a.mediaService = services.NewMediaService(teamRepo, playerRepo, a.store, clk)
+	a.seedService = services.NewSeedService(teamRepo, playerRepo, gameRepo, playerStatsRepo)
+	// A restored database replaces everything the lookup caches and coalesced writes hold
+	a.backupService = services.NewBackupService(database.DB, a.store, backupRetain, func() error {
+		for _, reset := range resetOnRestore {
+			reset()
+		}
+		return database.RunMigrations()
+	}, clk)
+	a.closers = append(a.closers, func() { a.backupService.Close() })
+	a.healthService = services.NewHealthService(a.db, database.Path, pendingMigrations)
+
+	a.analyticsService = services.NewAnalyticsService(analyticsRepo, analyticsEnabled, time.Minute, clk)
+	a.closers = append(a.closers, func() {
+		if err := a.analyticsService.Close(); err != nil {
+			log.Printf("Failed to flush usage analytics: %v", err)
+		}
+	})
+
+	// Closed first, so running jobs are interrupted before the stores they write to are flushed
+	// and closed. Workers are only started by serve, once migrations have run.
+	a.jobService = services.NewJobService(jobRepo, jobWorkers, jobQueueSize, clk)
+	a.importJobService = services.NewImportJobService(a.jobService, a.adpService, a.projectionService, a.dfsService, a.oddsService, a.nflverseService, a.advancedStatsService)
+	a.exportService = services.NewExportService(a.jobService, a.playerStatsService, a.store, clk)
+	a.notificationService = services.NewNotificationService(notificationRepo, a.jobService, a.eventService, newNotificationChannels())
+	a.closers = append(a.closers, func() {
+		if err := a.jobService.Close(); err != nil {
+			log.Printf("Failed to stop job workers: %v", err)
+		}
+	})
+
+	return a
+}
+
+// migrate brings the schema up to date
+func (a *app) migrate() {
+	if err := database.RunMigrations(); err != nil {
+		log.Fatal("Failed to run migrations:", err)
+	}
+}
+
+// Close flushes buffered writes and closes the database
+func (a *app) Close() {
+	for i := len(a.closers) - 1; i >= 0; i-- {
+		a.closers[i]()
+	}
+}
+
+// pendingMigrations counts the migrations not yet applied to the database
+func pendingMigrations() (int, error) {
+	states, err := database.MigrationStatus()
+	if err != nil {
+		return 0, err
+	}
+
+	pending := 0
+	for _, state := range states {
+		if !state.Applied {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// newStorage opens the storage backend named by STORAGE_BACKEND: a local directory, or an
+// S3-compatible bucket. Invalid configuration is fatal.
+func newStorage() storage.Storage {
+	switch backend := os.Getenv("STORAGE_BACKEND"); backend {
+	case "", "local":
+		dir := "./data"
+		if path := os.Getenv("STORAGE_DIR"); path != "" {
+			dir = path
+		}
+		store, err := storage.NewLocal(dir)
+		if err != nil {
+			log.Fatalf("Failed to open storage: %v", err)
+		}
+		return store
+	case "s3":
+		pathStyle := false
+		if value := os.Getenv("S3_PATH_STYLE"); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				log.Fatalf("Invalid S3_PATH_STYLE %q: must be true or false", value)
+			}
+			pathStyle = parsed
+		}
+		store, err := storage.NewS3(storage.S3Config{
+			Bucket:          os.Getenv("S3_BUCKET"),
+			Region:          os.Getenv("S3_REGION"),
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("S3_SESSION_TOKEN"),
+			Prefix:          os.Getenv("S3_PREFIX"),
+			PathStyle:       pathStyle,
+		})
+		if err != nil {
+			log.Fatalf("Invalid S3 storage configuration: %v", err)
+		}
+		return store
+	default:
+		log.Fatalf("Invalid STORAGE_BACKEND %q: must be local or s3", backend)
+		return nil
+	}
+}
+
+// newNotificationChannels configures the channels notifications can be sent over. Webhooks
+// are always available, only to public addresses unless WEBHOOK_ALLOW_PRIVATE is set; email
+// needs SMTP_HOST and SMTP_FROM, and push needs PUSH_PROVIDER. Invalid configuration is fatal.
+func newNotificationChannels() map[string]notify.Channel {
+	allowPrivate := false
+	if allow := os.Getenv("WEBHOOK_ALLOW_PRIVATE"); allow != "" {
+		parsed, err := strconv.ParseBool(allow)
+		if err != nil {
+			log.Fatalf("Invalid WEBHOOK_ALLOW_PRIVATE %q: must be true or false", allow)
+		}
+		allowPrivate = parsed
+	}
+	channels := map[string]notify.Channel{models.NotificationChannelWebhook: notify.NewWebhook(allowPrivate)}
+
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		email, err := notify.NewSMTP(notify.SMTPConfig{
+			Host:     host,
+			Port:     positiveIntEnv("SMTP_PORT", 587),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("SMTP_FROM"),
+		})
+		if err != nil {
+			log.Fatalf("Invalid SMTP configuration: %v", err)
+		}
+		channels[models.NotificationChannelEmail] = email
+	}
+
+	switch provider := os.Getenv("PUSH_PROVIDER"); provider {
+	case "":
+	case "ntfy":
+		server := "https://ntfy.sh"
+		if url := os.Getenv("NTFY_URL"); url != "" {
+			server = url
+		}
+		push, err := notify.NewNtfy(server, os.Getenv("NTFY_TOKEN"))
+		if err != nil {
+			log.Fatalf("Invalid ntfy configuration: %v", err)
+		}
+		channels[models.NotificationChannelPush] = push
+	default:
+		log.Fatalf("Invalid PUSH_PROVIDER %q: must be ntfy", provider)
+	}
+
+	return channels
+}
+
+// nonNegativeIntEnv reads a whole number setting, 0 when it is unset. An invalid value is fatal.
+func nonNegativeIntEnv(name string) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		log.Fatalf("Invalid %s %q: must be a whole number, or 0 for no limit", name, value)
+	}
+	return n
+}
+
+// nonNegativeIntEnvDefault reads a whole number setting, using def when it is unset. An invalid
+// value is fatal.
+func nonNegativeIntEnvDefault(name string, def int) int {
+	if os.Getenv(name) == "" {
+		return def
+	}
+	return nonNegativeIntEnv(name)
+}
+
+// durationEnv reads a duration setting, using def when it is unset. An invalid value is fatal.
+func durationEnv(name string, def time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		log.Fatalf("Invalid %s %q: must be a duration such as 5m, or 0 for no limit", name, value)
+	}
+	return d
+}
+
+// positiveIntEnv reads a positive integer setting, using def when it is unset. An invalid value is fatal.
+func positiveIntEnv(name string, def int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		log.Fatalf("Invalid %s %q: must be a positive whole number", name, value)
+	}
+	return n
+}
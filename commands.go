@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"sports-backend/database"
+	"sports-backend/models"
+	"sports-backend/services"
+)
+
+// runMigrate applies the migrations or reports their status
+func runMigrate(args []string) {
+	const synopsis = "up|down|status"
+	args = parseFlags("migrate", synopsis, "Apply the schema migrations, or list which are applied.", args)
+	if len(args) != 1 {
+		usageError("migrate", synopsis, "migrate takes exactly one action")
+	}
+
+	switch args[0] {
+	case "up":
+		if err := database.InitDB(); err != nil {
+			log.Fatal("Failed to initialize database:", err)
+		}
+		defer database.CloseDB()
+		if err := database.RunMigrations(); err != nil {
+			log.Fatal("Failed to run migrations:", err)
+		}
+	case "status":
+		if err := database.InitDB(); err != nil {
+			log.Fatal("Failed to initialize database:", err)
+		}
+		defer database.CloseDB()
+		states, err := database.MigrationStatus()
+		if err != nil {
+			log.Fatal("Failed to check migrations:", err)
+		}
+		pending := 0
+		for _, state := range states {
+			status := "applied"
+			if !state.Applied {
+				status = "pending"
+				pending++
+			}
+			fmt.Printf("%-8s %s\n", status, state.Name)
+		}
+		fmt.Printf("\n%d of %d migrations pending\n", pending, len(states))
+	case "down":
+		// Migrations only ever add tables, columns and indexes and are safe to rerun, so there
+		// is nothing to reverse them with
+		log.Fatal("Migrations are forward-only; restore a backup of the database to roll back")
+	default:
+		usageError("migrate", synopsis, "Unknown migrate action %q", args[0])
+	}
+}
+
+// runSeed migrates the database and loads the sample data
+func runSeed(args []string) {
+	if args = parseFlags("seed", "", "Load sample teams, rosters, schedule and box scores into an empty database.", args); len(args) > 0 {
+		usageError("seed", "", "seed takes no arguments")
+	}
+
+	a := newApp()
+	defer a.Close()
+	a.migrate()
+
+	result, err := a.seedService.Seed()
+	if err != nil {
+		log.Fatalf("Failed to load sample data: %v", err)
+	}
+	log.Printf("Loaded %d teams, %d players, %d games (%d completed) and %d stat lines for the %s season",
+		result.Teams, result.Players, result.Games, result.CompletedGames, result.StatLines, result.Season)
+}
+
+// nflverseBatchSize is how many stat lines an nflverse import writes in one transaction
+const nflverseBatchSize = 500
+
+// runImport migrates the database and bulk imports a CSV file through the same service, and
+// with the same validation, as the import endpoint for its kind
+func runImport(args []string) {
+	const synopsis = "csv projections|adp|dfs-salaries|advanced-stats|nflverse-stats FILE"
+	args = parseFlags("import", synopsis, "Bulk import a CSV file whose header names the fields of the JSON import body, "+
+		"or an nflverse weekly player stats or play-by-play file for nflverse-stats.", args)
+	if len(args) != 3 || args[0] != "csv" {
+		usageError("import", synopsis, "import takes the csv format, a kind and a file")
+	}
+	kind, path := args[1], args[2]
+	if kind != "projections" && kind != "adp" && kind != "dfs-salaries" && kind != models.JobImportAdvanced && kind != models.JobImportNflverse {
+		usageError("import", synopsis, "Unknown import kind %q", kind)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", path, err)
+	}
+	defer file.Close()
+
+	a := newApp()
+	defer a.Close()
+	a.migrate()
+
+	var result *models.ImportResult
+	switch kind {
+	case "projections":
+		var reqs []*models.CreateProjectionRequest
+		if reqs, err = services.DecodeCSV[models.CreateProjectionRequest](file); err == nil {
+			result, err = a.projectionService.ImportProjections(reqs)
+		}
+	case "adp":
+		var reqs []*models.CreateADPRequest
+		if reqs, err = services.DecodeCSV[models.CreateADPRequest](file); err == nil {
+			result, err = a.adpService.ImportADP(reqs)
+		}
+	case "dfs-salaries":
+		var reqs []*models.CreateDFSSalaryRequest
+		if reqs, err = services.DecodeCSV[models.CreateDFSSalaryRequest](file); err == nil {
+			result, err = a.dfsService.ImportSalaries(reqs)
+		}
+	case models.JobImportAdvanced:
+		var reqs []*models.CreateAdvancedStatsRequest
+		if reqs, err = services.DecodeCSV[models.CreateAdvancedStatsRequest](file); err == nil {
+			result, err = a.advancedStatsService.ImportAdvancedStats(reqs)
+		}
+	case models.JobImportNflverse:
+		var lines []*models.NflverseStatLine
+		if lines, err = services.DecodeNflverseCSV(file); err == nil {
+			result, err = importNflverseStats(a, lines)
+		}
+	}
+	if err != nil {
+		log.Fatalf("Failed to import %s: %v", path, err)
+	}
+
+	log.Printf("Imported %s: %d created, %d updated, %d skipped", path, result.Created, result.Updated, result.Skipped)
+}
+
+// importNflverseStats writes nflverse stat lines batch by batch, logging progress, since a
+// season of them takes a while. Batches written before a failure stay written; importing the
+// file again replaces them.
+func importNflverseStats(a *app, lines []*models.NflverseStatLine) (*models.ImportResult, error) {
+	result := &models.ImportResult{}
+	for start := 0; start < len(lines); start += nflverseBatchSize {
+		end := min(start+nflverseBatchSize, len(lines))
+		batch, err := a.nflverseService.ImportStatLines(lines[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("stat lines %d-%d: %w", start, end-1, err)
+		}
+		result.Created += batch.Created
+		result.Updated += batch.Updated
+		result.Skipped += batch.Skipped
+		log.Printf("Loaded %d of %d stat lines (%d skipped)", end, len(lines), result.Skipped)
+	}
+	return result, nil
+}
+
+// runBackup takes a backup or lists the backups
+func runBackup(args []string) {
+	const synopsis = "create|list"
+	args = parseFlags("backup", synopsis, "Back up the database to storage, or list the backups there.", args)
+	if len(args) != 1 {
+		usageError("backup", synopsis, "backup takes exactly one action")
+	}
+
+	a := newApp()
+	defer a.Close()
+
+	switch args[0] {
+	case "create":
+		backup, err := a.backupService.CreateBackup()
+		if err != nil {
+			log.Fatalf("Failed to back up database: %v", err)
+		}
+		log.Printf("Backed up the database to %s (%d bytes)", backup.Name, backup.Size)
+	case "list":
+		backups, err := a.backupService.ListBackups()
+		if err != nil {
+			log.Fatalf("Failed to list backups: %v", err)
+		}
+		for _, backup := range backups {
+			fmt.Printf("%s  %10d bytes\n", backup.Name, backup.Size)
+		}
+		fmt.Printf("\n%d backups\n", len(backups))
+	default:
+		usageError("backup", synopsis, "Unknown backup action %q", args[0])
+	}
+}
+
+// runRestore replaces the database with a backup and migrates it
+func runRestore(args []string) {
+	const synopsis = "NAME"
+	args = parseFlags("restore", synopsis, "Replace the database with a backup from storage. The current contents are backed up first.", args)
+	if len(args) != 1 {
+		usageError("restore", synopsis, "restore takes the name of one backup")
+	}
+
+	a := newApp()
+	defer a.Close()
+
+	result, err := a.backupService.RestoreBackup(args[0])
+	if err != nil {
+		log.Fatalf("Failed to restore backup: %v", err)
+	}
+	log.Printf("Restored %s; the previous contents were backed up to %s", result.Restored, result.SafetyBackup.Name)
+}
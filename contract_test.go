@@ -0,0 +1,487 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/testutil"
+)
+
+// contractStart is the time the contract suite runs at: the Tuesday after week 3 of the sample
+// season, with weeks 1 to 3 played
+var contractStart = time.Date(2026, 9, 29, 12, 0, 0, 0, time.UTC)
+
+// contractMask holds the fields masked in every golden file
+var contractMask = []string{"created_at", "updated_at", "changed_at"}
+
+// contractStep is one request of the contract suite and the response it is checked against
+type contractStep struct {
+	name   string // golden file under testdata/contract, and the subtest name
+	method string
+	path   string
+	body   string
+	upload bool     // send a PNG as the multipart form's file instead of body
+	status int      // expected status code
+	mask   []string // fields masked besides contractMask
+	// For responses that aren't JSON: the Content-Type they are served with, and text the body
+	// must contain. The body isn't compared with a golden file.
+	contentType string
+	contains    string
+	job         bool   // the response is a job; wait for it to finish and compare it as <name>-done
+	saveName    string // placeholder later steps use for the name in the response, or its first item
+}
+
+// contractSteps covers every endpoint, in an order where each step can rely on what earlier
+// steps created. IDs past the sample data's (32 teams, 256 players, 272 games, 768 stat lines)
+// were created by an earlier step.
+var contractSteps = []contractStep{
+	{name: "health/livez", method: "GET", path: "/livez", status: 200},
+	{name: "health/readyz", method: "GET", path: "/readyz", status: 200},
+	{name: "health/health", method: "GET", path: "/health", status: 200},
+	{name: "admin/seed", method: "POST", path: "/api/admin/seed", status: 201},
+	{name: "admin/seed-again", method: "POST", path: "/api/admin/seed", status: 409, contentType: "text/plain", contains: "only be loaded into an empty database"},
+
+	// Sports and metadata
+	{name: "sports/list", method: "GET", path: "/api/sports", status: 200},
+	{name: "sports/get", method: "GET", path: "/api/sports/football", status: 200},
+	{name: "meta/schema", method: "GET", path: "/api/meta/schema", status: 200},
+	{name: "meta/stats", method: "GET", path: "/api/meta/stats", status: 200},
+
+	// Teams
+	{name: "teams/list", method: "GET", path: "/api/v1/teams", status: 200},
+	{name: "teams/get", method: "GET", path: "/api/teams/1", status: 200},
+	{name: "teams/get-invalid-id", method: "GET", path: "/api/teams/abc", status: 400, contentType: "text/plain", contains: `Invalid team ID "abc"`},
+	{name: "teams/get-missing", method: "GET", path: "/api/teams/999", status: 404, contentType: "text/plain", contains: "not found"},
+	{name: "teams/create", method: "POST", path: "/api/teams", body: `{"name":"Rush","city":"Portland","conference":"NFC","division":"West","external_ids":{"espn":"900"}}`, status: 201},
+	{name: "teams/create-invalid", method: "POST", path: "/api/teams", body: `{"name":"Rush","city":"Portland","conference":"XFL","division":"West"}`, status: 400, contentType: "text/plain", contains: "conference must be one of"},
+	{name: "teams/update", method: "PUT", path: "/api/teams/33", body: `{"city":"Salem"}`, status: 200},
+	{name: "teams/external-ids", method: "GET", path: "/api/teams/33/external-ids", status: 200},
+	{name: "teams/games", method: "GET", path: "/api/teams/1/games", status: 200},
+	{name: "teams/games-won", method: "GET", path: "/api/teams/1/games?result=win", status: 200},
+	{name: "teams/schedule-calendar", method: "GET", path: "/api/teams/1/schedule.ics", status: 200, contentType: "text/calendar", contains: "BEGIN:VCALENDAR"},
+	{name: "teams/schedule-strength", method: "GET", path: "/api/teams/1/schedule-strength?position=WR", status: 200},
+	{name: "teams/ratings", method: "GET", path: "/api/teams/1/ratings", status: 200},
+	{name: "teams/stats", method: "GET", path: "/api/teams/1/stats", status: 501, contentType: "text/plain", contains: "Not implemented"},
+	{name: "teams/create-stats", method: "POST", path: "/api/teams/1/stats", body: `{}`, status: 501, contentType: "text/plain", contains: "Not implemented"},
+	{name: "teams/upload-logo", method: "POST", path: "/api/teams/1/logo", upload: true, status: 200},
+	{name: "teams/get-logo", method: "GET", path: "/api/teams/1/logo", status: 200, contentType: "image/png"},
+	{name: "teams/delete-logo", method: "DELETE", path: "/api/teams/1/logo", status: 204},
+	{name: "teams/delete", method: "DELETE", path: "/api/teams/33", status: 204},
+	{name: "teams/get-deleted", method: "GET", path: "/api/teams/33", status: 404, contentType: "text/plain", contains: "not found"},
+	{name: "teams/restore", method: "POST", path: "/api/teams/33/restore", status: 200},
+
+	// Players
+	{name: "players/list", method: "GET", path: "/api/players", status: 200},
+	{name: "players/get", method: "GET", path: "/api/players/1", status: 200},
+	{name: "players/get-include", method: "GET", path: "/api/players/1?include=team,stats", status: 200},
+	{name: "players/get-unknown-include", method: "GET", path: "/api/players/1?include=odds", status: 400, contentType: "text/plain", contains: "include must be one of"},
+	{name: "players/create", method: "POST", path: "/api/players", body: `{"team_id":1,"first_name":"Marcus","last_name":"Reed","position":"WR","jersey_number":88,"height":73,"weight":198}`, status: 201},
+	{name: "players/create-duplicate", method: "POST", path: "/api/players", body: `{"team_id":1,"first_name":"Caleb","last_name":"Collins","position":"QB"}`, status: 201},
+	{name: "players/create-invalid", method: "POST", path: "/api/players", body: `{"team_id":1,"first_name":"Marcus","last_name":"Reed","position":"Goalie"}`, status: 400, contentType: "text/plain", contains: "position must be one of"},
+	{name: "players/update", method: "PUT", path: "/api/players/257", body: `{"jersey_number":11}`, status: 200},
+	{name: "players/stats", method: "GET", path: "/api/players/1/stats", status: 200},
+	{name: "players/create-stats", method: "POST", path: "/api/players/257/stats", body: `{"game_id":1,"receptions":4,"receiving_yards":52,"receiving_targets":6}`, status: 201},
+	{name: "players/update-stats", method: "PUT", path: "/api/players/257/stats/769", body: `{"receptions":5,"receiving_yards":61}`, status: 200},
+	{name: "players/upsert-game-stats", method: "PUT", path: "/api/players/257/games/1/stats", body: `{"receptions":6,"receiving_yards":70,"receiving_touchdowns":1}`, status: 200},
+	{name: "players/delete-stats", method: "DELETE", path: "/api/players/257/stats/769", status: 204},
+	{name: "players/duplicates", method: "GET", path: "/api/admin/players/duplicates", status: 200},
+	{name: "players/merge", method: "POST", path: "/api/admin/players/1/merge/258", status: 200},
+	{name: "players/backfill", method: "POST", path: "/api/admin/players/backfill", body: `[{"player_id":257,"college":"Oregon","birth_date":"2001-04-12"}]`, status: 200},
+	{name: "players/season-stats", method: "GET", path: "/api/players/1/season-stats", status: 200},
+	{name: "players/season", method: "GET", path: "/api/players/1/season-stats/2026", status: 200},
+	{name: "players/career", method: "GET", path: "/api/players/1/career", status: 200},
+	{name: "players/upload-headshot", method: "POST", path: "/api/players/1/headshot", upload: true, status: 200},
+	{name: "players/get-headshot", method: "GET", path: "/api/players/1/headshot", status: 200, contentType: "image/jpeg"},
+	{name: "players/delete-headshot", method: "DELETE", path: "/api/players/1/headshot", status: 204},
+	{name: "players/delete", method: "DELETE", path: "/api/players/257", status: 204},
+	{name: "players/restore", method: "POST", path: "/api/players/257/restore", status: 200},
+
+	// Stats
+	{name: "player-stats/list", method: "GET", path: "/api/player-stats?limit=3", status: 200},
+	{name: "player-stats/export", method: "GET", path: "/api/player-stats/export?season=2026&format=ndjson", status: 200, contentType: "application/x-ndjson", contains: `"player_id":1,`},
+	{name: "season-stats/leaders", method: "GET", path: "/api/season-stats/2026/leaders?stat=passing_yards&limit=3", status: 200},
+	{name: "season-stats/leaders-per-game", method: "GET", path: "/api/season-stats/2026/leaders?stat=rushing_yards&position=RB&per_game=true&limit=3", status: 200},
+	{name: "admin/rebuild-season-stats", method: "POST", path: "/api/admin/season-stats/rebuild", status: 200},
+	{name: "highlights/week", method: "GET", path: "/api/highlights?season=2026&week=1", status: 200},
+	{name: "records/list", method: "GET", path: "/api/records", status: 200},
+	{name: "stat-conflicts/list", method: "GET", path: "/api/stat-conflicts", status: 200},
+	{name: "search/players-and-teams", method: "GET", path: "/api/search?q=coll", status: 200},
+
+	// Games
+	{name: "games/list", method: "GET", path: "/api/games", status: 200},
+	{name: "games/get", method: "GET", path: "/api/games/1", status: 200},
+	{name: "games/get-include", method: "GET", path: "/api/games/1?include=home_team,away_team,player_stats", status: 200},
+	{name: "games/season", method: "GET", path: "/api/games/season/2026?game_type=wildcard", status: 200},
+	{name: "games/week", method: "GET", path: "/api/games/season/2026/week/1", status: 200},
+	{name: "games/create", method: "POST", path: "/api/games", body: `{"home_team_id":1,"away_team_id":2,"season":"2026","week":18,"game_date":"2027-01-10T18:00:00Z"}`, status: 201},
+	{name: "games/create-invalid", method: "POST", path: "/api/games", body: `{"home_team_id":1,"away_team_id":1,"season":"2026","week":18,"game_date":"2027-01-10T18:00:00Z"}`, status: 400, contentType: "text/plain", contains: "cannot be the same"},
+	{name: "games/update", method: "PUT", path: "/api/games/273", body: `{"neutral_site":true}`, status: 200},
+	{name: "games/reschedule", method: "POST", path: "/api/games/273/reschedule", body: `{"game_date":"2027-01-10T21:25:00Z","reason":"Flexed to the late window"}`, status: 201},
+	{name: "games/schedule-changes", method: "GET", path: "/api/games/273/schedule-changes", status: 200},
+	{name: "schedule-changes/list", method: "GET", path: "/api/schedule-changes", status: 200},
+	{name: "games/reconciliation", method: "GET", path: "/api/games/1/reconciliation", status: 200},
+	{name: "games/external-ids", method: "GET", path: "/api/games/1/external-ids", status: 200},
+	{name: "games/win-probability", method: "GET", path: "/api/games/49/win-probability", status: 200},
+	{name: "games/delete", method: "DELETE", path: "/api/games/273", status: 204},
+	{name: "games/restore", method: "POST", path: "/api/games/273/restore", status: 200},
+	{name: "games/delete-again", method: "DELETE", path: "/api/games/273", status: 204},
+	{name: "games/purge", method: "DELETE", path: "/api/admin/games/273", status: 204},
+	{name: "ratings/list", method: "GET", path: "/api/ratings?season=2026", status: 200},
+	{name: "ratings/predictions", method: "GET", path: "/api/ratings/predictions?season=2026&week=4", status: 200},
+	{name: "seasons/simulate", method: "POST", path: "/api/seasons/2026/simulate", body: `{"iterations":200,"seed":7}`, status: 200},
+
+	// Odds
+	{name: "odds/create", method: "POST", path: "/api/games/49/odds", body: `{"spread":-3.5,"total":47.5,"home_moneyline":-175,"away_moneyline":150,"source":"consensus"}`, status: 201},
+	{name: "odds/import", method: "POST", path: "/api/odds", body: `[{"game_id":49,"spread":-3,"total":46.5,"source":"draftkings","captured_at":"2026-09-29T10:00:00Z"}]`, status: 201},
+	{name: "odds/game", method: "GET", path: "/api/games/49/odds", status: 200},
+
+	// Venues
+	{name: "venues/create", method: "POST", path: "/api/venues", body: `{"name":"Harbor Field","city":"Portland","country":"USA","surface":"turf","roof_type":"dome","capacity":65000,"timezone":"America/Los_Angeles","latitude":45.52,"longitude":-122.68}`, status: 201},
+	{name: "venues/list", method: "GET", path: "/api/venues", status: 200},
+	{name: "venues/get", method: "GET", path: "/api/venues/1", status: 200},
+	{name: "venues/update", method: "PUT", path: "/api/venues/1", body: `{"capacity":68000}`, status: 200},
+	{name: "venues/delete", method: "DELETE", path: "/api/venues/1", status: 204},
+
+	// Draft picks
+	{name: "draft-picks/create", method: "POST", path: "/api/draft-picks", body: `{"year":2024,"round":1,"pick":10,"team_id":1,"player_id":1}`, status: 201},
+	{name: "draft-picks/list", method: "GET", path: "/api/draft-picks?year=2024", status: 200},
+	{name: "draft-picks/get", method: "GET", path: "/api/draft-picks/1", status: 200},
+	{name: "draft-picks/update", method: "PUT", path: "/api/draft-picks/1", body: `{"pick":11}`, status: 200},
+	{name: "draft-picks/team", method: "GET", path: "/api/teams/1/draft-picks", status: 200},
+	{name: "draft-picks/player", method: "GET", path: "/api/players/1/draft-info", status: 200},
+	{name: "draft-picks/delete", method: "DELETE", path: "/api/draft-picks/1", status: 204},
+
+	// External IDs
+	{name: "external-ids/create", method: "POST", path: "/api/external-ids", body: `{"entity_type":"player","entity_id":1,"provider":"espn","external_id":"3139477"}`, status: 201},
+	{name: "external-ids/lookup", method: "GET", path: "/api/external-ids/lookup?entity_type=player&provider=espn&external_id=3139477", status: 200},
+	{name: "external-ids/player", method: "GET", path: "/api/players/1/external-ids", status: 200},
+	{name: "external-ids/delete", method: "DELETE", path: "/api/external-ids/2", status: 204},
+
+	// Projections and ADP
+	{name: "projections/import", method: "POST", path: "/api/projections", body: `[{"player_id":1,"season":"2026","week":1,"source":"espn","stats":{"passing_yards":280,"passing_touchdowns":2}},{"player_id":2,"season":"2026","week":1,"source":"espn","points":21.5}]`, status: 200},
+	{name: "projections/save", method: "POST", path: "/api/players/1/projections", body: `{"season":"2026","week":4,"source":"sleeper","points":19.5}`, status: 201},
+	{name: "projections/player", method: "GET", path: "/api/players/1/projections", status: 200},
+	{name: "projections/weekly-leaders", method: "GET", path: "/api/projections/season/2026/week/1", status: 200},
+	{name: "projections/accuracy", method: "GET", path: "/api/projections/accuracy?season=2026&group_by=position", status: 200},
+	{name: "projections/delete", method: "DELETE", path: "/api/projections/2", status: 204},
+	{name: "adp/import", method: "POST", path: "/api/adp", body: `[{"player_id":1,"season":"2026","format":"ppr","source":"sleeper","adp":24.5},{"player_id":1,"season":"2026","format":"ppr","source":"espn","adp":20.5}]`, status: 200},
+	{name: "adp/player", method: "GET", path: "/api/players/1/adp", status: 200},
+	{name: "adp/draft-board", method: "GET", path: "/api/players?sort=adp&format=ppr&season=2026", status: 200},
+
+	// Advanced stats
+	{name: "advanced-stats/import", method: "POST", path: "/api/advanced-stats", body: `[{"player_id":3,"game_id":1,"source":"ngs","offense_snaps":58,"offense_snap_share":0.92,"routes_run":34,"air_yards":120,"target_share":0.28}]`, status: 200},
+	{name: "advanced-stats/player", method: "GET", path: "/api/players/3/advanced-stats", status: 200},
+	{name: "advanced-stats/player-season", method: "GET", path: "/api/players/3/advanced-stats/2026", status: 200},
+	{name: "advanced-stats/game", method: "GET", path: "/api/games/1/advanced-stats", status: 200},
+	{name: "advanced-stats/leaders", method: "GET", path: "/api/advanced-stats/2026/leaders?stat=air_yards", status: 200},
+
+	// Scoring and DFS
+	{name: "scoring/validate", method: "POST", path: "/api/scoring/validate", body: `{"rules":[{"name":"100-yard bonus","expression":"rushing_yards >= 100 ? 3 : 0"}]}`, status: 200},
+	{name: "scoring/evaluate", method: "POST", path: "/api/scoring/evaluate", body: `{"stats_id":1,"rules":[{"name":"passing","expression":"passing_yards / 25"},{"name":"fumbles","expression":"-1 * fumbles_lost"}]}`, status: 200},
+	{name: "dfs/import-salaries", method: "POST", path: "/api/dfs/salaries", body: `[{"player_id":1,"season":"2026","week":4,"site":"draftkings","salary":8200},{"player_id":2,"season":"2026","week":4,"site":"draftkings","salary":7400}]`, status: 200},
+	{name: "dfs/slate", method: "GET", path: "/api/dfs/salaries/season/2026/week/4?site=draftkings", status: 200},
+	{name: "dfs/validate-lineup", method: "POST", path: "/api/dfs/lineups/validate", body: `{"season":"2026","week":4,"site":"draftkings","slots":["QB","RB"],"player_ids":[1,2]}`, status: 200},
+	{name: "dfs/optimize-lineup", method: "POST", path: "/api/dfs/lineups/optimize", body: `{"season":"2026","week":4,"site":"draftkings","slots":["QB","RB"],"locked":[2]}`, status: 200},
+
+	// Injuries and news
+	{name: "injuries/report", method: "POST", path: "/api/injuries/report", body: `{"season":"2026","week":4,"players":[{"player_id":2,"status":"questionable","injury":"Hamstring"}]}`, status: 200},
+	{name: "injuries/list", method: "GET", path: "/api/injuries", status: 200},
+	{name: "injuries/player", method: "GET", path: "/api/players/2/injuries", status: 200},
+	{name: "news/import", method: "POST", path: "/api/news", body: `[{"headline":"Collins limited at practice","source_url":"https://news.example.com/collins-practice","published_at":"2026-09-28T16:30:00Z","player_ids":[1],"team_ids":[1]}]`, status: 200},
+	{name: "news/list", method: "GET", path: "/api/news", status: 200},
+	{name: "news/get", method: "GET", path: "/api/news/1", status: 200},
+	{name: "news/player", method: "GET", path: "/api/players/1/news?limit=5", status: 200},
+	{name: "news/team", method: "GET", path: "/api/teams/1/news", status: 200},
+	{name: "news/delete", method: "DELETE", path: "/api/news/1", status: 204},
+
+	// Notifications
+	{name: "notifications/create", method: "POST", path: "/api/notifications/subscriptions", mask: []string{"target"}, body: `{"name":"Schedule bot","channel":"webhook","target":"{webhook}/schedule","event_types":["game.rescheduled"]}`, status: 201},
+	{name: "notifications/list", method: "GET", path: "/api/notifications/subscriptions", status: 200, mask: []string{"target"}},
+	{name: "notifications/get", method: "GET", path: "/api/notifications/subscriptions/1", status: 200, mask: []string{"target"}},
+	{name: "notifications/update", method: "PUT", path: "/api/notifications/subscriptions/1", body: `{"enabled":false}`, status: 200, mask: []string{"target"}},
+	{name: "notifications/test", method: "POST", path: "/api/notifications/subscriptions/1/test", status: 202, job: true},
+	{name: "notifications/delete", method: "DELETE", path: "/api/notifications/subscriptions/1", status: 204},
+
+	// Background jobs and exports
+	{name: "jobs/import", method: "POST", path: "/api/imports/adp", body: `[{"player_id":2,"season":"2026","format":"ppr","source":"sleeper","adp":30.5}]`, status: 202, job: true},
+	{name: "jobs/list", method: "GET", path: "/api/jobs", status: 200},
+	{name: "jobs/get", method: "GET", path: "/api/jobs/2", status: 200},
+	{name: "jobs/cancel-finished", method: "POST", path: "/api/jobs/2/cancel", status: 409, contentType: "text/plain", contains: "validation failed"},
+	{name: "exports/submit", method: "POST", path: "/api/exports/player-stats?season=2026", status: 202, job: true},
+	{name: "exports/list", method: "GET", path: "/api/exports", status: 200, saveName: "{export}"},
+	{name: "exports/download", method: "GET", path: "/api/exports/{export}", status: 200, contentType: "text/csv", contains: "player_id"},
+
+	// Admin
+	// Query timings and usage counts depend on how often the suite polled its jobs
+	{name: "admin/queries", method: "GET", path: "/api/admin/queries", status: 200, contentType: "application/json", contains: `"queries"`},
+	{name: "admin/rate-limits", method: "GET", path: "/api/admin/rate-limits", status: 200},
+	{name: "admin/reset-rate-limits", method: "DELETE", path: "/api/admin/rate-limits", status: 200},
+	{name: "admin/reset-client-rate-limit", method: "DELETE", path: "/api/admin/rate-limits/203.0.113.7", status: 404, contentType: "text/plain", contains: "not found"},
+	{name: "admin/analytics", method: "GET", path: "/api/admin/analytics?days=7", status: 200, contentType: "application/json", contains: `"/teams/{id}"`},
+	{name: "admin/delete-player", method: "DELETE", path: "/api/players/257", status: 204},
+	{name: "admin/purge-player", method: "DELETE", path: "/api/admin/players/257", status: 204},
+	{name: "admin/delete-team", method: "DELETE", path: "/api/teams/33", status: 204},
+	{name: "admin/purge-team", method: "DELETE", path: "/api/admin/teams/33", status: 204},
+	{name: "admin/create-backup", method: "POST", path: "/api/admin/backups", status: 201, saveName: "{backup}"},
+	{name: "admin/backups", method: "GET", path: "/api/admin/backups", status: 200},
+	{name: "admin/restore-backup", method: "POST", path: "/api/admin/backups/{backup}/restore", status: 200},
+}
+
+// newContractServer serves the app on a new database, with the clock stopped at contractStart
+func newContractServer(t *testing.T) (*httptest.Server, *clock.Fake) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("DB_PATH", path.Join(dir, "contract.db"))
+	t.Setenv("STORAGE_DIR", path.Join(dir, "storage"))
+	t.Setenv("DEV_MODE", "true")
+	// The suite's webhook listens on loopback
+	t.Setenv("WEBHOOK_ALLOW_PRIVATE", "true")
+
+	// The app's logging would drown out test failures
+	output := log.Writer()
+	log.SetOutput(io.Discard)
+	clk := clock.NewFake(contractStart)
+	appClock = clk
+	t.Cleanup(func() {
+		appClock = clock.System
+		log.SetOutput(output)
+	})
+
+	a := newApp()
+	a.migrate()
+	if err := a.jobService.Start(); err != nil {
+		t.Fatalf("failed to start job workers: %v", err)
+	}
+	server := httptest.NewUnstartedServer(a.newRouter())
+	server.Config.RegisterOnShutdown(a.eventService.Close)
+	server.Start()
+	t.Cleanup(func() {
+		a.eventService.Close()
+		server.Close()
+		a.Close()
+	})
+	return server, clk
+}
+
+// TestContract sends each of contractSteps in order to a server on the sample data, checking
+// each response's status and comparing its body with testdata/contract/<step>.golden
+func TestContract(t *testing.T) {
+	server, clk := newContractServer(t)
+
+	// Subscriptions post to a webhook that accepts everything
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer webhook.Close()
+
+	// Values only known once the suite runs, replaced in each step's path and body
+	placeholders := map[string]string{"{webhook}": webhook.URL}
+	for _, step := range contractSteps {
+		for placeholder, value := range placeholders {
+			step.path = strings.ReplaceAll(step.path, placeholder, value)
+			step.body = strings.ReplaceAll(step.body, placeholder, value)
+		}
+		// Every step runs a second later, so anything named for the time, such as a backup, is unique
+		clk.Advance(time.Second)
+
+		ok := t.Run(step.name, func(t *testing.T) {
+			status, contentType, body := send(t, server, step)
+			if status != step.status {
+				t.Fatalf("%s %s status = %d, want %d: %s", step.method, step.path, status, step.status, body)
+			}
+
+			if status == http.StatusNoContent {
+				if len(body) != 0 {
+					t.Errorf("body = %q, want none", body)
+				}
+				return
+			}
+			if step.contentType != "" {
+				if !strings.HasPrefix(contentType, step.contentType) {
+					t.Errorf("Content-Type = %q, want %s", contentType, step.contentType)
+				}
+				if !bytes.Contains(body, []byte(step.contains)) {
+					t.Errorf("body = %q, want it to contain %q", body, step.contains)
+				}
+				return
+			}
+
+			mask := append(append([]string{}, contractMask...), step.mask...)
+			testutil.Golden(t, path.Join("contract", step.name), body, mask...)
+
+			if step.saveName != "" {
+				var named struct {
+					Name string `json:"name"`
+				}
+				if bytes.HasPrefix(body, []byte("[")) {
+					var items []json.RawMessage
+					if err := json.Unmarshal(body, &items); err == nil && len(items) > 0 {
+						body = items[0]
+					}
+				}
+				if err := json.Unmarshal(body, &named); err != nil || named.Name == "" {
+					t.Fatalf("response has no name: %s", body)
+				}
+				placeholders[step.saveName] = named.Name
+			}
+
+			if step.job {
+				var job struct {
+					ID int `json:"id"`
+				}
+				if err := json.Unmarshal(body, &job); err != nil || job.ID == 0 {
+					t.Fatalf("response is not a job: %s", body)
+				}
+				testutil.Golden(t, path.Join("contract", step.name+"-done"), waitForJob(t, server, job.ID), mask...)
+			}
+		})
+		// Later steps rely on what earlier ones created, so a failure would cascade
+		if !ok {
+			t.Fatalf("stopping at the first failed step")
+		}
+	}
+}
+
+// send makes a step's request, returning the response's status, Content-Type and body
+func send(t *testing.T, server *httptest.Server, step contractStep) (int, string, []byte) {
+	t.Helper()
+
+	body, contentType := io.Reader(strings.NewReader(step.body)), "application/json"
+	if step.upload {
+		body, contentType = pngForm(t)
+	}
+	req, err := http.NewRequest(step.method, server.URL+step.path, body)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	if step.body != "" || step.upload {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", step.method, step.path, err)
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("failed to read response: %v", err)
+	}
+	return res.StatusCode, res.Header.Get("Content-Type"), data
+}
+
+// pngForm is a multipart form holding a small PNG as its file, and the form's Content-Type
+func pngForm(t *testing.T) (io.Reader, string) {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	for x := 0; x < 64; x++ {
+		for y := 0; y < 64; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 4), G: 40, B: uint8(y * 4), A: 255})
+		}
+	}
+
+	var form bytes.Buffer
+	writer := multipart.NewWriter(&form)
+	part, err := writer.CreateFormFile("file", "image.png")
+	if err != nil {
+		t.Fatalf("failed to create form file: %v", err)
+	}
+	if err := png.Encode(part, img); err != nil {
+		t.Fatalf("failed to encode PNG: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("failed to close form: %v", err)
+	}
+	return &form, writer.FormDataContentType()
+}
+
+// waitForJob polls a job until it finishes, returning its final state
+func waitForJob(t *testing.T, server *httptest.Server, id int) []byte {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		status, _, body := send(t, server, contractStep{method: "GET", path: fmt.Sprintf("/api/jobs/%d", id)})
+		if status != http.StatusOK {
+			t.Fatalf("job %d status = %d: %s", id, status, body)
+		}
+		var job struct {
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal(body, &job); err != nil {
+			t.Fatalf("failed to decode job %d: %v", id, err)
+		}
+		if job.Status != "queued" && job.Status != "running" {
+			return body
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job %d is still %s", id, job.Status)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// TestContractEventStream checks that a subscriber to the event stream receives a game's
+// reschedule as it happens
+func TestContractEventStream(t *testing.T) {
+	server, _ := newContractServer(t)
+	if status, _, body := send(t, server, contractStep{method: "POST", path: "/api/admin/seed"}); status != http.StatusCreated {
+		t.Fatalf("seed status = %d: %s", status, body)
+	}
+
+	res, err := server.Client().Get(server.URL + "/api/events?types=game.rescheduled")
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK || !strings.HasPrefix(res.Header.Get("Content-Type"), "text/event-stream") {
+		t.Fatalf("subscribe status = %d, Content-Type = %q", res.StatusCode, res.Header.Get("Content-Type"))
+	}
+
+	reschedule := contractStep{method: "POST", path: "/api/games/49/reschedule", body: `{"game_date":"2026-10-04T20:20:00Z","reason":"Flexed to Sunday Night Football"}`}
+	if status, _, body := send(t, server, reschedule); status != http.StatusCreated {
+		t.Fatalf("reschedule status = %d: %s", status, body)
+	}
+
+	// Read until the event's data line, skipping comments such as keep-alives
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				t.Fatal("event stream ended before the reschedule arrived")
+			}
+			if data, found := strings.CutPrefix(line, "data: "); found {
+				testutil.Golden(t, "contract/events/game-rescheduled", []byte(data), contractMask...)
+				return
+			}
+		case <-timeout:
+			t.Fatal("no event within 5s of the reschedule")
+		}
+	}
+}
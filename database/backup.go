@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Backup writes a consistent copy of the live database to path, which must not exist yet.
+// VACUUM INTO reads in a single transaction, so writes during the backup are either wholly
+// in it or not at all, and the copy is compacted.
+func Backup(db *sql.DB, path string) error {
+	if _, err := db.Exec("VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("failed to back up database: %v", err)
+	}
+	return nil
+}
+
+// Restore replaces the contents of the live database with the backup at path, using SQLite's
+// online backup API so open connections see the restored data. The backup is integrity
+// checked first and the live database is left untouched if the check fails.
+func Restore(db *sql.DB, path string) error {
+	// Opening a missing file would create an empty database
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("failed to open backup: %v", err)
+	}
+
+	src, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("failed to open backup: %v", err)
+	}
+	defer src.Close()
+
+	var check string
+	if err := src.QueryRow("PRAGMA quick_check").Scan(&check); err != nil {
+		return fmt.Errorf("invalid backup: not a readable SQLite database: %v", err)
+	}
+	if check != "ok" {
+		return fmt.Errorf("invalid backup: integrity check failed: %s", check)
+	}
+
+	ctx := context.Background()
+	srcConn, err := src.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open backup: %v", err)
+	}
+	defer srcConn.Close()
+
+	destConn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %v", err)
+	}
+	defer destConn.Close()
+
+	return destConn.Raw(func(destDriverConn interface{}) error {
+		return srcConn.Raw(func(srcDriverConn interface{}) error {
+			dest, ok := destDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return fmt.Errorf("database is not an SQLite connection")
+			}
+			backup, err := dest.Backup("main", srcDriverConn.(*sqlite3.SQLiteConn), "main")
+			if err != nil {
+				return fmt.Errorf("failed to start restore: %v", err)
+			}
+			// Copy every page in one step, so the database is never left half restored
+			if _, err := backup.Step(-1); err != nil {
+				backup.Finish()
+				return fmt.Errorf("failed to restore database: %v", err)
+			}
+			if err := backup.Finish(); err != nil {
+				return fmt.Errorf("failed to finish restore: %v", err)
+			}
+			return nil
+		})
+	})
+}
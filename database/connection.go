@@ -11,6 +11,9 @@ import (
 
 var DB *sql.DB
 
+// Path is the file InitDB opened
+var Path string
+
 // InitDB initializes the database connection
 func InitDB() error {
 	var err error
@@ -21,19 +24,31 @@ func InitDB() error {
 		dbPath = "./sports.db"
 	}
 	
-	// Open SQLite database
-	DB, err = sql.Open("sqlite3", dbPath)
+	DB, err = Open(dbPath)
+	if err != nil {
+		return err
+	}
+	Path = dbPath
+	
+	log.Println("Database connection established successfully")
+	return nil
+}
+
+// Open opens and pings the SQLite database at path
+func Open(path string) (*sql.DB, error) {
+	// Open SQLite database with foreign key enforcement on every connection
+	db, err := sql.Open(Driver, path+"?_foreign_keys=on")
 	if err != nil {
-		return fmt.Errorf("failed to open database: %v", err)
+		return nil, fmt.Errorf("failed to open database: %v", err)
 	}
 	
 	// Test the connection
-	if err = DB.Ping(); err != nil {
-		return fmt.Errorf("failed to ping database: %v", err)
+	if err = db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %v", err)
 	}
 	
-	log.Println("Database connection established successfully")
-	return nil
+	return db, nil
 }
 
 // CloseDB closes the database connection
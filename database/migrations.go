@@ -1,36 +1,292 @@
 package database
 
 import (
+	"database/sql"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
+
+	"sports-backend/models"
 )
 
+// migration creates tables, indexes, views or triggers. Its SQL must be safe to run again.
+type migration struct {
+	name string
+	sql  string
+}
+
+// columnMigration adds a column introduced after its table was first created
+type columnMigration struct {
+	table      string
+	column     string
+	definition string
+}
+
+// tableMigrations run first, in order
+var tableMigrations = []migration{
+	{"teams", createTeamsTable},
+	{"games", createGamesTable},
+	{"players", createPlayersTable},
+	{"player_stats", createPlayerStatsTable},
+	{"search_indexes", createSearchIndexes},
+	{"venues", createVenuesTable},
+	{"game_odds", createGameOddsTable},
+	{"usage_analytics", createUsageAnalyticsTable},
+	{"draft_picks", createDraftPicksTable},
+	{"external_ids", createExternalIDsTable},
+	{"projections", createProjectionsTable},
+	{"adp", createADPTable},
+	{"dfs_salaries", createDFSSalariesTable},
+	{"sports", createSportsTable},
+	{"stat_definitions", createStatDefinitionsTable},
+	{"player_stats_feed_index", createPlayerStatsFeedIndex},
+	{"jobs", createJobsTable},
+	{"game_schedule_changes", createGameScheduleChangesTable},
+	{"notification_subscriptions", createNotificationSubscriptionsTable},
+	{"stat_conflicts", createStatConflictsTable},
+	{"player_injury_changes", createPlayerInjuryChangesTable},
+	{"news_items", createNewsItemsTable},
+	{"player_advanced_stats", createPlayerAdvancedStatsTable},
+}
+
+// columnMigrations add columns introduced after the original tables were created
+var columnMigrations = []columnMigration{
+	{"games", "venue_id", "INTEGER REFERENCES venues (id)"},
+	{"games", "neutral_site", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"players", "birth_date", "TEXT"}, // YYYY-MM-DD
+	{"players", "college", "TEXT"},
+	{"players", "years_experience", "INTEGER"},
+	{"players", "headshot_url", "TEXT"},
+	{"teams", "deleted_at", "DATETIME"},
+	{"players", "deleted_at", "DATETIME"},
+	{"games", "deleted_at", "DATETIME"},
+	{"player_stats", "flagged", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"player_stats", "flag_reason", "TEXT"},
+	{"teams", "sport", "TEXT NOT NULL DEFAULT 'football'"}, // a code in sports
+	{"stat_definitions", "default_points", "REAL NOT NULL DEFAULT 0"},
+	{"teams", "logo_url", "TEXT"},
+	{"games", "game_type", "TEXT NOT NULL DEFAULT 'regular'"}, // preseason, regular, wildcard, divisional, conference, superbowl
+	{"games", "overtime", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"games", "period_scores", "TEXT"}, // JSON array of {period, home_score, away_score}
+	{"player_stats", "stat_sources", "TEXT"}, // JSON object of the source that wrote each stat
+	{"players", "injury_status", "TEXT"},     // out, doubtful or questionable
+	{"players", "injury", "TEXT"},
+}
+
+// lateMigrations run last, as they depend on the added columns
+var lateMigrations = []migration{
+	{"players_team_jersey", createPlayersTeamJerseyIndex},
+	{"player_season_stats", createPlayerSeasonStatsTable},
+	{"player_season_totals", createPlayerSeasonTotalsView},
+	{"player_season_stats_triggers", createPlayerSeasonStatsTriggers},
+	{"stat_records", createStatRecordsTable},
+	{"stat_records_triggers", createStatRecordsTriggers},
+}
+
 // RunMigrations creates all necessary database tables
 func RunMigrations() error {
 	// First, run table creation migrations
-	migrations := []struct {
-		name string
-		sql  string
-	}{
-		{"teams", createTeamsTable},
-		{"games", createGamesTable},
-		{"players", createPlayersTable},
-		{"player_stats", createPlayerStatsTable},
+	for _, migration := range tableMigrations {
+		log.Printf("Running migration: %s", migration.name)
+		if _, err := DB.Exec(migration.sql); err != nil {
+			return fmt.Errorf("failed to run migration %s: %v", migration.name, err)
+		}
+		log.Printf("Migration %s completed successfully", migration.name)
+	}
+
+	// player_stats.sacks is declared REAL for half sacks. Databases created while it was INTEGER
+	// need no rebuild: SQLite keeps a fractional value as REAL even in an INTEGER column.
+
+	// Then, add columns introduced after the original tables were created
+	for _, migration := range columnMigrations {
+		exists, err := ColumnExists(migration.table, migration.column)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		log.Printf("Adding column %s.%s", migration.table, migration.column)
+		query := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", migration.table, migration.column, migration.definition)
+		if _, err := DB.Exec(query); err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %v", migration.table, migration.column, err)
+		}
+	}
+
+	// The season rollup is backfilled once, when its table is first created
+	seasonStatsExisted, err := TableExists("player_season_stats")
+	if err != nil {
+		return err
+	}
+
+	// The jersey index can't be built over duplicate numbers
+	if err := checkJerseyConflicts(); err != nil {
+		return err
 	}
 
-	for _, migration := range migrations {
+	// Finally, run migrations that depend on the added columns
+	for _, migration := range lateMigrations {
 		log.Printf("Running migration: %s", migration.name)
 		if _, err := DB.Exec(migration.sql); err != nil {
 			return fmt.Errorf("failed to run migration %s: %v", migration.name, err)
 		}
-		log.Printf("Migration %s completed successfully", migration.name)
 	}
 
+	if !seasonStatsExisted {
+		log.Println("Backfilling player_season_stats")
+		if _, err := DB.Exec(rebuildPlayerSeasonStats); err != nil {
+			return fmt.Errorf("failed to backfill player_season_stats: %v", err)
+		}
+	}
+
+	if _, err := DB.Exec(syncStatRecords()); err != nil {
+		return fmt.Errorf("failed to sync stat_records: %v", err)
+	}
+
+	if err := seedStatDefinitions(models.SportFootball, models.FootballStats); err != nil {
+		return err
+	}
+
+	if err := normalizePlayerPositions(); err != nil {
+		return err
+	}
+
+	if err := normalizeGameDates(); err != nil {
+		return err
+	}
 
 	log.Println("All database migrations completed successfully")
 	return nil
 }
 
+// seedStatDefinitions writes a sport's stat definitions from its registry in models, replacing
+// rows written by earlier versions so the table always follows the code
+func seedStatDefinitions(sport string, definitions []*models.StatDefinition) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO stat_definitions (sport, name, label, category, value_type, min_value, step, default_points, sort_order)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (sport, name) DO UPDATE SET
+			label = excluded.label, category = excluded.category, value_type = excluded.value_type,
+			min_value = excluded.min_value, step = excluded.step, default_points = excluded.default_points,
+			sort_order = excluded.sort_order`
+	for i, definition := range definitions {
+		_, err := tx.Exec(query, sport, definition.Name, definition.Label, definition.Category, definition.ValueType,
+			definition.MinValue, definition.Step, definition.DefaultPoints, i+1)
+		if err != nil {
+			return fmt.Errorf("failed to seed stat definition %s.%s: %v", sport, definition.Name, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// checkJerseyConflicts fails when active players on a team share a jersey number, which the
+// unique index of players_team_jersey would reject. Each conflict is logged and listed in the
+// error rather than resolved here, as which player keeps the number is not the migration's call.
+func checkJerseyConflicts() error {
+	rows, err := DB.Query(`
+		SELECT team_id, jersey_number, GROUP_CONCAT(id, ', ')
+		FROM (
+			SELECT id, team_id, jersey_number FROM players
+			WHERE jersey_number IS NOT NULL AND deleted_at IS NULL
+			ORDER BY id
+		)
+		GROUP BY team_id, jersey_number
+		HAVING COUNT(*) > 1
+		ORDER BY team_id, jersey_number`)
+	if err != nil {
+		return fmt.Errorf("failed to check for shared jersey numbers: %v", err)
+	}
+	defer rows.Close()
+
+	var conflicts []string
+	for rows.Next() {
+		var teamID, number int
+		var playerIDs string
+		if err := rows.Scan(&teamID, &number, &playerIDs); err != nil {
+			return fmt.Errorf("failed to scan shared jersey number: %v", err)
+		}
+		log.Printf("Players %s on team %d share jersey number %d", playerIDs, teamID, number)
+		conflicts = append(conflicts, fmt.Sprintf("team %d #%d: players %s", teamID, number, playerIDs))
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to check for shared jersey numbers: %v", err)
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("failed to run migration players_team_jersey: active players on the same team share %d jersey numbers (%s); change or clear the numbers, then migrate again",
+			len(conflicts), strings.Join(conflicts, "; "))
+	}
+	return nil
+}
+
+// normalizePlayerPositions rewrites free-text positions such as "Quarterback" or "qb" to their
+// canonical codes. Unrecognized positions, and rows whose rewrite would duplicate another player,
+// are left as they are and logged.
+func normalizePlayerPositions() error {
+	rows, err := DB.Query("SELECT DISTINCT position FROM players")
+	if err != nil {
+		return fmt.Errorf("failed to read player positions: %v", err)
+	}
+	var positions []string
+	for rows.Next() {
+		var position string
+		if err := rows.Scan(&position); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan player position: %v", err)
+		}
+		positions = append(positions, position)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read player positions: %v", err)
+	}
+
+	for _, position := range positions {
+		code, ok := models.NormalizePosition(position)
+		if !ok {
+			log.Printf("Unrecognized player position %q left unchanged", position)
+			continue
+		}
+		if code == position {
+			continue
+		}
+
+		result, err := DB.Exec("UPDATE OR IGNORE players SET position = ? WHERE position = ?", code, position)
+		if err != nil {
+			return fmt.Errorf("failed to normalize position %q: %v", position, err)
+		}
+		updated, _ := result.RowsAffected()
+		log.Printf("Normalized %d players from position %q to %s", updated, position, code)
+	}
+
+	return nil
+}
+
+// normalizeGameDates rewrites game dates stored with a non-UTC offset, as earlier versions
+// wrote them in the server's timezone, to UTC. SQLite applies the offset when it parses the
+// date. Rows whose rewrite would duplicate another game are left as they are.
+func normalizeGameDates() error {
+	result, err := DB.Exec(`
+		UPDATE OR IGNORE games SET game_date = strftime('%Y-%m-%d %H:%M:%S', game_date) || '+00:00'
+		WHERE game_date NOT LIKE '%+00:00' AND strftime('%Y-%m-%d %H:%M:%S', game_date) IS NOT NULL`)
+	if err != nil {
+		return fmt.Errorf("failed to normalize game dates: %v", err)
+	}
+	if updated, _ := result.RowsAffected(); updated > 0 {
+		log.Printf("Normalized %d game dates to UTC", updated)
+	}
+	return nil
+}
+
 // TableExists checks if a table exists in the database
 func TableExists(tableName string) (bool, error) {
 	query := `
@@ -50,6 +306,78 @@ func TableExists(tableName string) (bool, error) {
 	return true, nil
 }
 
+// ColumnExists checks if a column exists on a table
+func ColumnExists(tableName, columnName string) (bool, error) {
+	query := `SELECT 1 FROM pragma_table_info(?) WHERE name = ?`
+
+	var exists int
+	err := DB.QueryRow(query, tableName, columnName).Scan(&exists)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check if column %s.%s exists: %v", tableName, columnName, err)
+	}
+
+	return true, nil
+}
+
+// MigrationState reports whether a migration's schema changes are in the database
+type MigrationState struct {
+	Name    string
+	Applied bool
+}
+
+// createdObject matches the name of each table, index, view or trigger a migration creates
+var createdObject = regexp.MustCompile(`(?i)CREATE\s+(?:UNIQUE\s+)?(?:TABLE|INDEX|VIEW|TRIGGER)\s+(?:IF\s+NOT\s+EXISTS\s+)?(\w+)`)
+
+// MigrationStatus lists every migration in the order RunMigrations applies them. A migration
+// is applied when every object it creates exists, or for a column migration, when the column
+// exists. The data backfills RunMigrations performs are not listed.
+func MigrationStatus() ([]MigrationState, error) {
+	var states []MigrationState
+
+	objectsExist := func(migration migration) (bool, error) {
+		for _, match := range createdObject.FindAllStringSubmatch(migration.sql, -1) {
+			var exists int
+			err := DB.QueryRow("SELECT 1 FROM sqlite_master WHERE name = ?", match[1]).Scan(&exists)
+			if err == sql.ErrNoRows {
+				return false, nil
+			}
+			if err != nil {
+				return false, fmt.Errorf("failed to check migration %s: %v", migration.name, err)
+			}
+		}
+		return true, nil
+	}
+
+	for _, migration := range tableMigrations {
+		applied, err := objectsExist(migration)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, MigrationState{Name: migration.name, Applied: applied})
+	}
+
+	for _, migration := range columnMigrations {
+		applied, err := ColumnExists(migration.table, migration.column)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, MigrationState{Name: migration.table + "." + migration.column, Applied: applied})
+	}
+
+	for _, migration := range lateMigrations {
+		applied, err := objectsExist(migration)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, MigrationState{Name: migration.name, Applied: applied})
+	}
+
+	return states, nil
+}
+
 const createTeamsTable = `
 CREATE TABLE IF NOT EXISTS teams (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -125,7 +453,7 @@ CREATE TABLE IF NOT EXISTS player_stats (
     tackles INTEGER DEFAULT 0,
     solo_tackles INTEGER DEFAULT 0,
     assisted_tackles INTEGER DEFAULT 0,
-    sacks INTEGER DEFAULT 0,
+    sacks REAL DEFAULT 0, -- half sacks are credited as 0.5
     defensive_interceptions INTEGER DEFAULT 0,
     pass_deflections INTEGER DEFAULT 0,
     forced_fumbles INTEGER DEFAULT 0,
@@ -155,3 +483,568 @@ CREATE TABLE IF NOT EXISTS player_stats (
     -- Ensure one stat record per player per game
     UNIQUE(player_id, game_id)
 );`
+
+// Case-insensitive indexes backing prefix searches (LIKE 'abc%')
+const createSearchIndexes = `
+CREATE INDEX IF NOT EXISTS idx_players_first_name_nocase ON players (first_name COLLATE NOCASE);
+CREATE INDEX IF NOT EXISTS idx_players_last_name_nocase ON players (last_name COLLATE NOCASE);
+CREATE INDEX IF NOT EXISTS idx_teams_name_nocase ON teams (name COLLATE NOCASE);
+CREATE INDEX IF NOT EXISTS idx_teams_city_nocase ON teams (city COLLATE NOCASE);`
+
+const createVenuesTable = `
+CREATE TABLE IF NOT EXISTS venues (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    city TEXT NOT NULL,
+    country TEXT NOT NULL DEFAULT 'USA',
+    surface TEXT NOT NULL, -- grass, turf, hybrid
+    roof_type TEXT NOT NULL, -- open, dome, retractable
+    capacity INTEGER,
+    timezone TEXT NOT NULL, -- IANA name, e.g. America/Chicago
+    latitude REAL,
+    longitude REAL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(name, city)
+);`
+
+const createGameOddsTable = `
+CREATE TABLE IF NOT EXISTS game_odds (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    game_id INTEGER NOT NULL,
+    spread REAL, -- home team spread, negative when the home team is favored
+    total REAL, -- over/under for combined points
+    home_moneyline INTEGER, -- American odds, e.g. -150 or +130
+    away_moneyline INTEGER,
+    source TEXT NOT NULL,
+    captured_at DATETIME NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (game_id) REFERENCES games (id)
+);
+CREATE INDEX IF NOT EXISTS idx_game_odds_game_captured ON game_odds (game_id, captured_at);`
+
+
+const createUsageAnalyticsTable = `
+CREATE TABLE IF NOT EXISTS usage_analytics (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    category TEXT NOT NULL, -- endpoint route template, e.g. /games/{id}/odds
+    day TEXT NOT NULL, -- UTC, YYYY-MM-DD
+    count INTEGER NOT NULL DEFAULT 0,
+    UNIQUE(category, day)
+);`
+
+const createDraftPicksTable = `
+CREATE TABLE IF NOT EXISTS draft_picks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    year INTEGER NOT NULL,
+    round INTEGER NOT NULL,
+    pick INTEGER NOT NULL, -- pick number within the round
+    team_id INTEGER NOT NULL, -- team that holds the pick
+    player_id INTEGER, -- NULL until the pick is made
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (team_id) REFERENCES teams (id),
+    FOREIGN KEY (player_id) REFERENCES players (id),
+    UNIQUE(year, round, pick)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_draft_picks_player ON draft_picks (player_id) WHERE player_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_draft_picks_team_year ON draft_picks (team_id, year);`
+
+const createExternalIDsTable = `
+CREATE TABLE IF NOT EXISTS external_ids (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_type TEXT NOT NULL, -- player, team, game
+    entity_id INTEGER NOT NULL,
+    provider TEXT NOT NULL, -- espn, sleeper, gsis, pfr
+    external_id TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(entity_type, provider, external_id),
+    UNIQUE(entity_type, entity_id, provider)
+);`
+
+const createProjectionsTable = `
+CREATE TABLE IF NOT EXISTS projections (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    season TEXT NOT NULL,
+    week INTEGER NOT NULL,
+    source TEXT NOT NULL, -- provider of the projection, lowercase
+    stats TEXT NOT NULL DEFAULT '{}', -- JSON object of projected stats keyed by player_stats column name
+    points REAL NOT NULL, -- projected fantasy points
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players (id),
+    UNIQUE(player_id, season, week, source)
+);
+CREATE INDEX IF NOT EXISTS idx_projections_season_week ON projections (season, week);`
+
+const createADPTable = `
+CREATE TABLE IF NOT EXISTS adp (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    season TEXT NOT NULL,
+    format TEXT NOT NULL, -- standard, half_ppr, ppr, superflex
+    source TEXT NOT NULL, -- provider of the ADP, lowercase
+    adp REAL NOT NULL, -- average overall pick
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players (id),
+    UNIQUE(player_id, season, format, source)
+);
+CREATE INDEX IF NOT EXISTS idx_adp_season_format ON adp (season, format);`
+
+const createDFSSalariesTable = `
+CREATE TABLE IF NOT EXISTS dfs_salaries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    season TEXT NOT NULL,
+    week INTEGER NOT NULL,
+    site TEXT NOT NULL, -- daily fantasy site, lowercase
+    salary INTEGER NOT NULL, -- in dollars, a multiple of 100
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players (id),
+    UNIQUE(player_id, season, week, site)
+);
+CREATE INDEX IF NOT EXISTS idx_dfs_salaries_slate ON dfs_salaries (season, week, site);`
+
+const createSportsTable = `
+CREATE TABLE IF NOT EXISTS sports (
+    code TEXT PRIMARY KEY, -- lowercase, e.g. football
+    name TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+INSERT OR IGNORE INTO sports (code, name) VALUES ('football', 'American Football');`
+
+const createStatDefinitionsTable = `
+CREATE TABLE IF NOT EXISTS stat_definitions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    sport TEXT NOT NULL,
+    name TEXT NOT NULL, -- player_stats column and JSON field
+    label TEXT NOT NULL,
+    category TEXT NOT NULL, -- stat group, e.g. passing
+    value_type TEXT NOT NULL, -- count or decimal
+    min_value REAL, -- NULL when any negative value is allowed
+    step REAL NOT NULL DEFAULT 1, -- values must be a multiple of the step
+    sort_order INTEGER NOT NULL,
+    FOREIGN KEY (sport) REFERENCES sports (code),
+    UNIQUE(sport, name)
+);`
+
+// The player stats feed pages newest first on (created_at, id)
+const createPlayerStatsFeedIndex = `
+CREATE INDEX IF NOT EXISTS idx_player_stats_created ON player_stats (created_at, id);`
+
+// Background jobs. Workers claim the oldest queued job whose run_after has passed.
+const createJobsTable = `
+CREATE TABLE IF NOT EXISTS jobs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    kind TEXT NOT NULL,
+    status TEXT NOT NULL, -- queued, running, done, failed or cancelled
+    payload TEXT, -- JSON input, cleared once the job finishes
+    total INTEGER NOT NULL DEFAULT 0,
+    processed INTEGER NOT NULL DEFAULT 0,
+    result TEXT, -- JSON
+    errors TEXT, -- JSON array of record ranges that were not processed
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL,
+    last_error TEXT,
+    cancel_requested BOOLEAN NOT NULL DEFAULT 0,
+    run_after DATETIME NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    started_at DATETIME,
+    finished_at DATETIME
+);
+CREATE INDEX IF NOT EXISTS idx_jobs_queue ON jobs (status, run_after, id);`
+
+// Kickoff moves, such as flexed games, newest last
+const createGameScheduleChangesTable = `
+CREATE TABLE IF NOT EXISTS game_schedule_changes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    game_id INTEGER NOT NULL,
+    previous_date DATETIME NOT NULL, -- UTC
+    new_date DATETIME NOT NULL, -- UTC
+    reason TEXT,
+    changed_at DATETIME NOT NULL,
+    FOREIGN KEY (game_id) REFERENCES games (id)
+);
+CREATE INDEX IF NOT EXISTS idx_game_schedule_changes_game ON game_schedule_changes (game_id, id);
+CREATE INDEX IF NOT EXISTS idx_game_schedule_changes_changed ON game_schedule_changes (changed_at, id);`
+
+// Where events are sent, and over which channel
+const createNotificationSubscriptionsTable = `
+CREATE TABLE IF NOT EXISTS notification_subscriptions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    channel TEXT NOT NULL, -- email, webhook or push
+    target TEXT NOT NULL, -- email address, URL or push topic
+    event_types TEXT NOT NULL DEFAULT '[]', -- JSON array, empty for every event type
+    enabled BOOLEAN NOT NULL DEFAULT 1,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);`
+
+// Stat values a source reported that were not written because a higher-ranked source had
+// already set the stat. A source has at most one open conflict per stat of a line.
+const createStatConflictsTable = `
+CREATE TABLE IF NOT EXISTS stat_conflicts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    stats_id INTEGER NOT NULL,
+    stat TEXT NOT NULL, -- player_stats column and JSON field
+    kept_value REAL NOT NULL,
+    kept_source TEXT NOT NULL,
+    rejected_value REAL NOT NULL,
+    rejected_source TEXT NOT NULL,
+    detected_at DATETIME NOT NULL,
+    FOREIGN KEY (stats_id) REFERENCES player_stats (id) ON DELETE CASCADE,
+    UNIQUE (stats_id, stat, rejected_source)
+);
+CREATE INDEX IF NOT EXISTS idx_stat_conflicts_detected ON stat_conflicts (detected_at, id);`
+
+// Injury report designations as they changed, newest last
+const createPlayerInjuryChangesTable = `
+CREATE TABLE IF NOT EXISTS player_injury_changes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    season TEXT NOT NULL,
+    week INTEGER NOT NULL,
+    previous_status TEXT, -- NULL when the player had no designation
+    status TEXT, -- NULL when the designation was cleared
+    injury TEXT,
+    changed_at DATETIME NOT NULL,
+    FOREIGN KEY (player_id) REFERENCES players (id)
+);
+CREATE INDEX IF NOT EXISTS idx_player_injury_changes_player ON player_injury_changes (player_id, id);`
+
+// News items are keyed by their source URL, so ingesting an item again updates it. Each links
+// to any number of players and teams.
+const createNewsItemsTable = `
+CREATE TABLE IF NOT EXISTS news_items (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    headline TEXT NOT NULL,
+    body TEXT,
+    source_url TEXT NOT NULL UNIQUE,
+    published_at DATETIME NOT NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_news_items_published ON news_items (published_at);
+CREATE TABLE IF NOT EXISTS news_item_players (
+    news_item_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    PRIMARY KEY (news_item_id, player_id),
+    FOREIGN KEY (news_item_id) REFERENCES news_items (id) ON DELETE CASCADE,
+    FOREIGN KEY (player_id) REFERENCES players (id)
+);
+CREATE INDEX IF NOT EXISTS idx_news_item_players_player ON news_item_players (player_id);
+CREATE TABLE IF NOT EXISTS news_item_teams (
+    news_item_id INTEGER NOT NULL,
+    team_id INTEGER NOT NULL,
+    PRIMARY KEY (news_item_id, team_id),
+    FOREIGN KEY (news_item_id) REFERENCES news_items (id) ON DELETE CASCADE,
+    FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE INDEX IF NOT EXISTS idx_news_item_teams_team ON news_item_teams (team_id);`
+
+// Usage and depth-of-target metrics from advanced data sources, one row per player and game
+// beside the box score line in player_stats. Shares are fractions between 0 and 1.
+const createPlayerAdvancedStatsTable = `
+CREATE TABLE IF NOT EXISTS player_advanced_stats (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    game_id INTEGER NOT NULL,
+    offense_snaps INTEGER,
+    defense_snaps INTEGER,
+    special_teams_snaps INTEGER,
+    offense_snap_share REAL,
+    routes_run INTEGER,
+    air_yards INTEGER, -- intended air yards of the player's targets
+    red_zone_touches INTEGER, -- carries and receptions inside the opponent's 20
+    target_share REAL,
+    source TEXT NOT NULL, -- the source that last wrote the row
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    UNIQUE (player_id, game_id),
+    FOREIGN KEY (player_id) REFERENCES players (id),
+    FOREIGN KEY (game_id) REFERENCES games (id)
+);
+CREATE INDEX IF NOT EXISTS idx_player_advanced_stats_game ON player_advanced_stats (game_id);`
+
+// Jersey numbers are unique among a team's active players. Earlier data may hold duplicates
+// because the check used to happen outside a transaction; checkJerseyConflicts refuses to
+// migrate until they are resolved.
+const createPlayersTeamJerseyIndex = `
+CREATE UNIQUE INDEX IF NOT EXISTS idx_players_team_jersey ON players (team_id, jersey_number)
+WHERE jersey_number IS NOT NULL AND deleted_at IS NULL;`
+
+// player_season_stats holds each player's totals per season so season queries do not scan
+// every stat line. Rows are maintained by the triggers below; updated_at must stay the last
+// column because rows are copied from player_season_totals with SELECT *.
+const createPlayerSeasonStatsTable = `
+CREATE TABLE IF NOT EXISTS player_season_stats (
+    player_id INTEGER NOT NULL,
+    season TEXT NOT NULL,
+    games_played INTEGER NOT NULL DEFAULT 0,
+    passing_attempts INTEGER NOT NULL DEFAULT 0,
+    passing_completions INTEGER NOT NULL DEFAULT 0,
+    passing_yards INTEGER NOT NULL DEFAULT 0,
+    passing_touchdowns INTEGER NOT NULL DEFAULT 0,
+    passing_interceptions INTEGER NOT NULL DEFAULT 0,
+    rushing_attempts INTEGER NOT NULL DEFAULT 0,
+    rushing_yards INTEGER NOT NULL DEFAULT 0,
+    rushing_touchdowns INTEGER NOT NULL DEFAULT 0,
+    receiving_targets INTEGER NOT NULL DEFAULT 0,
+    receptions INTEGER NOT NULL DEFAULT 0,
+    receiving_yards INTEGER NOT NULL DEFAULT 0,
+    receiving_touchdowns INTEGER NOT NULL DEFAULT 0,
+    fumbles INTEGER NOT NULL DEFAULT 0,
+    fumbles_lost INTEGER NOT NULL DEFAULT 0,
+    tackles INTEGER NOT NULL DEFAULT 0,
+    solo_tackles INTEGER NOT NULL DEFAULT 0,
+    assisted_tackles INTEGER NOT NULL DEFAULT 0,
+    sacks REAL NOT NULL DEFAULT 0,
+    defensive_interceptions INTEGER NOT NULL DEFAULT 0,
+    pass_deflections INTEGER NOT NULL DEFAULT 0,
+    forced_fumbles INTEGER NOT NULL DEFAULT 0,
+    fumble_recoveries INTEGER NOT NULL DEFAULT 0,
+    defensive_touchdowns INTEGER NOT NULL DEFAULT 0,
+    field_goals_attempted INTEGER NOT NULL DEFAULT 0,
+    field_goals_made INTEGER NOT NULL DEFAULT 0,
+    extra_points_attempted INTEGER NOT NULL DEFAULT 0,
+    extra_points_made INTEGER NOT NULL DEFAULT 0,
+    punts INTEGER NOT NULL DEFAULT 0,
+    punt_yards INTEGER NOT NULL DEFAULT 0,
+    kick_returns INTEGER NOT NULL DEFAULT 0,
+    kick_return_yards INTEGER NOT NULL DEFAULT 0,
+    kick_return_touchdowns INTEGER NOT NULL DEFAULT 0,
+    punt_returns INTEGER NOT NULL DEFAULT 0,
+    punt_return_yards INTEGER NOT NULL DEFAULT 0,
+    punt_return_touchdowns INTEGER NOT NULL DEFAULT 0,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (player_id, season),
+    FOREIGN KEY (player_id) REFERENCES players (id)
+);
+CREATE INDEX IF NOT EXISTS idx_player_season_stats_season ON player_season_stats (season);`
+
+// player_season_totals computes the rollup rows from player_stats. Only regular season games
+// count, so deleted, preseason and playoff games are left out.
+// It is recreated on every start so the definition follows the table.
+const createPlayerSeasonTotalsView = `
+DROP VIEW IF EXISTS player_season_totals;
+CREATE VIEW player_season_totals AS
+SELECT ps.player_id, g.season, COUNT(*) AS games_played,
+       COALESCE(SUM(ps.passing_attempts), 0) AS passing_attempts,
+       COALESCE(SUM(ps.passing_completions), 0) AS passing_completions,
+       COALESCE(SUM(ps.passing_yards), 0) AS passing_yards,
+       COALESCE(SUM(ps.passing_touchdowns), 0) AS passing_touchdowns,
+       COALESCE(SUM(ps.passing_interceptions), 0) AS passing_interceptions,
+       COALESCE(SUM(ps.rushing_attempts), 0) AS rushing_attempts,
+       COALESCE(SUM(ps.rushing_yards), 0) AS rushing_yards,
+       COALESCE(SUM(ps.rushing_touchdowns), 0) AS rushing_touchdowns,
+       COALESCE(SUM(ps.receiving_targets), 0) AS receiving_targets,
+       COALESCE(SUM(ps.receptions), 0) AS receptions,
+       COALESCE(SUM(ps.receiving_yards), 0) AS receiving_yards,
+       COALESCE(SUM(ps.receiving_touchdowns), 0) AS receiving_touchdowns,
+       COALESCE(SUM(ps.fumbles), 0) AS fumbles,
+       COALESCE(SUM(ps.fumbles_lost), 0) AS fumbles_lost,
+       COALESCE(SUM(ps.tackles), 0) AS tackles,
+       COALESCE(SUM(ps.solo_tackles), 0) AS solo_tackles,
+       COALESCE(SUM(ps.assisted_tackles), 0) AS assisted_tackles,
+       TOTAL(ps.sacks) AS sacks,
+       COALESCE(SUM(ps.defensive_interceptions), 0) AS defensive_interceptions,
+       COALESCE(SUM(ps.pass_deflections), 0) AS pass_deflections,
+       COALESCE(SUM(ps.forced_fumbles), 0) AS forced_fumbles,
+       COALESCE(SUM(ps.fumble_recoveries), 0) AS fumble_recoveries,
+       COALESCE(SUM(ps.defensive_touchdowns), 0) AS defensive_touchdowns,
+       COALESCE(SUM(ps.field_goals_attempted), 0) AS field_goals_attempted,
+       COALESCE(SUM(ps.field_goals_made), 0) AS field_goals_made,
+       COALESCE(SUM(ps.extra_points_attempted), 0) AS extra_points_attempted,
+       COALESCE(SUM(ps.extra_points_made), 0) AS extra_points_made,
+       COALESCE(SUM(ps.punts), 0) AS punts,
+       COALESCE(SUM(ps.punt_yards), 0) AS punt_yards,
+       COALESCE(SUM(ps.kick_returns), 0) AS kick_returns,
+       COALESCE(SUM(ps.kick_return_yards), 0) AS kick_return_yards,
+       COALESCE(SUM(ps.kick_return_touchdowns), 0) AS kick_return_touchdowns,
+       COALESCE(SUM(ps.punt_returns), 0) AS punt_returns,
+       COALESCE(SUM(ps.punt_return_yards), 0) AS punt_return_yards,
+       COALESCE(SUM(ps.punt_return_touchdowns), 0) AS punt_return_touchdowns
+FROM player_stats ps
+JOIN games g ON g.id = ps.game_id
+WHERE g.deleted_at IS NULL AND g.game_type = 'regular'
+GROUP BY ps.player_id, g.season;`
+
+// refreshPlayerSeasonStats recomputes the rollup rows matching condition, which may only
+// refer to the player_id and season columns
+func refreshPlayerSeasonStats(condition string) string {
+	return fmt.Sprintf(`
+    DELETE FROM player_season_stats WHERE %[1]s;
+    INSERT INTO player_season_stats SELECT *, CURRENT_TIMESTAMP FROM player_season_totals WHERE %[1]s;`, condition)
+}
+
+// Every stat write refreshes the touched player's season. Moving a line to another player or
+// game refreshes both the old and the new season, and so does changing or deleting a game.
+var createPlayerSeasonStatsTriggers = `
+DROP TRIGGER IF EXISTS player_stats_season_insert;
+CREATE TRIGGER player_stats_season_insert AFTER INSERT ON player_stats
+BEGIN` + refreshPlayerSeasonStats("player_id = NEW.player_id AND season = (SELECT season FROM games WHERE id = NEW.game_id)") + `
+END;
+DROP TRIGGER IF EXISTS player_stats_season_update;
+CREATE TRIGGER player_stats_season_update AFTER UPDATE ON player_stats
+BEGIN` + refreshPlayerSeasonStats("player_id = OLD.player_id AND season = (SELECT season FROM games WHERE id = OLD.game_id)") +
+	refreshPlayerSeasonStats("player_id = NEW.player_id AND season = (SELECT season FROM games WHERE id = NEW.game_id)") + `
+END;
+DROP TRIGGER IF EXISTS player_stats_season_delete;
+CREATE TRIGGER player_stats_season_delete AFTER DELETE ON player_stats
+BEGIN` + refreshPlayerSeasonStats("player_id = OLD.player_id AND season = (SELECT season FROM games WHERE id = OLD.game_id)") + `
+END;
+DROP TRIGGER IF EXISTS games_season_stats_update;
+CREATE TRIGGER games_season_stats_update AFTER UPDATE OF season, deleted_at, game_type ON games
+BEGIN` + refreshPlayerSeasonStats("season IN (OLD.season, NEW.season) AND player_id IN (SELECT player_id FROM player_stats WHERE game_id = NEW.id)") + `
+END;`
+
+// rebuildPlayerSeasonStats recomputes the whole rollup
+const rebuildPlayerSeasonStats = `
+DELETE FROM player_season_stats;
+INSERT INTO player_season_stats SELECT *, CURRENT_TIMESTAMP FROM player_season_totals;`
+
+// stat_records holds the best single game and season of each record stat, so the record book
+// is read without scanning every stat line. A NULL value means no record yet. A row is marked
+// stale when its holder changes and is recomputed from the stat lines or season rollup.
+const createStatRecordsTable = `
+CREATE TABLE IF NOT EXISTS stat_records (
+    scope TEXT NOT NULL, -- game or season
+    stat TEXT NOT NULL, -- a stat name, or fantasy_points
+    value REAL,
+    player_id INTEGER,
+    season TEXT,
+    stats_id INTEGER, -- the stat line holding a single game record
+    stale BOOLEAN NOT NULL DEFAULT 1,
+    PRIMARY KEY (scope, stat)
+);`
+
+// recordValue is the SQL for a record stat of the row aliased alias. Fantasy points use the
+// default points of the football stats.
+func recordValue(stat, alias string) string {
+	if stat != models.RecordFantasyPoints {
+		return fmt.Sprintf("COALESCE(%s.%s, 0)", alias, stat)
+	}
+	var terms []string
+	for _, definition := range models.FootballStats {
+		if definition.DefaultPoints != 0 {
+			terms = append(terms, fmt.Sprintf("COALESCE(%s.%s, 0) * %g", alias, definition.Name, definition.DefaultPoints))
+		}
+	}
+	return "(" + strings.Join(terms, " + ") + ")"
+}
+
+// recomputeStatRecords recomputes the stale records of a scope. The earliest of tied holders
+// keeps the record.
+func recomputeStatRecords(scope string) string {
+	var sql strings.Builder
+	for _, stat := range models.RecordStats {
+		if scope == models.RecordScopeGame {
+			value := recordValue(stat, "ps")
+			fmt.Fprintf(&sql, `
+    UPDATE stat_records SET (value, player_id, season, stats_id) = (
+        SELECT %[2]s, ps.player_id, g.season, ps.id
+        FROM player_stats ps JOIN games g ON g.id = ps.game_id
+        WHERE g.deleted_at IS NULL AND g.game_type = 'regular' AND %[2]s > 0
+        ORDER BY %[2]s DESC, g.game_date, ps.id LIMIT 1
+    ), stale = 0
+    WHERE scope = 'game' AND stat = '%[1]s' AND stale;`, stat, value)
+		} else {
+			value := recordValue(stat, "ss")
+			fmt.Fprintf(&sql, `
+    UPDATE stat_records SET (value, player_id, season, stats_id) = (
+        SELECT %[2]s, ss.player_id, ss.season, NULL
+        FROM player_season_stats ss
+        WHERE %[2]s > 0
+        ORDER BY %[2]s DESC, ss.season, ss.player_id LIMIT 1
+    ), stale = 0
+    WHERE scope = 'season' AND stat = '%[1]s' AND stale;`, stat, value)
+		}
+	}
+	return sql.String()
+}
+
+// offerGameRecords makes NEW, a player_stats row, the holder of every record it beats
+func offerGameRecords() string {
+	var sql strings.Builder
+	for _, stat := range models.RecordStats {
+		value := recordValue(stat, "NEW")
+		fmt.Fprintf(&sql, `
+    UPDATE stat_records SET value = %[2]s, player_id = NEW.player_id, stats_id = NEW.id,
+        season = (SELECT season FROM games WHERE id = NEW.game_id)
+    WHERE scope = 'game' AND stat = '%[1]s' AND %[2]s > 0 AND (value IS NULL OR %[2]s > value)
+      AND EXISTS (SELECT 1 FROM games WHERE id = NEW.game_id AND deleted_at IS NULL AND game_type = 'regular');`, stat, value)
+	}
+	return sql.String()
+}
+
+// offerSeasonRecords makes NEW, a player_season_stats row, the holder of every record it beats
+func offerSeasonRecords() string {
+	var sql strings.Builder
+	for _, stat := range models.RecordStats {
+		value := recordValue(stat, "NEW")
+		fmt.Fprintf(&sql, `
+    UPDATE stat_records SET value = %[2]s, player_id = NEW.player_id, season = NEW.season, stats_id = NULL
+    WHERE scope = 'season' AND stat = '%[1]s' AND %[2]s > 0 AND (value IS NULL OR %[2]s > value);`, stat, value)
+	}
+	return sql.String()
+}
+
+// A new stat line or season total only has to beat the current records. Changing or removing
+// a record holder recomputes that record, and so does changing which games count.
+var createStatRecordsTriggers = `
+DROP TRIGGER IF EXISTS player_stats_records_insert;
+CREATE TRIGGER player_stats_records_insert AFTER INSERT ON player_stats
+BEGIN` + offerGameRecords() + `
+END;
+DROP TRIGGER IF EXISTS player_stats_records_update;
+CREATE TRIGGER player_stats_records_update AFTER UPDATE ON player_stats
+BEGIN
+    UPDATE stat_records SET stale = 1 WHERE scope = 'game' AND stats_id = OLD.id;` +
+	recomputeStatRecords(models.RecordScopeGame) + offerGameRecords() + `
+END;
+DROP TRIGGER IF EXISTS player_stats_records_delete;
+CREATE TRIGGER player_stats_records_delete AFTER DELETE ON player_stats
+BEGIN
+    UPDATE stat_records SET stale = 1 WHERE scope = 'game' AND stats_id = OLD.id;` +
+	recomputeStatRecords(models.RecordScopeGame) + `
+END;
+DROP TRIGGER IF EXISTS games_records_update;
+CREATE TRIGGER games_records_update AFTER UPDATE OF season, deleted_at, game_type ON games
+WHEN OLD.season IS NOT NEW.season OR OLD.deleted_at IS NOT NEW.deleted_at OR OLD.game_type IS NOT NEW.game_type
+BEGIN
+    UPDATE stat_records SET stale = 1 WHERE scope = 'game';` +
+	recomputeStatRecords(models.RecordScopeGame) + `
+END;
+DROP TRIGGER IF EXISTS player_season_stats_records_insert;
+CREATE TRIGGER player_season_stats_records_insert AFTER INSERT ON player_season_stats
+BEGIN` + offerSeasonRecords() + `
+END;
+DROP TRIGGER IF EXISTS player_season_stats_records_delete;
+CREATE TRIGGER player_season_stats_records_delete AFTER DELETE ON player_season_stats
+BEGIN
+    UPDATE stat_records SET stale = 1
+    WHERE scope = 'season' AND player_id = OLD.player_id AND season = OLD.season;` +
+	recomputeStatRecords(models.RecordScopeSeason) + `
+END;`
+
+// syncStatRecords adds rows for new record stats and drops retired ones, then computes the
+// new rows. Fantasy point records are recomputed on every start in case the default points
+// changed.
+func syncStatRecords() string {
+	var rows, names []string
+	for _, stat := range models.RecordStats {
+		for _, scope := range []string{models.RecordScopeGame, models.RecordScopeSeason} {
+			rows = append(rows, fmt.Sprintf("('%s', '%s')", scope, stat))
+		}
+		names = append(names, "'"+stat+"'")
+	}
+	return `
+INSERT OR IGNORE INTO stat_records (scope, stat) VALUES ` + strings.Join(rows, ", ") + `;
+DELETE FROM stat_records WHERE stat NOT IN (` + strings.Join(names, ", ") + `);
+UPDATE stat_records SET stale = 1 WHERE stat = '` + models.RecordFantasyPoints + `';` +
+		recomputeStatRecords(models.RecordScopeGame) + recomputeStatRecords(models.RecordScopeSeason)
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Driver is the database/sql driver Open uses
+const Driver = "sqlite3"
+
+// PoolConfig sizes the connection pool of a database handle
+type PoolConfig struct {
+	MaxOpenConns    int           // 0 for no limit
+	MaxIdleConns    int           // 0 to keep none; capped at MaxOpenConns
+	ConnMaxLifetime time.Duration // 0 to keep connections open indefinitely
+	ConnMaxIdleTime time.Duration // 0 to keep idle connections indefinitely
+}
+
+// DefaultPoolConfig returns the pool settings suited to a driver. database/sql on its own
+// allows unlimited connections and keeps only 2 idle, so bursts of requests open and close
+// connections over and over.
+func DefaultPoolConfig(driver string) PoolConfig {
+	switch driver {
+	case "sqlite3":
+		// Connections to a local file are cheap and never go stale, so keep every connection
+		// idle rather than reopening it, and only close those unused for a while. SQLite runs
+		// one writer at a time, so more connections than this only queue on its lock.
+		return PoolConfig{
+			MaxOpenConns:    10,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: 0,
+			ConnMaxIdleTime: 5 * time.Minute,
+		}
+	default:
+		// Network databases drop connections from their side, so recycle them periodically
+		return PoolConfig{
+			MaxOpenConns:    25,
+			MaxIdleConns:    25,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+		}
+	}
+}
+
+// ConfigurePool applies pool settings to a database handle
+func ConfigurePool(db *sql.DB, config PoolConfig) {
+	db.SetMaxOpenConns(config.MaxOpenConns)
+	db.SetMaxIdleConns(config.MaxIdleConns)
+	db.SetConnMaxLifetime(config.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+}
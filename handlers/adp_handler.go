@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ADPHandler handles HTTP requests for average draft position data
+type ADPHandler struct {
+	adpService services.ADPService
+}
+
+// NewADPHandler creates a new ADP handler
+func NewADPHandler(adpService services.ADPService) *ADPHandler {
+	return &ADPHandler{
+		adpService: adpService,
+	}
+}
+
+// RegisterRoutes registers the ADP routes
+func (h *ADPHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/players/{id}/adp", h.GetPlayerADP).Methods("GET")
+	r.HandleFunc("/adp", h.ImportADP).Methods("POST")
+}
+
+// GetPlayerADP handles GET /api/players/{id}/adp
+func (h *ADPHandler) GetPlayerADP(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
+	entries, err := h.adpService.GetPlayerADP(playerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get ADP: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(entries)
+}
+
+// ImportADP handles POST /api/adp with an array of ADP values for any players
+func (h *ADPHandler) ImportADP(w http.ResponseWriter, r *http.Request) {
+	var reqs []*models.CreateADPRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.adpService.ImportADP(reqs)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to import ADP: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// AdvancedStatsHandler handles HTTP requests for advanced stats
+type AdvancedStatsHandler struct {
+	advancedStatsService services.AdvancedStatsService
+}
+
+// NewAdvancedStatsHandler creates a new advanced stats handler
+func NewAdvancedStatsHandler(advancedStatsService services.AdvancedStatsService) *AdvancedStatsHandler {
+	return &AdvancedStatsHandler{
+		advancedStatsService: advancedStatsService,
+	}
+}
+
+// RegisterRoutes registers the advanced stats routes
+func (h *AdvancedStatsHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/advanced-stats", h.ImportAdvancedStats).Methods("POST")
+	r.HandleFunc("/advanced-stats/{season}/leaders", h.GetAdvancedLeaders).Methods("GET")
+	r.HandleFunc("/players/{id}/advanced-stats", h.GetPlayerAdvancedStats).Methods("GET")
+	r.HandleFunc("/players/{id}/advanced-stats/{season}", h.GetPlayerAdvancedSeason).Methods("GET")
+	r.HandleFunc("/games/{id}/advanced-stats", h.GetGameAdvancedStats).Methods("GET")
+}
+
+// ImportAdvancedStats handles POST /api/advanced-stats with an array of advanced stats rows
+func (h *AdvancedStatsHandler) ImportAdvancedStats(w http.ResponseWriter, r *http.Request) {
+	var reqs []*models.CreateAdvancedStatsRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.advancedStatsService.ImportAdvancedStats(reqs)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to import advanced stats: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// GetPlayerAdvancedStats handles GET /api/players/{id}/advanced-stats?season=
+func (h *AdvancedStatsHandler) GetPlayerAdvancedStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.advancedStatsService.GetPlayerAdvancedStats(pathID(r, "id"), r.URL.Query().Get("season"))
+	writeAdvancedStats(w, stats, err)
+}
+
+// GetGameAdvancedStats handles GET /api/games/{id}/advanced-stats
+func (h *AdvancedStatsHandler) GetGameAdvancedStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.advancedStatsService.GetGameAdvancedStats(pathID(r, "id"))
+	writeAdvancedStats(w, stats, err)
+}
+
+// GetPlayerAdvancedSeason handles GET /api/players/{id}/advanced-stats/{season}
+func (h *AdvancedStatsHandler) GetPlayerAdvancedSeason(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.advancedStatsService.GetPlayerAdvancedSeason(pathID(r, "id"), mux.Vars(r)["season"])
+	writeAdvancedStats(w, stats, err)
+}
+
+// GetAdvancedLeaders handles GET /api/advanced-stats/{season}/leaders?stat=yards_per_route_run&position=WR&limit=10
+func (h *AdvancedStatsHandler) GetAdvancedLeaders(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	stat := query.Get("stat")
+	if stat == "" {
+		http.Error(w, "Stat parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	limit := 10
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	leaders, err := h.advancedStatsService.GetAdvancedLeaders(mux.Vars(r)["season"], stat, query.Get("position"), limit)
+	writeAdvancedStats(w, leaders, err)
+}
+
+// writeAdvancedStats writes an advanced stats response or the error reading it
+func writeAdvancedStats(w http.ResponseWriter, body interface{}, err error) {
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") ||
+			strings.Contains(err.Error(), "cannot be empty") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get advanced stats: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
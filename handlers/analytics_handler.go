@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/routes"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// AnalyticsHandler handles HTTP requests for usage analytics
+type AnalyticsHandler struct {
+	analyticsService services.AnalyticsService
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(analyticsService services.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// RegisterRoutes registers the usage report route
+func (h *AnalyticsHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/admin/analytics", h.GetUsageReport).Methods("GET")
+}
+
+// Middleware records the matched route template (e.g. /games/{id}/odds) as the
+// usage category, the same for the versioned and unversioned paths. IDs and other
+// path values are never recorded, and admin routes are not counted.
+func (h *AnalyticsHandler) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if category, ok := routes.Template(r); ok && !strings.HasPrefix(category, "/admin") {
+			h.analyticsService.Record(category)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// GetUsageReport handles GET /api/admin/analytics?days=N
+func (h *AnalyticsHandler) GetUsageReport(w http.ResponseWriter, r *http.Request) {
+	days := 30
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		parsed, err := strconv.Atoi(daysStr)
+		if err != nil {
+			http.Error(w, "Invalid days parameter", http.StatusBadRequest)
+			return
+		}
+		days = parsed
+	}
+
+	report, err := h.analyticsService.GetUsageReport(days)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get usage report: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// BackupHandler handles HTTP requests for database backups
+type BackupHandler struct {
+	backupService services.BackupService
+}
+
+// NewBackupHandler creates a new backup handler
+func NewBackupHandler(backupService services.BackupService) *BackupHandler {
+	return &BackupHandler{
+		backupService: backupService,
+	}
+}
+
+// RegisterRoutes registers the backup and restore routes
+func (h *BackupHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/admin/backups", h.ListBackups).Methods("GET")
+	r.HandleFunc("/admin/backups", h.CreateBackup).Methods("POST")
+	r.HandleFunc("/admin/backups/{name}/restore", h.RestoreBackup).Methods("POST")
+}
+
+// CreateBackup handles POST /api/admin/backups
+func (h *BackupHandler) CreateBackup(w http.ResponseWriter, r *http.Request) {
+	backup, err := h.backupService.CreateBackup()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to back up database: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(backup)
+}
+
+// ListBackups handles GET /api/admin/backups
+func (h *BackupHandler) ListBackups(w http.ResponseWriter, r *http.Request) {
+	backups, err := h.backupService.ListBackups()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to list backups: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(backups)
+}
+
+// RestoreBackup handles POST /api/admin/backups/{name}/restore
+func (h *BackupHandler) RestoreBackup(w http.ResponseWriter, r *http.Request) {
+	result, err := h.backupService.RestoreBackup(mux.Vars(r)["name"])
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to restore backup: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
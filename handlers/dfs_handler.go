@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// DFSHandler handles HTTP requests for daily fantasy salaries and lineups
+type DFSHandler struct {
+	dfsService services.DFSService
+}
+
+// NewDFSHandler creates a new DFS handler
+func NewDFSHandler(dfsService services.DFSService) *DFSHandler {
+	return &DFSHandler{
+		dfsService: dfsService,
+	}
+}
+
+// RegisterRoutes registers the daily fantasy routes
+func (h *DFSHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/dfs/salaries", h.ImportSalaries).Methods("POST")
+	r.HandleFunc("/dfs/salaries/season/{season}/week/{week}", h.GetSlate).Methods("GET")
+	r.HandleFunc("/dfs/lineups/validate", h.ValidateLineup).Methods("POST")
+	r.HandleFunc("/dfs/lineups/optimize", h.OptimizeLineup).Methods("POST")
+}
+
+// GetSlate handles GET /api/dfs/salaries/season/{season}/week/{week}?site=&source=&position=
+func (h *DFSHandler) GetSlate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	week, err := strconv.Atoi(vars["week"])
+	if err != nil {
+		http.Error(w, "Invalid week parameter", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	players, err := h.dfsService.GetSlate(vars["season"], week, query.Get("site"), query.Get("source"), query.Get("position"))
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get DFS salaries: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(players)
+}
+
+// ImportSalaries handles POST /api/dfs/salaries with an array of salaries for any players
+func (h *DFSHandler) ImportSalaries(w http.ResponseWriter, r *http.Request) {
+	var reqs []*models.CreateDFSSalaryRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.dfsService.ImportSalaries(reqs)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to import DFS salaries: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// ValidateLineup handles POST /api/dfs/lineups/validate
+func (h *DFSHandler) ValidateLineup(w http.ResponseWriter, r *http.Request) {
+	var req models.DFSLineupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	lineup, err := h.dfsService.ValidateLineup(&req)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to validate lineup: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(lineup)
+}
+
+// OptimizeLineup handles POST /api/dfs/lineups/optimize
+func (h *DFSHandler) OptimizeLineup(w http.ResponseWriter, r *http.Request) {
+	var req models.DFSLineupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	lineup, err := h.dfsService.OptimizeLineup(&req)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to optimize lineup: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(lineup)
+}
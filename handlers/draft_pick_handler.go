@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// DraftPickHandler handles HTTP requests for draft picks
+type DraftPickHandler struct {
+	draftPickService services.DraftPickService
+}
+
+// NewDraftPickHandler creates a new draft pick handler
+func NewDraftPickHandler(draftPickService services.DraftPickService) *DraftPickHandler {
+	return &DraftPickHandler{
+		draftPickService: draftPickService,
+	}
+}
+
+// RegisterRoutes registers the draft pick routes
+func (h *DraftPickHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/draft-picks", h.GetDraftPicks).Methods("GET")
+	r.HandleFunc("/draft-picks", h.CreateDraftPick).Methods("POST")
+	r.HandleFunc("/draft-picks/{id}", h.GetDraftPick).Methods("GET")
+	r.HandleFunc("/draft-picks/{id}", h.UpdateDraftPick).Methods("PUT")
+	r.HandleFunc("/draft-picks/{id}", h.DeleteDraftPick).Methods("DELETE")
+	r.HandleFunc("/teams/{id}/draft-picks", h.GetTeamDraftPicks).Methods("GET")
+	r.HandleFunc("/players/{id}/draft-info", h.GetPlayerDraftInfo).Methods("GET")
+}
+
+// GetDraftPicks handles GET /api/draft-picks with an optional year filter
+func (h *DraftPickHandler) GetDraftPicks(w http.ResponseWriter, r *http.Request) {
+	var year *int
+	if yearStr := r.URL.Query().Get("year"); yearStr != "" {
+		parsed, err := strconv.Atoi(yearStr)
+		if err != nil {
+			http.Error(w, "Invalid year parameter", http.StatusBadRequest)
+			return
+		}
+		year = &parsed
+	}
+
+	draftPicks, err := h.draftPickService.GetDraftPicks(year)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(draftPicks)
+}
+
+// CreateDraftPick handles POST /api/draft-picks
+func (h *DraftPickHandler) CreateDraftPick(w http.ResponseWriter, r *http.Request) {
+	var req models.CreateDraftPickRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	draftPick, err := h.draftPickService.CreateDraftPick(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "already") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(draftPick)
+}
+
+// GetDraftPick handles GET /api/draft-picks/{id}
+func (h *DraftPickHandler) GetDraftPick(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	draftPick, err := h.draftPickService.GetDraftPick(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(draftPick)
+}
+
+// UpdateDraftPick handles PUT /api/draft-picks/{id}
+func (h *DraftPickHandler) UpdateDraftPick(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	var req models.UpdateDraftPickRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	draftPick, err := h.draftPickService.UpdateDraftPick(id, &req)
+	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), fmt.Sprintf("draft pick with ID %d not found", id)) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "already") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(draftPick)
+}
+
+// DeleteDraftPick handles DELETE /api/draft-picks/{id}
+func (h *DraftPickHandler) DeleteDraftPick(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.draftPickService.DeleteDraftPick(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete draft pick: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetTeamDraftPicks handles GET /api/teams/{id}/draft-picks
+func (h *DraftPickHandler) GetTeamDraftPicks(w http.ResponseWriter, r *http.Request) {
+	teamID := pathID(r, "id")
+
+	draftPicks, err := h.draftPickService.GetDraftPicksByTeam(teamID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get team draft picks: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(draftPicks)
+}
+
+// GetPlayerDraftInfo handles GET /api/players/{id}/draft-info
+func (h *DraftPickHandler) GetPlayerDraftInfo(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
+	draftPick, err := h.draftPickService.GetPlayerDraftInfo(playerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get player draft info: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(draftPick)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// eventKeepAlive is how often an idle event stream sends a comment, so proxies keep it open
+const eventKeepAlive = 30 * time.Second
+
+// EventHandler handles HTTP requests for the live event stream
+type EventHandler struct {
+	eventService services.EventService
+}
+
+// NewEventHandler creates a new event handler
+func NewEventHandler(eventService services.EventService) *EventHandler {
+	return &EventHandler{
+		eventService: eventService,
+	}
+}
+
+// RegisterRoutes registers the live event stream route
+func (h *EventHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/events", h.StreamEvents).Methods("GET")
+}
+
+// StreamEvents handles GET /api/events?types=, a Server-Sent Events stream of changes as
+// they happen. Each event's id, type and JSON body are sent as the SSE id, event and data.
+func (h *EventHandler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	var types []string
+	if typesStr := r.URL.Query().Get("types"); typesStr != "" {
+		types = strings.Split(typesStr, ",")
+	}
+
+	sub, err := h.eventService.Subscribe(types)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to subscribe to events: %v", err), http.StatusInternalServerError)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	controller := http.NewResponseController(w)
+	if _, err := fmt.Fprint(w, ": subscribed\n\n"); err != nil || controller.Flush() != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-sub.Events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return
+			}
+		}
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ExportHandler handles HTTP requests for CSV exports
+type ExportHandler struct {
+	exportService services.ExportService
+}
+
+// NewExportHandler creates a new export handler
+func NewExportHandler(exportService services.ExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// RegisterRoutes registers the export routes
+func (h *ExportHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/exports", h.ListExports).Methods("GET")
+	r.HandleFunc("/exports/player-stats", h.SubmitPlayerStatsExport).Methods("POST")
+	r.HandleFunc("/exports/{name}", h.DownloadExport).Methods("GET")
+}
+
+// SubmitPlayerStatsExport handles POST /api/exports/player-stats?season=. It queues a CSV
+// export and responds 202 with the job; the finished job's result names the export.
+func (h *ExportHandler) SubmitPlayerStatsExport(w http.ResponseWriter, r *http.Request) {
+	job, err := h.exportService.SubmitPlayerStatsExport(r.URL.Query().Get("season"))
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, models.ErrQueueFull) {
+			w.Header().Set("Retry-After", jobQueueRetryAfter)
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to queue export: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/api/jobs/%d", job.ID))
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(job)
+}
+
+// ListExports handles GET /api/exports, the newest first
+func (h *ExportHandler) ListExports(w http.ResponseWriter, r *http.Request) {
+	exports, err := h.exportService.ListExports()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to list exports: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(exports)
+}
+
+// DownloadExport handles GET /api/exports/{name}, streaming the CSV from storage
+func (h *ExportHandler) DownloadExport(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+	body, err := h.exportService.OpenExport(name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to download export: %v", err), http.StatusInternalServerError)
+		return
+	}
+	defer body.Close()
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	if _, err := io.Copy(w, body); err != nil {
+		log.Printf("Failed to send export %s: %v", name, err)
+	}
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ExternalIDHandler handles HTTP requests for provider ID mappings
+type ExternalIDHandler struct {
+	externalIDService services.ExternalIDService
+}
+
+// NewExternalIDHandler creates a new external ID handler
+func NewExternalIDHandler(externalIDService services.ExternalIDService) *ExternalIDHandler {
+	return &ExternalIDHandler{
+		externalIDService: externalIDService,
+	}
+}
+
+// RegisterRoutes registers the external ID routes
+func (h *ExternalIDHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/external-ids", h.CreateExternalID).Methods("POST")
+	r.HandleFunc("/external-ids/lookup", h.LookupExternalID).Methods("GET")
+	r.HandleFunc("/external-ids/{id}", h.DeleteExternalID).Methods("DELETE")
+	r.HandleFunc("/players/{id}/external-ids", h.GetPlayerExternalIDs).Methods("GET")
+	r.HandleFunc("/teams/{id}/external-ids", h.GetTeamExternalIDs).Methods("GET")
+	r.HandleFunc("/games/{id}/external-ids", h.GetGameExternalIDs).Methods("GET")
+}
+
+// LookupExternalID handles GET /api/external-ids/lookup?entity_type=player&provider=espn&external_id=123
+func (h *ExternalIDHandler) LookupExternalID(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	mapping, err := h.externalIDService.LookupExternalID(query.Get("entity_type"), query.Get("provider"), query.Get("external_id"))
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to look up external ID: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapping)
+}
+
+// CreateExternalID handles POST /api/external-ids
+func (h *ExternalIDHandler) CreateExternalID(w http.ResponseWriter, r *http.Request) {
+	var req models.CreateExternalIDRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	mapping, err := h.externalIDService.CreateExternalID(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "already") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") ||
+			strings.Contains(err.Error(), "not found") ||
+			strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to create external ID: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(mapping)
+}
+
+// DeleteExternalID handles DELETE /api/external-ids/{id}
+func (h *ExternalIDHandler) DeleteExternalID(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.externalIDService.DeleteExternalID(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete external ID: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPlayerExternalIDs handles GET /api/players/{id}/external-ids
+func (h *ExternalIDHandler) GetPlayerExternalIDs(w http.ResponseWriter, r *http.Request) {
+	h.getEntityExternalIDs(w, r, "player")
+}
+
+// GetTeamExternalIDs handles GET /api/teams/{id}/external-ids
+func (h *ExternalIDHandler) GetTeamExternalIDs(w http.ResponseWriter, r *http.Request) {
+	h.getEntityExternalIDs(w, r, "team")
+}
+
+// GetGameExternalIDs handles GET /api/games/{id}/external-ids
+func (h *ExternalIDHandler) GetGameExternalIDs(w http.ResponseWriter, r *http.Request) {
+	h.getEntityExternalIDs(w, r, "game")
+}
+
+// getEntityExternalIDs writes the provider IDs of the entity identified by the {id} path variable
+func (h *ExternalIDHandler) getEntityExternalIDs(w http.ResponseWriter, r *http.Request, entityType string) {
+	id := pathID(r, "id")
+
+	externalIDs, err := h.externalIDService.GetExternalIDs(entityType, id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get external IDs: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(externalIDs)
+}
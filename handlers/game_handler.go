@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"sports-backend/models"
 	"sports-backend/services"
@@ -24,6 +26,21 @@ func NewGameHandler(gameService services.GameService) *GameHandler {
 	}
 }
 
+// RegisterRoutes registers the game routes, including team schedules and purging a deleted game
+func (h *GameHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/games", h.GetGames).Methods("GET")
+	r.HandleFunc("/games", h.CreateGame).Methods("POST")
+	r.HandleFunc("/games/{id}", h.GetGame).Methods("GET")
+	r.HandleFunc("/games/{id}", h.UpdateGame).Methods("PUT")
+	r.HandleFunc("/games/{id}", h.DeleteGame).Methods("DELETE")
+	r.HandleFunc("/games/{id}/restore", h.RestoreGame).Methods("POST")
+	r.HandleFunc("/teams/{id}/games", h.GetGamesByTeam).Methods("GET")
+	r.HandleFunc("/teams/{id}/schedule.ics", h.GetTeamScheduleCalendar).Methods("GET")
+	r.HandleFunc("/games/season/{season}", h.GetGamesBySeason).Methods("GET")
+	r.HandleFunc("/games/season/{season}/week/{week}", h.GetGamesByWeek).Methods("GET")
+	r.HandleFunc("/admin/games/{id}", h.PurgeGame).Methods("DELETE")
+}
+
 // GetGames handles GET /api/games
 func (h *GameHandler) GetGames(w http.ResponseWriter, r *http.Request) {
 	games, err := h.gameService.GetAllGames()
@@ -32,18 +49,16 @@ func (h *GameHandler) GetGames(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(games)
+	h.writeGames(w, r, games)
 }
 
 // GetGame handles GET /api/games/{id}
 func (h *GameHandler) GetGame(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
+	id := pathID(r, "id")
 
-	id, err := strconv.Atoi(idStr)
+	tz, err := displayTimezone(w, r)
 	if err != nil {
-		http.Error(w, "Invalid game ID", http.StatusBadRequest)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
@@ -57,12 +72,26 @@ func (h *GameHandler) GetGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(game)
+	if err := h.gameService.IncludeRelated([]*models.Game{game}, r.URL.Query().Get("include")); err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get game: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	writeGame(w, http.StatusOK, game, tz)
 }
 
 // CreateGame handles POST /api/games
 func (h *GameHandler) CreateGame(w http.ResponseWriter, r *http.Request) {
+	tz, err := displayTimezone(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	var req models.CreateGameRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid JSON", http.StatusBadRequest)
@@ -71,6 +100,14 @@ func (h *GameHandler) CreateGame(w http.ResponseWriter, r *http.Request) {
 
 	game, err := h.gameService.CreateGame(&req)
 	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "already exists with ID") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		if strings.Contains(err.Error(), "validation failed") ||
 			strings.Contains(err.Error(), "not found") ||
 			strings.Contains(err.Error(), "cannot be the same") {
@@ -81,19 +118,16 @@ func (h *GameHandler) CreateGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(game)
+	writeGame(w, http.StatusCreated, game, tz)
 }
 
 // UpdateGame handles PUT /api/games/{id}
 func (h *GameHandler) UpdateGame(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
+	id := pathID(r, "id")
 
-	id, err := strconv.Atoi(idStr)
+	tz, err := displayTimezone(w, r)
 	if err != nil {
-		http.Error(w, "Invalid game ID", http.StatusBadRequest)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
@@ -105,6 +139,10 @@ func (h *GameHandler) UpdateGame(w http.ResponseWriter, r *http.Request) {
 
 	game, err := h.gameService.UpdateGame(id, &req)
 	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		if strings.Contains(err.Error(), "validation failed") ||
 			strings.Contains(err.Error(), "not found") ||
 			strings.Contains(err.Error(), "cannot be the same") {
@@ -115,57 +153,123 @@ func (h *GameHandler) UpdateGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(game)
+	writeGame(w, http.StatusOK, game, tz)
 }
 
 // DeleteGame handles DELETE /api/games/{id}
 func (h *GameHandler) DeleteGame(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
+	id := pathID(r, "id")
+
+	if err := h.gameService.DeleteGame(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete game: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
 
-	id, err := strconv.Atoi(idStr)
+// RestoreGame handles POST /api/games/{id}/restore
+func (h *GameHandler) RestoreGame(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	tz, err := displayTimezone(w, r)
 	if err != nil {
-		http.Error(w, "Invalid game ID", http.StatusBadRequest)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	err = h.gameService.DeleteGame(id)
+	game, err := h.gameService.RestoreGame(id)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			http.Error(w, err.Error(), http.StatusNotFound)
 			return
 		}
-		http.Error(w, fmt.Sprintf("Failed to delete game: %v", err), http.StatusInternalServerError)
+		if strings.Contains(err.Error(), "invalid game ID") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to restore game: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	writeGame(w, http.StatusOK, game, tz)
+}
+
+// PurgeGame handles DELETE /api/admin/games/{id}
+func (h *GameHandler) PurgeGame(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.gameService.PurgeGame(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid game ID") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(err.Error(), "cannot be purged") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to purge game: %v", err), http.StatusInternalServerError)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// GetGamesByTeam handles GET /api/teams/{id}/games
+// GetGamesByTeam handles GET /api/teams/{id}/games?result=, optionally only the games the team won, lost or tied
 func (h *GameHandler) GetGamesByTeam(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	teamIDStr := vars["id"]
+	teamID := pathID(r, "id")
 
-	teamID, err := strconv.Atoi(teamIDStr)
+	games, err := h.gameService.GetGamesByTeam(teamID)
 	if err != nil {
-		http.Error(w, "Invalid team ID", http.StatusBadRequest)
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get games: %v", err), http.StatusInternalServerError)
 		return
 	}
 
-	games, err := h.gameService.GetGamesByTeam(teamID)
+	games, err = h.gameService.FilterByResult(games, teamID, r.URL.Query().Get("result"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.writeGames(w, r, games)
+}
+
+// GetTeamScheduleCalendar handles GET /api/teams/{id}/schedule.ics, the team's games as an
+// iCalendar feed to subscribe to from calendar apps
+func (h *GameHandler) GetTeamScheduleCalendar(w http.ResponseWriter, r *http.Request) {
+	teamID := pathID(r, "id")
+
+	team, games, err := h.gameService.GetTeamSchedule(teamID)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			http.Error(w, err.Error(), http.StatusNotFound)
 			return
 		}
-		http.Error(w, fmt.Sprintf("Failed to get games: %v", err), http.StatusInternalServerError)
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get schedule: %v", err), http.StatusInternalServerError)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(games)
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"team-%d-schedule.ics\"", teamID))
+	if err := writeGameCalendar(w, fmt.Sprintf("%s %s schedule", team.City, team.Name), games); err != nil {
+		log.Printf("Failed to send schedule calendar for team %d: %v", teamID, err)
+	}
 }
 
 // GetGamesBySeason handles GET /api/games/season/{season}
@@ -184,8 +288,7 @@ func (h *GameHandler) GetGamesBySeason(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(games)
+	h.writeGames(w, r, games)
 }
 
 // GetGamesByWeek handles GET /api/games/season/{season}/week/{week}
@@ -215,6 +318,43 @@ func (h *GameHandler) GetGamesByWeek(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.writeGames(w, r, games)
+}
+
+// writeGames keeps the games of the game_type parameter, loads the related records named in
+// the include parameter and writes the games
+func (h *GameHandler) writeGames(w http.ResponseWriter, r *http.Request, games []*models.Game) {
+	games, err := h.gameService.FilterByGameType(games, r.URL.Query().Get("game_type"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.gameService.IncludeRelated(games, r.URL.Query().Get("include")); err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get games: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	tz, err := displayTimezone(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	localizeGames(games, tz)
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(games)
 }
+
+// writeGame writes one game with its date in the display timezone tz
+func writeGame(w http.ResponseWriter, status int, game *models.Game, tz string) {
+	localizeGames([]*models.Game{game}, tz)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(game)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// HealthHandler handles liveness and readiness probes
+type HealthHandler struct {
+	healthService services.HealthService
+}
+
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(healthService services.HealthService) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+	}
+}
+
+// RegisterRoutes registers the health check routes. They belong on the root router, outside
+// the API prefix. /health predates the split and reports readiness.
+func (h *HealthHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/livez", h.Livez).Methods("GET")
+	r.HandleFunc("/readyz", h.Readyz).Methods("GET")
+	r.HandleFunc("/health", h.Readyz).Methods("GET")
+}
+
+// Livez handles GET /livez. It only shows the process is serving requests and checks no dependencies.
+func (h *HealthHandler) Livez(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": models.HealthOK})
+}
+
+// Readyz handles GET /readyz and GET /health. It responds 503 when any dependency check fails.
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	report := h.healthService.Ready()
+
+	w.Header().Set("Content-Type", "application/json")
+	if report.Status != models.HealthOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
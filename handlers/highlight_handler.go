@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// HighlightHandler handles HTTP requests for weekly highlights
+type HighlightHandler struct {
+	highlightService services.HighlightService
+}
+
+// NewHighlightHandler creates a new highlight handler
+func NewHighlightHandler(highlightService services.HighlightService) *HighlightHandler {
+	return &HighlightHandler{
+		highlightService: highlightService,
+	}
+}
+
+// RegisterRoutes registers the highlights route
+func (h *HighlightHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/highlights", h.GetHighlights).Methods("GET")
+}
+
+// GetHighlights handles GET /api/highlights?week=N
+func (h *HighlightHandler) GetHighlights(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	weekStr := query.Get("week")
+	if weekStr == "" {
+		http.Error(w, "Week parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	week, err := strconv.Atoi(weekStr)
+	if err != nil {
+		http.Error(w, "Invalid week parameter", http.StatusBadRequest)
+		return
+	}
+
+	// Thresholds default to the standard values and can be overridden per request
+	thresholds := models.DefaultHighlightThresholds()
+	overrides := []struct {
+		param  string
+		target *int
+	}{
+		{"min_passing_yards", &thresholds.PassingYards},
+		{"min_rushing_yards", &thresholds.RushingYards},
+		{"min_receiving_yards", &thresholds.ReceivingYards},
+		{"min_touchdowns", &thresholds.Touchdowns},
+		{"min_defensive_touchdowns", &thresholds.DefensiveTouchdowns},
+	}
+	for _, override := range overrides {
+		value := query.Get(override.param)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid %s parameter", override.param), http.StatusBadRequest)
+			return
+		}
+		*override.target = parsed
+	}
+
+	highlights, err := h.highlightService.GetWeeklyHighlights(query.Get("season"), week, thresholds)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") ||
+			strings.Contains(err.Error(), "must be between 1 and 22") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(err.Error(), "no seasons found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get highlights: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(highlights)
+}
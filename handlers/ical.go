@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"sports-backend/models"
+)
+
+const (
+	// gameDuration is how long a game's calendar event lasts; games have no end time
+	gameDuration = 3*time.Hour + 30*time.Minute
+	// calendarRefresh is how often subscribed calendar apps are asked to fetch the feed again
+	calendarRefresh = "PT6H"
+
+	icalDateTime = "20060102T150405"
+)
+
+// writeGameCalendar writes games as an RFC 5545 iCalendar feed named name. Games at a venue
+// are in the venue's timezone, with a VTIMEZONE for each; other games are in UTC. Each game
+// keeps its UID across fetches, so calendar apps update events instead of duplicating them.
+func writeGameCalendar(w io.Writer, name string, games []*models.Game) error {
+	cal := &icalWriter{w: w}
+	cal.line("BEGIN:VCALENDAR")
+	cal.line("VERSION:2.0")
+	cal.line("PRODID:-//sports-backend//Game Schedules//EN")
+	cal.line("CALSCALE:GREGORIAN")
+	cal.line("METHOD:PUBLISH")
+	cal.line("X-WR-CALNAME:" + icalText(name))
+	cal.line("REFRESH-INTERVAL;VALUE=DURATION:" + calendarRefresh)
+	cal.line("X-PUBLISHED-TTL:" + calendarRefresh)
+
+	// Each timezone is described once, covering every game played in it
+	zones := make(map[string][]time.Time)
+	for _, game := range games {
+		if location := gameLocation(game); location != time.UTC {
+			zones[location.String()] = append(zones[location.String()], game.GameDate)
+		}
+	}
+	zoneNames := make([]string, 0, len(zones))
+	for zone := range zones {
+		zoneNames = append(zoneNames, zone)
+	}
+	sort.Strings(zoneNames)
+	for _, zone := range zoneNames {
+		location, _ := time.LoadLocation(zone)
+		writeTimezone(cal, location, zones[zone])
+	}
+
+	for _, game := range games {
+		start := game.GameDate
+		cal.line("BEGIN:VEVENT")
+		cal.line(fmt.Sprintf("UID:game-%d@sports-backend", game.ID))
+		cal.line("DTSTAMP:" + game.UpdatedAt.UTC().Format(icalDateTime) + "Z")
+		cal.line("LAST-MODIFIED:" + game.UpdatedAt.UTC().Format(icalDateTime) + "Z")
+		if location := gameLocation(game); location != time.UTC {
+			cal.line(fmt.Sprintf("DTSTART;TZID=%s:%s", location, start.In(location).Format(icalDateTime)))
+			cal.line(fmt.Sprintf("DTEND;TZID=%s:%s", location, start.Add(gameDuration).In(location).Format(icalDateTime)))
+		} else {
+			cal.line("DTSTART:" + start.UTC().Format(icalDateTime) + "Z")
+			cal.line("DTEND:" + start.Add(gameDuration).UTC().Format(icalDateTime) + "Z")
+		}
+		cal.line("SUMMARY:" + icalText(gameSummary(game)))
+		cal.line("DESCRIPTION:" + icalText(fmt.Sprintf("%s season, week %d", game.Season, game.Week)))
+		if game.Venue != nil {
+			cal.line("LOCATION:" + icalText(game.Venue.Name+", "+game.Venue.City))
+			if game.Venue.Latitude != nil && game.Venue.Longitude != nil {
+				cal.line(fmt.Sprintf("GEO:%f;%f", *game.Venue.Latitude, *game.Venue.Longitude))
+			}
+		}
+		if game.Status == "cancelled" {
+			cal.line("STATUS:CANCELLED")
+		} else {
+			cal.line("STATUS:CONFIRMED")
+		}
+		cal.line("TRANSP:TRANSPARENT")
+		cal.line("END:VEVENT")
+	}
+
+	cal.line("END:VCALENDAR")
+	return cal.err
+}
+
+// gameLocation is the timezone of the game's venue, or UTC when it has no venue or the
+// venue's timezone is unknown
+func gameLocation(game *models.Game) *time.Location {
+	if game.Venue == nil || game.Venue.Timezone == "" {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(game.Venue.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// gameSummary names the teams, away team first, with the score once the game is completed and
+// whether it went to overtime
+func gameSummary(game *models.Game) string {
+	away, home := fmt.Sprintf("Team %d", game.AwayTeamID), fmt.Sprintf("Team %d", game.HomeTeamID)
+	if game.AwayTeam != nil {
+		away = game.AwayTeam.City + " " + game.AwayTeam.Name
+	}
+	if game.HomeTeam != nil {
+		home = game.HomeTeam.City + " " + game.HomeTeam.Name
+	}
+
+	separator := "at"
+	if game.NeutralSite {
+		separator = "vs"
+	}
+	if game.Status == "completed" && game.HomeScore != nil && game.AwayScore != nil {
+		summary := fmt.Sprintf("%s %d %s %s %d", away, *game.AwayScore, separator, home, *game.HomeScore)
+		if game.Overtime {
+			summary += " (OT)"
+		}
+		return summary
+	}
+	return fmt.Sprintf("%s %s %s", away, separator, home)
+}
+
+// writeTimezone writes a VTIMEZONE for location with an observance for each UTC offset change
+// from a year before the first date to the last date, so every date falls within one. A zone
+// without changes in that span gets a single standard observance.
+func writeTimezone(cal *icalWriter, location *time.Location, dates []time.Time) {
+	first, last := dates[0], dates[0]
+	for _, date := range dates {
+		if date.Before(first) {
+			first = date
+		}
+		if date.After(last) {
+			last = date
+		}
+	}
+
+	cal.line("BEGIN:VTIMEZONE")
+	cal.line("TZID:" + location.String())
+
+	transitions := 0
+	at := first.AddDate(-1, 0, 0).In(location)
+	for at.Before(last) {
+		next := at.Add(24 * time.Hour)
+		_, offset := at.Zone()
+		if _, nextOffset := next.Zone(); nextOffset != offset {
+			// Narrow the change down to the second
+			before, after := at, next
+			for after.Sub(before) > time.Second {
+				mid := before.Add(after.Sub(before) / 2)
+				if _, midOffset := mid.Zone(); midOffset == offset {
+					before = mid
+				} else {
+					after = mid
+				}
+			}
+			writeObservance(cal, after, offset)
+			transitions++
+		}
+		at = next
+	}
+
+	if transitions == 0 {
+		_, offset := first.In(location).Zone()
+		writeObservance(cal, time.Date(1970, 1, 1, 0, 0, 0, 0, location), offset)
+	}
+	cal.line("END:VTIMEZONE")
+}
+
+// writeObservance writes the observance that starts at the instant start, when the offset
+// changes from fromOffset. DTSTART is the local time before the change, as RFC 5545 requires.
+func writeObservance(cal *icalWriter, start time.Time, fromOffset int) {
+	zoneName, toOffset := start.Zone()
+	kind := "STANDARD"
+	if start.IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	cal.line("BEGIN:" + kind)
+	cal.line("DTSTART:" + start.UTC().Add(time.Duration(fromOffset)*time.Second).Format(icalDateTime))
+	cal.line("TZOFFSETFROM:" + icalOffset(fromOffset))
+	cal.line("TZOFFSETTO:" + icalOffset(toOffset))
+	cal.line("TZNAME:" + icalText(zoneName))
+	cal.line("END:" + kind)
+}
+
+// icalOffset formats a UTC offset in seconds as +HHMM
+func icalOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+}
+
+// icalText escapes a TEXT value
+func icalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// icalWriter writes content lines with CRLF endings, folding lines longer than 75 octets
+// without splitting a UTF-8 character. The first error is kept and later writes are skipped.
+type icalWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes one content line
+func (c *icalWriter) line(s string) {
+	if c.err != nil {
+		return
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		size := len(string(r))
+		if width+size > 75 {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+	_, c.err = io.WriteString(c.w, b.String())
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// InjuryHandler handles HTTP requests for the injury report
+type InjuryHandler struct {
+	injuryService services.InjuryService
+}
+
+// NewInjuryHandler creates a new injury handler
+func NewInjuryHandler(injuryService services.InjuryService) *InjuryHandler {
+	return &InjuryHandler{
+		injuryService: injuryService,
+	}
+}
+
+// RegisterRoutes registers the injury report routes
+func (h *InjuryHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/injuries", h.GetInjuries).Methods("GET")
+	r.HandleFunc("/injuries/report", h.ApplyReport).Methods("POST")
+	r.HandleFunc("/players/{id}/injuries", h.GetPlayerInjuries).Methods("GET")
+}
+
+// GetInjuries handles GET /api/injuries?team_id=
+func (h *InjuryHandler) GetInjuries(w http.ResponseWriter, r *http.Request) {
+	teamID := 0
+	if teamIDStr := r.URL.Query().Get("team_id"); teamIDStr != "" {
+		var err error
+		teamID, err = strconv.Atoi(teamIDStr)
+		if err != nil {
+			http.Error(w, "Invalid team_id parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	players, err := h.injuryService.GetInjuries(teamID)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get injuries: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(players)
+}
+
+// ApplyReport handles POST /api/injuries/report
+func (h *InjuryHandler) ApplyReport(w http.ResponseWriter, r *http.Request) {
+	var report models.InjuryReport
+	if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.injuryService.ApplyReport(&report)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to apply injury report: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// GetPlayerInjuries handles GET /api/players/{id}/injuries
+func (h *InjuryHandler) GetPlayerInjuries(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
+	changes, err := h.injuryService.GetPlayerInjuries(playerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get player injuries: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(changes)
+}
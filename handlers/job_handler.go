@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// jobQueueRetryAfter is the Retry-After, in seconds, sent when the job queue is full
+const jobQueueRetryAfter = "10"
+
+// JobHandler handles HTTP requests for background jobs
+type JobHandler struct {
+	jobService       services.JobService
+	importJobService services.ImportJobService
+}
+
+// NewJobHandler creates a new job handler
+func NewJobHandler(jobService services.JobService, importJobService services.ImportJobService) *JobHandler {
+	return &JobHandler{
+		jobService:       jobService,
+		importJobService: importJobService,
+	}
+}
+
+// RegisterRoutes registers the background job and import routes
+func (h *JobHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/imports/{kind}", h.SubmitImport).Methods("POST")
+	r.HandleFunc("/jobs", h.ListJobs).Methods("GET")
+	r.HandleFunc("/jobs/{id}", h.GetJob).Methods("GET")
+	r.HandleFunc("/jobs/{id}/cancel", h.CancelJob).Methods("POST")
+}
+
+// SubmitImport handles POST /api/imports/{kind}, where kind is adp, projections, dfs-salaries,
+// odds or advanced-stats and the body is the array the matching bulk endpoint takes, or nflverse-stats and the
+// body is an nflverse weekly player stats or play-by-play CSV file. It queues the import and
+// responds 202 with the job; poll GET /api/jobs/{id} for progress.
+func (h *JobHandler) SubmitImport(w http.ResponseWriter, r *http.Request) {
+	kind := mux.Vars(r)["kind"]
+
+	var job *models.Job
+	var err error
+	switch kind {
+	case models.JobImportADP:
+		var reqs []*models.CreateADPRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+		job, err = h.importJobService.SubmitADP(reqs)
+	case models.JobImportProjections:
+		var reqs []*models.CreateProjectionRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+		job, err = h.importJobService.SubmitProjections(reqs)
+	case models.JobImportDFSSalaries:
+		var reqs []*models.CreateDFSSalaryRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+		job, err = h.importJobService.SubmitSalaries(reqs)
+	case models.JobImportOdds:
+		var reqs []*models.CreateGameOddsRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+		job, err = h.importJobService.SubmitOdds(reqs)
+	case models.JobImportAdvanced:
+		var reqs []*models.CreateAdvancedStatsRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+		job, err = h.importJobService.SubmitAdvancedStats(reqs)
+	case models.JobImportNflverse:
+		var lines []*models.NflverseStatLine
+		if lines, err = services.DecodeNflverseCSV(r.Body); err == nil {
+			job, err = h.importJobService.SubmitNflverseStats(lines)
+		}
+	default:
+		http.Error(w, fmt.Sprintf("Unknown import kind %q: must be one of %s", kind, strings.Join(models.ImportJobKinds, ", ")), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		if errors.Is(err, models.ErrQueueFull) {
+			w.Header().Set("Retry-After", jobQueueRetryAfter)
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to queue import: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/api/jobs/%d", job.ID))
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(job)
+}
+
+// ListJobs handles GET /api/jobs?status=&kind=&limit=, the latest jobs first
+func (h *JobHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	limit := 50
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	jobs, err := h.jobService.ListJobs(r.URL.Query().Get("status"), r.URL.Query().Get("kind"), limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to list jobs: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(jobs)
+}
+
+// GetJob handles GET /api/jobs/{id}
+func (h *JobHandler) GetJob(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	job, err := h.jobService.GetJob(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get job: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(job)
+}
+
+// CancelJob handles POST /api/jobs/{id}/cancel. A queued job is cancelled at once; a running
+// one stops after its current batch. Jobs that have already finished give 409.
+func (h *JobHandler) CancelJob(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	job, err := h.jobService.CancelJob(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to cancel job: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(job)
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// multipartOverhead allows for the multipart boundaries and headers around an uploaded file
+const multipartOverhead = 64 << 10
+
+// MediaHandler handles HTTP requests for team logos and player headshots
+type MediaHandler struct {
+	mediaService   services.MediaService
+	maxUploadBytes int64
+}
+
+// NewMediaHandler creates a new media handler accepting images of up to maxUploadBytes
+func NewMediaHandler(mediaService services.MediaService, maxUploadBytes int64) *MediaHandler {
+	return &MediaHandler{
+		mediaService:   mediaService,
+		maxUploadBytes: maxUploadBytes,
+	}
+}
+
+// RegisterRoutes registers the team logo and player headshot routes
+func (h *MediaHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/teams/{id}/logo", h.GetTeamLogo).Methods("GET")
+	r.HandleFunc("/teams/{id}/logo", h.UploadTeamLogo).Methods("POST")
+	r.HandleFunc("/teams/{id}/logo", h.DeleteTeamLogo).Methods("DELETE")
+	r.HandleFunc("/players/{id}/headshot", h.GetPlayerHeadshot).Methods("GET")
+	r.HandleFunc("/players/{id}/headshot", h.UploadPlayerHeadshot).Methods("POST")
+	r.HandleFunc("/players/{id}/headshot", h.DeletePlayerHeadshot).Methods("DELETE")
+}
+
+// UploadTeamLogo handles POST /api/teams/{id}/logo, a multipart form with the image in file
+func (h *MediaHandler) UploadTeamLogo(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	data, contentType, ok := h.readUpload(w, r)
+	if !ok {
+		return
+	}
+
+	team, err := h.mediaService.UploadTeamLogo(id, data, contentType)
+	if err != nil {
+		writeMediaError(w, "Failed to upload logo", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(team)
+}
+
+// GetTeamLogo handles GET /api/teams/{id}/logo
+func (h *MediaHandler) GetTeamLogo(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	body, contentType, err := h.mediaService.GetTeamLogo(id)
+	if err != nil {
+		writeMediaError(w, "Failed to get logo", err)
+		return
+	}
+	serveImage(w, body, contentType)
+}
+
+// DeleteTeamLogo handles DELETE /api/teams/{id}/logo
+func (h *MediaHandler) DeleteTeamLogo(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.mediaService.DeleteTeamLogo(id); err != nil {
+		writeMediaError(w, "Failed to delete logo", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UploadPlayerHeadshot handles POST /api/players/{id}/headshot, a multipart form with the
+// image in file
+func (h *MediaHandler) UploadPlayerHeadshot(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	data, contentType, ok := h.readUpload(w, r)
+	if !ok {
+		return
+	}
+
+	player, err := h.mediaService.UploadPlayerHeadshot(id, data, contentType)
+	if err != nil {
+		writeMediaError(w, "Failed to upload headshot", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(player)
+}
+
+// GetPlayerHeadshot handles GET /api/players/{id}/headshot
+func (h *MediaHandler) GetPlayerHeadshot(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	body, contentType, err := h.mediaService.GetPlayerHeadshot(id)
+	if err != nil {
+		writeMediaError(w, "Failed to get headshot", err)
+		return
+	}
+	serveImage(w, body, contentType)
+}
+
+// DeletePlayerHeadshot handles DELETE /api/players/{id}/headshot
+func (h *MediaHandler) DeletePlayerHeadshot(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.mediaService.DeletePlayerHeadshot(id); err != nil {
+		writeMediaError(w, "Failed to delete headshot", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// readUpload reads the file field of a multipart upload, writing the error response and
+// returning false if there is none or it is too large
+func (h *MediaHandler) readUpload(w http.ResponseWriter, r *http.Request) ([]byte, string, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxUploadBytes+multipartOverhead)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, fmt.Sprintf("Image must be at most %d bytes", h.maxUploadBytes), http.StatusRequestEntityTooLarge)
+			return nil, "", false
+		}
+		http.Error(w, "Expected a multipart form with the image in a file field", http.StatusBadRequest)
+		return nil, "", false
+	}
+	defer file.Close()
+
+	if header.Size > h.maxUploadBytes {
+		http.Error(w, fmt.Sprintf("Image must be at most %d bytes", h.maxUploadBytes), http.StatusRequestEntityTooLarge)
+		return nil, "", false
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Failed to read upload", http.StatusBadRequest)
+		return nil, "", false
+	}
+	return data, header.Header.Get("Content-Type"), true
+}
+
+// writeMediaError maps a media service error to its status
+func writeMediaError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not found"):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case strings.Contains(err.Error(), "unsupported media type"):
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+	case strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid"):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, fmt.Sprintf("%s: %v", message, err), http.StatusInternalServerError)
+	}
+}
+
+// serveImage streams a stored image
+func serveImage(w http.ResponseWriter, body io.ReadCloser, contentType string) {
+	defer body.Close()
+	w.Header().Set("Content-Type", contentType)
+	if _, err := io.Copy(w, body); err != nil {
+		log.Printf("Failed to send image: %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// NewsHandler handles HTTP requests for news items
+type NewsHandler struct {
+	newsService services.NewsService
+}
+
+// NewNewsHandler creates a new news handler
+func NewNewsHandler(newsService services.NewsService) *NewsHandler {
+	return &NewsHandler{
+		newsService: newsService,
+	}
+}
+
+// RegisterRoutes registers the news routes
+func (h *NewsHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/news", h.ListNews).Methods("GET")
+	r.HandleFunc("/news", h.ImportNews).Methods("POST")
+	r.HandleFunc("/news/{id}", h.GetNewsItem).Methods("GET")
+	r.HandleFunc("/news/{id}", h.DeleteNewsItem).Methods("DELETE")
+	r.HandleFunc("/players/{id}/news", h.GetPlayerNews).Methods("GET")
+	r.HandleFunc("/teams/{id}/news", h.GetTeamNews).Methods("GET")
+}
+
+// ImportNews handles POST /api/news with an array of news items
+func (h *NewsHandler) ImportNews(w http.ResponseWriter, r *http.Request) {
+	var reqs []*models.CreateNewsItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.newsService.ImportNews(reqs)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to import news: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// ListNews handles GET /api/news?since=&limit=, the latest published first
+func (h *NewsHandler) ListNews(w http.ResponseWriter, r *http.Request) {
+	since, limit, ok := newsPage(w, r)
+	if !ok {
+		return
+	}
+
+	items, err := h.newsService.ListNews(since, limit)
+	h.writeNews(w, items, err)
+}
+
+// GetNewsItem handles GET /api/news/{id}
+func (h *NewsHandler) GetNewsItem(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	item, err := h.newsService.GetNewsItem(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get news item: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+// DeleteNewsItem handles DELETE /api/news/{id}
+func (h *NewsHandler) DeleteNewsItem(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.newsService.DeleteNewsItem(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete news item: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPlayerNews handles GET /api/players/{id}/news?since=&limit=, the latest published first
+func (h *NewsHandler) GetPlayerNews(w http.ResponseWriter, r *http.Request) {
+	since, limit, ok := newsPage(w, r)
+	if !ok {
+		return
+	}
+
+	items, err := h.newsService.GetPlayerNews(pathID(r, "id"), since, limit)
+	h.writeNews(w, items, err)
+}
+
+// GetTeamNews handles GET /api/teams/{id}/news?since=&limit=, the latest published first
+func (h *NewsHandler) GetTeamNews(w http.ResponseWriter, r *http.Request) {
+	since, limit, ok := newsPage(w, r)
+	if !ok {
+		return
+	}
+
+	items, err := h.newsService.GetTeamNews(pathID(r, "id"), since, limit)
+	h.writeNews(w, items, err)
+}
+
+// writeNews writes a news listing or the error reading it
+func (h *NewsHandler) writeNews(w http.ResponseWriter, items []*models.NewsItem, err error) {
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get news: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(items)
+}
+
+// newsPage parses the since and limit parameters of a news listing, answering 400 when either
+// is invalid
+func newsPage(w http.ResponseWriter, r *http.Request) (*time.Time, int, bool) {
+	query := r.URL.Query()
+
+	limit := 50
+	if limitStr := query.Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return nil, 0, false
+		}
+	}
+
+	var since *time.Time
+	if sinceStr := query.Get("since"); sinceStr != "" {
+		parsed, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			http.Error(w, "Invalid since parameter: must be an RFC 3339 time such as 2024-09-01T00:00:00Z", http.StatusBadRequest)
+			return nil, 0, false
+		}
+		since = &parsed
+	}
+
+	return since, limit, true
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// NotificationHandler handles HTTP requests for notification subscriptions
+type NotificationHandler struct {
+	notificationService services.NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(notificationService services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// RegisterRoutes registers the notification subscription routes
+func (h *NotificationHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/notifications/subscriptions", h.GetSubscriptions).Methods("GET")
+	r.HandleFunc("/notifications/subscriptions", h.CreateSubscription).Methods("POST")
+	r.HandleFunc("/notifications/subscriptions/{id}", h.GetSubscription).Methods("GET")
+	r.HandleFunc("/notifications/subscriptions/{id}", h.UpdateSubscription).Methods("PUT")
+	r.HandleFunc("/notifications/subscriptions/{id}", h.DeleteSubscription).Methods("DELETE")
+	r.HandleFunc("/notifications/subscriptions/{id}/test", h.SendTestNotification).Methods("POST")
+}
+
+// GetSubscriptions handles GET /api/notifications/subscriptions
+func (h *NotificationHandler) GetSubscriptions(w http.ResponseWriter, r *http.Request) {
+	subscriptions, err := h.notificationService.GetAllSubscriptions()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(subscriptions)
+}
+
+// CreateSubscription handles POST /api/notifications/subscriptions
+func (h *NotificationHandler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var req models.CreateNotificationSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	subscription, err := h.notificationService.CreateSubscription(&req)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to create notification subscription: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(subscription)
+}
+
+// GetSubscription handles GET /api/notifications/subscriptions/{id}
+func (h *NotificationHandler) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	subscription, err := h.notificationService.GetSubscription(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(subscription)
+}
+
+// UpdateSubscription handles PUT /api/notifications/subscriptions/{id}
+func (h *NotificationHandler) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	var req models.UpdateNotificationSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	subscription, err := h.notificationService.UpdateSubscription(id, &req)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to update notification subscription: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(subscription)
+}
+
+// DeleteSubscription handles DELETE /api/notifications/subscriptions/{id}
+func (h *NotificationHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.notificationService.DeleteSubscription(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete notification subscription: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SendTestNotification handles POST /api/notifications/subscriptions/{id}/test. It queues a
+// test notification and responds 202 with the job; the finished job's result reports whether
+// it was delivered.
+func (h *NotificationHandler) SendTestNotification(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	job, err := h.notificationService.SendTest(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, models.ErrQueueFull) {
+			w.Header().Set("Retry-After", jobQueueRetryAfter)
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to queue test notification: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/api/jobs/%d", job.ID))
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(job)
+}
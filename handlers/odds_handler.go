@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// OddsHandler handles HTTP requests for betting lines
+type OddsHandler struct {
+	oddsService services.OddsService
+}
+
+// NewOddsHandler creates a new odds handler
+func NewOddsHandler(oddsService services.OddsService) *OddsHandler {
+	return &OddsHandler{
+		oddsService: oddsService,
+	}
+}
+
+// RegisterRoutes registers the betting line routes
+func (h *OddsHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/games/{id}/odds", h.GetGameOdds).Methods("GET")
+	r.HandleFunc("/games/{id}/odds", h.CreateGameOdds).Methods("POST")
+	r.HandleFunc("/odds", h.ImportOdds).Methods("POST")
+}
+
+// GetGameOdds handles GET /api/games/{id}/odds
+func (h *OddsHandler) GetGameOdds(w http.ResponseWriter, r *http.Request) {
+	gameID := pathID(r, "id")
+
+	oddsList, err := h.oddsService.GetOddsByGame(gameID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get game odds: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(oddsList)
+}
+
+// CreateGameOdds handles POST /api/games/{id}/odds
+func (h *OddsHandler) CreateGameOdds(w http.ResponseWriter, r *http.Request) {
+	gameID := pathID(r, "id")
+
+	var req models.CreateGameOddsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	// Set the game ID from the URL
+	req.GameID = gameID
+
+	odds, err := h.oddsService.CreateOdds(&req)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") ||
+			strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to create game odds: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(odds)
+}
+
+// ImportOdds handles POST /api/odds with an array of lines for any games
+func (h *OddsHandler) ImportOdds(w http.ResponseWriter, r *http.Request) {
+	var reqs []*models.CreateGameOddsRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	oddsList, err := h.oddsService.CreateOddsBatch(reqs)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") ||
+			strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to import odds: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(oddsList)
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// pathIDsKey is the request context key of the parsed path IDs
+type pathIDsKey struct{}
+
+// pathIDLabels names the ID in a path segment's error message by the segment before it, e.g.
+// "game" for /games/{id}. Segments not listed use the parameter name.
+var pathIDLabels = map[string]string{
+	"teams":         "team",
+	"players":       "player",
+	"games":         "game",
+	"venues":        "venue",
+	"draft-picks":   "draft pick",
+	"external-ids":  "external",
+	"projections":   "projection",
+	"jobs":          "job",
+	"subscriptions": "notification subscription",
+	"stats":         "stats",
+	"merge":         "duplicate player",
+	"news":          "news item",
+}
+
+// PathIDs parses the ID path parameters of the matched route, {id} and any parameter ending in
+// Id or _id, once for every handler. A route with an ID that isn't a positive whole number is
+// answered with 400 before its handler runs; handlers read the parsed IDs with pathID.
+func PathIDs(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		if len(vars) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ids := make(map[string]int)
+		for name, value := range vars {
+			if !isPathIDParam(name) {
+				continue
+			}
+			id, err := strconv.Atoi(value)
+			if err != nil || id <= 0 {
+				http.Error(w, fmt.Sprintf("Invalid %s ID %q: must be a positive whole number", pathIDLabel(r, name), value), http.StatusBadRequest)
+				return
+			}
+			ids[name] = id
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), pathIDsKey{}, ids)))
+	})
+}
+
+// pathID is the ID path parameter name parsed by PathIDs. Without the middleware, as in tests
+// calling a handler directly, the parameter is parsed here and an invalid ID is 0, which the
+// services reject.
+func pathID(r *http.Request, name string) int {
+	if ids, ok := r.Context().Value(pathIDsKey{}).(map[string]int); ok {
+		return ids[name]
+	}
+	id, err := strconv.Atoi(mux.Vars(r)[name])
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// isPathIDParam reports whether a path parameter holds an ID
+func isPathIDParam(name string) bool {
+	return name == "id" || strings.HasSuffix(name, "Id") || strings.HasSuffix(name, "_id")
+}
+
+// pathIDLabel names the ID held by a path parameter of the matched route
+func pathIDLabel(r *http.Request, name string) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			segments := strings.Split(template, "/")
+			for i, segment := range segments {
+				if segment == "{"+name+"}" && i > 0 {
+					if label, ok := pathIDLabels[segments[i-1]]; ok {
+						return label
+					}
+				}
+			}
+		}
+	}
+	return name
+}
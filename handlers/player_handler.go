@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"sports-backend/models"
 	"sports-backend/services"
@@ -25,10 +29,61 @@ func NewPlayerHandler(playerService services.PlayerService, playerStatsService s
 	}
 }
 
-// GetPlayers handles GET /api/players
+// RegisterRoutes registers the player and player stats routes, including the player admin routes
+func (h *PlayerHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/players", h.GetPlayers).Methods("GET")
+	r.HandleFunc("/players", h.CreatePlayer).Methods("POST")
+	r.HandleFunc("/players/{id}", h.GetPlayer).Methods("GET")
+	r.HandleFunc("/players/{id}", h.UpdatePlayer).Methods("PUT")
+	r.HandleFunc("/players/{id}", h.DeletePlayer).Methods("DELETE")
+	r.HandleFunc("/players/{id}/restore", h.RestorePlayer).Methods("POST")
+	r.HandleFunc("/players/{id}/stats", h.GetPlayerStats).Methods("GET")
+	r.HandleFunc("/players/{id}/stats", h.CreatePlayerStats).Methods("POST")
+	r.HandleFunc("/players/{id}/stats/{stats_id}", h.UpdatePlayerStats).Methods("PUT")
+	r.HandleFunc("/players/{id}/stats/{stats_id}", h.DeletePlayerStats).Methods("DELETE")
+	r.HandleFunc("/players/{id}/games/{gameId}/stats", h.UpsertPlayerGameStats).Methods("PUT")
+	r.HandleFunc("/player-stats", h.ListPlayerStats).Methods("GET")
+	r.HandleFunc("/player-stats/export", h.ExportPlayerStats).Methods("GET")
+	r.HandleFunc("/admin/players/backfill", h.BackfillPlayerBio).Methods("POST")
+	r.HandleFunc("/admin/players/duplicates", h.GetDuplicatePlayers).Methods("GET")
+	r.HandleFunc("/admin/players/{keepId}/merge/{dupId}", h.MergePlayers).Methods("POST")
+	r.HandleFunc("/admin/players/{id}", h.PurgePlayer).Methods("DELETE")
+}
+
+// GetPlayers handles GET /api/players, sorted by name or with sort=adp by consensus ADP
+// in the format (default ppr) and season (default latest), with optional include=team,stats
 func (h *PlayerHandler) GetPlayers(w http.ResponseWriter, r *http.Request) {
-	players, err := h.playerService.GetAllPlayers()
+	query := r.URL.Query()
+
+	var players []*models.Player
+	var err error
+	switch query.Get("sort") {
+	case "", "name":
+		players, err = h.playerService.GetAllPlayers()
+	case "adp":
+		format := query.Get("format")
+		if format == "" {
+			format = "ppr"
+		}
+		players, err = h.playerService.GetPlayersByADP(query.Get("season"), format)
+	default:
+		http.Error(w, "Invalid sort parameter: must be name or adp", http.StatusBadRequest)
+		return
+	}
 	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.playerService.IncludeRelated(players, query.Get("include")); err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -47,6 +102,14 @@ func (h *PlayerHandler) CreatePlayer(w http.ResponseWriter, r *http.Request) {
 
 	player, err := h.playerService.CreatePlayer(&req)
 	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "already exists with ID") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -58,12 +121,7 @@ func (h *PlayerHandler) CreatePlayer(w http.ResponseWriter, r *http.Request) {
 
 // GetPlayer handles GET /api/players/{id}
 func (h *PlayerHandler) GetPlayer(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
-	if err != nil {
-		http.Error(w, "Invalid player ID", http.StatusBadRequest)
-		return
-	}
+	id := pathID(r, "id")
 
 	player, err := h.playerService.GetPlayer(id)
 	if err != nil {
@@ -71,18 +129,22 @@ func (h *PlayerHandler) GetPlayer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.playerService.IncludeRelated([]*models.Player{player}, r.URL.Query().Get("include")); err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(player)
 }
 
 // UpdatePlayer handles PUT /api/players/{id}
 func (h *PlayerHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
-	if err != nil {
-		http.Error(w, "Invalid player ID", http.StatusBadRequest)
-		return
-	}
+	id := pathID(r, "id")
 
 	var req models.UpdatePlayerRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -92,6 +154,10 @@ func (h *PlayerHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
 
 	player, err := h.playerService.UpdatePlayer(id, &req)
 	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -102,30 +168,117 @@ func (h *PlayerHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
 
 // DeletePlayer handles DELETE /api/players/{id}
 func (h *PlayerHandler) DeletePlayer(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
+	id := pathID(r, "id")
+
+	if err := h.playerService.DeletePlayer(id); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestorePlayer handles POST /api/players/{id}/restore
+func (h *PlayerHandler) RestorePlayer(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	player, err := h.playerService.RestorePlayer(id)
 	if err != nil {
-		http.Error(w, "Invalid player ID", http.StatusBadRequest)
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid player ID") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to restore player: %v", err), http.StatusInternalServerError)
 		return
 	}
 
-	if err := h.playerService.DeletePlayer(id); err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(player)
+}
+
+// PurgePlayer handles DELETE /api/admin/players/{id}
+func (h *PlayerHandler) PurgePlayer(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.playerService.PurgePlayer(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid player ID") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(err.Error(), "cannot be purged") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to purge player: %v", err), http.StatusInternalServerError)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// GetPlayerStats handles GET /api/players/{id}/stats
-func (h *PlayerHandler) GetPlayerStats(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	playerID, err := strconv.Atoi(vars["id"])
+// ListPlayerStats handles GET /api/player-stats?limit=&cursor=, a feed of every stat line, newest first
+func (h *PlayerHandler) ListPlayerStats(w http.ResponseWriter, r *http.Request) {
+	limit := 50
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	page, err := h.playerStatsService.GetPlayerStatsPage(r.URL.Query().Get("cursor"), limit)
 	if err != nil {
-		http.Error(w, "Invalid player ID", http.StatusBadRequest)
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get player stats: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(page)
+}
+
+// ExportPlayerStats handles GET /api/player-stats/export?season=&format=, every stat line of a
+// season, or of every season, streamed as a JSON array or as NDJSON with format=ndjson
+func (h *PlayerHandler) ExportPlayerStats(w http.ResponseWriter, r *http.Request) {
+	stream := newJSONStream(w, r)
+	err := h.playerStatsService.ExportPlayerStats(r.URL.Query().Get("season"), func(stats *models.PlayerStats) error {
+		return stream.Write(stats)
+	})
+	if err == nil {
+		err = stream.Close()
+	}
+	if err == nil {
 		return
 	}
 
+	if stream.Started() {
+		log.Printf("Player stats export failed after %d records: %v", stream.written, err)
+		stream.Abort()
+	}
+	http.Error(w, fmt.Sprintf("Failed to export player stats: %v", err), http.StatusInternalServerError)
+}
+
+// GetPlayerStats handles GET /api/players/{id}/stats
+func (h *PlayerHandler) GetPlayerStats(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
 	stats, err := h.playerStatsService.GetPlayerStatsByPlayer(playerID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
@@ -138,12 +291,7 @@ func (h *PlayerHandler) GetPlayerStats(w http.ResponseWriter, r *http.Request) {
 
 // CreatePlayerStats handles POST /api/players/{id}/stats
 func (h *PlayerHandler) CreatePlayerStats(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	playerID, err := strconv.Atoi(vars["id"])
-	if err != nil {
-		http.Error(w, "Invalid player ID", http.StatusBadRequest)
-		return
-	}
+	playerID := pathID(r, "id")
 
 	var req models.CreatePlayerStatsRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -156,6 +304,10 @@ func (h *PlayerHandler) CreatePlayerStats(w http.ResponseWriter, r *http.Request
 
 	stats, err := h.playerStatsService.CreatePlayerStats(&req)
 	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -165,15 +317,47 @@ func (h *PlayerHandler) CreatePlayerStats(w http.ResponseWriter, r *http.Request
 	json.NewEncoder(w).Encode(stats)
 }
 
-// DeletePlayerStats handles DELETE /api/players/{id}/stats/{stats_id}
-func (h *PlayerHandler) DeletePlayerStats(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	statsID, err := strconv.Atoi(vars["stats_id"])
+// UpsertPlayerGameStats handles PUT /api/players/{id}/games/{gameId}/stats
+func (h *PlayerHandler) UpsertPlayerGameStats(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
+	gameID := pathID(r, "gameId")
+
+	var req models.CreatePlayerStatsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	stats, created, err := h.playerStatsService.UpsertPlayerStats(playerID, gameID, &req)
 	if err != nil {
-		http.Error(w, "Invalid stats ID", http.StatusBadRequest)
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to save player stats: %v", err), http.StatusInternalServerError)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
+	if created {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(stats)
+}
+
+// DeletePlayerStats handles DELETE /api/players/{id}/stats/{stats_id}
+func (h *PlayerHandler) DeletePlayerStats(w http.ResponseWriter, r *http.Request) {
+	statsID := pathID(r, "stats_id")
+
 	if err := h.playerStatsService.DeletePlayerStats(statsID); err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
@@ -184,12 +368,7 @@ func (h *PlayerHandler) DeletePlayerStats(w http.ResponseWriter, r *http.Request
 
 // UpdatePlayerStats handles PUT /api/players/{id}/stats/{stats_id}
 func (h *PlayerHandler) UpdatePlayerStats(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	statsID, err := strconv.Atoi(vars["stats_id"])
-	if err != nil {
-		http.Error(w, "Invalid stats ID", http.StatusBadRequest)
-		return
-	}
+	statsID := pathID(r, "stats_id")
 
 	var req models.UpdatePlayerStatsRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -199,6 +378,10 @@ func (h *PlayerHandler) UpdatePlayerStats(w http.ResponseWriter, r *http.Request
 
 	stats, err := h.playerStatsService.UpdatePlayerStats(statsID, &req)
 	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -206,3 +389,73 @@ func (h *PlayerHandler) UpdatePlayerStats(w http.ResponseWriter, r *http.Request
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(stats)
 }
+
+// BackfillPlayerBio handles POST /api/admin/players/backfill
+func (h *PlayerHandler) BackfillPlayerBio(w http.ResponseWriter, r *http.Request) {
+	var entries []*models.PlayerBioBackfill
+	if err := json.NewDecoder(r.Body).Decode(&entries); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	overwrite := false
+	if overwriteStr := r.URL.Query().Get("overwrite"); overwriteStr != "" {
+		parsed, err := strconv.ParseBool(overwriteStr)
+		if err != nil {
+			http.Error(w, "Invalid overwrite parameter", http.StatusBadRequest)
+			return
+		}
+		overwrite = parsed
+	}
+
+	result, err := h.playerService.BackfillPlayerBio(entries, overwrite)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") ||
+			strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to backfill players: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// GetDuplicatePlayers handles GET /api/admin/players/duplicates
+func (h *PlayerHandler) GetDuplicatePlayers(w http.ResponseWriter, r *http.Request) {
+	groups, err := h.playerService.FindDuplicatePlayers()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to find duplicate players: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(groups)
+}
+
+// MergePlayers handles POST /api/admin/players/{keepId}/merge/{dupId}
+func (h *PlayerHandler) MergePlayers(w http.ResponseWriter, r *http.Request) {
+	keepID := pathID(r, "keepId")
+
+	duplicateID := pathID(r, "dupId")
+
+	result, err := h.playerService.MergePlayers(keepID, duplicateID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") ||
+			strings.Contains(err.Error(), "invalid player ID") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to merge players: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
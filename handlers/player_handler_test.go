@@ -0,0 +1,130 @@
+package handlers_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"sports-backend/handlers"
+	"sports-backend/models"
+	"sports-backend/services/mocks"
+)
+
+func TestGetPlayerRejectsUnknownInclude(t *testing.T) {
+	players := &mocks.PlayerServiceMock{
+		GetPlayerFunc: func(id int) (*models.Player, error) {
+			return &models.Player{ID: id}, nil
+		},
+		IncludeRelatedFunc: func(players []*models.Player, include string) error {
+			return fmt.Errorf("validation failed: include must be one of: [team stats]")
+		},
+	}
+	handler := handlers.NewPlayerHandler(players, &mocks.PlayerStatsServiceMock{})
+
+	res := serve(handler, "GET", "/api/players/1?include=odds", "")
+	if res.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400: %s", res.Code, res.Body)
+	}
+	if calls := players.IncludeRelatedCalls(); len(calls) != 1 || calls[0].Include != "odds" {
+		t.Errorf("IncludeRelated calls = %+v, want one with odds", calls)
+	}
+}
+
+func TestCreatePlayerStatsTakesPlayerFromPath(t *testing.T) {
+	stats := &mocks.PlayerStatsServiceMock{
+		CreatePlayerStatsFunc: func(req *models.CreatePlayerStatsRequest) (*models.PlayerStats, error) {
+			return &models.PlayerStats{ID: 1, PlayerID: req.PlayerID, GameID: req.GameID}, nil
+		},
+	}
+	handler := handlers.NewPlayerHandler(&mocks.PlayerServiceMock{}, stats)
+
+	// The body's player_id is ignored in favor of the path's
+	res := serve(handler, "POST", "/api/players/7/stats", `{"player_id":99,"game_id":3,"passing_yards":250}`)
+	if res.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201: %s", res.Code, res.Body)
+	}
+	calls := stats.CreatePlayerStatsCalls()
+	if len(calls) != 1 || calls[0].Req.PlayerID != 7 || calls[0].Req.GameID != 3 {
+		t.Fatalf("CreatePlayerStats calls = %+v, want player 7 and game 3", calls)
+	}
+	if yards := calls[0].Req.PassingYards; yards == nil || *yards != 250 {
+		t.Errorf("passing yards = %v, want 250", yards)
+	}
+}
+
+func TestUpsertPlayerGameStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		created bool
+		err     error
+		status  int
+	}{
+		{"created", "/api/players/1/games/2/stats", true, nil, http.StatusCreated},
+		{"updated", "/api/players/1/games/2/stats", false, nil, http.StatusOK},
+		{"missing game", "/api/players/1/games/2/stats", false, fmt.Errorf("game with ID 2 not found"), http.StatusNotFound},
+		{"invalid stats", "/api/players/1/games/2/stats", false, fmt.Errorf("validation failed: passing_yards must be at least -100"), http.StatusBadRequest},
+		{"invalid game ID", "/api/players/1/games/0/stats", false, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := &mocks.PlayerStatsServiceMock{
+				UpsertPlayerStatsFunc: func(playerID, gameID int, req *models.CreatePlayerStatsRequest) (*models.PlayerStats, bool, error) {
+					if tt.err != nil {
+						return nil, false, tt.err
+					}
+					return &models.PlayerStats{ID: 4, PlayerID: playerID, GameID: gameID}, tt.created, nil
+				},
+			}
+			handler := handlers.NewPlayerHandler(&mocks.PlayerServiceMock{}, stats)
+
+			res := serve(handler, "PUT", tt.path, `{"rushing_yards":80}`)
+			if res.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", res.Code, tt.status, res.Body)
+			}
+			if tt.path == "/api/players/1/games/0/stats" {
+				if !strings.Contains(res.Body.String(), "Invalid game ID") {
+					t.Errorf("body = %q, want the invalid game ID", res.Body)
+				}
+				return
+			}
+			if calls := stats.UpsertPlayerStatsCalls(); len(calls) != 1 || calls[0].PlayerID != 1 || calls[0].GameID != 2 {
+				t.Errorf("UpsertPlayerStats calls = %+v, want player 1 and game 2", calls)
+			}
+		})
+	}
+}
+
+func TestMergePlayersStatusCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"merged", nil, http.StatusOK},
+		{"same player", fmt.Errorf("validation failed: a player cannot be merged into itself"), http.StatusBadRequest},
+		{"missing player", fmt.Errorf("failed to merge players: player with ID 2 not found"), http.StatusNotFound},
+		{"database error", fmt.Errorf("failed to merge players: database is locked"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := &mocks.PlayerServiceMock{
+				MergePlayersFunc: func(keepID, duplicateID int) (*models.PlayerMergeResult, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &models.PlayerMergeResult{Player: &models.Player{ID: keepID}}, nil
+				},
+			}
+			handler := handlers.NewPlayerHandler(players, &mocks.PlayerStatsServiceMock{})
+
+			res := serve(handler, "POST", "/api/admin/players/1/merge/2", "")
+			if res.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", res.Code, tt.status, res.Body)
+			}
+		})
+	}
+}
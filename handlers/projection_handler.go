@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ProjectionHandler handles HTTP requests for fantasy projections
+type ProjectionHandler struct {
+	projectionService services.ProjectionService
+}
+
+// NewProjectionHandler creates a new projection handler
+func NewProjectionHandler(projectionService services.ProjectionService) *ProjectionHandler {
+	return &ProjectionHandler{
+		projectionService: projectionService,
+	}
+}
+
+// RegisterRoutes registers the projection routes
+func (h *ProjectionHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/players/{id}/projections", h.GetPlayerProjections).Methods("GET")
+	r.HandleFunc("/players/{id}/projections", h.SavePlayerProjection).Methods("POST")
+	r.HandleFunc("/projections", h.ImportProjections).Methods("POST")
+	r.HandleFunc("/projections/{id}", h.DeleteProjection).Methods("DELETE")
+	r.HandleFunc("/projections/accuracy", h.GetAccuracy).Methods("GET")
+	r.HandleFunc("/projections/season/{season}/week/{week}", h.GetWeeklyLeaders).Methods("GET")
+}
+
+// GetPlayerProjections handles GET /api/players/{id}/projections with optional season, week and source filters
+func (h *ProjectionHandler) GetPlayerProjections(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
+	week := 0
+	if weekStr := r.URL.Query().Get("week"); weekStr != "" {
+		parsed, err := strconv.Atoi(weekStr)
+		if err != nil {
+			http.Error(w, "Invalid week parameter", http.StatusBadRequest)
+			return
+		}
+		week = parsed
+	}
+
+	projections, err := h.projectionService.GetPlayerProjections(playerID, r.URL.Query().Get("season"), week, r.URL.Query().Get("source"))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get projections: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(projections)
+}
+
+// SavePlayerProjection handles POST /api/players/{id}/projections
+func (h *ProjectionHandler) SavePlayerProjection(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
+	var req models.CreateProjectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	projection, created, err := h.projectionService.SavePlayerProjection(playerID, &req)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to save projection: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if created {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(projection)
+}
+
+// ImportProjections handles POST /api/projections with an array of projections for any players
+func (h *ProjectionHandler) ImportProjections(w http.ResponseWriter, r *http.Request) {
+	var reqs []*models.CreateProjectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.projectionService.ImportProjections(reqs)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to import projections: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// DeleteProjection handles DELETE /api/projections/{id}
+func (h *ProjectionHandler) DeleteProjection(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.projectionService.DeleteProjection(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete projection: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetWeeklyLeaders handles GET /api/projections/season/{season}/week/{week}?source=&position=&blend=&limit=
+func (h *ProjectionHandler) GetWeeklyLeaders(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	week, err := strconv.Atoi(vars["week"])
+	if err != nil {
+		http.Error(w, "Invalid week parameter", http.StatusBadRequest)
+		return
+	}
+
+	limit := 25
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	blend := false
+	if blendStr := r.URL.Query().Get("blend"); blendStr != "" {
+		blend, err = strconv.ParseBool(blendStr)
+		if err != nil {
+			http.Error(w, "Invalid blend parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	leaders, err := h.projectionService.GetWeeklyLeaders(vars["season"], week, r.URL.Query().Get("source"), r.URL.Query().Get("position"), blend, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "cannot be empty") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get projection leaders: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(leaders)
+}
+
+// GetAccuracy handles GET /api/projections/accuracy?season=&week=&source=&position=&group_by=
+func (h *ProjectionHandler) GetAccuracy(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	season := query.Get("season")
+	if season == "" {
+		http.Error(w, "Season parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	week := 0
+	if weekStr := query.Get("week"); weekStr != "" {
+		parsed, err := strconv.Atoi(weekStr)
+		if err != nil {
+			http.Error(w, "Invalid week parameter", http.StatusBadRequest)
+			return
+		}
+		week = parsed
+	}
+
+	report, err := h.projectionService.GetAccuracy(season, week, query.Get("source"), query.Get("position"), query.Get("group_by"))
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "cannot be empty") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get projection accuracy: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
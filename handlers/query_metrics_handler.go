@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// QueryMetricsHandler handles HTTP requests for repository query metrics
+type QueryMetricsHandler struct {
+	queryMetricsService services.QueryMetricsService
+}
+
+// NewQueryMetricsHandler creates a new query metrics handler
+func NewQueryMetricsHandler(queryMetricsService services.QueryMetricsService) *QueryMetricsHandler {
+	return &QueryMetricsHandler{
+		queryMetricsService: queryMetricsService,
+	}
+}
+
+// RegisterRoutes registers the query metrics route
+func (h *QueryMetricsHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/admin/queries", h.GetQueryMetrics).Methods("GET")
+}
+
+// GetQueryMetrics handles GET /api/admin/queries
+func (h *QueryMetricsHandler) GetQueryMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.queryMetricsService.GetQueryMetrics())
+}
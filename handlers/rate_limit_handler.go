@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/routes"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// RateLimitHandler handles request budgets and the HTTP requests to inspect and reset them
+type RateLimitHandler struct {
+	rateLimitService services.RateLimitService
+	trustProxy       bool // take the client address from X-Forwarded-For
+}
+
+// NewRateLimitHandler creates a new rate limit handler. With trustProxy, clients are told
+// apart by the last X-Forwarded-For address, the one added by the proxy in front of the
+// server; only set it behind such a proxy, as clients can send the header themselves.
+func NewRateLimitHandler(rateLimitService services.RateLimitService, trustProxy bool) *RateLimitHandler {
+	return &RateLimitHandler{
+		rateLimitService: rateLimitService,
+		trustProxy:       trustProxy,
+	}
+}
+
+// RegisterRoutes registers the rate limit admin routes
+func (h *RateLimitHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/admin/rate-limits", h.GetRateLimits).Methods("GET")
+	r.HandleFunc("/admin/rate-limits", h.ResetRateLimits).Methods("DELETE")
+	r.HandleFunc("/admin/rate-limits/{client}", h.ResetClientRateLimit).Methods("DELETE")
+}
+
+// Middleware takes each request from its client's read or write budget, refusing it with 429
+// and a Retry-After once the budget is spent. Limited responses carry X-RateLimit-Limit and
+// X-RateLimit-Remaining. The rate limit admin routes are not limited, so a throttled client can
+// still be inspected and reset.
+func (h *RateLimitHandler) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if template, ok := routes.Template(r); ok && strings.HasPrefix(template, "/admin/rate-limits") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		class := models.RateLimitWrite
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			class = models.RateLimitRead
+		}
+
+		decision := h.rateLimitService.Allow(h.clientAddress(r), class)
+		if decision.Limited {
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(decision.Limit))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		}
+		if !decision.Allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+			http.Error(w, fmt.Sprintf("Too many %s requests, limit is %d a minute", class, decision.Limit), http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// GetRateLimits handles GET /api/admin/rate-limits
+func (h *RateLimitHandler) GetRateLimits(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.rateLimitService.GetLimits())
+}
+
+// ResetRateLimits handles DELETE /api/admin/rate-limits, restoring every client's budgets
+func (h *RateLimitHandler) ResetRateLimits(w http.ResponseWriter, r *http.Request) {
+	reset := h.rateLimitService.ResetAll()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int{"reset": reset})
+}
+
+// ResetClientRateLimit handles DELETE /api/admin/rate-limits/{client}, restoring one client's
+// budgets
+func (h *RateLimitHandler) ResetClientRateLimit(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	if err := h.rateLimitService.Reset(vars["client"]); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to reset rate limit: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// clientAddress is the IP address a request came from
+func (h *RateLimitHandler) clientAddress(r *http.Request) string {
+	if h.trustProxy {
+		if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+			addresses := strings.Split(forwarded[len(forwarded)-1], ",")
+			if address := strings.TrimSpace(addresses[len(addresses)-1]); address != "" {
+				return address
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// RatingHandler handles HTTP requests for Elo team ratings
+type RatingHandler struct {
+	ratingService services.RatingService
+}
+
+// NewRatingHandler creates a new rating handler
+func NewRatingHandler(ratingService services.RatingService) *RatingHandler {
+	return &RatingHandler{
+		ratingService: ratingService,
+	}
+}
+
+// RegisterRoutes registers the team rating and prediction routes
+func (h *RatingHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/ratings", h.GetRatings).Methods("GET")
+	r.HandleFunc("/ratings/predictions", h.GetPredictions).Methods("GET")
+	r.HandleFunc("/teams/{id}/ratings", h.GetTeamRatingHistory).Methods("GET")
+	r.HandleFunc("/games/{id}/win-probability", h.GetWinProbability).Methods("GET")
+}
+
+// GetRatings handles GET /api/ratings?season=
+func (h *RatingHandler) GetRatings(w http.ResponseWriter, r *http.Request) {
+	ratings, err := h.ratingService.GetRatings(r.URL.Query().Get("season"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get ratings: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(ratings)
+}
+
+// GetTeamRatingHistory handles GET /api/teams/{id}/ratings?season=
+func (h *RatingHandler) GetTeamRatingHistory(w http.ResponseWriter, r *http.Request) {
+	teamID := pathID(r, "id")
+
+	history, err := h.ratingService.GetTeamRatingHistory(teamID, r.URL.Query().Get("season"))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get rating history: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(history)
+}
+
+// GetPredictions handles GET /api/ratings/predictions?season=&week=
+func (h *RatingHandler) GetPredictions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	week := 0
+	if weekStr := query.Get("week"); weekStr != "" {
+		var err error
+		week, err = strconv.Atoi(weekStr)
+		if err != nil {
+			http.Error(w, "Invalid week parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	predictions, err := h.ratingService.GetPredictions(query.Get("season"), week)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get predictions: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(predictions)
+}
+
+// GetWinProbability handles GET /api/games/{id}/win-probability
+func (h *RatingHandler) GetWinProbability(w http.ResponseWriter, r *http.Request) {
+	gameID := pathID(r, "id")
+
+	probability, err := h.ratingService.GetWinProbability(gameID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get win probability: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(probability)
+}
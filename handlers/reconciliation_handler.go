@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ReconciliationHandler handles HTTP requests for stat consistency checks
+type ReconciliationHandler struct {
+	reconciliationService services.ReconciliationService
+}
+
+// NewReconciliationHandler creates a new reconciliation handler
+func NewReconciliationHandler(reconciliationService services.ReconciliationService) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		reconciliationService: reconciliationService,
+	}
+}
+
+// RegisterRoutes registers the reconciliation route
+func (h *ReconciliationHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/games/{id}/reconciliation", h.ReconcileGame).Methods("GET")
+}
+
+// ReconcileGame handles GET /api/games/{id}/reconciliation
+func (h *ReconciliationHandler) ReconcileGame(w http.ResponseWriter, r *http.Request) {
+	reconciliation, err := h.reconciliationService.ReconcileGame(pathID(r, "id"))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to reconcile game: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reconciliation)
+}
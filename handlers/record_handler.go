@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// RecordHandler handles HTTP requests for the record book
+type RecordHandler struct {
+	recordService services.RecordService
+}
+
+// NewRecordHandler creates a new record handler
+func NewRecordHandler(recordService services.RecordService) *RecordHandler {
+	return &RecordHandler{
+		recordService: recordService,
+	}
+}
+
+// RegisterRoutes registers the record book route
+func (h *RecordHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/records", h.GetRecords).Methods("GET")
+}
+
+// GetRecords handles GET /api/records
+func (h *RecordHandler) GetRecords(w http.ResponseWriter, r *http.Request) {
+	book, err := h.recordService.GetRecords()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get records: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(book)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ScheduleChangeHandler handles HTTP requests for moving kickoffs
+type ScheduleChangeHandler struct {
+	scheduleChangeService services.ScheduleChangeService
+}
+
+// NewScheduleChangeHandler creates a new schedule change handler
+func NewScheduleChangeHandler(scheduleChangeService services.ScheduleChangeService) *ScheduleChangeHandler {
+	return &ScheduleChangeHandler{
+		scheduleChangeService: scheduleChangeService,
+	}
+}
+
+// RegisterRoutes registers the kickoff move routes
+func (h *ScheduleChangeHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/games/{id}/reschedule", h.RescheduleGame).Methods("POST")
+	r.HandleFunc("/games/{id}/schedule-changes", h.GetGameScheduleChanges).Methods("GET")
+	r.HandleFunc("/schedule-changes", h.ListScheduleChanges).Methods("GET")
+}
+
+// RescheduleGame handles POST /api/games/{id}/reschedule
+func (h *ScheduleChangeHandler) RescheduleGame(w http.ResponseWriter, r *http.Request) {
+	gameID := pathID(r, "id")
+
+	var req models.RescheduleGameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	change, err := h.scheduleChangeService.RescheduleGame(gameID, &req)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to reschedule game: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(change)
+}
+
+// GetGameScheduleChanges handles GET /api/games/{id}/schedule-changes
+func (h *ScheduleChangeHandler) GetGameScheduleChanges(w http.ResponseWriter, r *http.Request) {
+	gameID := pathID(r, "id")
+
+	changes, err := h.scheduleChangeService.GetGameScheduleChanges(gameID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get schedule changes: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(changes)
+}
+
+// ListScheduleChanges handles GET /api/schedule-changes?season=&since=&limit=, the latest moves first
+func (h *ScheduleChangeHandler) ListScheduleChanges(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := 50
+	if limitStr := query.Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var since *time.Time
+	if sinceStr := query.Get("since"); sinceStr != "" {
+		parsed, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			http.Error(w, "Invalid since parameter: must be an RFC 3339 time such as 2024-09-01T00:00:00Z", http.StatusBadRequest)
+			return
+		}
+		since = &parsed
+	}
+
+	changes, err := h.scheduleChangeService.ListScheduleChanges(query.Get("season"), since, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get schedule changes: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(changes)
+}
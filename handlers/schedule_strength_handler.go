@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ScheduleStrengthHandler handles HTTP requests for strength of schedule
+type ScheduleStrengthHandler struct {
+	scheduleStrengthService services.ScheduleStrengthService
+}
+
+// NewScheduleStrengthHandler creates a new schedule strength handler
+func NewScheduleStrengthHandler(scheduleStrengthService services.ScheduleStrengthService) *ScheduleStrengthHandler {
+	return &ScheduleStrengthHandler{
+		scheduleStrengthService: scheduleStrengthService,
+	}
+}
+
+// RegisterRoutes registers the strength of schedule route
+func (h *ScheduleStrengthHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/teams/{id}/schedule-strength", h.GetScheduleStrength).Methods("GET")
+}
+
+// GetScheduleStrength handles GET /api/teams/{id}/schedule-strength?position=&season=
+func (h *ScheduleStrengthHandler) GetScheduleStrength(w http.ResponseWriter, r *http.Request) {
+	teamID := pathID(r, "id")
+
+	position := r.URL.Query().Get("position")
+	if position == "" {
+		http.Error(w, "Position parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	strength, err := h.scheduleStrengthService.GetScheduleStrength(teamID, r.URL.Query().Get("season"), position)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get schedule strength: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(strength)
+}
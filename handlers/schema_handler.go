@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// SchemaHandler handles HTTP requests for the database schema
+type SchemaHandler struct {
+	schemaService services.SchemaService
+}
+
+// NewSchemaHandler creates a new schema handler
+func NewSchemaHandler(schemaService services.SchemaService) *SchemaHandler {
+	return &SchemaHandler{
+		schemaService: schemaService,
+	}
+}
+
+// RegisterRoutes registers the schema route
+func (h *SchemaHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/meta/schema", h.GetSchema).Methods("GET")
+}
+
+// GetSchema handles GET /api/meta/schema
+func (h *SchemaHandler) GetSchema(w http.ResponseWriter, r *http.Request) {
+	schema, err := h.schemaService.GetSchema()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get schema: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(schema)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ScoringHandler handles HTTP requests for custom scoring rules
+type ScoringHandler struct {
+	scoringService services.ScoringService
+}
+
+// NewScoringHandler creates a new scoring handler
+func NewScoringHandler(scoringService services.ScoringService) *ScoringHandler {
+	return &ScoringHandler{
+		scoringService: scoringService,
+	}
+}
+
+// RegisterRoutes registers the custom scoring routes
+func (h *ScoringHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/scoring/validate", h.ValidateRules).Methods("POST")
+	r.HandleFunc("/scoring/evaluate", h.Evaluate).Methods("POST")
+}
+
+// ValidateRules handles POST /api/scoring/validate with {"rules": [...]}
+func (h *ScoringHandler) ValidateRules(w http.ResponseWriter, r *http.Request) {
+	var req models.ScoringRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	validation, err := h.scoringService.ValidateRules(req.Rules)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to validate scoring rules: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(validation)
+}
+
+// Evaluate handles POST /api/scoring/evaluate
+func (h *ScoringHandler) Evaluate(w http.ResponseWriter, r *http.Request) {
+	var req models.ScoringRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.scoringService.Evaluate(&req)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to evaluate scoring rules: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// SeasonStatsHandler handles HTTP requests for season totals and leaderboards
+type SeasonStatsHandler struct {
+	seasonStatsService services.SeasonStatsService
+}
+
+// NewSeasonStatsHandler creates a new season stats handler
+func NewSeasonStatsHandler(seasonStatsService services.SeasonStatsService) *SeasonStatsHandler {
+	return &SeasonStatsHandler{
+		seasonStatsService: seasonStatsService,
+	}
+}
+
+// RegisterRoutes registers the season totals and leaderboard routes, including the rebuild
+func (h *SeasonStatsHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/players/{id}/season-stats", h.GetPlayerSeasons).Methods("GET")
+	r.HandleFunc("/players/{id}/season-stats/{season}", h.GetPlayerSeason).Methods("GET")
+	r.HandleFunc("/players/{id}/career", h.GetPlayerCareer).Methods("GET")
+	r.HandleFunc("/season-stats/{season}/leaders", h.GetSeasonLeaders).Methods("GET")
+	r.HandleFunc("/admin/season-stats/rebuild", h.RebuildSeasonStats).Methods("POST")
+}
+
+// GetPlayerSeasons handles GET /api/players/{id}/season-stats
+func (h *SeasonStatsHandler) GetPlayerSeasons(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
+	seasons, err := h.seasonStatsService.GetPlayerSeasons(playerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") ||
+			strings.Contains(err.Error(), "cannot be empty") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get season stats: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(seasons)
+}
+
+// GetPlayerSeason handles GET /api/players/{id}/season-stats/{season}
+func (h *SeasonStatsHandler) GetPlayerSeason(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	playerID := pathID(r, "id")
+
+	stats, err := h.seasonStatsService.GetPlayerSeason(playerID, vars["season"])
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") ||
+			strings.Contains(err.Error(), "cannot be empty") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get season stats: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(stats)
+}
+
+// GetPlayerCareer handles GET /api/players/{id}/career
+func (h *SeasonStatsHandler) GetPlayerCareer(w http.ResponseWriter, r *http.Request) {
+	playerID := pathID(r, "id")
+
+	career, err := h.seasonStatsService.GetPlayerCareer(playerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get career stats: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(career)
+}
+
+// GetSeasonLeaders handles GET /api/season-stats/{season}/leaders?stat=passing_yards&position=QB&per_game=true&limit=10
+func (h *SeasonStatsHandler) GetSeasonLeaders(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	stat := query.Get("stat")
+	if stat == "" {
+		http.Error(w, "Stat parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	perGame := false
+	if perGameStr := query.Get("per_game"); perGameStr != "" {
+		parsed, err := strconv.ParseBool(perGameStr)
+		if err != nil {
+			http.Error(w, "Invalid per_game parameter", http.StatusBadRequest)
+			return
+		}
+		perGame = parsed
+	}
+
+	limit := 10
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	leaders, err := h.seasonStatsService.GetSeasonLeaders(mux.Vars(r)["season"], stat, query.Get("position"), perGame, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") ||
+			strings.Contains(err.Error(), "cannot be empty") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get season leaders: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(leaders)
+}
+
+// RebuildSeasonStats handles POST /api/admin/season-stats/rebuild
+func (h *SeasonStatsHandler) RebuildSeasonStats(w http.ResponseWriter, r *http.Request) {
+	result, err := h.seasonStatsService.RebuildSeasonStats()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to rebuild season stats: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
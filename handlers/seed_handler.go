@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// SeedHandler handles HTTP requests for loading sample data
+type SeedHandler struct {
+	seedService services.SeedService
+}
+
+// NewSeedHandler creates a new seed handler
+func NewSeedHandler(seedService services.SeedService) *SeedHandler {
+	return &SeedHandler{
+		seedService: seedService,
+	}
+}
+
+// RegisterRoutes registers the sample data route. Only mount it in development mode.
+func (h *SeedHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/admin/seed", h.Seed).Methods("POST")
+}
+
+// Seed handles POST /api/admin/seed
+func (h *SeedHandler) Seed(w http.ResponseWriter, r *http.Request) {
+	result, err := h.seedService.Seed()
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to load sample data: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// SimulationHandler handles HTTP requests for season simulations
+type SimulationHandler struct {
+	simulationService services.SimulationService
+}
+
+// NewSimulationHandler creates a new simulation handler
+func NewSimulationHandler(simulationService services.SimulationService) *SimulationHandler {
+	return &SimulationHandler{
+		simulationService: simulationService,
+	}
+}
+
+// RegisterRoutes registers the season simulation route
+func (h *SimulationHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/seasons/{season}/simulate", h.SimulateSeason).Methods("POST")
+}
+
+// SimulateSeason handles POST /api/seasons/{season}/simulate. The body is optional.
+func (h *SimulationHandler) SimulateSeason(w http.ResponseWriter, r *http.Request) {
+	var req models.SimulateSeasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	simulation, err := h.simulationService.SimulateSeason(mux.Vars(r)["season"], &req)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to simulate season: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(simulation)
+}
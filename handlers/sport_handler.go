@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// SportHandler handles HTTP requests for sports and their stat definitions
+type SportHandler struct {
+	sportService services.SportService
+}
+
+// NewSportHandler creates a new sport handler
+func NewSportHandler(sportService services.SportService) *SportHandler {
+	return &SportHandler{
+		sportService: sportService,
+	}
+}
+
+// RegisterRoutes registers the sport and stat metadata routes
+func (h *SportHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/sports", h.GetSports).Methods("GET")
+	r.HandleFunc("/sports/{code}", h.GetSport).Methods("GET")
+	r.HandleFunc("/meta/stats", h.GetStatMetadata).Methods("GET")
+}
+
+// GetSports handles GET /api/sports
+func (h *SportHandler) GetSports(w http.ResponseWriter, r *http.Request) {
+	sports, err := h.sportService.GetSports()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get sports: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(sports)
+}
+
+// GetSport handles GET /api/sports/{code}
+func (h *SportHandler) GetSport(w http.ResponseWriter, r *http.Request) {
+	sport, err := h.sportService.GetSport(mux.Vars(r)["code"])
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "cannot be empty") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get sport: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(sport)
+}
+
+// GetStatMetadata handles GET /api/meta/stats?sport=
+func (h *SportHandler) GetStatMetadata(w http.ResponseWriter, r *http.Request) {
+	metadata, err := h.sportService.GetStatMetadata(r.URL.Query().Get("sport"))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get stat metadata: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(metadata)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// StatConflictHandler handles HTTP requests for the stat conflict report
+type StatConflictHandler struct {
+	statConflictService services.StatConflictService
+}
+
+// NewStatConflictHandler creates a new stat conflict handler
+func NewStatConflictHandler(statConflictService services.StatConflictService) *StatConflictHandler {
+	return &StatConflictHandler{
+		statConflictService: statConflictService,
+	}
+}
+
+// RegisterRoutes registers the stat conflict routes
+func (h *StatConflictHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/stat-conflicts", h.GetStatConflicts).Methods("GET")
+}
+
+// GetStatConflicts handles GET /api/stat-conflicts?game_id=&source=&limit=, the latest detected first
+func (h *StatConflictHandler) GetStatConflicts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := 50
+	if limitStr := query.Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	gameID := 0
+	if gameIDStr := query.Get("game_id"); gameIDStr != "" {
+		var err error
+		gameID, err = strconv.Atoi(gameIDStr)
+		if err != nil {
+			http.Error(w, "Invalid game_id parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	report, err := h.statConflictService.GetConflicts(gameID, query.Get("source"), limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "validation failed") || strings.Contains(err.Error(), "invalid") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get stat conflicts: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
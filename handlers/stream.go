@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// streamFlushEvery is how many records are written between flushes to the client
+const streamFlushEvery = 100
+
+// jsonStream writes records to the response one at a time, as a JSON array or as
+// newline-delimited JSON, so a large export never has to be held in memory. Headers are
+// sent with the first record, so a handler can still send an error status if the export
+// fails before anything is written.
+type jsonStream struct {
+	w       http.ResponseWriter
+	ndjson  bool
+	encoder *json.Encoder
+	written int
+}
+
+// newJSONStream starts a stream, in NDJSON when the request asks for it with ?format=ndjson
+// or an Accept header of application/x-ndjson
+func newJSONStream(w http.ResponseWriter, r *http.Request) *jsonStream {
+	ndjson := r.URL.Query().Get("format") == "ndjson" || strings.Contains(r.Header.Get("Accept"), "application/x-ndjson")
+	return &jsonStream{w: w, ndjson: ndjson, encoder: json.NewEncoder(w)}
+}
+
+// Write sends one record
+func (s *jsonStream) Write(record interface{}) error {
+	if s.written == 0 {
+		s.start()
+	} else if !s.ndjson {
+		if _, err := s.w.Write([]byte(",")); err != nil {
+			return err
+		}
+	}
+
+	// Encode ends every record with a newline, which also separates NDJSON lines
+	if err := s.encoder.Encode(record); err != nil {
+		return err
+	}
+	s.written++
+
+	if s.written%streamFlushEvery == 0 {
+		return http.NewResponseController(s.w).Flush()
+	}
+	return nil
+}
+
+// Close ends the stream, closing the JSON array
+func (s *jsonStream) Close() error {
+	if s.written == 0 {
+		s.start()
+	}
+	if !s.ndjson {
+		_, err := s.w.Write([]byte("]\n"))
+		return err
+	}
+	return nil
+}
+
+// start sends the headers and opens the JSON array
+func (s *jsonStream) start() {
+	if s.ndjson {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		return
+	}
+	s.w.Header().Set("Content-Type", "application/json")
+	s.w.Write([]byte("["))
+}
+
+// Abort ends a stream that failed after records were written. Aborting the connection
+// leaves the chunked response unterminated, so the client sees the export failed instead
+// of mistaking it for a complete, shorter one.
+func (s *jsonStream) Abort() {
+	panic(http.ErrAbortHandler)
+}
+
+// Started reports whether anything has been written, after which the status can't change
+func (s *jsonStream) Started() bool {
+	return s.written > 0
+}
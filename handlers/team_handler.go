@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
-	"strconv"
+	"strings"
 
 	"sports-backend/models"
 	"sports-backend/services"
@@ -23,6 +25,19 @@ func NewTeamHandler(teamService services.TeamService) *TeamHandler {
 	}
 }
 
+// RegisterRoutes registers the team routes, including purging a deleted team
+func (h *TeamHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/teams", h.GetTeams).Methods("GET")
+	r.HandleFunc("/teams", h.CreateTeam).Methods("POST")
+	r.HandleFunc("/teams/{id}", h.GetTeam).Methods("GET")
+	r.HandleFunc("/teams/{id}", h.UpdateTeam).Methods("PUT")
+	r.HandleFunc("/teams/{id}", h.DeleteTeam).Methods("DELETE")
+	r.HandleFunc("/teams/{id}/restore", h.RestoreTeam).Methods("POST")
+	r.HandleFunc("/teams/{id}/stats", h.GetTeamStats).Methods("GET")
+	r.HandleFunc("/teams/{id}/stats", h.CreateTeamStats).Methods("POST")
+	r.HandleFunc("/admin/teams/{id}", h.PurgeTeam).Methods("DELETE")
+}
+
 // GetTeams handles GET /api/teams
 func (h *TeamHandler) GetTeams(w http.ResponseWriter, r *http.Request) {
 	teams, err := h.teamService.GetAllTeams()
@@ -45,6 +60,14 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 
 	team, err := h.teamService.CreateTeam(&req)
 	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "already exists with ID") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -56,12 +79,7 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 
 // GetTeam handles GET /api/teams/{id}
 func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
-	if err != nil {
-		http.Error(w, "Invalid team ID", http.StatusBadRequest)
-		return
-	}
+	id := pathID(r, "id")
 
 	team, err := h.teamService.GetTeam(id)
 	if err != nil {
@@ -75,12 +93,7 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 
 // UpdateTeam handles PUT /api/teams/{id}
 func (h *TeamHandler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
-	if err != nil {
-		http.Error(w, "Invalid team ID", http.StatusBadRequest)
-		return
-	}
+	id := pathID(r, "id")
 
 	var req models.UpdateTeamRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -90,6 +103,10 @@ func (h *TeamHandler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
 
 	team, err := h.teamService.UpdateTeam(id, &req)
 	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -100,15 +117,68 @@ func (h *TeamHandler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
 
 // DeleteTeam handles DELETE /api/teams/{id}
 func (h *TeamHandler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
+	id := pathID(r, "id")
+
+	if err := h.teamService.DeleteTeam(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid team ID") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(err.Error(), "cannot be deleted") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete team: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreTeam handles POST /api/teams/{id}/restore
+func (h *TeamHandler) RestoreTeam(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	team, err := h.teamService.RestoreTeam(id)
 	if err != nil {
-		http.Error(w, "Invalid team ID", http.StatusBadRequest)
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid team ID") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to restore team: %v", err), http.StatusInternalServerError)
 		return
 	}
 
-	if err := h.teamService.DeleteTeam(id); err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(team)
+}
+
+// PurgeTeam handles DELETE /api/admin/teams/{id}
+func (h *TeamHandler) PurgeTeam(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.teamService.PurgeTeam(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "invalid team ID") {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(err.Error(), "cannot be purged") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to purge team: %v", err), http.StatusInternalServerError)
 		return
 	}
 
@@ -0,0 +1,138 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"sports-backend/handlers"
+	"sports-backend/models"
+	"sports-backend/routes"
+	"sports-backend/services/mocks"
+
+	"github.com/gorilla/mux"
+)
+
+// serve sends a request through the registrar's routes, mounted with the ID middleware the
+// server uses, and returns the recorded response
+func serve(registrar routes.Registrar, method, path, body string) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	routes.MountAPI(router, []mux.MiddlewareFunc{handlers.PathIDs}, registrar)
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, req)
+	return res
+}
+
+func TestGetTeam(t *testing.T) {
+	service := &mocks.TeamServiceMock{
+		GetTeamFunc: func(id int) (*models.Team, error) {
+			if id != 1 {
+				return nil, fmt.Errorf("failed to get team: team with ID %d not found", id)
+			}
+			return &models.Team{ID: 1, Name: "Chiefs", City: "Kansas City"}, nil
+		},
+	}
+	handler := handlers.NewTeamHandler(service)
+
+	res := serve(handler, "GET", "/api/v1/teams/1", "")
+	if res.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", res.Code, res.Body)
+	}
+	var team models.Team
+	if err := json.NewDecoder(res.Body).Decode(&team); err != nil {
+		t.Fatalf("decode team: %v", err)
+	}
+	if team.ID != 1 || team.Name != "Chiefs" {
+		t.Errorf("team = %+v, want the Chiefs", team)
+	}
+
+	if res := serve(handler, "GET", "/api/teams/2", ""); res.Code != http.StatusNotFound {
+		t.Errorf("missing team status = %d, want 404", res.Code)
+	}
+}
+
+func TestGetTeamRejectsInvalidID(t *testing.T) {
+	service := &mocks.TeamServiceMock{}
+	handler := handlers.NewTeamHandler(service)
+
+	res := serve(handler, "GET", "/api/teams/abc", "")
+	if res.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", res.Code)
+	}
+	if !strings.Contains(res.Body.String(), `Invalid team ID "abc"`) {
+		t.Errorf("body = %q, want the invalid team ID", res.Body)
+	}
+	if len(service.GetTeamCalls()) != 0 {
+		t.Error("service was called with an invalid ID")
+	}
+}
+
+func TestCreateTeam(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		err    error
+		status int
+	}{
+		{"created", `{"name":"Chiefs","city":"Kansas City","conference":"AFC","division":"West"}`, nil, http.StatusCreated},
+		{"invalid JSON", `{"name":`, nil, http.StatusBadRequest},
+		{"validation failure", `{"name":""}`, fmt.Errorf("validation failed: team name is required"), http.StatusBadRequest},
+		{"conflict", `{"name":"Chiefs"}`, fmt.Errorf("team Chiefs: %w", models.ErrConflict), http.StatusConflict},
+		{"claimed provider ID", `{"name":"Chiefs"}`, fmt.Errorf("team with espn ID 12 already exists with ID 4"), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &mocks.TeamServiceMock{
+				CreateTeamFunc: func(req *models.CreateTeamRequest) (*models.Team, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &models.Team{ID: 5, Name: req.Name, City: req.City, Conference: req.Conference, Division: req.Division}, nil
+				},
+			}
+
+			res := serve(handlers.NewTeamHandler(service), "POST", "/api/teams", tt.body)
+			if res.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", res.Code, tt.status, res.Body)
+			}
+			if tt.status == http.StatusCreated {
+				if calls := service.CreateTeamCalls(); len(calls) != 1 || calls[0].Req.Name != "Chiefs" {
+					t.Errorf("CreateTeam calls = %+v, want one for the Chiefs", calls)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteTeamStatusCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"deleted", nil, http.StatusNoContent},
+		{"not found", fmt.Errorf("team with ID 1 not found"), http.StatusNotFound},
+		{"in use", fmt.Errorf("team with ID 1 cannot be deleted because it still has players"), http.StatusConflict},
+		{"database error", fmt.Errorf("failed to delete team: disk I/O error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &mocks.TeamServiceMock{
+				DeleteTeamFunc: func(id int) error { return tt.err },
+			}
+
+			res := serve(handlers.NewTeamHandler(service), "DELETE", "/api/teams/1", "")
+			if res.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", res.Code, tt.status, res.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"sports-backend/models"
+)
+
+// timezoneVenue asks for each game's kickoff in the timezone of its venue
+const timezoneVenue = "venue"
+
+// displayTimezone reads the timezone game dates are shown in: the tz query parameter, or
+// failing that the Accept-Timezone header. It is an IANA name such as America/Los_Angeles, or
+// venue for each game's local time. Dates are shown in UTC, as they are stored, when neither
+// is given.
+func displayTimezone(w http.ResponseWriter, r *http.Request) (string, error) {
+	w.Header().Add("Vary", "Accept-Timezone")
+
+	tz := strings.TrimSpace(r.URL.Query().Get("tz"))
+	if tz == "" {
+		tz = strings.TrimSpace(r.Header.Get("Accept-Timezone"))
+	}
+	if tz == "" || tz == timezoneVenue {
+		return tz, nil
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return "", fmt.Errorf("invalid timezone %q: must be an IANA name such as America/New_York, or venue", tz)
+	}
+	return tz, nil
+}
+
+// localizeGames shows the games' dates in the display timezone. With venue, games without a
+// venue or with an unknown venue timezone stay in UTC.
+func localizeGames(games []*models.Game, tz string) {
+	if tz == "" {
+		return
+	}
+
+	location, _ := time.LoadLocation(tz)
+	for _, game := range games {
+		if tz == timezoneVenue {
+			location = gameLocation(game)
+		}
+		game.GameDate = game.GameDate.In(location)
+	}
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/services"
+
+	"github.com/gorilla/mux"
+)
+
+// VenueHandler handles HTTP requests for venues
+type VenueHandler struct {
+	venueService services.VenueService
+}
+
+// NewVenueHandler creates a new venue handler
+func NewVenueHandler(venueService services.VenueService) *VenueHandler {
+	return &VenueHandler{
+		venueService: venueService,
+	}
+}
+
+// RegisterRoutes registers the venue routes
+func (h *VenueHandler) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/venues", h.GetVenues).Methods("GET")
+	r.HandleFunc("/venues", h.CreateVenue).Methods("POST")
+	r.HandleFunc("/venues/{id}", h.GetVenue).Methods("GET")
+	r.HandleFunc("/venues/{id}", h.UpdateVenue).Methods("PUT")
+	r.HandleFunc("/venues/{id}", h.DeleteVenue).Methods("DELETE")
+}
+
+// GetVenues handles GET /api/venues
+func (h *VenueHandler) GetVenues(w http.ResponseWriter, r *http.Request) {
+	venues, err := h.venueService.GetAllVenues()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(venues)
+}
+
+// CreateVenue handles POST /api/venues
+func (h *VenueHandler) CreateVenue(w http.ResponseWriter, r *http.Request) {
+	var req models.CreateVenueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	venue, err := h.venueService.CreateVenue(&req)
+	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(venue)
+}
+
+// GetVenue handles GET /api/venues/{id}
+func (h *VenueHandler) GetVenue(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	venue, err := h.venueService.GetVenue(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(venue)
+}
+
+// UpdateVenue handles PUT /api/venues/{id}
+func (h *VenueHandler) UpdateVenue(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	var req models.UpdateVenueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	venue, err := h.venueService.UpdateVenue(id, &req)
+	if err != nil {
+		if errors.Is(err, models.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(venue)
+}
+
+// DeleteVenue handles DELETE /api/venues/{id}
+func (h *VenueHandler) DeleteVenue(w http.ResponseWriter, r *http.Request) {
+	id := pathID(r, "id")
+
+	if err := h.venueService.DeleteVenue(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "cannot be deleted") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete venue: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
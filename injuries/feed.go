@@ -0,0 +1,84 @@
+package injuries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// feedTimeout bounds one read of the feed
+	feedTimeout = 30 * time.Second
+	// feedMaxBytes caps the size of a report
+	feedMaxBytes = 4 << 20
+	// feedSeason and feedWeek are the placeholders for the week in the feed URL
+	feedSeason = "{season}"
+	feedWeek   = "{week}"
+)
+
+// feedProvider reads reports as JSON in the shape of Report from a URL per week
+type feedProvider struct {
+	name        string
+	urlTemplate string
+	client      *http.Client
+}
+
+// NewFeed creates a provider that GETs each week's report from urlTemplate with {season} and
+// {week} replaced by the week's
+func NewFeed(name, urlTemplate string) (Provider, error) {
+	parsed, err := url.Parse(weekURL(urlTemplate, "0", 0))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid feed URL %q", urlTemplate)
+	}
+	if !strings.Contains(urlTemplate, feedSeason) || !strings.Contains(urlTemplate, feedWeek) {
+		return nil, fmt.Errorf("feed URL %q needs %s and %s placeholders for the week", urlTemplate, feedSeason, feedWeek)
+	}
+	return &feedProvider{
+		name:        name,
+		urlTemplate: urlTemplate,
+		client:      &http.Client{Timeout: feedTimeout},
+	}, nil
+}
+
+// weekURL fills in the week's placeholders
+func weekURL(urlTemplate, season string, week int) string {
+	target := strings.ReplaceAll(urlTemplate, feedSeason, url.PathEscape(season))
+	return strings.ReplaceAll(target, feedWeek, strconv.Itoa(week))
+}
+
+// Name returns the provider whose IDs the feed uses
+func (p *feedProvider) Name() string {
+	return p.name
+}
+
+// Report fetches a week's report. Any response other than 200 fails the read.
+func (p *feedProvider) Report(ctx context.Context, season string, week int) (*Report, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, weekURL(p.urlTemplate, season, week), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create feed request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return nil, fmt.Errorf("feed responded %s for season %s week %d", resp.Status, season, week)
+	}
+
+	var report Report
+	if err := json.NewDecoder(io.LimitReader(resp.Body, feedMaxBytes)).Decode(&report); err != nil {
+		return nil, fmt.Errorf("invalid feed response for season %s week %d: %w", season, week, err)
+	}
+	return &report, nil
+}
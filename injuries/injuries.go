@@ -0,0 +1,24 @@
+// Package injuries reads the weekly official injury report from a data provider.
+package injuries
+
+import "context"
+
+// Report is a provider's injury report for one week. It lists every player with a designation.
+type Report struct {
+	Players []*Entry `json:"players"`
+}
+
+// Entry is one player's line on the report
+type Entry struct {
+	PlayerID string `json:"player_id"` // the provider's ID
+	Status   string `json:"status"`    // Out, Doubtful or Questionable; empty for none
+	Injury   string `json:"injury,omitempty"`
+}
+
+// Provider fetches injury reports with players by the provider's own IDs
+type Provider interface {
+	// Name is the external ID provider whose IDs the report's players are mapped by
+	Name() string
+	// Report fetches the injury report of a week. It should stop when ctx is cancelled.
+	Report(ctx context.Context, season string, week int) (*Report, error)
+}
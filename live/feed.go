@@ -0,0 +1,78 @@
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// feedTimeout bounds one read of the feed
+	feedTimeout = 10 * time.Second
+	// feedMaxBytes caps the size of a game's state
+	feedMaxBytes = 4 << 20
+	// feedGameID is the placeholder for the game's ID in the feed URL
+	feedGameID = "{id}"
+)
+
+// feedProvider reads games as JSON in the shape of Game from a URL per game, such as an
+// adapter in front of a commercial data feed
+type feedProvider struct {
+	name        string
+	urlTemplate string
+	client      *http.Client
+}
+
+// NewFeed creates a provider that GETs each game from urlTemplate with {id} replaced by the
+// game's ID at the named provider
+func NewFeed(name, urlTemplate string) (Provider, error) {
+	parsed, err := url.Parse(strings.ReplaceAll(urlTemplate, feedGameID, "0"))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid feed URL %q", urlTemplate)
+	}
+	if !strings.Contains(urlTemplate, feedGameID) {
+		return nil, fmt.Errorf("feed URL %q has no %s placeholder for the game", urlTemplate, feedGameID)
+	}
+	return &feedProvider{
+		name:        name,
+		urlTemplate: urlTemplate,
+		client:      &http.Client{Timeout: feedTimeout},
+	}, nil
+}
+
+// Name returns the provider whose IDs the feed uses
+func (p *feedProvider) Name() string {
+	return p.name
+}
+
+// Game fetches a game's current state. Any response other than 200 fails the read.
+func (p *feedProvider) Game(ctx context.Context, gameID string) (*Game, error) {
+	target := strings.ReplaceAll(p.urlTemplate, feedGameID, url.PathEscape(gameID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create feed request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return nil, fmt.Errorf("feed responded %s for game %s", resp.Status, gameID)
+	}
+
+	var game Game
+	if err := json.NewDecoder(io.LimitReader(resp.Body, feedMaxBytes)).Decode(&game); err != nil {
+		return nil, fmt.Errorf("invalid feed response for game %s: %w", gameID, err)
+	}
+	return &game, nil
+}
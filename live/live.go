@@ -0,0 +1,28 @@
+// Package live reads the state of games in progress from a live data provider: the score,
+// status and every player's stats so far.
+package live
+
+import "context"
+
+// Game is a provider's current view of one game. Stats are totals for the game so far, not
+// changes since the last read, so reading the same state twice changes nothing.
+type Game struct {
+	Status    string         `json:"status"` // scheduled, in_progress or completed
+	HomeScore *int           `json:"home_score,omitempty"`
+	AwayScore *int           `json:"away_score,omitempty"`
+	Players   []*PlayerStats `json:"players"`
+}
+
+// PlayerStats is one player's stats in a game so far
+type PlayerStats struct {
+	PlayerID string             `json:"player_id"` // the provider's ID
+	Stats    map[string]float64 `json:"stats"`     // by player stats field name, e.g. passing_yards
+}
+
+// Provider fetches games in progress by the provider's own IDs
+type Provider interface {
+	// Name is the external ID provider whose IDs the provider's games and players are mapped by
+	Name() string
+	// Game fetches the current state of a game. It should stop when ctx is cancelled.
+	Game(ctx context.Context, gameID string) (*Game, error)
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// loadRequestTimeout bounds each request, so a stalled server shows up as errors rather than
+// a load test that never ends
+const loadRequestTimeout = 10 * time.Second
+
+// loadTarget is one endpoint of a load profile, requested in proportion to its weight
+type loadTarget struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"` // {player_id} is replaced with a random player's ID on each request
+	Weight int    `json:"weight"`
+}
+
+// defaultLoadProfile is the read traffic of a game day against the sample data: player lists,
+// box scores and leaderboards
+var defaultLoadProfile = []*loadTarget{
+	{Name: "players", Path: "/api/players", Weight: 3},
+	{Name: "players with stats", Path: "/api/players?include=team,stats", Weight: 1},
+	{Name: "player stats", Path: "/api/players/{player_id}/stats", Weight: 4},
+	{Name: "stats feed", Path: "/api/player-stats?limit=50", Weight: 2},
+	{Name: "week games", Path: "/api/games/season/2026/week/1", Weight: 2},
+	{Name: "passing leaders", Path: "/api/season-stats/2026/leaders?stat=passing_yards", Weight: 2},
+	{Name: "rushing leaders per game", Path: "/api/season-stats/2026/leaders?stat=rushing_yards&position=RB&per_game=true", Weight: 1},
+}
+
+// loadResults collects the latencies and failures of one target
+type loadResults struct {
+	latencies []time.Duration
+	errors    int
+}
+
+// runLoadTest sends a steady rate of requests from a load profile to a running server and
+// reports latency percentiles per endpoint. It exits non-zero when a threshold is exceeded, so
+// it can gate a build.
+func runLoadTest(args []string) {
+	const synopsis = "[-url URL] [-rate N] [-duration D] [-profile FILE] [-max-p95 D] [-max-error-rate R]"
+	const summary = "Send a steady rate of requests to a running server and report latencies per endpoint."
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+	}
+
+	flags := flag.NewFlagSet("loadtest", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s loadtest %s\n\n%s\n\n", os.Args[0], synopsis, summary)
+		flags.PrintDefaults()
+	}
+	baseURL := flags.String("url", "http://localhost:"+port, "server to load")
+	rate := flags.Int("rate", 50, "requests a second")
+	duration := flags.Duration("duration", 30*time.Second, "how long to send requests")
+	profilePath := flags.String("profile", "", "JSON file of targets (name, path, weight) to use instead of the default profile")
+	maxP95 := flags.Duration("max-p95", 0, "fail when any endpoint's 95th percentile latency is above this; 0 to not check")
+	maxErrorRate := flags.Float64("max-error-rate", 0.01, "fail when more than this share of requests error")
+	flags.Parse(args)
+	if flags.NArg() > 0 {
+		usageError("loadtest", synopsis, "loadtest takes no arguments")
+	}
+	if *rate <= 0 || *duration <= 0 {
+		usageError("loadtest", synopsis, "rate and duration must be positive")
+	}
+
+	profile := defaultLoadProfile
+	if *profilePath != "" {
+		loaded, err := loadProfile(*profilePath)
+		if err != nil {
+			log.Fatalf("Invalid load profile %s: %v", *profilePath, err)
+		}
+		profile = loaded
+	}
+
+	base := strings.TrimRight(*baseURL, "/")
+	client := &http.Client{Timeout: loadRequestTimeout}
+	playerIDs, err := fetchPlayerIDs(client, base)
+	if err != nil {
+		log.Fatalf("Failed to list players: %v", err)
+	}
+	if len(playerIDs) == 0 {
+		log.Fatal("The server has no players; load the sample data with the seed command first")
+	}
+
+	// Draw targets by weight
+	var weighted []*loadTarget
+	for _, target := range profile {
+		for i := 0; i < target.Weight; i++ {
+			weighted = append(weighted, target)
+		}
+	}
+
+	log.Printf("Sending %d requests a second to %s for %s", *rate, base, *duration)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]*loadResults, len(profile))
+	for _, target := range profile {
+		results[target.Name] = &loadResults{}
+	}
+
+	// Requests go out on schedule whether or not earlier ones have returned, so a slow server
+	// builds a backlog instead of quietly lowering the rate
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ticker := time.NewTicker(time.Second / time.Duration(*rate))
+	deadline := time.After(*duration)
+	started := time.Now()
+send:
+	for {
+		select {
+		case <-deadline:
+			break send
+		case <-ticker.C:
+			target := weighted[rng.Intn(len(weighted))]
+			path := strings.ReplaceAll(target.Path, "{player_id}", strconv.Itoa(playerIDs[rng.Intn(len(playerIDs))]))
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				latency, ok := loadRequest(client, base+path)
+				mu.Lock()
+				defer mu.Unlock()
+				result := results[target.Name]
+				if !ok {
+					result.errors++
+					return
+				}
+				result.latencies = append(result.latencies, latency)
+			}()
+		}
+	}
+	ticker.Stop()
+	wg.Wait()
+	elapsed := time.Since(started)
+
+	if !reportLoadResults(profile, results, elapsed, *maxP95, *maxErrorRate) {
+		os.Exit(1)
+	}
+}
+
+// loadProfile reads a profile of targets from a JSON file
+func loadProfile(path string) ([]*loadTarget, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile []*loadTarget
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return nil, err
+	}
+	if len(profile) == 0 {
+		return nil, fmt.Errorf("profile has no targets")
+	}
+
+	names := make(map[string]bool, len(profile))
+	for _, target := range profile {
+		if target.Name == "" || !strings.HasPrefix(target.Path, "/") {
+			return nil, fmt.Errorf("every target needs a name and a path starting with /")
+		}
+		if names[target.Name] {
+			return nil, fmt.Errorf("target %q is listed twice", target.Name)
+		}
+		names[target.Name] = true
+		if target.Weight <= 0 {
+			return nil, fmt.Errorf("target %q needs a positive weight", target.Name)
+		}
+	}
+	return profile, nil
+}
+
+// fetchPlayerIDs lists the IDs of the server's players, to fill in {player_id}
+func fetchPlayerIDs(client *http.Client, base string) ([]int, error) {
+	res, err := client.Get(base + "/api/players")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server returned %s", res.Status)
+	}
+
+	var players []struct {
+		ID int `json:"id"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&players); err != nil {
+		return nil, fmt.Errorf("failed to decode players: %w", err)
+	}
+
+	ids := make([]int, len(players))
+	for i, player := range players {
+		ids[i] = player.ID
+	}
+	return ids, nil
+}
+
+// loadRequest fetches url and reads the whole body, reporting how long it took. Transport
+// errors and 5xx responses are failures; a 4xx is the server answering correctly.
+func loadRequest(client *http.Client, url string) (time.Duration, bool) {
+	started := time.Now()
+	res, err := client.Get(url)
+	if err != nil {
+		return 0, false
+	}
+	defer res.Body.Close()
+	if _, err := io.Copy(io.Discard, res.Body); err != nil {
+		return 0, false
+	}
+	return time.Since(started), res.StatusCode < http.StatusInternalServerError
+}
+
+// reportLoadResults prints each target's request count, errors and latency percentiles, and
+// reports whether the run stayed within the thresholds
+func reportLoadResults(profile []*loadTarget, results map[string]*loadResults, elapsed, maxP95 time.Duration, maxErrorRate float64) bool {
+	fmt.Printf("%-28s %8s %7s %9s %9s %9s %9s\n", "endpoint", "requests", "errors", "p50", "p95", "p99", "max")
+
+	passed := true
+	total, failed := 0, 0
+	for _, target := range profile {
+		result := results[target.Name]
+		latencies := result.latencies
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		requests := len(latencies) + result.errors
+		total += requests
+		failed += result.errors
+
+		p95 := percentile(latencies, 0.95)
+		fmt.Printf("%-28s %8d %7d %9s %9s %9s %9s\n", target.Name, requests, result.errors,
+			roundLatency(percentile(latencies, 0.50)), roundLatency(p95),
+			roundLatency(percentile(latencies, 0.99)), roundLatency(percentile(latencies, 1)))
+
+		if maxP95 > 0 && p95 > maxP95 {
+			log.Printf("%s: p95 of %s is above the %s limit", target.Name, roundLatency(p95), maxP95)
+			passed = false
+		}
+	}
+
+	fmt.Printf("\n%d requests in %s (%.1f a second), %d errors\n", total, elapsed.Round(time.Millisecond), float64(total)/elapsed.Seconds(), failed)
+	if total > 0 && float64(failed)/float64(total) > maxErrorRate {
+		log.Printf("Error rate of %.2f%% is above the %.2f%% limit", 100*float64(failed)/float64(total), 100*maxErrorRate)
+		passed = false
+	}
+	return passed
+}
+
+// percentile is the latency at or below which share p of sorted latencies fall
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(float64(len(sorted))*p+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+// roundLatency rounds a latency for display
+func roundLatency(d time.Duration) time.Duration {
+	if d >= time.Millisecond {
+		return d.Round(10 * time.Microsecond)
+	}
+	return d.Round(time.Microsecond)
+}
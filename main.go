@@ -1,119 +1,75 @@
 package main
 
 import (
-	"log"
-	"net/http"
+	"flag"
+	"fmt"
 	"os"
-	"sports-backend/database"
-	"sports-backend/handlers"
-	"sports-backend/repositories"
-	"sports-backend/services"
-
-	"github.com/gorilla/mux"
 )
 
-func main() {
-	// Initialize database
-	if err := database.InitDB(); err != nil {
-		log.Fatal("Failed to initialize database:", err)
-	}
-	defer database.CloseDB()
-
-	// Run migrations
-	if err := database.RunMigrations(); err != nil {
-		log.Fatal("Failed to run migrations:", err)
-	}
-
-	// Initialize repositories
-	teamRepo := repositories.NewTeamRepository(database.DB)
-	playerRepo := repositories.NewPlayerRepository(database.DB)
-	playerStatsRepo := repositories.NewPlayerStatsRepository(database.DB)
-	gameRepo := repositories.NewGameRepository(database.DB)
-
-	// Initialize services
-	teamService := services.NewTeamService(teamRepo)
-	playerService := services.NewPlayerService(playerRepo, teamRepo)
-	playerStatsService := services.NewPlayerStatsService(playerStatsRepo, playerRepo)
-	gameService := services.NewGameService(gameRepo, teamRepo)
-
-	// Initialize handlers
-	teamHandler := handlers.NewTeamHandler(teamService)
-	playerHandler := handlers.NewPlayerHandler(playerService, playerStatsService)
-	gameHandler := handlers.NewGameHandler(gameService)
-
-	// Create router
-	router := mux.NewRouter()
-
-	// Add CORS middleware
-	router.Use(corsMiddleware)
-
-	// API routes
-	apiRouter := router.PathPrefix("/api").Subrouter()
-
-	// Teams routes
-	apiRouter.HandleFunc("/teams", teamHandler.GetTeams).Methods("GET")
-	apiRouter.HandleFunc("/teams", teamHandler.CreateTeam).Methods("POST")
-	apiRouter.HandleFunc("/teams/{id}", teamHandler.GetTeam).Methods("GET")
-	apiRouter.HandleFunc("/teams/{id}", teamHandler.UpdateTeam).Methods("PUT")
-	apiRouter.HandleFunc("/teams/{id}", teamHandler.DeleteTeam).Methods("DELETE")
-	apiRouter.HandleFunc("/teams/{id}/stats", teamHandler.GetTeamStats).Methods("GET")
-	apiRouter.HandleFunc("/teams/{id}/stats", teamHandler.CreateTeamStats).Methods("POST")
+// command is one subcommand of the binary. Every command reads the same environment
+// configuration and shares the wiring in newApp.
+type command struct {
+	name    string
+	args    string // argument synopsis shown in the usage
+	summary string
+	run     func(args []string)
+}
 
-	// Players routes
-	apiRouter.HandleFunc("/players", playerHandler.GetPlayers).Methods("GET")
-	apiRouter.HandleFunc("/players", playerHandler.CreatePlayer).Methods("POST")
-	apiRouter.HandleFunc("/players/{id}", playerHandler.GetPlayer).Methods("GET")
-	apiRouter.HandleFunc("/players/{id}", playerHandler.UpdatePlayer).Methods("PUT")
-	apiRouter.HandleFunc("/players/{id}", playerHandler.DeletePlayer).Methods("DELETE")
-	apiRouter.HandleFunc("/players/{id}/stats", playerHandler.GetPlayerStats).Methods("GET")
-	apiRouter.HandleFunc("/players/{id}/stats", playerHandler.CreatePlayerStats).Methods("POST")
-	apiRouter.HandleFunc("/players/{id}/stats/{stats_id}", playerHandler.UpdatePlayerStats).Methods("PUT")
-	apiRouter.HandleFunc("/players/{id}/stats/{stats_id}", playerHandler.DeletePlayerStats).Methods("DELETE")
+// commands lists the subcommands in the order the usage shows them
+var commands = []*command{
+	{"serve", "", "Run the HTTP API (the default when no command is given)", runServe},
+	{"migrate", "up|down|status", "Apply the schema migrations, or list which are applied", runMigrate},
+	{"seed", "", "Load sample teams, rosters, schedule and box scores into an empty database", runSeed},
+	{"import", "csv projections|adp|dfs-salaries|nflverse-stats FILE", "Bulk import a CSV file, as the matching import endpoint would", runImport},
+	{"backup", "create|list", "Back up the database to storage, or list the backups there", runBackup},
+	{"restore", "NAME", "Replace the database with a backup from storage, backing up the current contents first", runRestore},
+	{"loadtest", "[flags]", "Send a steady rate of requests to a running server and report latencies per endpoint", runLoadTest},
+}
 
-	// Games routes
-	apiRouter.HandleFunc("/games", gameHandler.GetGames).Methods("GET")
-	apiRouter.HandleFunc("/games", gameHandler.CreateGame).Methods("POST")
-	apiRouter.HandleFunc("/games/{id}", gameHandler.GetGame).Methods("GET")
-	apiRouter.HandleFunc("/games/{id}", gameHandler.UpdateGame).Methods("PUT")
-	apiRouter.HandleFunc("/games/{id}", gameHandler.DeleteGame).Methods("DELETE")
-	apiRouter.HandleFunc("/teams/{id}/games", gameHandler.GetGamesByTeam).Methods("GET")
-	apiRouter.HandleFunc("/games/season/{season}", gameHandler.GetGamesBySeason).Methods("GET")
-	apiRouter.HandleFunc("/games/season/{season}/week/{week}", gameHandler.GetGamesByWeek).Methods("GET")
+func main() {
+	flag.Usage = usage
+	flag.Parse()
 
-	// Health check endpoint
-	router.HandleFunc("/health", func(responseWriter http.ResponseWriter, request *http.Request) {
-		responseWriter.Header().Set("Content-Type", "application/json")
-		responseWriter.WriteHeader(http.StatusOK)
-		responseWriter.Write([]byte(`{"status": "healthy"}`))
-	}).Methods("GET")
+	args := flag.Args()
+	if len(args) == 0 {
+		runServe(nil)
+		return
+	}
 
-	// Get port from environment or use default
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			cmd.run(args[1:])
+			return
+		}
 	}
 
-	log.Printf("Server starting on port %s", port)
-	log.Printf("API endpoints available at http://localhost:%s/api", port)
-	log.Printf("Health check available at http://localhost:%s/health", port)
+	fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", args[0])
+	usage()
+	os.Exit(2)
+}
 
-	if serverError := http.ListenAndServe(":"+port, router); serverError != nil {
-		log.Fatal("Server failed to start:", serverError)
+// usage prints the commands and where configuration comes from
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [command]\n\nCommands:\n", os.Args[0])
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %-52s %s\n", cmd.name, cmd.args, cmd.summary)
 	}
+	fmt.Fprintln(os.Stderr, "\nConfiguration is read from the environment (DB_PATH, PORT, ...); see the README.")
 }
 
-// corsMiddleware adds CORS headers to allow frontend connections
-func corsMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+// parseFlags parses a command's arguments, handling -h, and returns the positional ones
+func parseFlags(name, synopsis, summary string, args []string) []string {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s %s %s\n\n%s\n", os.Args[0], name, synopsis, summary)
+	}
+	flags.Parse(args)
+	return flags.Args()
+}
 
-		next.ServeHTTP(w, r)
-	})
+// usageError reports a malformed command line and exits
+func usageError(name, synopsis, format string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", a...)
+	fmt.Fprintf(os.Stderr, "Usage: %s %s %s\n", os.Args[0], name, synopsis)
+	os.Exit(2)
 }
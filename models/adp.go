@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+// ADPFormats are the scoring formats average draft positions are kept for
+var ADPFormats = []string{"standard", "half_ppr", "ppr", "superflex"}
+
+// ADP is one source's average draft position for a player in a scoring format and season
+type ADP struct {
+	ID        int       `json:"id" db:"id"`
+	PlayerID  int       `json:"player_id" db:"player_id"`
+	Season    string    `json:"season" db:"season"`
+	Format    string    `json:"format" db:"format"`
+	Source    string    `json:"source" db:"source"`
+	Value     float64   `json:"adp" db:"adp"` // overall pick number, e.g. 12.4
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Request/Response structs for ADP
+type CreateADPRequest struct {
+	PlayerID int     `json:"player_id" validate:"required"`
+	Season   string  `json:"season" validate:"required"`
+	Format   string  `json:"format" validate:"required"`
+	Source   string  `json:"source" validate:"required"`
+	Value    float64 `json:"adp" validate:"required"`
+}
@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// AdvancedStatNames lists the season metrics advanced stats leaderboards rank by. The first
+// are totals and shares of the game rows; the rates combine them with the box score.
+var AdvancedStatNames = []string{
+	"offense_snaps", "defense_snaps", "special_teams_snaps", "offense_snap_share", "routes_run",
+	"air_yards", "red_zone_touches", "target_share",
+	"average_depth_of_target", "yards_per_route_run", "targets_per_route_run",
+}
+
+// AdvancedStats is a player's usage and depth-of-target metrics for one game, beside the
+// box score line. Metrics a source doesn't provide are left unset.
+type AdvancedStats struct {
+	ID                int       `json:"id" db:"id"`
+	PlayerID          int       `json:"player_id" db:"player_id"`
+	GameID            int       `json:"game_id" db:"game_id"`
+	OffenseSnaps      *int      `json:"offense_snaps,omitempty" db:"offense_snaps"`
+	DefenseSnaps      *int      `json:"defense_snaps,omitempty" db:"defense_snaps"`
+	SpecialTeamsSnaps *int      `json:"special_teams_snaps,omitempty" db:"special_teams_snaps"`
+	OffenseSnapShare  *float64  `json:"offense_snap_share,omitempty" db:"offense_snap_share"` // of the team's offensive snaps, 0 to 1
+	RoutesRun         *int      `json:"routes_run,omitempty" db:"routes_run"`
+	AirYards          *int      `json:"air_yards,omitempty" db:"air_yards"`               // intended air yards of the player's targets
+	RedZoneTouches    *int      `json:"red_zone_touches,omitempty" db:"red_zone_touches"` // carries and receptions inside the opponent's 20
+	TargetShare       *float64  `json:"target_share,omitempty" db:"target_share"`         // of the team's targets, 0 to 1
+	Source            string    `json:"source" db:"source"`                               // the source that last wrote the row
+	CreatedAt         time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateAdvancedStatsRequest is one row of an advanced stats import. The metrics it carries
+// replace those of the player's existing row for the game and the others are kept.
+type CreateAdvancedStatsRequest struct {
+	PlayerID          int      `json:"player_id" validate:"required"`
+	GameID            int      `json:"game_id" validate:"required"`
+	Source            string   `json:"source" validate:"required"` // e.g. pff, ngs or nflverse
+	OffenseSnaps      *int     `json:"offense_snaps,omitempty"`
+	DefenseSnaps      *int     `json:"defense_snaps,omitempty"`
+	SpecialTeamsSnaps *int     `json:"special_teams_snaps,omitempty"`
+	OffenseSnapShare  *float64 `json:"offense_snap_share,omitempty"`
+	RoutesRun         *int     `json:"routes_run,omitempty"`
+	AirYards          *int     `json:"air_yards,omitempty"`
+	RedZoneTouches    *int     `json:"red_zone_touches,omitempty"`
+	TargetShare       *float64 `json:"target_share,omitempty"`
+}
+
+// PlayerAdvancedSeason is a player's advanced stats over a season's games. Counts are totals,
+// shares are averaged over the games that have them, and the rates combine the totals with the
+// box score's targets and receiving yards in the same games. Metrics no game has are left out.
+type PlayerAdvancedSeason struct {
+	Rank                 int      `json:"rank,omitempty"` // on a leaderboard
+	PlayerID             int      `json:"player_id"`
+	FirstName            string   `json:"first_name"`
+	LastName             string   `json:"last_name"`
+	Position             string   `json:"position"`
+	TeamID               int      `json:"team_id"`
+	Season               string   `json:"season"`
+	Games                int      `json:"games"`
+	OffenseSnaps         *int     `json:"offense_snaps,omitempty"`
+	DefenseSnaps         *int     `json:"defense_snaps,omitempty"`
+	SpecialTeamsSnaps    *int     `json:"special_teams_snaps,omitempty"`
+	OffenseSnapShare     *float64 `json:"offense_snap_share,omitempty"`
+	RoutesRun            *int     `json:"routes_run,omitempty"`
+	AirYards             *int     `json:"air_yards,omitempty"`
+	RedZoneTouches       *int     `json:"red_zone_touches,omitempty"`
+	TargetShare          *float64 `json:"target_share,omitempty"`
+	Targets              *int     `json:"targets,omitempty"`
+	ReceivingYards       *int     `json:"receiving_yards,omitempty"`
+	AverageDepthOfTarget *float64 `json:"average_depth_of_target,omitempty"` // air yards per target
+	YardsPerRouteRun     *float64 `json:"yards_per_route_run,omitempty"`
+	TargetsPerRouteRun   *float64 `json:"targets_per_route_run,omitempty"`
+}
+
+// AdvancedLeadersResponse is the response body for GET /api/advanced-stats/{season}/leaders
+type AdvancedLeadersResponse struct {
+	Season   string                  `json:"season"`
+	Stat     string                  `json:"stat"`
+	Position string                  `json:"position,omitempty"`
+	Leaders  []*PlayerAdvancedSeason `json:"leaders"`
+}
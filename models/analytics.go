@@ -0,0 +1,24 @@
+package models
+
+// UsageCount is the number of requests to a feature category on one day
+type UsageCount struct {
+	Category string `json:"category" db:"category"`
+	Day      string `json:"day" db:"day"` // UTC, YYYY-MM-DD
+	Count    int    `json:"count" db:"count"`
+}
+
+// CategoryUsage is the total number of requests to a feature category over a report period
+type CategoryUsage struct {
+	Category string `json:"category"`
+	Count    int    `json:"count"`
+}
+
+// UsageReport summarizes feature usage over the last N days
+type UsageReport struct {
+	CollectionEnabled bool             `json:"collection_enabled"`
+	Since             string           `json:"since"`
+	Days              int              `json:"days"`
+	TotalRequests     int              `json:"total_requests"`
+	Categories        []*CategoryUsage `json:"categories"`
+	Daily             []*UsageCount    `json:"daily"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Backup is a copy of the database in the backup directory
+type Backup struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"` // bytes
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RestoreResult reports a restore and the backup taken of the database it replaced
+type RestoreResult struct {
+	Restored     string  `json:"restored"`
+	SafetyBackup *Backup `json:"safety_backup"`
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"time"
+)
+
+// DFSSlotPositions lists the positions each daily fantasy lineup slot accepts. A position
+// code slot accepts only that position.
+var DFSSlotPositions = map[string][]string{
+	"QB":        {"QB"},
+	"RB":        {"RB"},
+	"WR":        {"WR"},
+	"TE":        {"TE"},
+	"K":         {"K"},
+	"FLEX":      {"RB", "WR", "TE"},
+	"SUPERFLEX": {"QB", "RB", "WR", "TE"},
+}
+
+// DefaultDFSSlots is the classic salary-cap lineup used when a request names no slots
+var DefaultDFSSlots = []string{"QB", "RB", "RB", "WR", "WR", "WR", "TE", "FLEX"}
+
+// DefaultDFSSalaryCap is the salary cap used when a request does not set one
+const DefaultDFSSalaryCap = 50000
+
+// DFSSalary is a daily fantasy site's salary for a player in a week
+type DFSSalary struct {
+	ID        int       `json:"id" db:"id"`
+	PlayerID  int       `json:"player_id" db:"player_id"`
+	Season    string    `json:"season" db:"season"`
+	Week      int       `json:"week" db:"week"`
+	Site      string    `json:"site" db:"site"`
+	Salary    int       `json:"salary" db:"salary"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Request/Response structs for DFS salaries
+type CreateDFSSalaryRequest struct {
+	PlayerID int    `json:"player_id" validate:"required"`
+	Season   string `json:"season" validate:"required"`
+	Week     int    `json:"week" validate:"required"`
+	Site     string `json:"site" validate:"required"`
+	Salary   int    `json:"salary" validate:"required"`
+}
+
+// DFSSlatePlayer is a player priced on a site for a week, with the week's projected points
+type DFSSlatePlayer struct {
+	PlayerID        int      `json:"player_id"`
+	FirstName       string   `json:"first_name"`
+	LastName        string   `json:"last_name"`
+	Position        string   `json:"position"`
+	TeamID          int      `json:"team_id"`
+	Salary          int      `json:"salary"`
+	ProjectedPoints *float64 `json:"projected_points,omitempty"` // absent when no source projected the player
+	// Projected points per $1,000 of salary
+	Value *float64 `json:"value,omitempty"`
+}
+
+// DFSLineupRequest is the request body for the lineup endpoints. Validation checks the
+// given players; optimization picks players, keeping the locked ones and skipping the excluded.
+type DFSLineupRequest struct {
+	Season    string   `json:"season"`
+	Week      int      `json:"week"`
+	Site      string   `json:"site"`
+	SalaryCap int      `json:"salary_cap,omitempty"`
+	Slots     []string `json:"slots,omitempty"`
+	Source    string   `json:"source,omitempty"` // projection source; the average across sources when empty
+	PlayerIDs []int    `json:"player_ids,omitempty"`
+	Locked    []int    `json:"locked,omitempty"`
+	Excluded  []int    `json:"excluded,omitempty"`
+}
+
+// DFSLineup is a salary-cap lineup with its totals
+type DFSLineup struct {
+	Season          string           `json:"season"`
+	Week            int              `json:"week"`
+	Site            string           `json:"site"`
+	SalaryCap       int              `json:"salary_cap"`
+	Salary          int              `json:"salary"`
+	ProjectedPoints float64          `json:"projected_points"`
+	Valid           bool             `json:"valid"`
+	Errors          []string         `json:"errors,omitempty"`
+	Players         []*DFSLineupSlot `json:"players"`
+}
+
+// DFSLineupSlot is a player placed in a lineup slot
+type DFSLineupSlot struct {
+	Slot string `json:"slot"`
+	*DFSSlatePlayer
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// DraftPick represents a selection slot in the NFL draft and, once made, the player taken
+type DraftPick struct {
+	ID        int       `json:"id" db:"id"`
+	Year      int       `json:"year" db:"year"`
+	Round     int       `json:"round" db:"round"`
+	Pick      int       `json:"pick" db:"pick"`                     // pick number within the round
+	TeamID    int       `json:"team_id" db:"team_id"`               // team that holds the pick
+	PlayerID  *int      `json:"player_id,omitempty" db:"player_id"` // nil until the pick is made
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Request/Response structs for DraftPicks
+type CreateDraftPickRequest struct {
+	Year     int  `json:"year" validate:"required"`
+	Round    int  `json:"round" validate:"required,min=1"`
+	Pick     int  `json:"pick" validate:"required,min=1"`
+	TeamID   int  `json:"team_id" validate:"required"`
+	PlayerID *int `json:"player_id,omitempty"`
+}
+
+type UpdateDraftPickRequest struct {
+	Year     *int `json:"year,omitempty"`
+	Round    *int `json:"round,omitempty" validate:"omitempty,min=1"`
+	Pick     *int `json:"pick,omitempty" validate:"omitempty,min=1"`
+	TeamID   *int `json:"team_id,omitempty"`
+	PlayerID *int `json:"player_id,omitempty"`
+}
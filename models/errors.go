@@ -0,0 +1,33 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrConflict matches any ConflictError via errors.Is
+var ErrConflict = errors.New("conflict")
+
+// ErrQueueFull is returned when the job queue has no room for another job; the caller
+// should retry later
+var ErrQueueFull = errors.New("job queue is full")
+
+// ConflictError reports a write that would duplicate an existing record.
+// Fields names the columns whose combination must be unique.
+type ConflictError struct {
+	Entity string
+	Fields []string
+}
+
+func (e *ConflictError) Error() string {
+	if len(e.Fields) == 0 {
+		return fmt.Sprintf("%s conflicts with an existing record", e.Entity)
+	}
+	return fmt.Sprintf("%s conflicts with an existing record on %s", e.Entity, strings.Join(e.Fields, ", "))
+}
+
+// Is lets errors.Is(err, ErrConflict) match a ConflictError
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
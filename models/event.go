@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// Event types published to subscribers of the event stream
+const (
+	EventGameRescheduled = "game.rescheduled"
+	EventGameScore       = "game.score"
+	EventGameStats       = "game.stats"
+	EventPlayerInjury    = "player.injury"
+)
+
+// EventTypes lists every event type that can be subscribed to
+var EventTypes = []string{EventGameRescheduled, EventGameScore, EventGameStats, EventPlayerInjury}
+
+// Event is a change published to live subscribers. IDs increase in publish order and restart
+// with the server.
+type Event struct {
+	ID   int64       `json:"id"`
+	Type string      `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Export is a CSV export kept in storage
+type Export struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"` // bytes
+	CreatedAt time.Time `json:"created_at"`
+	URL       string    `json:"url"` // download path
+}
+
+// ExportResult is the result of an export job
+type ExportResult struct {
+	Export *Export `json:"export"`
+	Rows   int     `json:"rows"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+// ExternalID maps an internal player, team or game to its ID at a data provider
+type ExternalID struct {
+	ID         int       `json:"id" db:"id"`
+	EntityType string    `json:"entity_type" db:"entity_type"` // player, team, game
+	EntityID   int       `json:"entity_id" db:"entity_id"`
+	Provider   string    `json:"provider" db:"provider"` // espn, sleeper, gsis, pfr
+	ExternalID string    `json:"external_id" db:"external_id"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// ExternalIDProviders lists the data providers whose IDs can be mapped
+var ExternalIDProviders = []string{"espn", "sleeper", "gsis", "pfr"}
+
+// Request/Response structs for ExternalIDs
+type CreateExternalIDRequest struct {
+	EntityType string `json:"entity_type" validate:"required,oneof=player team game"`
+	EntityID   int    `json:"entity_id" validate:"required"`
+	Provider   string `json:"provider" validate:"required,oneof=espn sleeper gsis pfr"`
+	ExternalID string `json:"external_id" validate:"required"`
+}
@@ -0,0 +1,17 @@
+package models
+
+// Game types. Only regular season games count toward season totals, leaderboards, strength
+// of schedule, weekly highlights and projection scoring.
+const (
+	GameTypePreseason  = "preseason"
+	GameTypeRegular    = "regular"
+	GameTypeWildcard   = "wildcard"
+	GameTypeDivisional = "divisional"
+	GameTypeConference = "conference"
+	GameTypeSuperBowl  = "superbowl"
+)
+
+// GameTypes lists every game type in the order of a season
+var GameTypes = []string{
+	GameTypePreseason, GameTypeRegular, GameTypeWildcard, GameTypeDivisional, GameTypeConference, GameTypeSuperBowl,
+}
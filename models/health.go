@@ -0,0 +1,19 @@
+package models
+
+// Health statuses
+const (
+	HealthOK   = "ok"
+	HealthFail = "fail"
+)
+
+// HealthReport is the response body for GET /readyz. Status is ok only when every check is.
+type HealthReport struct {
+	Status string                  `json:"status"`
+	Checks map[string]*HealthCheck `json:"checks"`
+}
+
+// HealthCheck is the state of one dependency
+type HealthCheck struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
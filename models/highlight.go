@@ -0,0 +1,42 @@
+package models
+
+// Highlight represents a notable statistical performance in a single game
+type Highlight struct {
+	Category    string `json:"category"` // passing_yards, rushing_yards, receiving_yards, touchdowns, defensive_score
+	Description string `json:"description"`
+	Value       int    `json:"value"`
+	PlayerID    int    `json:"player_id"`
+	PlayerName  string `json:"player_name"`
+	Position    string `json:"position"`
+	TeamID      int    `json:"team_id"`
+	GameID      int    `json:"game_id"`
+	StatsID     int    `json:"stats_id"`
+}
+
+// HighlightThresholds controls which stat lines qualify as highlights
+type HighlightThresholds struct {
+	PassingYards        int `json:"passing_yards"`
+	RushingYards        int `json:"rushing_yards"`
+	ReceivingYards      int `json:"receiving_yards"`
+	Touchdowns          int `json:"touchdowns"`
+	DefensiveTouchdowns int `json:"defensive_touchdowns"`
+}
+
+// DefaultHighlightThresholds returns the thresholds used when none are provided
+func DefaultHighlightThresholds() HighlightThresholds {
+	return HighlightThresholds{
+		PassingYards:        300,
+		RushingYards:        100,
+		ReceivingYards:      100,
+		Touchdowns:          3,
+		DefensiveTouchdowns: 1,
+	}
+}
+
+// WeeklyHighlightsResponse is the response body for GET /api/highlights
+type WeeklyHighlightsResponse struct {
+	Season     string              `json:"season"`
+	Week       int                 `json:"week"`
+	Thresholds HighlightThresholds `json:"thresholds"`
+	Highlights []*Highlight        `json:"highlights"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// InjuryStatuses are the game status designations of the official injury report
+var InjuryStatuses = []string{"out", "doubtful", "questionable"}
+
+// InjuryReport is a week's injury report, for POST /api/injuries/report. It lists every player
+// with a designation, so players it leaves out have theirs cleared.
+type InjuryReport struct {
+	Season  string               `json:"season"`
+	Week    int                  `json:"week"`
+	Players []*InjuryReportEntry `json:"players"`
+}
+
+// InjuryReportEntry is one player's line on the injury report
+type InjuryReportEntry struct {
+	PlayerID int     `json:"player_id"`
+	Status   string  `json:"status"`           // out, doubtful or questionable; empty for none
+	Injury   *string `json:"injury,omitempty"` // e.g. Hamstring
+}
+
+// InjuryChange is a change of a player's line on the injury report. A change of designation is
+// published as a player.injury event.
+type InjuryChange struct {
+	ID             int       `json:"id" db:"id"`
+	PlayerID       int       `json:"player_id" db:"player_id"`
+	PlayerName     string    `json:"player_name" db:"-"`
+	TeamID         int       `json:"team_id" db:"-"`
+	Season         string    `json:"season" db:"season"`
+	Week           int       `json:"week" db:"week"`
+	PreviousStatus *string   `json:"previous_status,omitempty" db:"previous_status"` // unset when the player had no designation
+	Status         *string   `json:"status,omitempty" db:"status"`                   // unset when the designation was cleared
+	Injury         *string   `json:"injury,omitempty" db:"injury"`
+	ChangedAt      time.Time `json:"changed_at" db:"changed_at"`
+}
+
+// InjuryReportResult summarizes applying a week's injury report
+type InjuryReportResult struct {
+	Season    string          `json:"season"`
+	Week      int             `json:"week"`
+	Changes   []*InjuryChange `json:"changes"`
+	Unchanged int             `json:"unchanged"`
+	Skipped   int             `json:"skipped,omitempty"` // feed players without an ID mapping
+}
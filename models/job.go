@@ -0,0 +1,72 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Job statuses
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobDone      = "done"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// JobStatuses lists every job status
+var JobStatuses = []string{JobQueued, JobRunning, JobDone, JobFailed, JobCancelled}
+
+// Import job kinds, named after the path they are submitted to
+const (
+	JobImportADP         = "adp"
+	JobImportProjections = "projections"
+	JobImportDFSSalaries = "dfs-salaries"
+	JobImportOdds        = "odds"
+	JobImportNflverse    = "nflverse-stats"
+	JobImportAdvanced    = "advanced-stats"
+)
+
+// JobExportPlayerStats writes a CSV export of player stats to storage
+const JobExportPlayerStats = "player-stats-export"
+
+// JobSendNotification delivers one event to one notification subscription
+const JobSendNotification = "notification"
+
+// ImportJobKinds lists the import kinds that can run as jobs
+var ImportJobKinds = []string{JobImportADP, JobImportProjections, JobImportDFSSalaries, JobImportOdds, JobImportNflverse, JobImportAdvanced}
+
+// Job is a unit of background work. A failed attempt is retried after a delay until
+// MaxAttempts is reached, unless the failure is a validation failure, which retrying can't
+// fix. Jobs that process records in batches resume from Processed when retried.
+type Job struct {
+	ID              int             `json:"id" db:"id"`
+	Kind            string          `json:"kind" db:"kind"`
+	Status          string          `json:"status" db:"status"`
+	Payload         json.RawMessage `json:"-" db:"-"`
+	Total           int             `json:"total" db:"total"`         // records submitted
+	Processed       int             `json:"processed" db:"processed"` // records in finished batches, written or not
+	Result          json.RawMessage `json:"result,omitempty" db:"-"`  // kind-specific; counts written so far for imports
+	Errors          []*JobError     `json:"errors" db:"-"`            // stored as JSON in errors
+	Attempts        int             `json:"attempts" db:"attempts"`
+	MaxAttempts     int             `json:"max_attempts" db:"max_attempts"`
+	LastError       string          `json:"last_error,omitempty" db:"-"` // NULL when there is none
+	CancelRequested bool            `json:"cancel_requested" db:"cancel_requested"`
+	RunAfter        time.Time       `json:"run_after" db:"run_after"` // earliest start of the next attempt
+	CreatedAt       time.Time       `json:"created_at" db:"created_at"`
+	StartedAt       *time.Time      `json:"started_at,omitempty" db:"started_at"` // start of the latest attempt
+	FinishedAt      *time.Time      `json:"finished_at,omitempty" db:"finished_at"`
+}
+
+// Finished reports whether the job has stopped for good
+func (j *Job) Finished() bool {
+	return j.Status == JobDone || j.Status == JobFailed || j.Status == JobCancelled
+}
+
+// JobError is a batch of records that was not processed. Records are numbered from 0 in the
+// order submitted, and entry numbers in Error count from the start of the batch.
+type JobError struct {
+	FirstRecord int    `json:"first_record"`
+	LastRecord  int    `json:"last_record"`
+	Error       string `json:"error"`
+}
@@ -0,0 +1,29 @@
+package models
+
+// LiveScoreUpdate is published as a game.score event when live polling finds a game's score or
+// status changed
+type LiveScoreUpdate struct {
+	GameID            int    `json:"game_id"`
+	HomeTeamID        int    `json:"home_team_id"`
+	AwayTeamID        int    `json:"away_team_id"`
+	Status            string `json:"status"`
+	HomeScore         *int   `json:"home_score,omitempty"`
+	AwayScore         *int   `json:"away_score,omitempty"`
+	PreviousStatus    string `json:"previous_status"`
+	PreviousHomeScore *int   `json:"previous_home_score,omitempty"`
+	PreviousAwayScore *int   `json:"previous_away_score,omitempty"`
+}
+
+// LiveStatsUpdate is published as a game.stats event when live polling finds stat lines of a
+// game changed, with the players whose lines did
+type LiveStatsUpdate struct {
+	GameID  int               `json:"game_id"`
+	Players []*LiveStatsDelta `json:"players"`
+}
+
+// LiveStatsDelta is the change to one player's stat line since the previous poll
+type LiveStatsDelta struct {
+	PlayerID int                `json:"player_id"`
+	StatsID  int                `json:"stats_id"`
+	Delta    map[string]float64 `json:"delta"` // by stat field name; negative for corrections
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// NewsItem is a news story linked to the players and teams it is about
+type NewsItem struct {
+	ID          int       `json:"id" db:"id"`
+	Headline    string    `json:"headline" db:"headline"`
+	Body        *string   `json:"body,omitempty" db:"body"`
+	SourceURL   string    `json:"source_url" db:"source_url"`
+	PublishedAt time.Time `json:"published_at" db:"published_at"`
+	PlayerIDs   []int     `json:"player_ids" db:"-"`
+	TeamIDs     []int     `json:"team_ids" db:"-"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateNewsItemRequest is one news item of an ingestion. An item with the source URL of an
+// existing one replaces it, links included.
+type CreateNewsItemRequest struct {
+	Headline    string     `json:"headline" validate:"required"`
+	Body        *string    `json:"body,omitempty"`
+	SourceURL   string     `json:"source_url" validate:"required"`
+	PublishedAt *time.Time `json:"published_at" validate:"required"`
+	PlayerIDs   []int      `json:"player_ids,omitempty"`
+	TeamIDs     []int      `json:"team_ids,omitempty"`
+}
@@ -0,0 +1,18 @@
+package models
+
+// NflverseStatLine is one player's week read from a public nflverse (nflfastR) file: a row of a
+// weekly player stats file, or the player's plays of a play-by-play file added up. The player is
+// identified by GSIS ID and the game by the week and team, both matched to the database on import.
+type NflverseStatLine struct {
+	PlayerID string             `json:"player_id"` // GSIS ID, e.g. 00-0033873
+	Season   string             `json:"season"`
+	Week     int                `json:"week"`
+	Team     string             `json:"team"`  // abbreviation, e.g. KC
+	Stats    map[string]float64 `json:"stats"` // by player stats field name; stats the file doesn't carry are absent
+	// Advanced stats by field name, among NflverseAdvancedStats, for players the file has them for
+	Advanced map[string]float64 `json:"advanced,omitempty"`
+}
+
+// NflverseAdvancedStats are the advanced stats nflverse files carry: air yards and target share
+// in weekly files, and air yards, red-zone touches and target share worked out from play-by-play
+var NflverseAdvancedStats = []string{"air_yards", "red_zone_touches", "target_share"}
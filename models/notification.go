@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// Notification channels
+const (
+	NotificationChannelEmail   = "email"
+	NotificationChannelWebhook = "webhook"
+	NotificationChannelPush    = "push"
+)
+
+// NotificationChannels lists every notification channel
+var NotificationChannels = []string{NotificationChannelEmail, NotificationChannelWebhook, NotificationChannelPush}
+
+// NotificationSubscription sends events to a target over a channel: an email address, a
+// webhook URL, or a push topic
+type NotificationSubscription struct {
+	ID         int       `json:"id" db:"id"`
+	Name       string    `json:"name" db:"name"`
+	Channel    string    `json:"channel" db:"channel"` // email, webhook, push
+	Target     string    `json:"target" db:"target"`
+	EventTypes []string  `json:"event_types" db:"-"` // stored as JSON in event_types; empty for every event type
+	Enabled    bool      `json:"enabled" db:"enabled"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Request/Response structs for NotificationSubscriptions
+type CreateNotificationSubscriptionRequest struct {
+	Name       string   `json:"name" validate:"required"`
+	Channel    string   `json:"channel" validate:"required,oneof=email webhook push"`
+	Target     string   `json:"target" validate:"required"`
+	EventTypes []string `json:"event_types,omitempty"`
+	Enabled    *bool    `json:"enabled,omitempty"` // default true
+}
+
+type UpdateNotificationSubscriptionRequest struct {
+	Name       *string   `json:"name,omitempty"`
+	Target     *string   `json:"target,omitempty"`
+	EventTypes *[]string `json:"event_types,omitempty"`
+	Enabled    *bool     `json:"enabled,omitempty"`
+}
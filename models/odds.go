@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+// GameOdds represents a betting line for a game captured at a point in time
+type GameOdds struct {
+	ID            int       `json:"id" db:"id"`
+	GameID        int       `json:"game_id" db:"game_id"`
+	Spread        *float64  `json:"spread,omitempty" db:"spread"` // home team spread, negative when favored
+	Total         *float64  `json:"total,omitempty" db:"total"`   // over/under
+	HomeMoneyline *int      `json:"home_moneyline,omitempty" db:"home_moneyline"`
+	AwayMoneyline *int      `json:"away_moneyline,omitempty" db:"away_moneyline"`
+	Source        string    `json:"source" db:"source"`
+	CapturedAt    time.Time `json:"captured_at" db:"captured_at"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
+
+// Request/Response structs for GameOdds
+type CreateGameOddsRequest struct {
+	GameID        int        `json:"game_id" validate:"required"`
+	Spread        *float64   `json:"spread,omitempty"`
+	Total         *float64   `json:"total,omitempty" validate:"omitempty,min=0"`
+	HomeMoneyline *int       `json:"home_moneyline,omitempty"`
+	AwayMoneyline *int       `json:"away_moneyline,omitempty"`
+	Source        string     `json:"source" validate:"required"`
+	CapturedAt    *time.Time `json:"captured_at,omitempty"`
+}
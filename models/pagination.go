@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// PageCursor is the position of the last row of a page in a feed ordered by creation time, newest first
+type PageCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int       `json:"id"`
+}
+
+// PlayerStatsPage is the response body for GET /api/player-stats
+type PlayerStatsPage struct {
+	Stats []*PlayerStats `json:"stats"`
+	// Opaque cursor for the next page; absent on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
@@ -6,19 +6,34 @@ import (
 
 // Player represents a football player
 type Player struct {
-	ID           int       `json:"id" db:"id"`
-	TeamID       int       `json:"team_id" db:"team_id"`
-	FirstName    string    `json:"first_name" db:"first_name"`
-	LastName     string    `json:"last_name" db:"last_name"`
-	Position     string    `json:"position" db:"position"`
-	JerseyNumber *int      `json:"jersey_number,omitempty" db:"jersey_number"`
-	Height       *int      `json:"height,omitempty" db:"height"` // in inches
-	Weight       *int      `json:"weight,omitempty" db:"weight"` // in pounds
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	ID           int    `json:"id" db:"id"`
+	TeamID       int    `json:"team_id" db:"team_id"`
+	FirstName    string `json:"first_name" db:"first_name"`
+	LastName     string `json:"last_name" db:"last_name"`
+	Position     string `json:"position" db:"position"`
+	JerseyNumber *int   `json:"jersey_number,omitempty" db:"jersey_number"`
+	Height       *int   `json:"height,omitempty" db:"height"` // in inches
+	Weight       *int   `json:"weight,omitempty" db:"weight"` // in pounds
+	// Biographical and career metadata
+	BirthDate       *string `json:"birth_date,omitempty" db:"birth_date"` // YYYY-MM-DD
+	College         *string `json:"college,omitempty" db:"college"`
+	YearsExperience *int    `json:"years_experience,omitempty" db:"years_experience"`
+	HeadshotURL     *string `json:"headshot_url,omitempty" db:"headshot_url"`
+	// Designation on the latest injury report: out, doubtful or questionable, and the injury
+	InjuryStatus *string           `json:"injury_status,omitempty" db:"injury_status"`
+	Injury       *string           `json:"injury,omitempty" db:"injury"`
+	Draft        *DraftPick        `json:"draft,omitempty" db:"-"`
+	ExternalIDs  map[string]string `json:"external_ids,omitempty" db:"-"` // provider -> ID
+	ADP          *float64          `json:"adp,omitempty" db:"-"`          // consensus ADP when players are listed by ADP
+	// Related records, only set when requested with ?include=
+	Team      *Team          `json:"team,omitempty" db:"-"`
+	Stats     []*PlayerStats `json:"stats,omitempty" db:"-"`
+	CreatedAt time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at" db:"updated_at"`
 }
 
-// PlayerStats represents football statistics for a player in a specific game
+// PlayerStats represents football statistics for a player in a specific game. Sacks are the
+// only fractional stat; the rest are counts or whole yards.
 type PlayerStats struct {
 	ID       int `json:"id" db:"id"`
 	PlayerID int `json:"player_id" db:"player_id"`
@@ -39,30 +54,34 @@ type PlayerStats struct {
 	Fumbles              *int `json:"fumbles,omitempty" db:"fumbles"`
 	FumblesLost          *int `json:"fumbles_lost,omitempty" db:"fumbles_lost"`
 	// Defensive stats
-	Tackles                *int `json:"tackles,omitempty" db:"tackles"`
-	SoloTackles            *int `json:"solo_tackles,omitempty" db:"solo_tackles"`
-	AssistedTackles        *int `json:"assisted_tackles,omitempty" db:"assisted_tackles"`
-	Sacks                  *int `json:"sacks,omitempty" db:"sacks"`
-	DefensiveInterceptions *int `json:"defensive_interceptions,omitempty" db:"defensive_interceptions"`
-	PassDeflections        *int `json:"pass_deflections,omitempty" db:"pass_deflections"`
-	ForcedFumbles          *int `json:"forced_fumbles,omitempty" db:"forced_fumbles"`
-	FumbleRecoveries       *int `json:"fumble_recoveries,omitempty" db:"fumble_recoveries"`
-	DefensiveTouchdowns    *int `json:"defensive_touchdowns,omitempty" db:"defensive_touchdowns"`
+	Tackles                *int     `json:"tackles,omitempty" db:"tackles"`
+	SoloTackles            *int     `json:"solo_tackles,omitempty" db:"solo_tackles"`
+	AssistedTackles        *int     `json:"assisted_tackles,omitempty" db:"assisted_tackles"`
+	Sacks                  *float64 `json:"sacks,omitempty" db:"sacks"` // half sacks are credited as 0.5
+	DefensiveInterceptions *int     `json:"defensive_interceptions,omitempty" db:"defensive_interceptions"`
+	PassDeflections        *int     `json:"pass_deflections,omitempty" db:"pass_deflections"`
+	ForcedFumbles          *int     `json:"forced_fumbles,omitempty" db:"forced_fumbles"`
+	FumbleRecoveries       *int     `json:"fumble_recoveries,omitempty" db:"fumble_recoveries"`
+	DefensiveTouchdowns    *int     `json:"defensive_touchdowns,omitempty" db:"defensive_touchdowns"`
 	// Special teams
-	FieldGoalsAttempted  *int      `json:"field_goals_attempted,omitempty" db:"field_goals_attempted"`
-	FieldGoalsMade       *int      `json:"field_goals_made,omitempty" db:"field_goals_made"`
-	ExtraPointsAttempted *int      `json:"extra_points_attempted,omitempty" db:"extra_points_attempted"`
-	ExtraPointsMade      *int      `json:"extra_points_made,omitempty" db:"extra_points_made"`
-	Punts                *int      `json:"punts,omitempty" db:"punts"`
-	PuntYards            *int      `json:"punt_yards,omitempty" db:"punt_yards"`
-	KickReturns          *int      `json:"kick_returns,omitempty" db:"kick_returns"`
-	KickReturnYards      *int      `json:"kick_return_yards,omitempty" db:"kick_return_yards"`
-	KickReturnTouchdowns *int      `json:"kick_return_touchdowns,omitempty" db:"kick_return_touchdowns"`
-	PuntReturns          *int      `json:"punt_returns,omitempty" db:"punt_returns"`
-	PuntReturnYards      *int      `json:"punt_return_yards,omitempty" db:"punt_return_yards"`
-	PuntReturnTouchdowns *int      `json:"punt_return_touchdowns,omitempty" db:"punt_return_touchdowns"`
-	CreatedAt            time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt            time.Time `json:"updated_at" db:"updated_at"`
+	FieldGoalsAttempted  *int `json:"field_goals_attempted,omitempty" db:"field_goals_attempted"`
+	FieldGoalsMade       *int `json:"field_goals_made,omitempty" db:"field_goals_made"`
+	ExtraPointsAttempted *int `json:"extra_points_attempted,omitempty" db:"extra_points_attempted"`
+	ExtraPointsMade      *int `json:"extra_points_made,omitempty" db:"extra_points_made"`
+	Punts                *int `json:"punts,omitempty" db:"punts"`
+	PuntYards            *int `json:"punt_yards,omitempty" db:"punt_yards"`
+	KickReturns          *int `json:"kick_returns,omitempty" db:"kick_returns"`
+	KickReturnYards      *int `json:"kick_return_yards,omitempty" db:"kick_return_yards"`
+	KickReturnTouchdowns *int `json:"kick_return_touchdowns,omitempty" db:"kick_return_touchdowns"`
+	PuntReturns          *int `json:"punt_returns,omitempty" db:"punt_returns"`
+	PuntReturnYards      *int `json:"punt_return_yards,omitempty" db:"punt_return_yards"`
+	PuntReturnTouchdowns *int `json:"punt_return_touchdowns,omitempty" db:"punt_return_touchdowns"`
+	// Lines saved with an override despite failing the position's stat profile
+	Flagged    bool        `json:"flagged" db:"flagged"`
+	FlagReason *string     `json:"flag_reason,omitempty" db:"flag_reason"`
+	Sources    StatSources `json:"sources,omitempty" db:"stat_sources"`
+	CreatedAt  time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at" db:"updated_at"`
 }
 
 // Request/Response structs for Players
@@ -74,6 +93,12 @@ type CreatePlayerRequest struct {
 	JerseyNumber *int   `json:"jersey_number,omitempty"`
 	Height       *int   `json:"height,omitempty"`
 	Weight       *int   `json:"weight,omitempty"`
+	// Biographical and career metadata
+	BirthDate       *string           `json:"birth_date,omitempty"`
+	College         *string           `json:"college,omitempty"`
+	YearsExperience *int              `json:"years_experience,omitempty"`
+	HeadshotURL     *string           `json:"headshot_url,omitempty"`
+	ExternalIDs     map[string]string `json:"external_ids,omitempty"` // provider -> ID
 }
 
 type UpdatePlayerRequest struct {
@@ -83,83 +108,146 @@ type UpdatePlayerRequest struct {
 	JerseyNumber *int    `json:"jersey_number,omitempty"`
 	Height       *int    `json:"height,omitempty"`
 	Weight       *int    `json:"weight,omitempty"`
+	// Biographical and career metadata
+	BirthDate       *string `json:"birth_date,omitempty"`
+	College         *string `json:"college,omitempty"`
+	YearsExperience *int    `json:"years_experience,omitempty"`
+	HeadshotURL     *string `json:"headshot_url,omitempty"`
+}
+
+// PlayerBioBackfill carries biographical fields for one existing player
+type PlayerBioBackfill struct {
+	PlayerID        int     `json:"player_id" validate:"required"`
+	BirthDate       *string `json:"birth_date,omitempty"`
+	College         *string `json:"college,omitempty"`
+	YearsExperience *int    `json:"years_experience,omitempty"`
+	HeadshotURL     *string `json:"headshot_url,omitempty"`
+}
+
+// PlayerBioBackfillChange lists the fields that were filled in for a player
+type PlayerBioBackfillChange struct {
+	PlayerID int      `json:"player_id"`
+	Fields   []string `json:"fields"`
+}
+
+// PlayerBioBackfillResult summarizes a biographical backfill
+type PlayerBioBackfillResult struct {
+	Updated   int                        `json:"updated"`
+	Unchanged int                        `json:"unchanged"`
+	Changes   []*PlayerBioBackfillChange `json:"changes"`
 }
 
 // Request/Response structs for PlayerStats
 type CreatePlayerStatsRequest struct {
-	PlayerID               int  `json:"player_id" validate:"required"`
-	GameID                 int  `json:"game_id" validate:"required"`
-	PassingAttempts        *int `json:"passing_attempts,omitempty"`
-	PassingCompletions     *int `json:"passing_completions,omitempty"`
-	PassingYards           *int `json:"passing_yards,omitempty"`
-	PassingTouchdowns      *int `json:"passing_touchdowns,omitempty"`
-	PassingInterceptions   *int `json:"passing_interceptions,omitempty"`
-	RushingAttempts        *int `json:"rushing_attempts,omitempty"`
-	RushingYards           *int `json:"rushing_yards,omitempty"`
-	RushingTouchdowns      *int `json:"rushing_touchdowns,omitempty"`
-	ReceivingTargets       *int `json:"receiving_targets,omitempty"`
-	Receptions             *int `json:"receptions,omitempty"`
-	ReceivingYards         *int `json:"receiving_yards,omitempty"`
-	ReceivingTouchdowns    *int `json:"receiving_touchdowns,omitempty"`
-	Fumbles                *int `json:"fumbles,omitempty"`
-	FumblesLost            *int `json:"fumbles_lost,omitempty"`
-	Tackles                *int `json:"tackles,omitempty"`
-	SoloTackles            *int `json:"solo_tackles,omitempty"`
-	AssistedTackles        *int `json:"assisted_tackles,omitempty"`
-	Sacks                  *int `json:"sacks,omitempty"`
-	DefensiveInterceptions *int `json:"defensive_interceptions,omitempty"`
-	PassDeflections        *int `json:"pass_deflections,omitempty"`
-	ForcedFumbles          *int `json:"forced_fumbles,omitempty"`
-	FumbleRecoveries       *int `json:"fumble_recoveries,omitempty"`
-	DefensiveTouchdowns    *int `json:"defensive_touchdowns,omitempty"`
-	FieldGoalsAttempted    *int `json:"field_goals_attempted,omitempty"`
-	FieldGoalsMade         *int `json:"field_goals_made,omitempty"`
-	ExtraPointsAttempted   *int `json:"extra_points_attempted,omitempty"`
-	ExtraPointsMade        *int `json:"extra_points_made,omitempty"`
-	Punts                  *int `json:"punts,omitempty"`
-	PuntYards              *int `json:"punt_yards,omitempty"`
-	KickReturns            *int `json:"kick_returns,omitempty"`
-	KickReturnYards        *int `json:"kick_return_yards,omitempty"`
-	KickReturnTouchdowns   *int `json:"kick_return_touchdowns,omitempty"`
-	PuntReturns            *int `json:"punt_returns,omitempty"`
-	PuntReturnYards        *int `json:"punt_return_yards,omitempty"`
-	PuntReturnTouchdowns   *int `json:"punt_return_touchdowns,omitempty"`
+	PlayerID               int      `json:"player_id" validate:"required"`
+	GameID                 int      `json:"game_id" validate:"required"`
+	PassingAttempts        *int     `json:"passing_attempts,omitempty"`
+	PassingCompletions     *int     `json:"passing_completions,omitempty"`
+	PassingYards           *int     `json:"passing_yards,omitempty"`
+	PassingTouchdowns      *int     `json:"passing_touchdowns,omitempty"`
+	PassingInterceptions   *int     `json:"passing_interceptions,omitempty"`
+	RushingAttempts        *int     `json:"rushing_attempts,omitempty"`
+	RushingYards           *int     `json:"rushing_yards,omitempty"`
+	RushingTouchdowns      *int     `json:"rushing_touchdowns,omitempty"`
+	ReceivingTargets       *int     `json:"receiving_targets,omitempty"`
+	Receptions             *int     `json:"receptions,omitempty"`
+	ReceivingYards         *int     `json:"receiving_yards,omitempty"`
+	ReceivingTouchdowns    *int     `json:"receiving_touchdowns,omitempty"`
+	Fumbles                *int     `json:"fumbles,omitempty"`
+	FumblesLost            *int     `json:"fumbles_lost,omitempty"`
+	Tackles                *int     `json:"tackles,omitempty"`
+	SoloTackles            *int     `json:"solo_tackles,omitempty"`
+	AssistedTackles        *int     `json:"assisted_tackles,omitempty"`
+	Sacks                  *float64 `json:"sacks,omitempty"`
+	DefensiveInterceptions *int     `json:"defensive_interceptions,omitempty"`
+	PassDeflections        *int     `json:"pass_deflections,omitempty"`
+	ForcedFumbles          *int     `json:"forced_fumbles,omitempty"`
+	FumbleRecoveries       *int     `json:"fumble_recoveries,omitempty"`
+	DefensiveTouchdowns    *int     `json:"defensive_touchdowns,omitempty"`
+	FieldGoalsAttempted    *int     `json:"field_goals_attempted,omitempty"`
+	FieldGoalsMade         *int     `json:"field_goals_made,omitempty"`
+	ExtraPointsAttempted   *int     `json:"extra_points_attempted,omitempty"`
+	ExtraPointsMade        *int     `json:"extra_points_made,omitempty"`
+	Punts                  *int     `json:"punts,omitempty"`
+	PuntYards              *int     `json:"punt_yards,omitempty"`
+	KickReturns            *int     `json:"kick_returns,omitempty"`
+	KickReturnYards        *int     `json:"kick_return_yards,omitempty"`
+	KickReturnTouchdowns   *int     `json:"kick_return_touchdowns,omitempty"`
+	PuntReturns            *int     `json:"punt_returns,omitempty"`
+	PuntReturnYards        *int     `json:"punt_return_yards,omitempty"`
+	PuntReturnTouchdowns   *int     `json:"punt_return_touchdowns,omitempty"`
+	// Override saves a line that fails the position's stat profile and flags it for review
+	Override bool `json:"override,omitempty"`
+	// SkipTeamCheck saves a line although the player's team is not playing in the game, for a
+	// player who has changed teams since
+	SkipTeamCheck bool `json:"skip_team_check,omitempty"`
 }
 
 type UpdatePlayerStatsRequest struct {
-	PassingAttempts        *int `json:"passing_attempts,omitempty"`
-	PassingCompletions     *int `json:"passing_completions,omitempty"`
-	PassingYards           *int `json:"passing_yards,omitempty"`
-	PassingTouchdowns      *int `json:"passing_touchdowns,omitempty"`
-	PassingInterceptions   *int `json:"passing_interceptions,omitempty"`
-	RushingAttempts        *int `json:"rushing_attempts,omitempty"`
-	RushingYards           *int `json:"rushing_yards,omitempty"`
-	RushingTouchdowns      *int `json:"rushing_touchdowns,omitempty"`
-	ReceivingTargets       *int `json:"receiving_targets,omitempty"`
-	Receptions             *int `json:"receptions,omitempty"`
-	ReceivingYards         *int `json:"receiving_yards,omitempty"`
-	ReceivingTouchdowns    *int `json:"receiving_touchdowns,omitempty"`
-	Fumbles                *int `json:"fumbles,omitempty"`
-	FumblesLost            *int `json:"fumbles_lost,omitempty"`
-	Tackles                *int `json:"tackles,omitempty"`
-	SoloTackles            *int `json:"solo_tackles,omitempty"`
-	AssistedTackles        *int `json:"assisted_tackles,omitempty"`
-	Sacks                  *int `json:"sacks,omitempty"`
-	DefensiveInterceptions *int `json:"defensive_interceptions,omitempty"`
-	PassDeflections        *int `json:"pass_deflections,omitempty"`
-	ForcedFumbles          *int `json:"forced_fumbles,omitempty"`
-	FumbleRecoveries       *int `json:"fumble_recoveries,omitempty"`
-	DefensiveTouchdowns    *int `json:"defensive_touchdowns,omitempty"`
-	FieldGoalsAttempted    *int `json:"field_goals_attempted,omitempty"`
-	FieldGoalsMade         *int `json:"field_goals_made,omitempty"`
-	ExtraPointsAttempted   *int `json:"extra_points_attempted,omitempty"`
-	ExtraPointsMade        *int `json:"extra_points_made,omitempty"`
-	Punts                  *int `json:"punts,omitempty"`
-	PuntYards              *int `json:"punt_yards,omitempty"`
-	KickReturns            *int `json:"kick_returns,omitempty"`
-	KickReturnYards        *int `json:"kick_return_yards,omitempty"`
-	KickReturnTouchdowns   *int `json:"kick_return_touchdowns,omitempty"`
-	PuntReturns            *int `json:"punt_returns,omitempty"`
-	PuntReturnYards        *int `json:"punt_return_yards,omitempty"`
-	PuntReturnTouchdowns   *int `json:"punt_return_touchdowns,omitempty"`
+	PassingAttempts        *int     `json:"passing_attempts,omitempty"`
+	PassingCompletions     *int     `json:"passing_completions,omitempty"`
+	PassingYards           *int     `json:"passing_yards,omitempty"`
+	PassingTouchdowns      *int     `json:"passing_touchdowns,omitempty"`
+	PassingInterceptions   *int     `json:"passing_interceptions,omitempty"`
+	RushingAttempts        *int     `json:"rushing_attempts,omitempty"`
+	RushingYards           *int     `json:"rushing_yards,omitempty"`
+	RushingTouchdowns      *int     `json:"rushing_touchdowns,omitempty"`
+	ReceivingTargets       *int     `json:"receiving_targets,omitempty"`
+	Receptions             *int     `json:"receptions,omitempty"`
+	ReceivingYards         *int     `json:"receiving_yards,omitempty"`
+	ReceivingTouchdowns    *int     `json:"receiving_touchdowns,omitempty"`
+	Fumbles                *int     `json:"fumbles,omitempty"`
+	FumblesLost            *int     `json:"fumbles_lost,omitempty"`
+	Tackles                *int     `json:"tackles,omitempty"`
+	SoloTackles            *int     `json:"solo_tackles,omitempty"`
+	AssistedTackles        *int     `json:"assisted_tackles,omitempty"`
+	Sacks                  *float64 `json:"sacks,omitempty"`
+	DefensiveInterceptions *int     `json:"defensive_interceptions,omitempty"`
+	PassDeflections        *int     `json:"pass_deflections,omitempty"`
+	ForcedFumbles          *int     `json:"forced_fumbles,omitempty"`
+	FumbleRecoveries       *int     `json:"fumble_recoveries,omitempty"`
+	DefensiveTouchdowns    *int     `json:"defensive_touchdowns,omitempty"`
+	FieldGoalsAttempted    *int     `json:"field_goals_attempted,omitempty"`
+	FieldGoalsMade         *int     `json:"field_goals_made,omitempty"`
+	ExtraPointsAttempted   *int     `json:"extra_points_attempted,omitempty"`
+	ExtraPointsMade        *int     `json:"extra_points_made,omitempty"`
+	Punts                  *int     `json:"punts,omitempty"`
+	PuntYards              *int     `json:"punt_yards,omitempty"`
+	KickReturns            *int     `json:"kick_returns,omitempty"`
+	KickReturnYards        *int     `json:"kick_return_yards,omitempty"`
+	KickReturnTouchdowns   *int     `json:"kick_return_touchdowns,omitempty"`
+	PuntReturns            *int     `json:"punt_returns,omitempty"`
+	PuntReturnYards        *int     `json:"punt_return_yards,omitempty"`
+	PuntReturnTouchdowns   *int     `json:"punt_return_touchdowns,omitempty"`
+	// Override saves a line that fails the position's stat profile and flags it for review
+	Override bool `json:"override,omitempty"`
+}
+
+// PlayerDuplicateGroup is a set of players that look like the same person
+type PlayerDuplicateGroup struct {
+	Name      string    `json:"name"`
+	BirthDate *string   `json:"birth_date,omitempty"`
+	Reason    string    `json:"reason"`
+	Players   []*Player `json:"players"`
+}
+
+// PlayerMergeResult summarizes what was re-pointed when a duplicate player was merged
+type PlayerMergeResult struct {
+	Player                 *Player `json:"player"`
+	MergedPlayerID         int     `json:"merged_player_id"`
+	StatsMoved             int     `json:"stats_moved"`
+	StatsDiscarded         int     `json:"stats_discarded"` // the kept player already had stats for the game
+	ProjectionsMoved       int     `json:"projections_moved"`
+	ProjectionsDiscarded   int     `json:"projections_discarded"` // the kept player already had the source's projection for the week
+	ADPMoved               int     `json:"adp_moved"`
+	ADPDiscarded           int     `json:"adp_discarded"` // the kept player already had the source's ADP for the season and format
+	SalariesMoved          int     `json:"salaries_moved"`
+	SalariesDiscarded      int     `json:"salaries_discarded"` // the kept player already had the site's salary for the week
+	ExternalIDsMoved       int     `json:"external_ids_moved"`
+	ExternalIDsDiscarded   int     `json:"external_ids_discarded"` // the kept player already had an ID from the provider
+	InjuryChangesMoved     int     `json:"injury_changes_moved"`
+	AdvancedStatsMoved     int     `json:"advanced_stats_moved"`
+	AdvancedStatsDiscarded int     `json:"advanced_stats_discarded"` // the kept player already had advanced stats for the game
+	NewsMoved              int     `json:"news_moved"`               // news items linked to the duplicate; those also linked to the kept player stay linked once
+	DraftPickMoved         bool    `json:"draft_pick_moved"`
 }
@@ -0,0 +1,72 @@
+package models
+
+import "strings"
+
+// Positions lists the canonical player position codes. The position groups OL, DL, LB and DB
+// are accepted alongside their subtypes for sources that don't distinguish them.
+var Positions = []string{
+	"QB", "RB", "FB", "WR", "TE",
+	"OL", "OT", "OG", "C",
+	"DL", "DE", "DT", "NT",
+	"LB", "ILB", "OLB", "MLB",
+	"DB", "CB", "S", "FS", "SS",
+	"K", "P", "LS",
+}
+
+// positionAliases maps spelled-out and alternative names to their canonical code
+var positionAliases = map[string]string{
+	"QUARTERBACK":        "QB",
+	"RUNNING BACK":       "RB",
+	"HALFBACK":           "RB",
+	"HB":                 "RB",
+	"TAILBACK":           "RB",
+	"FULLBACK":           "FB",
+	"WIDE RECEIVER":      "WR",
+	"RECEIVER":           "WR",
+	"TIGHT END":          "TE",
+	"OFFENSIVE LINE":     "OL",
+	"OFFENSIVE LINEMAN":  "OL",
+	"OFFENSIVE TACKLE":   "OT",
+	"T":                  "OT",
+	"TACKLE":             "OT",
+	"GUARD":              "OG",
+	"G":                  "OG",
+	"CENTER":             "C",
+	"DEFENSIVE LINE":     "DL",
+	"DEFENSIVE LINEMAN":  "DL",
+	"DEFENSIVE END":      "DE",
+	"DEFENSIVE TACKLE":   "DT",
+	"NOSE TACKLE":        "NT",
+	"NOSE GUARD":         "NT",
+	"NG":                 "NT",
+	"LINEBACKER":         "LB",
+	"INSIDE LINEBACKER":  "ILB",
+	"OUTSIDE LINEBACKER": "OLB",
+	"MIDDLE LINEBACKER":  "MLB",
+	"DEFENSIVE BACK":     "DB",
+	"CORNERBACK":         "CB",
+	"CORNER":             "CB",
+	"SAFETY":             "S",
+	"FREE SAFETY":        "FS",
+	"STRONG SAFETY":      "SS",
+	"KICKER":             "K",
+	"PLACEKICKER":        "K",
+	"PK":                 "K",
+	"PUNTER":             "P",
+	"LONG SNAPPER":       "LS",
+}
+
+// NormalizePosition maps a position code or common alias, in any case, to its canonical
+// code. It reports false when the position is not recognized.
+func NormalizePosition(position string) (string, bool) {
+	key := strings.Join(strings.Fields(strings.ToUpper(strings.ReplaceAll(position, ".", ""))), " ")
+	for _, code := range Positions {
+		if key == code {
+			return code, true
+		}
+	}
+	if code, ok := positionAliases[key]; ok {
+		return code, true
+	}
+	return "", false
+}
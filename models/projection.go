@@ -0,0 +1,96 @@
+package models
+
+import (
+	"time"
+)
+
+// Projection is one source's projected stat line and fantasy points for a player in a week
+type Projection struct {
+	ID       int                `json:"id" db:"id"`
+	PlayerID int                `json:"player_id" db:"player_id"`
+	Season   string             `json:"season" db:"season"`
+	Week     int                `json:"week" db:"week"`
+	Source   string             `json:"source" db:"source"`
+	Stats    map[string]float64 `json:"stats" db:"-"` // stored as a JSON object keyed by stat name in stats
+	Points   float64            `json:"points" db:"points"`
+	// The player's actual stat line for the week, once one has been recorded
+	StatsID      *int               `json:"stats_id,omitempty" db:"-"`
+	ActualStats  map[string]float64 `json:"actual_stats,omitempty" db:"-"`
+	ActualPoints *float64           `json:"actual_points,omitempty" db:"-"`
+	CreatedAt    time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at" db:"updated_at"`
+}
+
+// Request/Response structs for Projections
+type CreateProjectionRequest struct {
+	PlayerID int                `json:"player_id" validate:"required"`
+	Season   string             `json:"season" validate:"required"`
+	Week     int                `json:"week" validate:"required"`
+	Source   string             `json:"source" validate:"required"`
+	Stats    map[string]float64 `json:"stats,omitempty"`
+	// Computed from the stat line with the default scoring when omitted
+	Points *float64 `json:"points,omitempty"`
+}
+
+// ImportResult counts the records added and replaced by a bulk import
+type ImportResult struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped,omitempty"` // records that match nothing in the database, for imports that allow them
+}
+
+// ProjectionLeader is one row of a weekly projection leaderboard
+type ProjectionLeader struct {
+	Rank            int      `json:"rank"`
+	PlayerID        int      `json:"player_id"`
+	FirstName       string   `json:"first_name"`
+	LastName        string   `json:"last_name"`
+	Position        string   `json:"position"`
+	TeamID          int      `json:"team_id"`
+	Sources         int      `json:"sources"`
+	ProjectedPoints float64  `json:"projected_points"`
+	ActualPoints    *float64 `json:"actual_points,omitempty"`
+}
+
+// ProjectionLeadersResponse is the response body for GET /api/projections/season/{season}/week/{week}
+type ProjectionLeadersResponse struct {
+	Season   string `json:"season"`
+	Week     int    `json:"week"`
+	Source   string `json:"source,omitempty"` // empty for the average across sources
+	Position string `json:"position,omitempty"`
+	// Source weights when blending by past accuracy; absent for a plain average
+	Weights map[string]float64  `json:"weights,omitempty"`
+	Leaders []*ProjectionLeader `json:"leaders"`
+}
+
+// ScoredProjection is a projection whose week has been played, with the stat line it is measured against
+type ScoredProjection struct {
+	PlayerID int
+	Position string
+	Week     int
+	Source   string
+	Points   float64
+	StatsID  int
+}
+
+// ProjectionAccuracy summarizes how far a source's projected points were from the actual points,
+// overall or within one group
+type ProjectionAccuracy struct {
+	Source string  `json:"source"`
+	Group  string  `json:"group,omitempty"` // position, week or player ID when grouped
+	Count  int     `json:"count"`
+	MAE    float64 `json:"mae"`  // mean absolute error in fantasy points
+	Bias   float64 `json:"bias"` // mean projected minus actual; positive when the source projects too high
+	// Share of a blend weighted by inverse MAE, on the per-source totals only
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// ProjectionAccuracyReport is the response body for GET /api/projections/accuracy
+type ProjectionAccuracyReport struct {
+	Season   string                `json:"season"`
+	Week     int                   `json:"week,omitempty"`
+	Position string                `json:"position,omitempty"`
+	GroupBy  string                `json:"group_by,omitempty"`
+	Sources  []*ProjectionAccuracy `json:"sources"`
+	Groups   []*ProjectionAccuracy `json:"groups,omitempty"`
+}
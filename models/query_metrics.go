@@ -0,0 +1,50 @@
+package models
+
+// QueryMetrics reports repository query counts and durations since startup, the queries that
+// were slow, retried or cancelled at the query timeout, and the state of the connection pool
+type QueryMetrics struct {
+	Timeout            string           `json:"timeout"`              // "0s" when queries are not bounded
+	StreamTimeout      string           `json:"stream_timeout"`       // for streamed queries such as exports
+	SlowQueryThreshold string           `json:"slow_query_threshold"` // "0s" when slow queries are not logged
+	Queries            int64            `json:"queries"`
+	TimedOut           int64            `json:"timed_out"`
+	Slow               int64            `json:"slow"`
+	Retries            int              `json:"retries"`           // most retries of a query on transient errors
+	Retried            int64            `json:"retried"`           // retries made
+	RetriesExhausted   int64            `json:"retries_exhausted"` // queries still failing after every retry
+	TimedOutQueries    []*TimedOutQuery `json:"timed_out_queries"`
+	Statements         []*QueryStats    `json:"statements"` // by total time, slowest first
+	Pool               *PoolStats       `json:"pool"`
+}
+
+// PoolStats reports the database connection pool: its connections now, and since startup how
+// often queries waited for a free connection and how many connections the pool closed
+type PoolStats struct {
+	MaxOpen           int     `json:"max_open"` // 0 when unlimited
+	Open              int     `json:"open"`
+	InUse             int     `json:"in_use"`
+	Idle              int     `json:"idle"`
+	WaitCount         int64   `json:"wait_count"`
+	WaitMs            float64 `json:"wait_ms"`
+	MaxIdleClosed     int64   `json:"max_idle_closed"`
+	MaxIdleTimeClosed int64   `json:"max_idle_time_closed"`
+	MaxLifetimeClosed int64   `json:"max_lifetime_closed"`
+}
+
+// TimedOutQuery counts the timeouts of one query, identified by its SQL with whitespace collapsed
+type TimedOutQuery struct {
+	Query string `json:"query"`
+	Count int64  `json:"count"`
+}
+
+// QueryStats aggregates the runs of one query, identified by its SQL with whitespace collapsed.
+// Durations include reading the rows.
+type QueryStats struct {
+	Query    string  `json:"query"`
+	Count    int64   `json:"count"`
+	TotalMs  float64 `json:"total_ms"`
+	MeanMs   float64 `json:"mean_ms"`
+	MaxMs    float64 `json:"max_ms"`
+	Slow     int64   `json:"slow"`
+	TimedOut int64   `json:"timed_out"`
+}
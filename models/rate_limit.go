@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Rate limit classes: reads are GET and HEAD requests, writes are everything else
+const (
+	RateLimitRead  = "read"
+	RateLimitWrite = "write"
+)
+
+// RateLimitBucket is a client's remaining budget for one class of request
+type RateLimitBucket struct {
+	Limit     int `json:"limit"`     // requests per minute, and the largest burst
+	Remaining int `json:"remaining"` // requests that can be made right now
+}
+
+// ClientRateLimit is one client's current budgets
+type ClientRateLimit struct {
+	Client    string           `json:"client"` // IP address
+	Read      *RateLimitBucket `json:"read,omitempty"`
+	Write     *RateLimitBucket `json:"write,omitempty"`
+	Throttled int              `json:"throttled"` // requests refused since the client was last idle
+	LastSeen  time.Time        `json:"last_seen"`
+}
+
+// RateLimitReport lists the configured budgets and the clients seen in the last minute
+type RateLimitReport struct {
+	Enabled        bool               `json:"enabled"`
+	ReadPerMinute  int                `json:"read_per_minute"`  // 0 when reads are not limited
+	WritePerMinute int                `json:"write_per_minute"` // 0 when writes are not limited
+	Clients        []*ClientRateLimit `json:"clients"`
+}
@@ -0,0 +1,92 @@
+package models
+
+import "time"
+
+// TeamRating is a team's Elo rating entering or during a season, with its record that season
+type TeamRating struct {
+	Rank     int     `json:"rank"`
+	TeamID   int     `json:"team_id"`
+	TeamName string  `json:"team_name"`
+	TeamCity string  `json:"team_city"`
+	Rating   float64 `json:"rating"`
+	Games    int     `json:"games"` // rated games this season
+	Wins     int     `json:"wins"`
+	Losses   int     `json:"losses"`
+	Ties     int     `json:"ties"`
+}
+
+// TeamRatings ranks every team by rating for a season
+type TeamRatings struct {
+	Season  string        `json:"season"`
+	Ratings []*TeamRating `json:"ratings"`
+}
+
+// RatingChange is the change in a team's rating from one completed game
+type RatingChange struct {
+	GameID        int       `json:"game_id"`
+	Season        string    `json:"season"`
+	Week          int       `json:"week"`
+	GameDate      time.Time `json:"game_date"`
+	OpponentID    int       `json:"opponent_id"`
+	Home          bool      `json:"home"`
+	Score         int       `json:"score"`
+	OpponentScore int       `json:"opponent_score"`
+	// Rating before the game, after any preseason regression, and the win probability it implied
+	RatingBefore   float64 `json:"rating_before"`
+	WinProbability float64 `json:"win_probability"`
+	RatingAfter    float64 `json:"rating_after"`
+	Change         float64 `json:"change"`
+}
+
+// TeamRatingHistory is a team's rating after every completed game, oldest first
+type TeamRatingHistory struct {
+	TeamID  int             `json:"team_id"`
+	Rating  float64         `json:"rating"` // current rating
+	Changes []*RatingChange `json:"changes"`
+}
+
+// GamePrediction is the win probability the teams' current ratings imply for a game that has
+// not been played
+type GamePrediction struct {
+	GameID             int       `json:"game_id"`
+	Season             string    `json:"season"`
+	Week               int       `json:"week"`
+	GameDate           time.Time `json:"game_date"`
+	HomeTeamID         int       `json:"home_team_id"`
+	AwayTeamID         int       `json:"away_team_id"`
+	NeutralSite        bool      `json:"neutral_site"`
+	HomeRating         float64   `json:"home_rating"`
+	AwayRating         float64   `json:"away_rating"`
+	HomeWinProbability float64   `json:"home_win_probability"`
+	AwayWinProbability float64   `json:"away_win_probability"`
+}
+
+// MarketProbability is the home win probability implied by a game's latest betting line
+type MarketProbability struct {
+	Source             string    `json:"source"`
+	CapturedAt         time.Time `json:"captured_at"`
+	Spread             *float64  `json:"spread,omitempty"`
+	HomeMoneyline      *int      `json:"home_moneyline,omitempty"`
+	AwayMoneyline      *int      `json:"away_moneyline,omitempty"`
+	HomeWinProbability float64   `json:"home_win_probability"`
+}
+
+// WinProbability is a game's win probabilities from the teams' ratings, blended with the
+// betting market when a line has been recorded. Completed games use the ratings entering them.
+type WinProbability struct {
+	GameID                   int                `json:"game_id"`
+	Season                   string             `json:"season"`
+	Week                     int                `json:"week"`
+	GameDate                 time.Time          `json:"game_date"`
+	Status                   string             `json:"status"`
+	HomeTeamID               int                `json:"home_team_id"`
+	AwayTeamID               int                `json:"away_team_id"`
+	NeutralSite              bool               `json:"neutral_site"`
+	HomeRating               float64            `json:"home_rating"`
+	AwayRating               float64            `json:"away_rating"`
+	HomeFieldAdvantage       float64            `json:"home_field_advantage"` // rating points
+	RatingHomeWinProbability float64            `json:"rating_home_win_probability"`
+	Market                   *MarketProbability `json:"market,omitempty"`
+	HomeWinProbability       float64            `json:"home_win_probability"`
+	AwayWinProbability       float64            `json:"away_win_probability"`
+}
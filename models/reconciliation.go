@@ -0,0 +1,32 @@
+package models
+
+// GameReconciliation checks a game's stat lines against each other and against the final
+// score, for GET /api/games/{id}/reconciliation. Discrepancies are reported, never corrected.
+type GameReconciliation struct {
+	GameID       int                 `json:"game_id"`
+	Status       string              `json:"status"`
+	Consistent   bool                `json:"consistent"` // true when neither side has discrepancies
+	Home         *TeamReconciliation `json:"home"`
+	Away         *TeamReconciliation `json:"away"`
+	Unattributed []int               `json:"unattributed"` // players with stat lines whose team is neither side
+}
+
+// TeamReconciliation totals one side's stat lines. Passing totals are what the side's passers
+// threw and receiving totals what its receivers caught, so the two should match. The scoring
+// plays are compared with the final score once the game is completed.
+type TeamReconciliation struct {
+	TeamID              int      `json:"team_id"`
+	StatLines           int      `json:"stat_lines"`
+	PassingYards        int      `json:"passing_yards"`
+	ReceivingYards      int      `json:"receiving_yards"`
+	Completions         int      `json:"completions"`
+	Receptions          int      `json:"receptions"`
+	PassingTouchdowns   int      `json:"passing_touchdowns"`
+	ReceivingTouchdowns int      `json:"receiving_touchdowns"`
+	RushingYards        int      `json:"rushing_yards"`
+	Touchdowns          int      `json:"touchdowns"`            // rushing, receiving, return and defensive
+	ScoringPoints       int      `json:"scoring_points"`        // 6 a touchdown, 3 a field goal and 1 an extra point
+	Score               *int     `json:"score,omitempty"`       // final score, once recorded
+	Unexplained         *int     `json:"unexplained,omitempty"` // score less scoring points: two-point conversions and safeties
+	Discrepancies       []string `json:"discrepancies"`
+}
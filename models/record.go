@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// Record book scopes and the record stat that is not a stat line column
+const (
+	RecordScopeGame   = "game"
+	RecordScopeSeason = "season"
+	// RecordFantasyPoints is fantasy points under the default scoring
+	RecordFantasyPoints = "fantasy_points"
+)
+
+// RecordStats lists the stats the record book tracks, for single games and for seasons
+var RecordStats = []string{
+	"passing_yards", "passing_touchdowns", "rushing_yards", "rushing_touchdowns",
+	"receptions", "receiving_yards", "receiving_touchdowns",
+	"sacks", "defensive_interceptions", "field_goals_made", RecordFantasyPoints,
+}
+
+// Team records computed from game results
+const (
+	RecordLongestWinStreak = "longest_win_streak"
+	RecordMostPoints       = "most_points"
+)
+
+// StatRecord is the best single game or season for a stat. Only regular season games count.
+type StatRecord struct {
+	Stat       string     `json:"stat"`
+	Label      string     `json:"label"`
+	Value      float64    `json:"value"`
+	PlayerID   int        `json:"player_id"`
+	PlayerName string     `json:"player_name"`
+	Position   string     `json:"position"`
+	Season     string     `json:"season"`
+	GameID     *int       `json:"game_id,omitempty"` // single game records
+	Week       *int       `json:"week,omitempty"`
+	GameDate   *time.Time `json:"game_date,omitempty"`
+}
+
+// TeamRecord is a team's best streak or game across completed regular season and playoff games
+type TeamRecord struct {
+	Record    string    `json:"record"`
+	Label     string    `json:"label"`
+	Value     int       `json:"value"`
+	TeamID    int       `json:"team_id"`
+	TeamName  string    `json:"team_name"`
+	TeamCity  string    `json:"team_city"`
+	GameID    *int      `json:"game_id,omitempty"` // single game records
+	StartDate time.Time `json:"start_date"`        // first game of a streak, or the game
+	EndDate   time.Time `json:"end_date"`
+	Active    bool      `json:"active,omitempty"` // a streak still going
+}
+
+// RecordBook is the response body for GET /api/records
+type RecordBook struct {
+	SingleGame []*StatRecord `json:"single_game"`
+	Season     []*StatRecord `json:"season"`
+	Team       []*TeamRecord `json:"team"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// ScheduleChange records a game's kickoff being moved within its week, such as a game flexed
+// into prime time
+type ScheduleChange struct {
+	ID           int       `json:"id" db:"id"`
+	GameID       int       `json:"game_id" db:"game_id"`
+	Season       string    `json:"season" db:"-"` // the game's, like its week and teams
+	Week         int       `json:"week" db:"-"`
+	HomeTeamID   int       `json:"home_team_id" db:"-"`
+	AwayTeamID   int       `json:"away_team_id" db:"-"`
+	PreviousDate time.Time `json:"previous_date" db:"previous_date"`
+	NewDate      time.Time `json:"new_date" db:"new_date"`
+	Reason       *string   `json:"reason,omitempty" db:"reason"`
+	ChangedAt    time.Time `json:"changed_at" db:"changed_at"`
+}
+
+// RescheduleGameRequest moves a game's kickoff
+type RescheduleGameRequest struct {
+	GameDate time.Time `json:"game_date" validate:"required"`
+	Reason   string    `json:"reason,omitempty"`
+}
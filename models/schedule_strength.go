@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// DefensePointsAllowed is the fantasy points a team's defense has allowed to one position
+// over its completed games of a season
+type DefensePointsAllowed struct {
+	TeamID      int
+	TeamName    string
+	TeamCity    string
+	GamesPlayed int
+	Points      float64
+}
+
+// ScheduleStrength rates a team's remaining games of a season for one position by how many
+// fantasy points each opponent has allowed to that position per game
+type ScheduleStrength struct {
+	TeamID   int    `json:"team_id"`
+	Season   string `json:"season"`
+	Position string `json:"position"`
+	// Points allowed per game to the position, averaged over every defense that has played
+	LeagueAverage float64 `json:"league_average"`
+	// Averages over the remaining games whose opponents have played; absent when none have
+	AveragePointsAllowed *float64                `json:"average_points_allowed,omitempty"`
+	VsAverage            *float64                `json:"vs_average,omitempty"`
+	Games                []*ScheduleStrengthGame `json:"games"`
+}
+
+// ScheduleStrengthGame is one remaining game and how its opponent has defended the position
+type ScheduleStrengthGame struct {
+	GameID        int       `json:"game_id"`
+	Week          int       `json:"week"`
+	GameDate      time.Time `json:"game_date"`
+	Home          bool      `json:"home"`
+	OpponentID    int       `json:"opponent_id"`
+	OpponentName  string    `json:"opponent_name"`
+	OpponentCity  string    `json:"opponent_city"`
+	OpponentGames int       `json:"opponent_games"`
+	// Opponent's points allowed per game, its rank among defenses (1 allows the fewest,
+	// the toughest matchup) and the difference from the league average (positive is easier).
+	// Absent until the opponent has completed a game.
+	PointsAllowed *float64 `json:"points_allowed,omitempty"`
+	Rank          *int     `json:"rank,omitempty"`
+	VsAverage     *float64 `json:"vs_average,omitempty"`
+}
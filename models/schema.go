@@ -0,0 +1,32 @@
+package models
+
+// Schema describes the tables of the live database, for GET /api/meta/schema
+type Schema struct {
+	Tables []*SchemaTable `json:"tables"` // by name
+}
+
+// SchemaTable describes one table: its columns in order, the other tables its columns refer
+// to, and the sets of columns whose values must be unique together
+type SchemaTable struct {
+	Name        string              `json:"name"`
+	Columns     []*SchemaColumn     `json:"columns"`
+	ForeignKeys []*SchemaForeignKey `json:"foreign_keys"`
+	Unique      [][]string          `json:"unique"` // besides the primary key
+}
+
+// SchemaColumn describes one column of a table
+type SchemaColumn struct {
+	Name       string  `json:"name"`
+	Type       string  `json:"type"` // declared SQLite type, e.g. INTEGER, TEXT or DATETIME
+	Nullable   bool    `json:"nullable"`
+	Default    *string `json:"default,omitempty"` // SQL expression
+	PrimaryKey bool    `json:"primary_key"`
+}
+
+// SchemaForeignKey is a relationship from columns of a table to columns of another
+type SchemaForeignKey struct {
+	Columns    []string `json:"columns"`
+	Table      string   `json:"table"`
+	References []string `json:"references"`
+	OnDelete   string   `json:"on_delete"` // NO ACTION, CASCADE, SET NULL, ...
+}
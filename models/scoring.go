@@ -0,0 +1,42 @@
+package models
+
+// ScoringRule is one custom scoring expression; a stat line scores the sum of its rules
+type ScoringRule struct {
+	Name       string `json:"name,omitempty"`
+	Expression string `json:"expression"`
+}
+
+// ScoringRequest is the request body for POST /api/scoring/evaluate. The stat line is
+// either a recorded one by stats_id or given inline with the player's position.
+type ScoringRequest struct {
+	Rules    []ScoringRule      `json:"rules"`
+	StatsID  *int               `json:"stats_id,omitempty"`
+	Stats    map[string]float64 `json:"stats,omitempty"`
+	Position string             `json:"position,omitempty"`
+}
+
+// ScoringResult is the points a stat line scores under custom rules, with each rule's share
+type ScoringResult struct {
+	Points   float64              `json:"points"`
+	Position string               `json:"position,omitempty"`
+	Rules    []*ScoringRulePoints `json:"rules"`
+}
+
+// ScoringRulePoints is what one rule contributed
+type ScoringRulePoints struct {
+	Name       string  `json:"name,omitempty"`
+	Expression string  `json:"expression"`
+	Points     float64 `json:"points"`
+}
+
+// ScoringValidation is the response body for POST /api/scoring/validate
+type ScoringValidation struct {
+	Valid  bool                `json:"valid"`
+	Errors []*ScoringRuleError `json:"errors,omitempty"`
+}
+
+// ScoringRuleError is why a rule did not compile
+type ScoringRuleError struct {
+	Rule  int    `json:"rule"` // index in the request
+	Error string `json:"error"`
+}
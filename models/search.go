@@ -0,0 +1,22 @@
+package models
+
+// SearchResult represents a single match from the global search
+type SearchResult struct {
+	Type     string `json:"type"` // player, team
+	ID       int    `json:"id"`
+	Label    string `json:"label"`
+	Subtitle string `json:"subtitle,omitempty"`
+	Score    int    `json:"score"`
+}
+
+// SearchGroup holds the results of a single entity type
+type SearchGroup struct {
+	Type    string          `json:"type"`
+	Results []*SearchResult `json:"results"`
+}
+
+// SearchResponse is the response body for GET /api/search
+type SearchResponse struct {
+	Query  string         `json:"query"`
+	Groups []*SearchGroup `json:"groups"`
+}
@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+// SeasonStatNames lists the stats totalled in the player_season_stats rollup, in column order.
+// The names match the player_stats columns and JSON fields.
+var SeasonStatNames = StatNames(FootballStats)
+
+// PlayerSeasonStats is a player's stat totals for one season. Totals are kept up to date
+// in the player_season_stats table as stat lines are written.
+type PlayerSeasonStats struct {
+	PlayerID    int                `json:"player_id" db:"player_id"`
+	Season      string             `json:"season" db:"season"`
+	GamesPlayed int                `json:"games_played" db:"games_played"`
+	Totals      map[string]float64 `json:"totals" db:"-"`
+	PerGame     map[string]float64 `json:"per_game" db:"-"`
+	// Rank among the season's players for each stat the player recorded; 1 is the leader
+	Ranks     map[string]int `json:"ranks,omitempty" db:"-"`
+	UpdatedAt time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// PlayerCareer is a player's totals across every season, with per-season splits and the
+// next milestone for each stat they have recorded
+type PlayerCareer struct {
+	PlayerID    int                  `json:"player_id"`
+	Seasons     int                  `json:"seasons"`
+	GamesPlayed int                  `json:"games_played"`
+	Totals      map[string]float64   `json:"totals"`
+	PerGame     map[string]float64   `json:"per_game"`
+	Splits      []*PlayerSeasonStats `json:"splits"`
+	Milestones  []*CareerMilestone   `json:"milestones"`
+}
+
+// CareerMilestone is how far a player is from the next round-number career total in a stat
+type CareerMilestone struct {
+	Stat      string  `json:"stat"`
+	Milestone float64 `json:"milestone"`
+	Current   float64 `json:"current"`
+	Remaining float64 `json:"remaining"`
+}
+
+// SeasonStatLeader is one row of a season leaderboard for a single stat
+type SeasonStatLeader struct {
+	Rank        int     `json:"rank"`
+	PlayerID    int     `json:"player_id"`
+	FirstName   string  `json:"first_name"`
+	LastName    string  `json:"last_name"`
+	Position    string  `json:"position"`
+	TeamID      int     `json:"team_id"`
+	GamesPlayed int     `json:"games_played"`
+	Total       float64 `json:"total"`
+	PerGame     float64 `json:"per_game"`
+}
+
+// SeasonLeadersResponse is the response body for GET /api/season-stats/{season}/leaders
+type SeasonLeadersResponse struct {
+	Season   string              `json:"season"`
+	Stat     string              `json:"stat"`
+	Position string              `json:"position,omitempty"`
+	PerGame  bool                `json:"per_game"`
+	Leaders  []*SeasonStatLeader `json:"leaders"`
+}
+
+// SeasonStatsRebuildResult reports the outcome of rebuilding the season rollup
+type SeasonStatsRebuildResult struct {
+	Rows int `json:"rows"`
+}
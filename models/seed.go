@@ -0,0 +1,11 @@
+package models
+
+// SeedResult counts the sample records loaded by POST /api/admin/seed or the -seed flag
+type SeedResult struct {
+	Season         string `json:"season"`
+	Teams          int    `json:"teams"`
+	Players        int    `json:"players"`
+	Games          int    `json:"games"`
+	CompletedGames int    `json:"completed_games"` // games with final scores and box scores
+	StatLines      int    `json:"stat_lines"`
+}
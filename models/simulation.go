@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// SimulateSeasonRequest configures a season simulation
+type SimulateSeasonRequest struct {
+	Iterations int    `json:"iterations,omitempty"` // default 10000
+	Seed       *int64 `json:"seed,omitempty"`       // set for repeatable results
+}
+
+// SeasonSimulation is the outcome of playing out a season's remaining regular season games
+// many times
+type SeasonSimulation struct {
+	Season         string            `json:"season"`
+	Iterations     int               `json:"iterations"`
+	Seed           *int64            `json:"seed,omitempty"`
+	GamesPlayed    int               `json:"games_played"`
+	GamesRemaining int               `json:"games_remaining"`
+	SimulatedAt    time.Time         `json:"simulated_at"`
+	Cached         bool              `json:"cached"` // reused from an earlier run with the same games
+	Teams          []*TeamSimulation `json:"teams"`
+}
+
+// TeamSimulation is one team's results across the simulated seasons
+type TeamSimulation struct {
+	TeamID         int     `json:"team_id"`
+	TeamName       string  `json:"team_name"`
+	TeamCity       string  `json:"team_city"`
+	Conference     string  `json:"conference"`
+	Division       string  `json:"division"`
+	Rating         float64 `json:"rating"`
+	Wins           int     `json:"wins"` // record so far
+	Losses         int     `json:"losses"`
+	Ties           int     `json:"ties"`
+	ExpectedWins   float64 `json:"expected_wins"` // including the record so far
+	ExpectedLosses float64 `json:"expected_losses"`
+	// Share of simulations in which the team made the playoffs, won its division and was the
+	// conference's top seed
+	PlayoffProbability  float64 `json:"playoff_probability"`
+	DivisionProbability float64 `json:"division_probability"`
+	TopSeedProbability  float64 `json:"top_seed_probability"`
+	// SeedProbabilities[i] is the share of simulations in which the team was seed i+1
+	SeedProbabilities []float64 `json:"seed_probabilities"`
+}
@@ -0,0 +1,102 @@
+package models
+
+import "time"
+
+// SportFootball is the code of the sport every team defaulted to before sports were modelled
+const SportFootball = "football"
+
+// Stat value types
+const (
+	StatValueCount   = "count"
+	StatValueDecimal = "decimal"
+)
+
+// Sport is a sport the backend can hold teams and stat lines for
+type Sport struct {
+	Code      string            `json:"code" db:"code"`
+	Name      string            `json:"name" db:"name"`
+	Stats     []*StatDefinition `json:"stats,omitempty" db:"-"`
+	CreatedAt time.Time         `json:"created_at" db:"created_at"`
+}
+
+// StatDefinition describes one stat a sport records: how it is labelled and grouped, and which
+// values are valid for it
+type StatDefinition struct {
+	Name      string   `json:"name" db:"name"` // matches the stat's column and JSON field
+	Label     string   `json:"label" db:"label"`
+	Category  string   `json:"category" db:"category"`
+	ValueType string   `json:"value_type" db:"value_type"`
+	MinValue  *float64 `json:"min_value,omitempty" db:"min_value"` // nil when negative values of any size are allowed
+	Step      float64  `json:"step" db:"step"`                     // values must be a multiple of the step
+	// Fantasy points per unit under the default PPR scoring
+	DefaultPoints float64 `json:"default_points" db:"default_points"`
+}
+
+// FootballStats is the registry of football stats, in player_stats column order. The
+// stat_definitions rows, the season rollup columns and the default scoring are all built from it.
+var FootballStats = []*StatDefinition{
+	statDefinition("passing_attempts", "Passing Attempts", "passing", StatValueCount, 1, 0),
+	statDefinition("passing_completions", "Passing Completions", "passing", StatValueCount, 1, 0),
+	statDefinition("passing_yards", "Passing Yards", "passing", StatValueCount, 1, 0.04),
+	statDefinition("passing_touchdowns", "Passing Touchdowns", "passing", StatValueCount, 1, 4),
+	statDefinition("passing_interceptions", "Passing Interceptions", "passing", StatValueCount, 1, -2),
+	statDefinition("rushing_attempts", "Rushing Attempts", "rushing", StatValueCount, 1, 0),
+	statDefinition("rushing_yards", "Rushing Yards", "rushing", StatValueCount, 1, 0.1),
+	statDefinition("rushing_touchdowns", "Rushing Touchdowns", "rushing", StatValueCount, 1, 6),
+	statDefinition("receiving_targets", "Receiving Targets", "receiving", StatValueCount, 1, 0),
+	statDefinition("receptions", "Receptions", "receiving", StatValueCount, 1, 1),
+	statDefinition("receiving_yards", "Receiving Yards", "receiving", StatValueCount, 1, 0.1),
+	statDefinition("receiving_touchdowns", "Receiving Touchdowns", "receiving", StatValueCount, 1, 6),
+	statDefinition("fumbles", "Fumbles", "fumbles", StatValueCount, 1, 0),
+	statDefinition("fumbles_lost", "Fumbles Lost", "fumbles", StatValueCount, 1, -2),
+	statDefinition("tackles", "Tackles", "tackling", StatValueCount, 1, 0),
+	statDefinition("solo_tackles", "Solo Tackles", "tackling", StatValueCount, 1, 0),
+	statDefinition("assisted_tackles", "Assisted Tackles", "tackling", StatValueCount, 1, 0),
+	statDefinition("sacks", "Sacks", "defense", StatValueDecimal, 0.5, 0),
+	statDefinition("defensive_interceptions", "Defensive Interceptions", "defense", StatValueCount, 1, 0),
+	statDefinition("pass_deflections", "Pass Deflections", "defense", StatValueCount, 1, 0),
+	statDefinition("forced_fumbles", "Forced Fumbles", "defense", StatValueCount, 1, 0),
+	statDefinition("fumble_recoveries", "Fumble Recoveries", "defense", StatValueCount, 1, 0),
+	statDefinition("defensive_touchdowns", "Defensive Touchdowns", "defense", StatValueCount, 1, 0),
+	statDefinition("field_goals_attempted", "Field Goals Attempted", "kicking", StatValueCount, 1, 0),
+	statDefinition("field_goals_made", "Field Goals Made", "kicking", StatValueCount, 1, 3),
+	statDefinition("extra_points_attempted", "Extra Points Attempted", "kicking", StatValueCount, 1, 0),
+	statDefinition("extra_points_made", "Extra Points Made", "kicking", StatValueCount, 1, 1),
+	statDefinition("punts", "Punts", "punting", StatValueCount, 1, 0),
+	statDefinition("punt_yards", "Punt Yards", "punting", StatValueCount, 1, 0),
+	statDefinition("kick_returns", "Kick Returns", "returns", StatValueCount, 1, 0),
+	statDefinition("kick_return_yards", "Kick Return Yards", "returns", StatValueCount, 1, 0),
+	statDefinition("kick_return_touchdowns", "Kick Return Touchdowns", "returns", StatValueCount, 1, 6),
+	statDefinition("punt_returns", "Punt Returns", "returns", StatValueCount, 1, 0),
+	statDefinition("punt_return_yards", "Punt Return Yards", "returns", StatValueCount, 1, 0),
+	statDefinition("punt_return_touchdowns", "Punt Return Touchdowns", "returns", StatValueCount, 1, 6),
+}
+
+// statDefinition builds a registry entry for a stat that is never negative
+func statDefinition(name, label, category, valueType string, step, defaultPoints float64) *StatDefinition {
+	minValue := 0.0
+	return &StatDefinition{
+		Name:          name,
+		Label:         label,
+		Category:      category,
+		ValueType:     valueType,
+		MinValue:      &minValue,
+		Step:          step,
+		DefaultPoints: defaultPoints,
+	}
+}
+
+// StatNames lists the names of the given stat definitions in order
+func StatNames(definitions []*StatDefinition) []string {
+	names := make([]string, len(definitions))
+	for i, definition := range definitions {
+		names[i] = definition.Name
+	}
+	return names
+}
+
+// StatMetadataResponse is the response body for GET /api/meta/stats
+type StatMetadataResponse struct {
+	Sport string            `json:"sport"`
+	Stats []*StatDefinition `json:"stats"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// StatProfile describes which stat groups are plausible for a position, with optional
+// hard caps on individual stats for a single game
+type StatProfile struct {
+	Groups []string       `json:"groups"`
+	Caps   map[string]int `json:"caps,omitempty"` // stat name -> maximum per game
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// StatSourceManual is the source of stats written through the API
+	StatSourceManual = "manual"
+	// StatSourceNflverse is the source of stats loaded from nflverse files
+	StatSourceNflverse = "nflverse"
+)
+
+// StatSources records the source that wrote each stat of a line, by stat field name: manual,
+// nflverse or a live provider. Stats written before sources were recorded have none. It is
+// stored as a JSON object.
+type StatSources map[string]string
+
+// Value stores the sources as JSON, NULL when there are none
+func (s StatSources) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(map[string]string(s))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan reads sources stored by Value
+func (s *StatSources) Scan(src interface{}) error {
+	var data []byte
+	switch value := src.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case string:
+		data = []byte(value)
+	case []byte:
+		data = value
+	default:
+		return fmt.Errorf("cannot scan %T into stat sources", src)
+	}
+	return json.Unmarshal(data, (*map[string]string)(s))
+}
+
+// StatConflict is a stat value a source reported that was not written because a source ranked
+// above it had set the stat, such as a live feed disagreeing with a manual correction
+type StatConflict struct {
+	ID             int       `json:"id" db:"id"`
+	StatsID        int       `json:"stats_id" db:"stats_id"`
+	PlayerID       int       `json:"player_id" db:"-"` // the stat line's, like its game
+	GameID         int       `json:"game_id" db:"-"`
+	Stat           string    `json:"stat" db:"stat"`
+	KeptValue      float64   `json:"kept_value" db:"kept_value"`
+	KeptSource     string    `json:"kept_source" db:"kept_source"`
+	RejectedValue  float64   `json:"rejected_value" db:"rejected_value"`
+	RejectedSource string    `json:"rejected_source" db:"rejected_source"`
+	DetectedAt     time.Time `json:"detected_at" db:"detected_at"` // last time the source reported the value
+}
+
+// StatConflictReport lists open conflicts under the precedence that decided them, for
+// GET /api/stat-conflicts
+type StatConflictReport struct {
+	Precedence [][]string      `json:"precedence"` // tiers of sources, highest first
+	Conflicts  []*StatConflict `json:"conflicts"`
+}
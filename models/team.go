@@ -6,36 +6,79 @@ import (
 
 // Team represents a football team
 type Team struct {
-	ID         int       `json:"id" db:"id"`
-	Name       string    `json:"name" db:"name"`
-	City       string    `json:"city" db:"city"`
-	Conference string    `json:"conference" db:"conference"`
-	Division   string    `json:"division" db:"division"`
-	CreatedAt  time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+	ID          int               `json:"id" db:"id"`
+	Name        string            `json:"name" db:"name"`
+	City        string            `json:"city" db:"city"`
+	Conference  string            `json:"conference" db:"conference"`
+	Division    string            `json:"division" db:"division"`
+	Sport       string            `json:"sport" db:"sport"`
+	LogoURL     *string           `json:"logo_url,omitempty" db:"logo_url"` // set when a logo is uploaded
+	ExternalIDs map[string]string `json:"external_ids,omitempty" db:"-"`    // provider -> ID
+	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at" db:"updated_at"`
 }
 
 // Game represents a football game/match
 type Game struct {
-	ID         int       `json:"id" db:"id"`
-	HomeTeamID int       `json:"home_team_id" db:"home_team_id"`
-	AwayTeamID int       `json:"away_team_id" db:"away_team_id"`
-	Season     string    `json:"season" db:"season"`
-	Week       int       `json:"week" db:"week"`
-	GameDate   time.Time `json:"game_date" db:"game_date"`
-	Status     string    `json:"status" db:"status"` // scheduled, in_progress, completed, cancelled
-	HomeScore  *int      `json:"home_score,omitempty" db:"home_score"`
-	AwayScore  *int      `json:"away_score,omitempty" db:"away_score"`
-	CreatedAt  time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+	ID          int               `json:"id" db:"id"`
+	HomeTeamID  int               `json:"home_team_id" db:"home_team_id"`
+	AwayTeamID  int               `json:"away_team_id" db:"away_team_id"`
+	Season      string            `json:"season" db:"season"`
+	Week        int               `json:"week" db:"week"`
+	GameType    string            `json:"game_type" db:"game_type"` // preseason, regular, wildcard, divisional, conference, superbowl
+	GameDate    time.Time         `json:"game_date" db:"game_date"`
+	Status      string            `json:"status" db:"status"` // scheduled, in_progress, completed, cancelled
+	HomeScore   *int              `json:"home_score,omitempty" db:"home_score"`
+	AwayScore   *int              `json:"away_score,omitempty" db:"away_score"`
+	VenueID     *int              `json:"venue_id,omitempty" db:"venue_id"`
+	NeutralSite bool              `json:"neutral_site" db:"neutral_site"` // neutral site or international game
+	Overtime    bool              `json:"overtime" db:"overtime"`
+	Periods     []PeriodScore     `json:"periods,omitempty" db:"-"` // stored as JSON in period_scores
+	Result      *GameResult       `json:"result,omitempty" db:"-"`  // set once the game is completed
+	Venue       *Venue            `json:"venue,omitempty" db:"-"`
+	LatestOdds  *GameOdds         `json:"latest_odds,omitempty" db:"-"`
+	ExternalIDs map[string]string `json:"external_ids,omitempty" db:"-"` // provider -> ID
+	// Related records, only set when requested with ?include=
+	HomeTeam    *Team          `json:"home_team,omitempty" db:"-"`
+	AwayTeam    *Team          `json:"away_team,omitempty" db:"-"`
+	PlayerStats []*PlayerStats `json:"player_stats,omitempty" db:"-"`
+	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// Game results from a team's point of view, for filtering its games
+const (
+	ResultWin  = "win"
+	ResultLoss = "loss"
+	ResultTie  = "tie"
+)
+
+// GameResults lists the results a team's games can be filtered by
+var GameResults = []string{ResultWin, ResultLoss, ResultTie}
+
+// GameResult is the outcome of a completed game. The winner and loser are unset for a tie.
+type GameResult struct {
+	WinnerTeamID *int `json:"winner_team_id,omitempty"`
+	LoserTeamID  *int `json:"loser_team_id,omitempty"`
+	Tie          bool `json:"tie"`
+}
+
+// PeriodScore is the points each team scored in one period of a game. Periods are numbered
+// from 1; those after regulation are overtime.
+type PeriodScore struct {
+	Period    int `json:"period"`
+	HomeScore int `json:"home_score"`
+	AwayScore int `json:"away_score"`
 }
 
 // Request/Response structs for Teams
 type CreateTeamRequest struct {
-	Name       string `json:"name" validate:"required"`
-	City       string `json:"city" validate:"required"`
-	Conference string `json:"conference" validate:"required"`
-	Division   string `json:"division" validate:"required"`
+	Name        string            `json:"name" validate:"required"`
+	City        string            `json:"city" validate:"required"`
+	Conference  string            `json:"conference" validate:"required"`
+	Division    string            `json:"division" validate:"required"`
+	Sport       string            `json:"sport,omitempty"`        // defaults to football
+	ExternalIDs map[string]string `json:"external_ids,omitempty"` // provider -> ID
 }
 
 type UpdateTeamRequest struct {
@@ -47,23 +90,34 @@ type UpdateTeamRequest struct {
 
 // Request/Response structs for Games
 type CreateGameRequest struct {
-	HomeTeamID int       `json:"home_team_id" validate:"required"`
-	AwayTeamID int       `json:"away_team_id" validate:"required"`
-	Season     string    `json:"season" validate:"required"`
-	Week       int       `json:"week" validate:"required,min=1,max=22"`
-	GameDate   time.Time `json:"game_date" validate:"required"`
-	Status     string    `json:"status,omitempty" validate:"omitempty,oneof=scheduled in_progress completed cancelled"`
-	HomeScore  *int      `json:"home_score,omitempty" validate:"omitempty,min=0"`
-	AwayScore  *int      `json:"away_score,omitempty" validate:"omitempty,min=0"`
+	HomeTeamID  int               `json:"home_team_id" validate:"required"`
+	AwayTeamID  int               `json:"away_team_id" validate:"required"`
+	Season      string            `json:"season" validate:"required"`
+	Week        int               `json:"week" validate:"required,min=1,max=22"`
+	GameType    string            `json:"game_type,omitempty"` // defaults to regular
+	GameDate    time.Time         `json:"game_date" validate:"required"`
+	Status      string            `json:"status,omitempty" validate:"omitempty,oneof=scheduled in_progress completed cancelled"`
+	HomeScore   *int              `json:"home_score,omitempty" validate:"omitempty,min=0"`
+	AwayScore   *int              `json:"away_score,omitempty" validate:"omitempty,min=0"`
+	VenueID     *int              `json:"venue_id,omitempty"`
+	NeutralSite bool              `json:"neutral_site,omitempty"`
+	Overtime    bool              `json:"overtime,omitempty"`
+	Periods     []PeriodScore     `json:"periods,omitempty"`
+	ExternalIDs map[string]string `json:"external_ids,omitempty"` // provider -> ID
 }
 
 type UpdateGameRequest struct {
-	HomeTeamID *int       `json:"home_team_id,omitempty"`
-	AwayTeamID *int       `json:"away_team_id,omitempty"`
-	Season     *string    `json:"season,omitempty"`
-	Week       *int       `json:"week,omitempty" validate:"omitempty,min=1,max=22"`
-	GameDate   *time.Time `json:"game_date,omitempty"`
-	Status     *string    `json:"status,omitempty" validate:"omitempty,oneof=scheduled in_progress completed cancelled"`
-	HomeScore  *int       `json:"home_score,omitempty" validate:"omitempty,min=0"`
-	AwayScore  *int       `json:"away_score,omitempty" validate:"omitempty,min=0"`
+	HomeTeamID  *int          `json:"home_team_id,omitempty"`
+	AwayTeamID  *int          `json:"away_team_id,omitempty"`
+	Season      *string       `json:"season,omitempty"`
+	Week        *int          `json:"week,omitempty" validate:"omitempty,min=1,max=22"`
+	GameType    *string       `json:"game_type,omitempty"`
+	GameDate    *time.Time    `json:"game_date,omitempty"`
+	Status      *string       `json:"status,omitempty" validate:"omitempty,oneof=scheduled in_progress completed cancelled"`
+	HomeScore   *int          `json:"home_score,omitempty" validate:"omitempty,min=0"`
+	AwayScore   *int          `json:"away_score,omitempty" validate:"omitempty,min=0"`
+	VenueID     *int          `json:"venue_id,omitempty"`
+	NeutralSite *bool         `json:"neutral_site,omitempty"`
+	Overtime    *bool         `json:"overtime,omitempty"`
+	Periods     []PeriodScore `json:"periods,omitempty"` // replaces every period; an empty list clears them
 }
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+// Venue represents a stadium where games are played
+type Venue struct {
+	ID        int       `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	City      string    `json:"city" db:"city"`
+	Country   string    `json:"country" db:"country"`
+	Surface   string    `json:"surface" db:"surface"`     // grass, turf, hybrid
+	RoofType  string    `json:"roof_type" db:"roof_type"` // open, dome, retractable
+	Capacity  *int      `json:"capacity,omitempty" db:"capacity"`
+	Timezone  string    `json:"timezone" db:"timezone"` // IANA name, e.g. America/Chicago
+	Latitude  *float64  `json:"latitude,omitempty" db:"latitude"`
+	Longitude *float64  `json:"longitude,omitempty" db:"longitude"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Request/Response structs for Venues
+type CreateVenueRequest struct {
+	Name      string   `json:"name" validate:"required"`
+	City      string   `json:"city" validate:"required"`
+	Country   string   `json:"country,omitempty"`
+	Surface   string   `json:"surface" validate:"required,oneof=grass turf hybrid"`
+	RoofType  string   `json:"roof_type" validate:"required,oneof=open dome retractable"`
+	Capacity  *int     `json:"capacity,omitempty" validate:"omitempty,min=1"`
+	Timezone  string   `json:"timezone" validate:"required"`
+	Latitude  *float64 `json:"latitude,omitempty" validate:"omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"omitempty,min=-180,max=180"`
+}
+
+type UpdateVenueRequest struct {
+	Name      *string  `json:"name,omitempty"`
+	City      *string  `json:"city,omitempty"`
+	Country   *string  `json:"country,omitempty"`
+	Surface   *string  `json:"surface,omitempty" validate:"omitempty,oneof=grass turf hybrid"`
+	RoofType  *string  `json:"roof_type,omitempty" validate:"omitempty,oneof=open dome retractable"`
+	Capacity  *int     `json:"capacity,omitempty" validate:"omitempty,min=1"`
+	Timezone  *string  `json:"timezone,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty" validate:"omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"omitempty,min=-180,max=180"`
+}
@@ -0,0 +1,25 @@
+// Package notify delivers notifications over pluggable channels: email through an SMTP server,
+// webhooks, and push through a push provider.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Message is a notification to deliver
+type Message struct {
+	EventType string          `json:"event_type"`
+	Subject   string          `json:"subject"`
+	Body      string          `json:"body"`
+	Data      json.RawMessage `json:"data,omitempty"` // the event's data, for webhooks
+}
+
+// Channel delivers messages to a target: an email address, a URL or a push topic, depending
+// on the channel
+type Channel interface {
+	// ValidateTarget checks a target before it is saved
+	ValidateTarget(target string) error
+	// Send delivers a message to target. It should stop when ctx is cancelled.
+	Send(ctx context.Context, target string, msg *Message) error
+}
@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ntfyTopic matches the topic names ntfy accepts
+var ntfyTopic = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// ntfyChannel sends push notifications through an ntfy server (https://ntfy.sh or self-hosted):
+// the target is the topic the recipient's devices subscribe to
+type ntfyChannel struct {
+	server string
+	token  string
+	client *http.Client
+}
+
+// NewNtfy creates a push channel that publishes to the ntfy server at serverURL, with an
+// access token for servers that require one
+func NewNtfy(serverURL, token string) (Channel, error) {
+	parsed, err := url.Parse(serverURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid ntfy server URL %q", serverURL)
+	}
+	return &ntfyChannel{
+		server: strings.TrimSuffix(serverURL, "/"),
+		token:  token,
+		client: &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+// ValidateTarget checks that target is a valid topic name
+func (c *ntfyChannel) ValidateTarget(target string) error {
+	if !ntfyTopic.MatchString(target) {
+		return fmt.Errorf("target must be a topic of up to 64 letters, digits, dashes or underscores")
+	}
+	return nil
+}
+
+// Send publishes the message to the target topic
+func (c *ntfyChannel) Send(ctx context.Context, target string, msg *Message) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.server+"/"+target, strings.NewReader(msg.Body))
+	if err != nil {
+		return fmt.Errorf("failed to create push request: %w", err)
+	}
+	req.Header.Set("Title", msg.Subject)
+	req.Header.Set("Tags", msg.EventType)
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send push notification: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("push server responded %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPConfig configures email delivery through an SMTP server
+type SMTPConfig struct {
+	Host     string
+	Port     int // default 587
+	Username string
+	Password string
+	From     string
+}
+
+// headerLineBreaks replaces the line breaks of a header value, which would end the header and
+// let the value add headers of its own
+var headerLineBreaks = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// smtpChannel sends email through an SMTP server, using STARTTLS when the server offers it
+type smtpChannel struct {
+	config SMTPConfig
+	from   *mail.Address
+}
+
+// NewSMTP creates an email channel
+func NewSMTP(config SMTPConfig) (Channel, error) {
+	if config.Host == "" {
+		return nil, fmt.Errorf("SMTP host is required")
+	}
+	if config.Port == 0 {
+		config.Port = 587
+	}
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address %q: %v", config.From, err)
+	}
+	return &smtpChannel{config: config, from: from}, nil
+}
+
+// ValidateTarget checks that target is a single email address
+func (c *smtpChannel) ValidateTarget(target string) error {
+	if _, err := mail.ParseAddress(target); err != nil {
+		return fmt.Errorf("target must be an email address")
+	}
+	return nil
+}
+
+// Send emails a plain text message to target
+func (c *smtpChannel) Send(ctx context.Context, target string, msg *Message) error {
+	to, err := mail.ParseAddress(target)
+	if err != nil {
+		return fmt.Errorf("invalid email address %q: %v", target, err)
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "From: %s\r\n", c.from.String())
+	fmt.Fprintf(&body, "To: %s\r\n", to.String())
+	fmt.Fprintf(&body, "Subject: %s\r\n", headerLineBreaks.Replace(msg.Subject))
+	fmt.Fprintf(&body, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	body.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	body.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	var auth smtp.Auth
+	if c.config.Username != "" {
+		auth = smtp.PlainAuth("", c.config.Username, c.config.Password, c.config.Host)
+	}
+
+	// net/smtp takes no context, so the send runs until it finishes or ctx is cancelled
+	addr := net.JoinHostPort(c.config.Host, fmt.Sprint(c.config.Port))
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(addr, auth, c.from.Address, []string{to.Address}, []byte(body.String()))
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to send email: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
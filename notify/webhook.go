@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// webhookTimeout bounds one webhook delivery
+const webhookTimeout = 10 * time.Second
+
+// webhookChannel POSTs each message as JSON to the target URL
+type webhookChannel struct {
+	client       *http.Client
+	allowPrivate bool
+}
+
+// NewWebhook creates a webhook channel. Unless allowPrivate is set, targets on loopback,
+// link-local and private addresses are refused, both when a subscription is validated and when
+// a delivery connects, so a subscription can't reach the server's own network.
+func NewWebhook(allowPrivate bool) Channel {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		// The check runs on the address each connection dials, after DNS and on every redirect.
+		// A proxy would be dialed in place of the target, so none is used.
+		transport.Proxy = nil
+		dialer := &net.Dialer{Timeout: webhookTimeout, Control: refusePrivateAddress}
+		transport.DialContext = dialer.DialContext
+	}
+	return &webhookChannel{
+		client:       &http.Client{Timeout: webhookTimeout, Transport: transport},
+		allowPrivate: allowPrivate,
+	}
+}
+
+// ValidateTarget checks that target is an absolute http or https URL, and unless private
+// addresses are allowed, that its host isn't localhost or a loopback, link-local or private IP
+func (c *webhookChannel) ValidateTarget(target string) error {
+	parsed, err := url.Parse(target)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("target must be an http or https URL")
+	}
+	if c.allowPrivate {
+		return nil
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("target must not be a local address")
+	}
+	if ip := net.ParseIP(host); ip != nil && isPrivateAddress(ip) {
+		return fmt.Errorf("target must not be a loopback, link-local or private address")
+	}
+	return nil
+}
+
+// refusePrivateAddress is a dialer Control function that fails connections to loopback,
+// link-local and private addresses
+func refusePrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || isPrivateAddress(ip) {
+		return fmt.Errorf("webhook address %s is not public", host)
+	}
+	return nil
+}
+
+// isPrivateAddress reports whether ip is unspecified, loopback, link-local or private
+func isPrivateAddress(ip net.IP) bool {
+	return ip.IsUnspecified() || ip.IsLoopback() || ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// Send posts the message to target. Any response other than 2xx fails the delivery.
+func (c *webhookChannel) Send(ctx context.Context, target string, msg *Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/adp_repository.go -pkg mocks . ADPRepository
+
+// ADPRepository defines the interface for average draft position data operations
+type ADPRepository interface {
+	GetByPlayerID(playerID int) ([]*models.ADP, error)
+	UpsertMany(entries []*models.ADP) (int, error)
+}
+
+// adpRepository implements ADPRepository interface
+type adpRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// adpColumns maps the adp columns to the ADP fields
+var adpColumns = newRowMapper[models.ADP]()
+
+// NewADPRepository creates a new ADP repository
+func NewADPRepository(db *TimeoutDB, clock clock.Clock) ADPRepository {
+	return &adpRepository{db: db, clock: clock}
+}
+
+// GetByPlayerID retrieves a player's ADP from every source, newest season first
+func (r *adpRepository) GetByPlayerID(playerID int) ([]*models.ADP, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM adp a
+		WHERE a.player_id = ?
+		ORDER BY a.season DESC, a.format ASC, a.source ASC
+	`, adpColumns.selectList("a"))
+
+	rows, err := r.db.Query(query, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ADP: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.ADP
+	for rows.Next() {
+		var entry models.ADP
+		if err := rows.Scan(adpColumns.targets(&entry)...); err != nil {
+			return nil, fmt.Errorf("failed to scan ADP: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ADP: %w", err)
+	}
+
+	return entries, nil
+}
+
+// UpsertMany creates each entry, or replaces the source's existing value for the player,
+// season and format, in a single transaction and returns how many were created
+func (r *adpRepository) UpsertMany(entries []*models.ADP) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	created := 0
+	for _, entry := range entries {
+		isNew, err := upsertADP(tx, entry, r.clock.Now())
+		if err != nil {
+			return 0, err
+		}
+		if isNew {
+			created++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit ADP: %w", err)
+	}
+
+	return created, nil
+}
+
+// upsertADP writes one entry inside the transaction
+func upsertADP(tx *sql.Tx, entry *models.ADP, currentTime time.Time) (bool, error) {
+	err := tx.QueryRow(
+		"SELECT id, created_at FROM adp WHERE player_id = ? AND season = ? AND format = ? AND source = ?",
+		entry.PlayerID, entry.Season, entry.Format, entry.Source,
+	).Scan(&entry.ID, &entry.CreatedAt)
+
+	if err == sql.ErrNoRows {
+		result, err := tx.Exec(`
+			INSERT INTO adp (player_id, season, format, source, adp, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, entry.PlayerID, entry.Season, entry.Format, entry.Source, entry.Value, currentTime, currentTime)
+		if err != nil {
+			return false, fmt.Errorf("failed to create ADP: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return false, fmt.Errorf("failed to get ADP ID: %w", err)
+		}
+
+		entry.ID = int(id)
+		entry.CreatedAt = currentTime
+		entry.UpdatedAt = currentTime
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check existing ADP: %w", err)
+	}
+
+	_, err = tx.Exec("UPDATE adp SET adp = ?, updated_at = ? WHERE id = ?", entry.Value, currentTime, entry.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update ADP: %w", err)
+	}
+
+	entry.UpdatedAt = currentTime
+	return false, nil
+}
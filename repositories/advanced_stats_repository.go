@@ -0,0 +1,266 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/advanced_stats_repository.go -pkg mocks . AdvancedStatsRepository
+
+// AdvancedStatsRepository defines the interface for advanced stats data access
+type AdvancedStatsRepository interface {
+	UpsertMany(stats []*models.AdvancedStats) (int, error)
+	GetByPlayerID(playerID int, season string) ([]*models.AdvancedStats, error)
+	GetByGameID(gameID int) ([]*models.AdvancedStats, error)
+	GetSeasons(season string, playerID int, position string) ([]*models.PlayerAdvancedSeason, error)
+}
+
+// advancedStatsRepository implements AdvancedStatsRepository interface
+type advancedStatsRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewAdvancedStatsRepository creates a new advanced stats repository
+func NewAdvancedStatsRepository(db *TimeoutDB, clock clock.Clock) AdvancedStatsRepository {
+	return &advancedStatsRepository{db: db, clock: clock}
+}
+
+// UpsertMany writes advanced stats rows in one transaction. A row for a player and game that
+// already has one keeps the metrics the new row leaves unset. It returns how many rows were new.
+func (r *advancedStatsRepository) UpsertMany(stats []*models.AdvancedStats) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	currentTime := r.clock.Now()
+	created := 0
+	for _, row := range stats {
+		isNew, err := upsertAdvancedStats(tx, row, currentTime)
+		if err != nil {
+			return 0, err
+		}
+		if isNew {
+			created++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit advanced stats: %w", err)
+	}
+
+	return created, nil
+}
+
+// upsertAdvancedStats writes one advanced stats row inside the transaction
+func upsertAdvancedStats(tx *sql.Tx, row *models.AdvancedStats, currentTime time.Time) (bool, error) {
+	err := tx.QueryRow(
+		"SELECT id, created_at FROM player_advanced_stats WHERE player_id = ? AND game_id = ?",
+		row.PlayerID, row.GameID,
+	).Scan(&row.ID, &row.CreatedAt)
+
+	if err == sql.ErrNoRows {
+		result, err := tx.Exec(`
+			INSERT INTO player_advanced_stats (
+				player_id, game_id, offense_snaps, defense_snaps, special_teams_snaps, offense_snap_share,
+				routes_run, air_yards, red_zone_touches, target_share, source, created_at, updated_at
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, row.PlayerID, row.GameID, row.OffenseSnaps, row.DefenseSnaps, row.SpecialTeamsSnaps, row.OffenseSnapShare,
+			row.RoutesRun, row.AirYards, row.RedZoneTouches, row.TargetShare, row.Source, currentTime, currentTime)
+		if err != nil {
+			return false, fmt.Errorf("failed to create advanced stats: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return false, fmt.Errorf("failed to get advanced stats ID: %w", err)
+		}
+
+		row.ID = int(id)
+		row.CreatedAt = currentTime
+		row.UpdatedAt = currentTime
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check existing advanced stats: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		UPDATE player_advanced_stats SET
+			offense_snaps = COALESCE(?, offense_snaps),
+			defense_snaps = COALESCE(?, defense_snaps),
+			special_teams_snaps = COALESCE(?, special_teams_snaps),
+			offense_snap_share = COALESCE(?, offense_snap_share),
+			routes_run = COALESCE(?, routes_run),
+			air_yards = COALESCE(?, air_yards),
+			red_zone_touches = COALESCE(?, red_zone_touches),
+			target_share = COALESCE(?, target_share),
+			source = ?, updated_at = ?
+		WHERE id = ?
+	`, row.OffenseSnaps, row.DefenseSnaps, row.SpecialTeamsSnaps, row.OffenseSnapShare,
+		row.RoutesRun, row.AirYards, row.RedZoneTouches, row.TargetShare, row.Source, currentTime, row.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update advanced stats: %w", err)
+	}
+
+	row.UpdatedAt = currentTime
+	return false, nil
+}
+
+// advancedStatsColumns maps the player_advanced_stats columns to the AdvancedStats fields
+var advancedStatsColumns = newRowMapper[models.AdvancedStats]()
+
+// GetByPlayerID retrieves a player's advanced stats in game order, optionally only those of
+// one season. Rows of deleted games are left out.
+func (r *advancedStatsRepository) GetByPlayerID(playerID int, season string) ([]*models.AdvancedStats, error) {
+	conditions := []string{"a.player_id = ?", "g.deleted_at IS NULL"}
+	args := []interface{}{playerID}
+	if season != "" {
+		conditions = append(conditions, "g.season = ?")
+		args = append(args, season)
+	}
+
+	query := `
+		SELECT ` + advancedStatsColumns.selectList("a") + `
+		FROM player_advanced_stats a
+		JOIN games g ON g.id = a.game_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY g.game_date ASC, g.id ASC
+	`
+	return r.query(query, args...)
+}
+
+// GetByGameID retrieves the advanced stats of a game's players
+func (r *advancedStatsRepository) GetByGameID(gameID int) ([]*models.AdvancedStats, error) {
+	query := `
+		SELECT ` + advancedStatsColumns.selectList("a") + `
+		FROM player_advanced_stats a
+		JOIN players p ON p.id = a.player_id
+		WHERE a.game_id = ?
+		ORDER BY p.team_id, p.last_name, p.first_name
+	`
+	return r.query(query, gameID)
+}
+
+// query runs an advanced stats query and scans its rows
+func (r *advancedStatsRepository) query(query string, args ...interface{}) ([]*models.AdvancedStats, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query advanced stats: %w", err)
+	}
+	defer rows.Close()
+
+	statsList := []*models.AdvancedStats{}
+	for rows.Next() {
+		var stats models.AdvancedStats
+		if err := rows.Scan(advancedStatsColumns.targets(&stats)...); err != nil {
+			return nil, fmt.Errorf("failed to scan advanced stats: %w", err)
+		}
+		statsList = append(statsList, &stats)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating advanced stats: %w", err)
+	}
+
+	return statsList, nil
+}
+
+// GetSeasons adds up each player's advanced stats over a season's games, optionally only for
+// one player or position. Each rate is worked out from the games that have both of its inputs,
+// so games an advanced source didn't cover don't dilute it.
+func (r *advancedStatsRepository) GetSeasons(season string, playerID int, position string) ([]*models.PlayerAdvancedSeason, error) {
+	conditions := []string{"g.season = ?", "g.deleted_at IS NULL", "p.deleted_at IS NULL"}
+	args := []interface{}{season}
+	if playerID > 0 {
+		conditions = append(conditions, "a.player_id = ?")
+		args = append(args, playerID)
+	}
+	if position != "" {
+		conditions = append(conditions, "p.position = ?")
+		args = append(args, position)
+	}
+
+	query := `
+		SELECT a.player_id, p.first_name, p.last_name, p.position, p.team_id, COUNT(*),
+		       SUM(a.offense_snaps), SUM(a.defense_snaps), SUM(a.special_teams_snaps), AVG(a.offense_snap_share),
+		       SUM(a.routes_run), SUM(a.air_yards), SUM(a.red_zone_touches), AVG(a.target_share),
+		       SUM(ps.receiving_targets), SUM(ps.receiving_yards),
+		       SUM(CASE WHEN a.air_yards IS NOT NULL THEN ps.receiving_targets END),
+		       SUM(CASE WHEN a.air_yards IS NOT NULL AND ps.receiving_targets IS NOT NULL THEN a.air_yards END),
+		       SUM(CASE WHEN ps.receiving_yards IS NOT NULL THEN a.routes_run END),
+		       SUM(CASE WHEN a.routes_run IS NOT NULL THEN ps.receiving_yards END),
+		       SUM(CASE WHEN ps.receiving_targets IS NOT NULL THEN a.routes_run END),
+		       SUM(CASE WHEN a.routes_run IS NOT NULL THEN ps.receiving_targets END)
+		FROM player_advanced_stats a
+		JOIN games g ON g.id = a.game_id
+		JOIN players p ON p.id = a.player_id
+		LEFT JOIN player_stats ps ON ps.player_id = a.player_id AND ps.game_id = a.game_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		GROUP BY a.player_id
+		ORDER BY p.last_name, p.first_name
+	`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query advanced season stats: %w", err)
+	}
+	defer rows.Close()
+
+	seasons := []*models.PlayerAdvancedSeason{}
+	for rows.Next() {
+		stats := models.PlayerAdvancedSeason{Season: season}
+		var depthTargets, depthAirYards, yardsRoutes, routeYards, targetRoutes, routeTargets sql.NullInt64
+		err := rows.Scan(
+			&stats.PlayerID, &stats.FirstName, &stats.LastName, &stats.Position, &stats.TeamID, &stats.Games,
+			&stats.OffenseSnaps, &stats.DefenseSnaps, &stats.SpecialTeamsSnaps, &stats.OffenseSnapShare,
+			&stats.RoutesRun, &stats.AirYards, &stats.RedZoneTouches, &stats.TargetShare,
+			&stats.Targets, &stats.ReceivingYards,
+			&depthTargets, &depthAirYards, &yardsRoutes, &routeYards, &targetRoutes, &routeTargets,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan advanced season stats: %w", err)
+		}
+
+		stats.OffenseSnapShare = roundedOrNil(stats.OffenseSnapShare, 3)
+		stats.TargetShare = roundedOrNil(stats.TargetShare, 3)
+		stats.AverageDepthOfTarget = ratio(depthAirYards, depthTargets)
+		stats.YardsPerRouteRun = ratio(routeYards, yardsRoutes)
+		stats.TargetsPerRouteRun = ratio(routeTargets, targetRoutes)
+		seasons = append(seasons, &stats)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating advanced season stats: %w", err)
+	}
+
+	return seasons, nil
+}
+
+// ratio divides two sums, rounded to 2 decimals, or nil when either is missing or the divisor
+// is 0
+func ratio(numerator, denominator sql.NullInt64) *float64 {
+	if !numerator.Valid || !denominator.Valid || denominator.Int64 == 0 {
+		return nil
+	}
+	value := float64(numerator.Int64) / float64(denominator.Int64)
+	return roundedOrNil(&value, 2)
+}
+
+// roundedOrNil rounds an optional value to a number of decimals
+func roundedOrNil(value *float64, decimals int) *float64 {
+	if value == nil {
+		return nil
+	}
+	scale := math.Pow(10, float64(decimals))
+	rounded := math.Round(*value*scale) / scale
+	return &rounded
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"fmt"
+
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/analytics_repository.go -pkg mocks . AnalyticsRepository
+
+// AnalyticsRepository defines the interface for usage analytics data operations
+type AnalyticsRepository interface {
+	IncrementMany(day string, counts map[string]int) error
+	GetSince(day string) ([]*models.UsageCount, error)
+}
+
+// analyticsRepository implements AnalyticsRepository interface
+type analyticsRepository struct {
+	db *TimeoutDB
+}
+
+// NewAnalyticsRepository creates a new analytics repository
+func NewAnalyticsRepository(db *TimeoutDB) AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+// IncrementMany adds the counts for each category on the given day in a single transaction
+func (r *analyticsRepository) IncrementMany(day string, counts map[string]int) error {
+	query := `
+		INSERT INTO usage_analytics (category, day, count)
+		VALUES (?, ?, ?)
+		ON CONFLICT (category, day) DO UPDATE SET count = count + excluded.count
+	`
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare usage increment: %w", err)
+	}
+	defer stmt.Close()
+
+	for category, count := range counts {
+		if _, err := stmt.Exec(category, day, count); err != nil {
+			return fmt.Errorf("failed to increment usage for %s: %w", category, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit usage counts: %w", err)
+	}
+
+	return nil
+}
+
+// GetSince retrieves the daily counts for every category from the given day onwards
+func (r *analyticsRepository) GetSince(day string) ([]*models.UsageCount, error) {
+	query := `
+		SELECT category, day, count
+		FROM usage_analytics
+		WHERE day >= ?
+		ORDER BY day ASC, category ASC
+	`
+
+	rows, err := r.db.Query(query, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query usage analytics: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []*models.UsageCount
+	for rows.Next() {
+		var count models.UsageCount
+		if err := rows.Scan(&count.Category, &count.Day, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan usage count: %w", err)
+		}
+		counts = append(counts, &count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating usage analytics: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/cached_player_repository.go -pkg mocks . CachedPlayerRepository
+
+// CachedPlayerRepository is a PlayerRepository that caches lookups by ID in memory
+type CachedPlayerRepository interface {
+	PlayerRepository
+	// ClearCache drops every cached lookup, for when the database changes underneath, such as
+	// on a restore
+	ClearCache()
+}
+
+// cachedPlayerRepository answers GetByID, Exists and ExistsMany from memory for players it has
+// already found, in the same way as cachedTeamRepository: writes through the repository drop
+// the player's entries and missing players are never cached.
+type cachedPlayerRepository struct {
+	PlayerRepository
+
+	players *lookupCache[models.Player]
+	exists  *lookupCache[bool]
+}
+
+// NewCachedPlayerRepository wraps a player repository with a lookup cache keeping entries for ttl
+func NewCachedPlayerRepository(inner PlayerRepository, ttl time.Duration, clock clock.Clock) CachedPlayerRepository {
+	return &cachedPlayerRepository{
+		PlayerRepository: inner,
+		players:          newLookupCache[models.Player](ttl, clock),
+		exists:           newLookupCache[bool](ttl, clock),
+	}
+}
+
+// GetByID returns a copy of the cached player, or reads and caches it
+func (r *cachedPlayerRepository) GetByID(id int) (*models.Player, error) {
+	if player, ok := r.players.get(id); ok {
+		return &player, nil
+	}
+
+	player, err := r.PlayerRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	r.players.set(id, *player)
+	r.exists.set(id, true)
+	return player, nil
+}
+
+// Exists reports whether a player exists, from the cache when it was found before
+func (r *cachedPlayerRepository) Exists(id int) (bool, error) {
+	if _, ok := r.exists.get(id); ok {
+		return true, nil
+	}
+
+	exists, err := r.PlayerRepository.Exists(id)
+	if err != nil || !exists {
+		return exists, err
+	}
+	r.exists.set(id, true)
+	return true, nil
+}
+
+// ExistsMany reports which of the players exist, querying only those not already cached
+func (r *cachedPlayerRepository) ExistsMany(ids []int) (map[int]bool, error) {
+	found := make(map[int]bool, len(ids))
+	var unknown []int
+	for _, id := range ids {
+		if _, ok := r.exists.get(id); ok {
+			found[id] = true
+		} else {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) == 0 {
+		return found, nil
+	}
+
+	queried, err := r.PlayerRepository.ExistsMany(unknown)
+	if err != nil {
+		return nil, err
+	}
+	for id := range queried {
+		found[id] = true
+		r.exists.set(id, true)
+	}
+	return found, nil
+}
+
+// Create creates the player, dropping anything cached under its new ID
+func (r *cachedPlayerRepository) Create(player *models.Player) error {
+	err := r.PlayerRepository.Create(player)
+	r.invalidate(player.ID)
+	return err
+}
+
+// Update updates the player and drops its cached entries
+func (r *cachedPlayerRepository) Update(player *models.Player) error {
+	err := r.PlayerRepository.Update(player)
+	r.invalidate(player.ID)
+	return err
+}
+
+// Delete soft deletes the player and drops its cached entries
+func (r *cachedPlayerRepository) Delete(id int) error {
+	err := r.PlayerRepository.Delete(id)
+	r.invalidate(id)
+	return err
+}
+
+// Restore restores the player and drops its cached entries
+func (r *cachedPlayerRepository) Restore(id int) error {
+	err := r.PlayerRepository.Restore(id)
+	r.invalidate(id)
+	return err
+}
+
+// Purge purges the player and drops its cached entries
+func (r *cachedPlayerRepository) Purge(id int) error {
+	err := r.PlayerRepository.Purge(id)
+	r.invalidate(id)
+	return err
+}
+
+// Merge merges the duplicate into the kept player and drops both players' cached entries
+func (r *cachedPlayerRepository) Merge(keepID, duplicateID int) (*models.PlayerMergeResult, error) {
+	result, err := r.PlayerRepository.Merge(keepID, duplicateID)
+	r.invalidate(keepID, duplicateID)
+	return result, err
+}
+
+// ApplyInjuryChanges writes the injury report changes and drops the changed players' cached
+// entries
+func (r *cachedPlayerRepository) ApplyInjuryChanges(changes []*models.InjuryChange) error {
+	err := r.PlayerRepository.ApplyInjuryChanges(changes)
+	ids := make([]int, len(changes))
+	for i, change := range changes {
+		ids[i] = change.PlayerID
+	}
+	r.invalidate(ids...)
+	return err
+}
+
+// ClearCache drops every cached player
+func (r *cachedPlayerRepository) ClearCache() {
+	r.players.clear()
+	r.exists.clear()
+}
+
+// invalidate drops the cached entries of players
+func (r *cachedPlayerRepository) invalidate(ids ...int) {
+	r.players.invalidate(ids...)
+	r.exists.invalidate(ids...)
+}
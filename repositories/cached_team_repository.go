@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/cached_team_repository.go -pkg mocks . CachedTeamRepository
+
+// CachedTeamRepository is a TeamRepository that caches lookups by ID in memory
+type CachedTeamRepository interface {
+	TeamRepository
+	// ClearCache drops every cached lookup, for when the database changes underneath, such as
+	// on a restore
+	ClearCache()
+}
+
+// cachedTeamRepository answers GetByID, Exists and ExistsMany from memory for teams it has
+// already found, since nearly every write checks that its teams exist and bulk ingestion checks
+// the same few teams over and over. Writes through the repository drop the team's entries. Missing teams are
+// never cached, so a team created by another process is seen at once; a change made by another
+// process shows within the TTL.
+type cachedTeamRepository struct {
+	TeamRepository
+
+	teams  *lookupCache[models.Team]
+	exists *lookupCache[bool]
+}
+
+// NewCachedTeamRepository wraps a team repository with a lookup cache keeping entries for ttl
+func NewCachedTeamRepository(inner TeamRepository, ttl time.Duration, clock clock.Clock) CachedTeamRepository {
+	return &cachedTeamRepository{
+		TeamRepository: inner,
+		teams:          newLookupCache[models.Team](ttl, clock),
+		exists:         newLookupCache[bool](ttl, clock),
+	}
+}
+
+// GetByID returns a copy of the cached team, or reads and caches it
+func (r *cachedTeamRepository) GetByID(id int) (*models.Team, error) {
+	if team, ok := r.teams.get(id); ok {
+		return &team, nil
+	}
+
+	team, err := r.TeamRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	r.teams.set(id, *team)
+	r.exists.set(id, true)
+	return team, nil
+}
+
+// Exists reports whether a team exists, from the cache when it was found before
+func (r *cachedTeamRepository) Exists(id int) (bool, error) {
+	if _, ok := r.exists.get(id); ok {
+		return true, nil
+	}
+
+	exists, err := r.TeamRepository.Exists(id)
+	if err != nil || !exists {
+		return exists, err
+	}
+	r.exists.set(id, true)
+	return true, nil
+}
+
+// ExistsMany reports which of the teams exist, querying only those not already cached
+func (r *cachedTeamRepository) ExistsMany(ids []int) (map[int]bool, error) {
+	found := make(map[int]bool, len(ids))
+	var unknown []int
+	for _, id := range ids {
+		if _, ok := r.exists.get(id); ok {
+			found[id] = true
+		} else {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) == 0 {
+		return found, nil
+	}
+
+	queried, err := r.TeamRepository.ExistsMany(unknown)
+	if err != nil {
+		return nil, err
+	}
+	for id := range queried {
+		found[id] = true
+		r.exists.set(id, true)
+	}
+	return found, nil
+}
+
+// Create creates the team, dropping anything cached under its new ID
+func (r *cachedTeamRepository) Create(team *models.Team) error {
+	err := r.TeamRepository.Create(team)
+	r.invalidate(team.ID)
+	return err
+}
+
+// Update updates the team and drops its cached entries
+func (r *cachedTeamRepository) Update(team *models.Team) error {
+	err := r.TeamRepository.Update(team)
+	r.invalidate(team.ID)
+	return err
+}
+
+// Delete soft deletes the team and drops its cached entries
+func (r *cachedTeamRepository) Delete(id int) error {
+	err := r.TeamRepository.Delete(id)
+	r.invalidate(id)
+	return err
+}
+
+// Restore restores the team and drops its cached entries
+func (r *cachedTeamRepository) Restore(id int) error {
+	err := r.TeamRepository.Restore(id)
+	r.invalidate(id)
+	return err
+}
+
+// Purge purges the team and drops its cached entries
+func (r *cachedTeamRepository) Purge(id int) error {
+	err := r.TeamRepository.Purge(id)
+	r.invalidate(id)
+	return err
+}
+
+// ClearCache drops every cached team
+func (r *cachedTeamRepository) ClearCache() {
+	r.teams.clear()
+	r.exists.clear()
+}
+
+// invalidate drops the cached entries of a team
+func (r *cachedTeamRepository) invalidate(id int) {
+	r.teams.invalidate(id)
+	r.exists.invalidate(id)
+}
@@ -0,0 +1,280 @@
+package repositories
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/coalescing_player_stats_repository.go -pkg mocks . CoalescingPlayerStatsRepository
+
+// CoalescingPlayerStatsRepository is a PlayerStatsRepository that buffers updates
+// and writes them to the database in batches
+type CoalescingPlayerStatsRepository interface {
+	PlayerStatsRepository
+	Flush() error
+	Discard() int
+	Close() error
+}
+
+// coalescingPlayerStatsRepository buffers stat updates for a short window so that
+// repeated updates to the same stat line (one per player per game) during live
+// ingestion collapse into a single write, and all pending lines are written in
+// one transaction. Reads by ID overlay pending updates so callers see their writes.
+type coalescingPlayerStatsRepository struct {
+	PlayerStatsRepository
+
+	window  time.Duration
+	clock   clock.Clock
+	flushMu sync.Mutex // held for a whole flush, so Discard can wait out one in progress
+	mu      sync.Mutex
+	pending map[int]*models.PlayerStats // keyed by stats ID
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewCoalescingPlayerStatsRepository wraps a player stats repository with write coalescing
+func NewCoalescingPlayerStatsRepository(inner PlayerStatsRepository, window time.Duration, clock clock.Clock) CoalescingPlayerStatsRepository {
+	r := &coalescingPlayerStatsRepository{
+		PlayerStatsRepository: inner,
+		window:                window,
+		clock:                 clock,
+		pending:               make(map[int]*models.PlayerStats),
+		stop:                  make(chan struct{}),
+		done:                  make(chan struct{}),
+	}
+
+	go r.run()
+	return r
+}
+
+// run flushes pending updates once per window until Close is called
+func (r *coalescingPlayerStatsRepository) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.Flush(); err != nil {
+				log.Printf("Failed to flush coalesced player stats: %v", err)
+			}
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// Update queues the stats to be written on the next flush
+func (r *coalescingPlayerStatsRepository) Update(stats *models.PlayerStats) error {
+	if stats.ID <= 0 {
+		return fmt.Errorf("player stats with ID %d not found", stats.ID)
+	}
+
+	queued := *stats
+	queued.UpdatedAt = r.clock.Now()
+
+	r.mu.Lock()
+	r.pending[stats.ID] = &queued
+	r.mu.Unlock()
+
+	stats.UpdatedAt = queued.UpdatedAt
+	return nil
+}
+
+// Flush writes all pending updates in a single transaction. The updates stay pending, and
+// visible to reads, until the transaction commits; on failure they are retried next flush.
+func (r *coalescingPlayerStatsRepository) Flush() error {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	r.mu.Lock()
+	batch := make([]*models.PlayerStats, 0, len(r.pending))
+	for _, stats := range r.pending {
+		batch = append(batch, stats)
+	}
+	r.mu.Unlock()
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := r.PlayerStatsRepository.UpdateMany(batch); err != nil {
+		return err
+	}
+
+	// Forget the written updates, keeping any newer update queued while the batch was written
+	r.mu.Lock()
+	for _, stats := range batch {
+		if r.pending[stats.ID] == stats {
+			delete(r.pending, stats.ID)
+		}
+	}
+	r.mu.Unlock()
+
+	return nil
+}
+
+// Discard drops every pending update without writing it, returning how many were dropped.
+// It is for when the database they were queued against has been replaced, as by restoring a
+// backup. A flush in progress finishes first.
+func (r *coalescingPlayerStatsRepository) Discard() int {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	dropped := len(r.pending)
+	r.pending = make(map[int]*models.PlayerStats)
+	return dropped
+}
+
+// Close stops the flush loop and writes any remaining updates
+func (r *coalescingPlayerStatsRepository) Close() error {
+	close(r.stop)
+	<-r.done
+	return r.Flush()
+}
+
+// Upsert writes the stat line at once, replacing any pending update to it
+func (r *coalescingPlayerStatsRepository) Upsert(stats *models.PlayerStats) (bool, error) {
+	created, err := r.PlayerStatsRepository.Upsert(stats)
+	if err != nil {
+		return false, err
+	}
+	r.dropPending([]*models.PlayerStats{stats})
+	return created, nil
+}
+
+// UpsertMany writes the stat lines at once, replacing any pending updates to them
+func (r *coalescingPlayerStatsRepository) UpsertMany(statsList []*models.PlayerStats) (int, error) {
+	created, err := r.PlayerStatsRepository.UpsertMany(statsList)
+	if err != nil {
+		return 0, err
+	}
+	r.dropPending(statsList)
+	return created, nil
+}
+
+// dropPending forgets the pending updates to stat lines that have just been written
+func (r *coalescingPlayerStatsRepository) dropPending(statsList []*models.PlayerStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stats := range statsList {
+		delete(r.pending, stats.ID)
+	}
+}
+
+// Delete drops any pending update for the stats before deleting them
+func (r *coalescingPlayerStatsRepository) Delete(id int) error {
+	r.mu.Lock()
+	delete(r.pending, id)
+	r.mu.Unlock()
+
+	return r.PlayerStatsRepository.Delete(id)
+}
+
+// GetByID retrieves player stats by ID, including any pending update
+func (r *coalescingPlayerStatsRepository) GetByID(id int) (*models.PlayerStats, error) {
+	stats, err := r.PlayerStatsRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.overlay(stats), nil
+}
+
+// GetAll retrieves all player stats, including pending updates
+func (r *coalescingPlayerStatsRepository) GetAll() ([]*models.PlayerStats, error) {
+	statsList, err := r.PlayerStatsRepository.GetAll()
+	return r.overlayList(statsList), err
+}
+
+// GetByPlayerID retrieves all stats for a player, including pending updates
+func (r *coalescingPlayerStatsRepository) GetByPlayerID(playerID int) ([]*models.PlayerStats, error) {
+	statsList, err := r.PlayerStatsRepository.GetByPlayerID(playerID)
+	return r.overlayList(statsList), err
+}
+
+// GetByGameID retrieves all stats for a game, including pending updates
+func (r *coalescingPlayerStatsRepository) GetByGameID(gameID int) ([]*models.PlayerStats, error) {
+	statsList, err := r.PlayerStatsRepository.GetByGameID(gameID)
+	return r.overlayList(statsList), err
+}
+
+// GetByPlayerIDs retrieves all stats for each player, including pending updates
+func (r *coalescingPlayerStatsRepository) GetByPlayerIDs(playerIDs []int) (map[int][]*models.PlayerStats, error) {
+	grouped, err := r.PlayerStatsRepository.GetByPlayerIDs(playerIDs)
+	for _, statsList := range grouped {
+		r.overlayList(statsList)
+	}
+	return grouped, err
+}
+
+// GetByGameIDs retrieves all stats for each game, including pending updates
+func (r *coalescingPlayerStatsRepository) GetByGameIDs(gameIDs []int) (map[int][]*models.PlayerStats, error) {
+	grouped, err := r.PlayerStatsRepository.GetByGameIDs(gameIDs)
+	for _, statsList := range grouped {
+		r.overlayList(statsList)
+	}
+	return grouped, err
+}
+
+// GetPage retrieves a page of stats, including pending updates
+func (r *coalescingPlayerStatsRepository) GetPage(after *models.PageCursor, limit int) ([]*models.PlayerStats, error) {
+	statsList, err := r.PlayerStatsRepository.GetPage(after, limit)
+	return r.overlayList(statsList), err
+}
+
+// GetByWeek retrieves all stats for a week, including pending updates
+func (r *coalescingPlayerStatsRepository) GetByWeek(season string, week int) ([]*models.PlayerStats, error) {
+	statsList, err := r.PlayerStatsRepository.GetByWeek(season, week)
+	return r.overlayList(statsList), err
+}
+
+// GetByWeekWithPlayers retrieves all stats for a week and their players, including pending updates
+func (r *coalescingPlayerStatsRepository) GetByWeekWithPlayers(season string, week int) ([]*models.PlayerStats, map[int]*models.Player, error) {
+	statsList, players, err := r.PlayerStatsRepository.GetByWeekWithPlayers(season, week)
+	return r.overlayList(statsList), players, err
+}
+
+// ForEachBySeason calls fn with each stat line of a season, including pending updates
+func (r *coalescingPlayerStatsRepository) ForEachBySeason(season string, fn func(*models.PlayerStats) error) error {
+	return r.PlayerStatsRepository.ForEachBySeason(season, func(stats *models.PlayerStats) error {
+		return fn(r.overlay(stats))
+	})
+}
+
+// GetByPlayerAndGame retrieves stats for a player in a game, including any pending update
+func (r *coalescingPlayerStatsRepository) GetByPlayerAndGame(playerID, gameID int) (*models.PlayerStats, error) {
+	stats, err := r.PlayerStatsRepository.GetByPlayerAndGame(playerID, gameID)
+	if err != nil {
+		return nil, err
+	}
+	return r.overlay(stats), nil
+}
+
+// overlay returns the pending version of the stats if one is queued
+func (r *coalescingPlayerStatsRepository) overlay(stats *models.PlayerStats) *models.PlayerStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if queued, ok := r.pending[stats.ID]; ok {
+		copied := *queued
+		copied.CreatedAt = stats.CreatedAt
+		return &copied
+	}
+	return stats
+}
+
+// overlayList applies overlay to every element of a list
+func (r *coalescingPlayerStatsRepository) overlayList(statsList []*models.PlayerStats) []*models.PlayerStats {
+	for i, stats := range statsList {
+		statsList[i] = r.overlay(stats)
+	}
+	return statsList
+}
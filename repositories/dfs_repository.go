@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/dfs_repository.go -pkg mocks . DFSRepository
+
+// DFSRepository defines the interface for daily fantasy salary data operations
+type DFSRepository interface {
+	GetSlate(season string, week int, site, source string) ([]*models.DFSSlatePlayer, error)
+	UpsertMany(salaries []*models.DFSSalary) (int, error)
+}
+
+// dfsRepository implements DFSRepository interface
+type dfsRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewDFSRepository creates a new DFS repository
+func NewDFSRepository(db *TimeoutDB, clock clock.Clock) DFSRepository {
+	return &dfsRepository{db: db, clock: clock}
+}
+
+// GetSlate retrieves the active players a site priced for the week, highest salary first,
+// with their projected points for the week: the source's projection, or the average across
+// sources when source is empty
+func (r *dfsRepository) GetSlate(season string, week int, site, source string) ([]*models.DFSSlatePlayer, error) {
+	projectionFilter := ""
+	args := []interface{}{season, week}
+	if source != "" {
+		projectionFilter = " AND source = ?"
+		args = append(args, source)
+	}
+	args = append(args, season, week, site)
+
+	query := `
+		SELECT s.player_id, p.first_name, p.last_name, p.position, p.team_id, s.salary, pr.points
+		FROM dfs_salaries s
+		JOIN players p ON s.player_id = p.id
+		LEFT JOIN (
+			SELECT player_id, ROUND(AVG(points), 2) AS points
+			FROM projections
+			WHERE season = ? AND week = ?` + projectionFilter + `
+			GROUP BY player_id
+		) pr ON pr.player_id = s.player_id
+		WHERE s.season = ? AND s.week = ? AND s.site = ? AND p.deleted_at IS NULL
+		ORDER BY s.salary DESC, p.last_name ASC, p.first_name ASC
+	`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query DFS slate: %w", err)
+	}
+	defer rows.Close()
+
+	var players []*models.DFSSlatePlayer
+	for rows.Next() {
+		var player models.DFSSlatePlayer
+		err := rows.Scan(
+			&player.PlayerID, &player.FirstName, &player.LastName, &player.Position, &player.TeamID,
+			&player.Salary, &player.ProjectedPoints,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan DFS slate player: %w", err)
+		}
+		players = append(players, &player)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating DFS slate: %w", err)
+	}
+
+	return players, nil
+}
+
+// UpsertMany creates each salary, or replaces the site's existing salary for the player and
+// week, in a single transaction and returns how many were created
+func (r *dfsRepository) UpsertMany(salaries []*models.DFSSalary) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	created := 0
+	for _, salary := range salaries {
+		isNew, err := upsertDFSSalary(tx, salary, r.clock.Now())
+		if err != nil {
+			return 0, err
+		}
+		if isNew {
+			created++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit DFS salaries: %w", err)
+	}
+
+	return created, nil
+}
+
+// upsertDFSSalary writes one salary inside the transaction
+func upsertDFSSalary(tx *sql.Tx, salary *models.DFSSalary, currentTime time.Time) (bool, error) {
+	err := tx.QueryRow(
+		"SELECT id, created_at FROM dfs_salaries WHERE player_id = ? AND season = ? AND week = ? AND site = ?",
+		salary.PlayerID, salary.Season, salary.Week, salary.Site,
+	).Scan(&salary.ID, &salary.CreatedAt)
+
+	if err == sql.ErrNoRows {
+		result, err := tx.Exec(`
+			INSERT INTO dfs_salaries (player_id, season, week, site, salary, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, salary.PlayerID, salary.Season, salary.Week, salary.Site, salary.Salary, currentTime, currentTime)
+		if err != nil {
+			return false, fmt.Errorf("failed to create DFS salary: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return false, fmt.Errorf("failed to get DFS salary ID: %w", err)
+		}
+
+		salary.ID = int(id)
+		salary.CreatedAt = currentTime
+		salary.UpdatedAt = currentTime
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check existing DFS salary: %w", err)
+	}
+
+	_, err = tx.Exec("UPDATE dfs_salaries SET salary = ?, updated_at = ? WHERE id = ?", salary.Salary, currentTime, salary.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update DFS salary: %w", err)
+	}
+
+	salary.UpdatedAt = currentTime
+	return false, nil
+}
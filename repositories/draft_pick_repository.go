@@ -0,0 +1,288 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/draft_pick_repository.go -pkg mocks . DraftPickRepository
+
+// DraftPickRepository defines the interface for draft pick data operations
+type DraftPickRepository interface {
+	GetByID(id int) (*models.DraftPick, error)
+	GetAll() ([]*models.DraftPick, error)
+	GetByYear(year int) ([]*models.DraftPick, error)
+	GetByTeamID(teamID int) ([]*models.DraftPick, error)
+	GetByPlayerID(playerID int) (*models.DraftPick, error)
+	GetByPlayerIDs(playerIDs []int) (map[int]*models.DraftPick, error)
+	GetBySlot(year, round, pick int) (*models.DraftPick, error)
+	Create(draftPick *models.DraftPick) error
+	Update(draftPick *models.DraftPick) error
+	Delete(id int) error
+	Exists(id int) (bool, error)
+}
+
+// draftPickColumns maps the draft_picks columns to the DraftPick fields
+var draftPickColumns = newRowMapper[models.DraftPick]()
+
+// draftPickRepository implements DraftPickRepository interface
+type draftPickRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewDraftPickRepository creates a new draft pick repository
+func NewDraftPickRepository(db *TimeoutDB, clock clock.Clock) DraftPickRepository {
+	return &draftPickRepository{db: db, clock: clock}
+}
+
+// GetByID retrieves a draft pick by its ID
+func (r *draftPickRepository) GetByID(id int) (*models.DraftPick, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM draft_picks dp WHERE id = ?
+	`, draftPickColumns.selectList("dp"))
+
+	var draftPick models.DraftPick
+	err := r.db.QueryRow(query, id).Scan(draftPickColumns.targets(&draftPick)...)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("draft pick with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to get draft pick: %w", err)
+	}
+
+	return &draftPick, nil
+}
+
+// GetAll retrieves all draft picks in draft order
+func (r *draftPickRepository) GetAll() ([]*models.DraftPick, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM draft_picks dp
+		ORDER BY year DESC, round ASC, pick ASC
+	`, draftPickColumns.selectList("dp"))
+
+	return r.queryDraftPicks(query)
+}
+
+// GetByYear retrieves all draft picks for a draft year in draft order
+func (r *draftPickRepository) GetByYear(year int) ([]*models.DraftPick, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM draft_picks dp
+		WHERE year = ?
+		ORDER BY round ASC, pick ASC
+	`, draftPickColumns.selectList("dp"))
+
+	return r.queryDraftPicks(query, year)
+}
+
+// GetByTeamID retrieves all draft picks held by a team
+func (r *draftPickRepository) GetByTeamID(teamID int) ([]*models.DraftPick, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM draft_picks dp
+		WHERE team_id = ?
+		ORDER BY year DESC, round ASC, pick ASC
+	`, draftPickColumns.selectList("dp"))
+
+	return r.queryDraftPicks(query, teamID)
+}
+
+// GetByPlayerID retrieves the pick a player was drafted with
+func (r *draftPickRepository) GetByPlayerID(playerID int) (*models.DraftPick, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM draft_picks dp WHERE player_id = ?
+	`, draftPickColumns.selectList("dp"))
+
+	var draftPick models.DraftPick
+	err := r.db.QueryRow(query, playerID).Scan(draftPickColumns.targets(&draftPick)...)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("draft pick for player with ID %d not found", playerID)
+		}
+		return nil, fmt.Errorf("failed to get draft pick: %w", err)
+	}
+
+	return &draftPick, nil
+}
+
+// GetByPlayerIDs retrieves the draft picks of several players keyed by player ID.
+// Undrafted players have no entry.
+func (r *draftPickRepository) GetByPlayerIDs(playerIDs []int) (map[int]*models.DraftPick, error) {
+	draftPicks := make(map[int]*models.DraftPick)
+	if len(playerIDs) == 0 {
+		return draftPicks, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(playerIDs)), ", ")
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM draft_picks dp WHERE player_id IN (%s)
+	`, draftPickColumns.selectList("dp"), placeholders)
+
+	args := make([]interface{}, len(playerIDs))
+	for i, id := range playerIDs {
+		args[i] = id
+	}
+
+	list, err := r.queryDraftPicks(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, draftPick := range list {
+		draftPicks[*draftPick.PlayerID] = draftPick
+	}
+
+	return draftPicks, nil
+}
+
+// GetBySlot retrieves the draft pick for a year, round and pick number, or nil if there is none
+func (r *draftPickRepository) GetBySlot(year, round, pick int) (*models.DraftPick, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM draft_picks dp WHERE year = ? AND round = ? AND pick = ?
+	`, draftPickColumns.selectList("dp"))
+
+	var draftPick models.DraftPick
+	err := r.db.QueryRow(query, year, round, pick).Scan(draftPickColumns.targets(&draftPick)...)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get draft pick: %w", err)
+	}
+
+	return &draftPick, nil
+}
+
+// queryDraftPicks runs a query returning draft pick rows
+func (r *draftPickRepository) queryDraftPicks(query string, args ...interface{}) ([]*models.DraftPick, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query draft picks: %w", err)
+	}
+	defer rows.Close()
+
+	var draftPicks []*models.DraftPick
+	for rows.Next() {
+		var draftPick models.DraftPick
+		if err := rows.Scan(draftPickColumns.targets(&draftPick)...); err != nil {
+			return nil, fmt.Errorf("failed to scan draft pick: %w", err)
+		}
+		draftPicks = append(draftPicks, &draftPick)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating draft picks: %w", err)
+	}
+
+	return draftPicks, nil
+}
+
+// Create adds a new draft pick to the database
+func (r *draftPickRepository) Create(draftPick *models.DraftPick) error {
+	query := `
+		INSERT INTO draft_picks (year, round, pick, team_id, player_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(query,
+		draftPick.Year, draftPick.Round, draftPick.Pick, draftPick.TeamID, draftPick.PlayerID,
+		currentTime, currentTime,
+	)
+	if err != nil {
+		if conflict := uniqueViolation(err, "draft pick"); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to create draft pick: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get draft pick ID: %w", err)
+	}
+
+	draftPick.ID = int(id)
+	draftPick.CreatedAt = currentTime
+	draftPick.UpdatedAt = currentTime
+
+	return nil
+}
+
+// Update modifies an existing draft pick
+func (r *draftPickRepository) Update(draftPick *models.DraftPick) error {
+	query := `
+		UPDATE draft_picks
+		SET year = ?, round = ?, pick = ?, team_id = ?, player_id = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(query,
+		draftPick.Year, draftPick.Round, draftPick.Pick, draftPick.TeamID, draftPick.PlayerID,
+		currentTime, draftPick.ID,
+	)
+	if err != nil {
+		if conflict := uniqueViolation(err, "draft pick"); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to update draft pick: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("draft pick with ID %d not found", draftPick.ID)
+	}
+
+	draftPick.UpdatedAt = currentTime
+	return nil
+}
+
+// Delete removes a draft pick from the database
+func (r *draftPickRepository) Delete(id int) error {
+	query := "DELETE FROM draft_picks WHERE id = ?"
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete draft pick: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("draft pick with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// Exists checks if a draft pick exists by ID
+func (r *draftPickRepository) Exists(id int) (bool, error) {
+	query := "SELECT 1 FROM draft_picks WHERE id = ? LIMIT 1"
+	var exists int
+	err := r.db.QueryRow(query, id).Scan(&exists)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check draft pick existence: %w", err)
+	}
+	return true, nil
+}
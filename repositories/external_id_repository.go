@@ -0,0 +1,232 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/external_id_repository.go -pkg mocks . ExternalIDRepository
+
+// ExternalIDRepository defines the interface for external ID mapping data operations
+type ExternalIDRepository interface {
+	GetByID(id int) (*models.ExternalID, error)
+	GetByEntity(entityType string, entityID int) ([]*models.ExternalID, error)
+	GetByEntityIDs(entityType string, entityIDs []int) (map[int]map[string]string, error)
+	Lookup(entityType, provider, externalID string) (*models.ExternalID, error)
+	Create(externalID *models.ExternalID) error
+	Delete(id int) error
+	DeleteByEntity(entityType string, entityID int) error
+}
+
+// externalIDColumns maps the external_ids columns to the ExternalID fields
+var externalIDColumns = newRowMapper[models.ExternalID]()
+
+// externalIDRepository implements ExternalIDRepository interface
+type externalIDRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewExternalIDRepository creates a new external ID repository
+func NewExternalIDRepository(db *TimeoutDB, clock clock.Clock) ExternalIDRepository {
+	return &externalIDRepository{db: db, clock: clock}
+}
+
+// GetByID retrieves an external ID mapping by its ID
+func (r *externalIDRepository) GetByID(id int) (*models.ExternalID, error) {
+	query := `
+		SELECT ` + externalIDColumns.columnList() + `
+		FROM external_ids WHERE id = ?
+	`
+
+	var externalID models.ExternalID
+	err := r.db.QueryRow(query, id).Scan(externalIDColumns.targets(&externalID)...)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("external ID with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to get external ID: %w", err)
+	}
+
+	return &externalID, nil
+}
+
+// GetByEntity retrieves all provider IDs of a player, team or game
+func (r *externalIDRepository) GetByEntity(entityType string, entityID int) ([]*models.ExternalID, error) {
+	query := `
+		SELECT ` + externalIDColumns.columnList() + `
+		FROM external_ids
+		WHERE entity_type = ? AND entity_id = ?
+		ORDER BY provider ASC
+	`
+
+	rows, err := r.db.Query(query, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query external IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var externalIDs []*models.ExternalID
+	for rows.Next() {
+		var externalID models.ExternalID
+		if err := rows.Scan(externalIDColumns.targets(&externalID)...); err != nil {
+			return nil, fmt.Errorf("failed to scan external ID: %w", err)
+		}
+		externalIDs = append(externalIDs, &externalID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating external IDs: %w", err)
+	}
+
+	return externalIDs, nil
+}
+
+// GetByEntityIDs retrieves the provider IDs of several entities of one type,
+// keyed by entity ID and then by provider
+func (r *externalIDRepository) GetByEntityIDs(entityType string, entityIDs []int) (map[int]map[string]string, error) {
+	mappings := make(map[int]map[string]string)
+	if len(entityIDs) == 0 {
+		return mappings, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(entityIDs)), ", ")
+	query := fmt.Sprintf(`
+		SELECT entity_id, provider, external_id
+		FROM external_ids
+		WHERE entity_type = ? AND entity_id IN (%s)
+	`, placeholders)
+
+	args := make([]interface{}, 0, len(entityIDs)+1)
+	args = append(args, entityType)
+	for _, id := range entityIDs {
+		args = append(args, id)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query external IDs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entityID int
+		var provider, externalID string
+		if err := rows.Scan(&entityID, &provider, &externalID); err != nil {
+			return nil, fmt.Errorf("failed to scan external ID: %w", err)
+		}
+		if mappings[entityID] == nil {
+			mappings[entityID] = make(map[string]string)
+		}
+		mappings[entityID][provider] = externalID
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating external IDs: %w", err)
+	}
+
+	return mappings, nil
+}
+
+// Lookup finds the mapping for a provider ID of the given entity type
+func (r *externalIDRepository) Lookup(entityType, provider, externalID string) (*models.ExternalID, error) {
+	query := `
+		SELECT ` + externalIDColumns.columnList() + `
+		FROM external_ids
+		WHERE entity_type = ? AND provider = ? AND external_id = ?
+	`
+
+	var mapping models.ExternalID
+	err := r.db.QueryRow(query, entityType, provider, externalID).Scan(externalIDColumns.targets(&mapping)...)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s with %s ID %s not found", entityType, provider, externalID)
+		}
+		return nil, fmt.Errorf("failed to look up external ID: %w", err)
+	}
+
+	return &mapping, nil
+}
+
+// Create adds a new external ID mapping to the database
+func (r *externalIDRepository) Create(externalID *models.ExternalID) error {
+	query := `
+		INSERT INTO external_ids (entity_type, entity_id, provider, external_id, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(query,
+		externalID.EntityType, externalID.EntityID, externalID.Provider, externalID.ExternalID, currentTime,
+	)
+	if err != nil {
+		if conflict := uniqueViolation(err, "external ID"); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to create external ID: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get external ID: %w", err)
+	}
+
+	externalID.ID = int(id)
+	externalID.CreatedAt = currentTime
+
+	return nil
+}
+
+// insertExternalIDs adds the provider IDs of a newly created entity inside the transaction
+// that creates it, so the entity is never left behind without them
+func insertExternalIDs(tx *sql.Tx, entityType string, entityID int, ids map[string]string, currentTime time.Time) error {
+	for provider, externalID := range ids {
+		_, err := tx.Exec(`
+			INSERT INTO external_ids (entity_type, entity_id, provider, external_id, created_at)
+			VALUES (?, ?, ?, ?, ?)
+		`, entityType, entityID, provider, externalID, currentTime)
+		if err != nil {
+			if conflict := uniqueViolation(err, "external ID"); conflict != nil {
+				return conflict
+			}
+			return fmt.Errorf("failed to save %s ID: %w", provider, err)
+		}
+	}
+	return nil
+}
+
+// Delete removes an external ID mapping from the database
+func (r *externalIDRepository) Delete(id int) error {
+	query := "DELETE FROM external_ids WHERE id = ?"
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete external ID: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("external ID with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// DeleteByEntity removes every mapping of a player, team or game
+func (r *externalIDRepository) DeleteByEntity(entityType string, entityID int) error {
+	query := "DELETE FROM external_ids WHERE entity_type = ? AND entity_id = ?"
+	if _, err := r.db.Exec(query, entityType, entityID); err != nil {
+		return fmt.Errorf("failed to delete external IDs: %w", err)
+	}
+	return nil
+}
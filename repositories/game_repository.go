@@ -2,11 +2,16 @@ package repositories
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
-	"sports-backend/models"
 	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
 )
 
+//go:generate moq -out mocks/game_repository.go -pkg mocks . GameRepository
+
 // GameRepository defines the interface for game data operations
 type GameRepository interface {
 	GetAll() ([]*models.Game, error)
@@ -14,36 +19,45 @@ type GameRepository interface {
 	Create(game *models.Game) error
 	Update(game *models.Game) error
 	Delete(id int) error
+	Restore(id int) error
+	Purge(id int) error
 	GetByTeamID(teamID int) ([]*models.Game, error)
 	GetBySeason(season string) ([]*models.Game, error)
 	GetByWeek(season string, week int) ([]*models.Game, error)
+	GetUnfinished(from, to time.Time) ([]*models.Game, error)
+	GetLatestSeason() (string, error)
 	Exists(id int) (bool, error)
+	ExistsMany(ids []int) (map[int]bool, error)
 }
 
+// gameColumns maps the games columns to the Game fields
+var gameColumns = newRowMapper[models.Game]()
+
+// gameSelectList selects the games columns and the period scores, which are stored as JSON, for
+// scanGame
+var gameSelectList = gameColumns.selectList("g") + ", g.period_scores"
+
 // gameRepository implements the GameRepository interface
 type gameRepository struct {
-	db *sql.DB
+	db    *TimeoutDB
+	clock clock.Clock
 }
 
 // NewGameRepository creates a new game repository
-func NewGameRepository(db *sql.DB) GameRepository {
-	return &gameRepository{db: db}
+func NewGameRepository(db *TimeoutDB, clock clock.Clock) GameRepository {
+	return &gameRepository{db: db, clock: clock}
 }
 
 // GetAll retrieves all games with team information
 func (r *gameRepository) GetAll() ([]*models.Game, error) {
-	query := `
-		SELECT 
-			g.id, g.home_team_id, g.away_team_id, g.season, g.week, 
-			g.game_date, g.status, g.home_score, g.away_score, 
-			g.created_at, g.updated_at,
-			ht.name as home_team_name, ht.city as home_team_city,
-			at.name as away_team_name, at.city as away_team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM games g
 		JOIN teams ht ON g.home_team_id = ht.id
 		JOIN teams at ON g.away_team_id = at.id
+		WHERE g.deleted_at IS NULL
 		ORDER BY g.game_date DESC, g.created_at DESC
-	`
+	`, gameSelectList)
 
 	rows, err := r.db.Query(query)
 	if err != nil {
@@ -51,56 +65,20 @@ func (r *gameRepository) GetAll() ([]*models.Game, error) {
 	}
 	defer rows.Close()
 
-	var games []*models.Game
-	for rows.Next() {
-		var game models.Game
-		var homeTeamName, homeTeamCity, awayTeamName, awayTeamCity string
-
-		err := rows.Scan(
-			&game.ID, &game.HomeTeamID, &game.AwayTeamID, &game.Season, &game.Week,
-			&game.GameDate, &game.Status, &game.HomeScore, &game.AwayScore,
-			&game.CreatedAt, &game.UpdatedAt,
-			&homeTeamName, &homeTeamCity, &awayTeamName, &awayTeamCity,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan game: %w", err)
-		}
-
-		games = append(games, &game)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating games: %w", err)
-	}
-
-	return games, nil
+	return scanGames(rows)
 }
 
 // GetByID retrieves a game by ID with team information
 func (r *gameRepository) GetByID(id int) (*models.Game, error) {
-	query := `
-		SELECT 
-			g.id, g.home_team_id, g.away_team_id, g.season, g.week, 
-			g.game_date, g.status, g.home_score, g.away_score, 
-			g.created_at, g.updated_at,
-			ht.name as home_team_name, ht.city as home_team_city,
-			at.name as away_team_name, at.city as away_team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM games g
 		JOIN teams ht ON g.home_team_id = ht.id
 		JOIN teams at ON g.away_team_id = at.id
-		WHERE g.id = ?
-	`
-
-	var game models.Game
-	var homeTeamName, homeTeamCity, awayTeamName, awayTeamCity string
-
-	err := r.db.QueryRow(query, id).Scan(
-		&game.ID, &game.HomeTeamID, &game.AwayTeamID, &game.Season, &game.Week,
-		&game.GameDate, &game.Status, &game.HomeScore, &game.AwayScore,
-		&game.CreatedAt, &game.UpdatedAt,
-		&homeTeamName, &homeTeamCity, &awayTeamName, &awayTeamCity,
-	)
+		WHERE g.id = ? AND g.deleted_at IS NULL
+	`, gameSelectList)
 
+	game, err := scanGame(r.db.QueryRow(query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("game with ID %d not found", id)
@@ -108,26 +86,42 @@ func (r *gameRepository) GetByID(id int) (*models.Game, error) {
 		return nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
-	return &game, nil
+	return game, nil
 }
 
-// Create creates a new game
+// Create creates a new game, with the provider IDs in its ExternalIDs, in one transaction
 func (r *gameRepository) Create(game *models.Game) error {
 	query := `
 		INSERT INTO games (
-			home_team_id, away_team_id, season, week, game_date, status, 
-			home_score, away_score, created_at, updated_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			home_team_id, away_team_id, season, week, game_type, game_date, status, 
+			home_score, away_score, venue_id, neutral_site, overtime, period_scores, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	currentTime := time.Now()
-	result, err := r.db.Exec(query,
-		game.HomeTeamID, game.AwayTeamID, game.Season, game.Week,
+	periods, err := marshalPeriods(game.Periods)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Kickoffs are stored in UTC, so they sort and compare as text
+	game.GameDate = game.GameDate.UTC()
+	currentTime := r.clock.Now()
+	result, err := tx.Exec(query,
+		game.HomeTeamID, game.AwayTeamID, game.Season, game.Week, game.GameType,
 		game.GameDate, game.Status, game.HomeScore, game.AwayScore,
-		currentTime, currentTime,
+		game.VenueID, game.NeutralSite, game.Overtime, periods, currentTime, currentTime,
 	)
 
 	if err != nil {
+		if conflict := uniqueViolation(err, "game"); conflict != nil {
+			return conflict
+		}
 		return fmt.Errorf("failed to create game: %w", err)
 	}
 
@@ -135,6 +129,13 @@ func (r *gameRepository) Create(game *models.Game) error {
 	if err != nil {
 		return fmt.Errorf("failed to get game ID: %w", err)
 	}
+	if err := insertExternalIDs(tx, "game", int(id), game.ExternalIDs, currentTime); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
 	game.ID = int(id)
 	game.CreatedAt = currentTime
@@ -147,20 +148,30 @@ func (r *gameRepository) Create(game *models.Game) error {
 func (r *gameRepository) Update(game *models.Game) error {
 	query := `
 		UPDATE games SET 
-			home_team_id = ?, away_team_id = ?, season = ?, week = ?, 
+			home_team_id = ?, away_team_id = ?, season = ?, week = ?, game_type = ?,
 			game_date = ?, status = ?, home_score = ?, away_score = ?, 
-			updated_at = ?
-		WHERE id = ?
+			venue_id = ?, neutral_site = ?, overtime = ?, period_scores = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	currentTime := time.Now()
+	periods, err := marshalPeriods(game.Periods)
+	if err != nil {
+		return err
+	}
+
+	// Kickoffs are stored in UTC, so they sort and compare as text
+	game.GameDate = game.GameDate.UTC()
+	currentTime := r.clock.Now()
 	result, err := r.db.Exec(query,
-		game.HomeTeamID, game.AwayTeamID, game.Season, game.Week,
+		game.HomeTeamID, game.AwayTeamID, game.Season, game.Week, game.GameType,
 		game.GameDate, game.Status, game.HomeScore, game.AwayScore,
-		currentTime, game.ID,
+		game.VenueID, game.NeutralSite, game.Overtime, periods, currentTime, game.ID,
 	)
 
 	if err != nil {
+		if conflict := uniqueViolation(err, "game"); conflict != nil {
+			return conflict
+		}
 		return fmt.Errorf("failed to update game: %w", err)
 	}
 
@@ -177,11 +188,10 @@ func (r *gameRepository) Update(game *models.Game) error {
 	return nil
 }
 
-// Delete deletes a game by ID
+// Delete soft-deletes a game so it can later be restored
 func (r *gameRepository) Delete(id int) error {
-	query := `DELETE FROM games WHERE id = ?`
-
-	result, err := r.db.Exec(query, id)
+	query := "UPDATE games SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL"
+	result, err := r.db.Exec(query, r.clock.Now(), id)
 	if err != nil {
 		return fmt.Errorf("failed to delete game: %w", err)
 	}
@@ -198,21 +208,79 @@ func (r *gameRepository) Delete(id int) error {
 	return nil
 }
 
+// Restore brings back a soft-deleted game
+func (r *gameRepository) Restore(id int) error {
+	query := "UPDATE games SET deleted_at = NULL, updated_at = ? WHERE id = ? AND deleted_at IS NOT NULL"
+	result, err := r.db.Exec(query, r.clock.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to restore game: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("deleted game with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// Purge permanently removes a soft-deleted game along with its stats, advanced stats, odds, schedule
+// changes and external IDs
+func (r *gameRepository) Purge(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkPurgeable(tx, "games", "game", id); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM player_stats WHERE game_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete game stats: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM player_advanced_stats WHERE game_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete game advanced stats: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM game_odds WHERE game_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete game odds: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM game_schedule_changes WHERE game_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete game schedule changes: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM external_ids WHERE entity_type = 'game' AND entity_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete game external IDs: %w", err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM games WHERE id = ?", id); err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("game with ID %d cannot be purged because other records still reference it", id)
+		}
+		return fmt.Errorf("failed to purge game: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 // GetByTeamID retrieves all games for a specific team (both home and away)
 func (r *gameRepository) GetByTeamID(teamID int) ([]*models.Game, error) {
-	query := `
-		SELECT 
-			g.id, g.home_team_id, g.away_team_id, g.season, g.week, 
-			g.game_date, g.status, g.home_score, g.away_score, 
-			g.created_at, g.updated_at,
-			ht.name as home_team_name, ht.city as home_team_city,
-			at.name as away_team_name, at.city as away_team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM games g
 		JOIN teams ht ON g.home_team_id = ht.id
 		JOIN teams at ON g.away_team_id = at.id
-		WHERE g.home_team_id = ? OR g.away_team_id = ?
+		WHERE (g.home_team_id = ? OR g.away_team_id = ?) AND g.deleted_at IS NULL
 		ORDER BY g.game_date DESC, g.created_at DESC
-	`
+	`, gameSelectList)
 
 	rows, err := r.db.Query(query, teamID, teamID)
 	if err != nil {
@@ -220,46 +288,19 @@ func (r *gameRepository) GetByTeamID(teamID int) ([]*models.Game, error) {
 	}
 	defer rows.Close()
 
-	var games []*models.Game
-	for rows.Next() {
-		var game models.Game
-		var homeTeamName, homeTeamCity, awayTeamName, awayTeamCity string
-
-		err := rows.Scan(
-			&game.ID, &game.HomeTeamID, &game.AwayTeamID, &game.Season, &game.Week,
-			&game.GameDate, &game.Status, &game.HomeScore, &game.AwayScore,
-			&game.CreatedAt, &game.UpdatedAt,
-			&homeTeamName, &homeTeamCity, &awayTeamName, &awayTeamCity,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan game: %w", err)
-		}
-
-		games = append(games, &game)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating games: %w", err)
-	}
-
-	return games, nil
+	return scanGames(rows)
 }
 
 // GetBySeason retrieves all games for a specific season
 func (r *gameRepository) GetBySeason(season string) ([]*models.Game, error) {
-	query := `
-		SELECT 
-			g.id, g.home_team_id, g.away_team_id, g.season, g.week, 
-			g.game_date, g.status, g.home_score, g.away_score, 
-			g.created_at, g.updated_at,
-			ht.name as home_team_name, ht.city as home_team_city,
-			at.name as away_team_name, at.city as away_team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM games g
 		JOIN teams ht ON g.home_team_id = ht.id
 		JOIN teams at ON g.away_team_id = at.id
-		WHERE g.season = ?
+		WHERE g.season = ? AND g.deleted_at IS NULL
 		ORDER BY g.week ASC, g.game_date ASC
-	`
+	`, gameSelectList)
 
 	rows, err := r.db.Query(query, season)
 	if err != nil {
@@ -267,46 +308,19 @@ func (r *gameRepository) GetBySeason(season string) ([]*models.Game, error) {
 	}
 	defer rows.Close()
 
-	var games []*models.Game
-	for rows.Next() {
-		var game models.Game
-		var homeTeamName, homeTeamCity, awayTeamName, awayTeamCity string
-
-		err := rows.Scan(
-			&game.ID, &game.HomeTeamID, &game.AwayTeamID, &game.Season, &game.Week,
-			&game.GameDate, &game.Status, &game.HomeScore, &game.AwayScore,
-			&game.CreatedAt, &game.UpdatedAt,
-			&homeTeamName, &homeTeamCity, &awayTeamName, &awayTeamCity,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan game: %w", err)
-		}
-
-		games = append(games, &game)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating games: %w", err)
-	}
-
-	return games, nil
+	return scanGames(rows)
 }
 
 // GetByWeek retrieves all games for a specific week in a season
 func (r *gameRepository) GetByWeek(season string, week int) ([]*models.Game, error) {
-	query := `
-		SELECT 
-			g.id, g.home_team_id, g.away_team_id, g.season, g.week, 
-			g.game_date, g.status, g.home_score, g.away_score, 
-			g.created_at, g.updated_at,
-			ht.name as home_team_name, ht.city as home_team_city,
-			at.name as away_team_name, at.city as away_team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM games g
 		JOIN teams ht ON g.home_team_id = ht.id
 		JOIN teams at ON g.away_team_id = at.id
-		WHERE g.season = ? AND g.week = ?
+		WHERE g.season = ? AND g.week = ? AND g.deleted_at IS NULL
 		ORDER BY g.game_date ASC
-	`
+	`, gameSelectList)
 
 	rows, err := r.db.Query(query, season, week)
 	if err != nil {
@@ -314,34 +328,49 @@ func (r *gameRepository) GetByWeek(season string, week int) ([]*models.Game, err
 	}
 	defer rows.Close()
 
-	var games []*models.Game
-	for rows.Next() {
-		var game models.Game
-		var homeTeamName, homeTeamCity, awayTeamName, awayTeamCity string
-
-		err := rows.Scan(
-			&game.ID, &game.HomeTeamID, &game.AwayTeamID, &game.Season, &game.Week,
-			&game.GameDate, &game.Status, &game.HomeScore, &game.AwayScore,
-			&game.CreatedAt, &game.UpdatedAt,
-			&homeTeamName, &homeTeamCity, &awayTeamName, &awayTeamCity,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan game: %w", err)
-		}
+	return scanGames(rows)
+}
+
+// GetUnfinished retrieves the games kicking off between from and to that are scheduled or in
+// progress, by kickoff
+func (r *gameRepository) GetUnfinished(from, to time.Time) ([]*models.Game, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM games g
+		JOIN teams ht ON g.home_team_id = ht.id
+		JOIN teams at ON g.away_team_id = at.id
+		WHERE g.game_date BETWEEN ? AND ? AND g.status IN ('scheduled', 'in_progress') AND g.deleted_at IS NULL
+		ORDER BY g.game_date ASC
+	`, gameSelectList)
 
-		games = append(games, &game)
+	rows, err := r.db.Query(query, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unfinished games: %w", err)
 	}
+	defer rows.Close()
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating games: %w", err)
+	return scanGames(rows)
+}
+
+// GetLatestSeason returns the most recent season that has at least one game
+func (r *gameRepository) GetLatestSeason() (string, error) {
+	query := `SELECT season FROM games WHERE deleted_at IS NULL ORDER BY season DESC LIMIT 1`
+
+	var season string
+	err := r.db.QueryRow(query).Scan(&season)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("no seasons found")
+		}
+		return "", fmt.Errorf("failed to get latest season: %w", err)
 	}
 
-	return games, nil
+	return season, nil
 }
 
 // Exists checks if a game exists by ID
 func (r *gameRepository) Exists(id int) (bool, error) {
-	query := `SELECT 1 FROM games WHERE id = ? LIMIT 1`
+	query := `SELECT 1 FROM games WHERE id = ? AND deleted_at IS NULL LIMIT 1`
 
 	var exists int
 	err := r.db.QueryRow(query, id).Scan(&exists)
@@ -354,3 +383,62 @@ func (r *gameRepository) Exists(id int) (bool, error) {
 
 	return true, nil
 }
+
+// ExistsMany reports which of the games exist, checking them in batches rather than one query
+// each. IDs that don't exist are absent from the result.
+func (r *gameRepository) ExistsMany(ids []int) (map[int]bool, error) {
+	found, err := existsMany(r.db, "games", ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check game existence: %w", err)
+	}
+	return found, nil
+}
+
+// scanGame scans a game row selected with gameSelectList
+func scanGame(scanner interface{ Scan(...interface{}) error }) (*models.Game, error) {
+	var game models.Game
+	var periods sql.NullString
+
+	if err := scanner.Scan(append(gameColumns.targets(&game), &periods)...); err != nil {
+		return nil, err
+	}
+
+	if periods.Valid {
+		if err := json.Unmarshal([]byte(periods.String), &game.Periods); err != nil {
+			return nil, fmt.Errorf("failed to decode period scores of game %d: %w", game.ID, err)
+		}
+	}
+
+	return &game, nil
+}
+
+// scanGames reads every row of a query selecting gameSelectList
+func scanGames(rows *Rows) ([]*models.Game, error) {
+	var games []*models.Game
+	for rows.Next() {
+		game, err := scanGame(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan game: %w", err)
+		}
+		games = append(games, game)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating games: %w", err)
+	}
+
+	return games, nil
+}
+
+// marshalPeriods encodes period scores for the period_scores column, NULL when there are none
+func marshalPeriods(periods []models.PeriodScore) (interface{}, error) {
+	if len(periods) == 0 {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(periods)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode period scores: %w", err)
+	}
+	return string(encoded), nil
+}
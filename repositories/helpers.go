@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sports-backend/models"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// likeEscaper escapes the LIKE wildcards so user input is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// prefixPattern builds a LIKE pattern matching values that start with prefix.
+// Queries using it must declare ESCAPE '\'.
+func prefixPattern(prefix string) string {
+	return likeEscaper.Replace(prefix) + "%"
+}
+
+// searchRank builds an ORDER BY term ranking name search matches as the search service scores
+// them, best first, with its arguments: an exact full or primary name, then a full name prefix
+// for queries with a space, then a primary name prefix and a secondary name prefix
+func searchRank(query string, spaced bool, fullName, primary, secondary string) (string, []interface{}) {
+	pattern := prefixPattern(query)
+	rank := fmt.Sprintf("CASE WHEN %s = ? COLLATE NOCASE OR %s = ? COLLATE NOCASE THEN 0", fullName, primary)
+	args := []interface{}{query, query}
+	if spaced {
+		rank += fmt.Sprintf(` WHEN %s LIKE ? ESCAPE '\' THEN 1`, fullName)
+		args = append(args, pattern)
+	}
+	rank += fmt.Sprintf(` WHEN %s LIKE ? ESCAPE '\' THEN 2 WHEN %s LIKE ? ESCAPE '\' THEN 3 ELSE 4 END`, primary, secondary)
+	return rank, append(args, pattern, pattern)
+}
+
+// execRowsAffected runs a statement in the transaction and returns how many rows it changed
+func execRowsAffected(tx *sql.Tx, query string, args ...interface{}) (int, error) {
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
+
+// existsBatchSize is how many IDs one existence query checks, well under SQLite's limit on
+// bound parameters
+const existsBatchSize = 500
+
+// existsMany reports which of ids are rows of table that haven't been soft-deleted, checking
+// them in batches rather than one query each
+func existsMany(db *TimeoutDB, table string, ids []int) (map[int]bool, error) {
+	found := make(map[int]bool, len(ids))
+	for start := 0; start < len(ids); start += existsBatchSize {
+		batch := ids[start:min(start+existsBatchSize, len(ids))]
+
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(batch)), ", ")
+		args := make([]interface{}, len(batch))
+		for i, id := range batch {
+			args[i] = id
+		}
+
+		rows, err := db.Query(fmt.Sprintf("SELECT id FROM %s WHERE id IN (%s) AND deleted_at IS NULL", table, placeholders), args...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			found[id] = true
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
+// checkPurgeable verifies that a row exists in table and has already been soft-deleted
+func checkPurgeable(tx *sql.Tx, table, entity string, id int) error {
+	var deletedAt sql.NullTime
+	err := tx.QueryRow(fmt.Sprintf("SELECT deleted_at FROM %s WHERE id = ?", table), id).Scan(&deletedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%s with ID %d not found", entity, id)
+		}
+		return fmt.Errorf("failed to check %s: %w", entity, err)
+	}
+	if !deletedAt.Valid {
+		return fmt.Errorf("%s with ID %d cannot be purged until it has been deleted", entity, id)
+	}
+	return nil
+}
+
+// isForeignKeyViolation reports whether err is SQLite refusing a change that would leave dangling references
+func isForeignKeyViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintForeignKey
+}
+
+// uniqueViolation converts a UNIQUE constraint failure into a ConflictError naming the
+// conflicting columns. It returns nil for any other error.
+func uniqueViolation(err error, entity string) error {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) || sqliteErr.ExtendedCode != sqlite3.ErrConstraintUnique {
+		return nil
+	}
+
+	// SQLite reports the columns as "UNIQUE constraint failed: teams.name, teams.city"
+	var fields []string
+	if _, columns, ok := strings.Cut(sqliteErr.Error(), "constraint failed: "); ok {
+		for _, column := range strings.Split(columns, ", ") {
+			if i := strings.LastIndex(column, "."); i >= 0 {
+				column = column[i+1:]
+			}
+			fields = append(fields, column)
+		}
+	}
+
+	return &models.ConflictError{Entity: entity, Fields: fields}
+}
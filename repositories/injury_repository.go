@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"fmt"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/injury_repository.go -pkg mocks . InjuryRepository
+
+// InjuryRepository defines the interface for injury report data operations
+type InjuryRepository interface {
+	GetDesignated(teamID int) ([]*models.Player, error)
+	GetByPlayerID(playerID int) ([]*models.InjuryChange, error)
+}
+
+// injuryChangeColumns maps the player_injury_changes columns to the InjuryChange fields
+var injuryChangeColumns = newRowMapper[models.InjuryChange]()
+
+// injuryRepository implements InjuryRepository interface
+type injuryRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewInjuryRepository creates a new injury repository
+func NewInjuryRepository(db *TimeoutDB, clock clock.Clock) InjuryRepository {
+	return &injuryRepository{db: db, clock: clock}
+}
+
+// GetDesignated retrieves the active players with a designation on the injury report, optionally
+// only those of one team, by team and name
+func (r *injuryRepository) GetDesignated(teamID int) ([]*models.Player, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM players p
+		JOIN teams t ON p.team_id = t.id
+		WHERE p.injury_status IS NOT NULL AND p.deleted_at IS NULL AND (? = 0 OR p.team_id = ?)
+		ORDER BY t.name ASC, p.last_name ASC, p.first_name ASC
+	`, playerColumns.selectList("p"))
+
+	rows, err := r.db.Query(query, teamID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query injured players: %w", err)
+	}
+	defer rows.Close()
+
+	players := []*models.Player{}
+	for rows.Next() {
+		var player models.Player
+		if err := rows.Scan(playerColumns.targets(&player)...); err != nil {
+			return nil, fmt.Errorf("failed to scan player: %w", err)
+		}
+		players = append(players, &player)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating players: %w", err)
+	}
+
+	return players, nil
+}
+
+// GetByPlayerID retrieves every change of a player's injury report line, newest first
+func (r *injuryRepository) GetByPlayerID(playerID int) ([]*models.InjuryChange, error) {
+	query := fmt.Sprintf(`
+		SELECT %s, p.first_name || ' ' || p.last_name, p.team_id
+		FROM player_injury_changes c
+		JOIN players p ON p.id = c.player_id
+		WHERE c.player_id = ?
+		ORDER BY c.id DESC
+	`, injuryChangeColumns.selectList("c"))
+
+	rows, err := r.db.Query(query, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query injury changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*models.InjuryChange{}
+	for rows.Next() {
+		var change models.InjuryChange
+		targets := append(injuryChangeColumns.targets(&change), &change.PlayerName, &change.TeamID)
+		if err := rows.Scan(targets...); err != nil {
+			return nil, fmt.Errorf("failed to scan injury change: %w", err)
+		}
+		changes = append(changes, &change)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating injury changes: %w", err)
+	}
+
+	return changes, nil
+}
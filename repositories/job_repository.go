@@ -0,0 +1,302 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/job_repository.go -pkg mocks . JobRepository
+
+// JobRepository defines the interface for background job data operations
+type JobRepository interface {
+	Create(job *models.Job) error
+	GetByID(id int) (*models.Job, error)
+	List(status, kind string, limit int) ([]*models.Job, error)
+	CountQueued() (int, error)
+	ClaimNext(now time.Time) (*models.Job, error)
+	SaveProgress(job *models.Job) (bool, error)
+	Update(job *models.Job) error
+	RequestCancel(id int, now time.Time) error
+	RequeueRunning(now time.Time) (int, int, error)
+}
+
+// jobColumns maps the jobs columns to the Job fields
+var jobColumns = newRowMapper[models.Job]()
+
+// jobSelectList lists the columns read into a Job, apart from the payload: the mapped columns
+// followed by the nullable result, errors and last error
+var jobSelectList = jobColumns.columnList() + ", result, errors, last_error"
+
+// jobRepository implements JobRepository interface
+type jobRepository struct {
+	db *TimeoutDB
+}
+
+// NewJobRepository creates a new job repository
+func NewJobRepository(db *TimeoutDB) JobRepository {
+	return &jobRepository{db: db}
+}
+
+// Create queues a new job
+func (r *jobRepository) Create(job *models.Job) error {
+	query := `
+		INSERT INTO jobs (kind, status, payload, total, max_attempts, run_after, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.Exec(query, job.Kind, job.Status, string(job.Payload), job.Total, job.MaxAttempts, job.RunAfter, job.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create job: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get job ID: %w", err)
+	}
+	job.ID = int(id)
+
+	return nil
+}
+
+// GetByID retrieves a job by ID, without its payload
+func (r *jobRepository) GetByID(id int) (*models.Job, error) {
+	query := `SELECT ` + jobSelectList + ` FROM jobs WHERE id = ?`
+
+	job, err := scanJob(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("job with ID %d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	return job, nil
+}
+
+// List retrieves up to limit jobs, newest first, optionally only those with a status or kind
+func (r *jobRepository) List(status, kind string, limit int) ([]*models.Job, error) {
+	query := `SELECT ` + jobSelectList + ` FROM jobs WHERE 1 = 1`
+	args := []interface{}{}
+	if status != "" {
+		query += " AND status = ?"
+		args = append(args, status)
+	}
+	if kind != "" {
+		query += " AND kind = ?"
+		args = append(args, kind)
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*models.Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// CountQueued counts the jobs waiting for a worker, including those waiting to be retried
+func (r *jobRepository) CountQueued() (int, error) {
+	var count int
+	err := r.db.QueryRow("SELECT COUNT(*) FROM jobs WHERE status = ?", models.JobQueued).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count queued jobs: %w", err)
+	}
+	return count, nil
+}
+
+// ClaimNext marks the oldest queued job that is due as running and returns it with its
+// payload, or nil when none is due. The claim is a single statement, so two workers never
+// claim the same job.
+func (r *jobRepository) ClaimNext(now time.Time) (*models.Job, error) {
+	query := `
+		UPDATE jobs SET status = ?, attempts = attempts + 1, started_at = ?
+		WHERE id = (
+			SELECT id FROM jobs WHERE status = ? AND run_after <= ? ORDER BY id LIMIT 1
+		)
+		RETURNING ` + jobSelectList + `, payload`
+
+	var payload sql.NullString
+	job, err := scanJob(r.db.QueryRow(query, models.JobRunning, now, models.JobQueued, now), &payload)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim job: %w", err)
+	}
+	job.Payload = json.RawMessage(payload.String)
+
+	return job, nil
+}
+
+// SaveProgress records a running job's progress and reports whether it has been asked to cancel
+func (r *jobRepository) SaveProgress(job *models.Job) (bool, error) {
+	jobErrors, err := json.Marshal(job.Errors)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode job errors: %w", err)
+	}
+
+	query := `
+		UPDATE jobs SET processed = ?, result = ?, errors = ?
+		WHERE id = ?
+		RETURNING cancel_requested
+	`
+
+	var cancelRequested bool
+	err = r.db.QueryRow(query, job.Processed, nullableJSON(job.Result), string(jobErrors), job.ID).Scan(&cancelRequested)
+	if err != nil {
+		return false, fmt.Errorf("failed to save job progress: %w", err)
+	}
+
+	return cancelRequested, nil
+}
+
+// Update saves a job's state after an attempt. The payload is dropped once the job is finished.
+func (r *jobRepository) Update(job *models.Job) error {
+	jobErrors, err := json.Marshal(job.Errors)
+	if err != nil {
+		return fmt.Errorf("failed to encode job errors: %w", err)
+	}
+
+	query := `
+		UPDATE jobs
+		SET status = ?, processed = ?, result = ?, errors = ?, attempts = ?, last_error = ?,
+			run_after = ?, finished_at = ?, payload = CASE WHEN ? THEN NULL ELSE payload END
+		WHERE id = ?
+	`
+
+	_, err = r.db.Exec(query,
+		job.Status, job.Processed, nullableJSON(job.Result), string(jobErrors), job.Attempts,
+		sql.NullString{String: job.LastError, Valid: job.LastError != ""},
+		job.RunAfter, job.FinishedAt, job.Finished(), job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+
+	return nil
+}
+
+// RequestCancel asks a job to stop. A queued job is cancelled at once; a running job is flagged
+// and stops at its next progress save. Finished jobs are left alone.
+func (r *jobRepository) RequestCancel(id int, now time.Time) error {
+	query := `
+		UPDATE jobs
+		SET cancel_requested = 1,
+			status = CASE WHEN status = ? THEN ? ELSE status END,
+			finished_at = CASE WHEN status = ? THEN ? ELSE finished_at END,
+			payload = CASE WHEN status = ? THEN NULL ELSE payload END
+		WHERE id = ? AND status IN (?, ?)
+	`
+
+	_, err := r.db.Exec(query,
+		models.JobQueued, models.JobCancelled,
+		models.JobQueued, now,
+		models.JobQueued,
+		id, models.JobQueued, models.JobRunning,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to cancel job: %w", err)
+	}
+
+	return nil
+}
+
+// RequeueRunning puts jobs left running by a previous process back in the queue, cancels
+// them if they were asked to cancel, or fails them if they have used every attempt, and
+// returns how many were requeued and how many failed. A graceful shutdown puts running jobs
+// back itself, so a job still running here was cut off by a crash and its attempt counts:
+// a job that takes the process down fails after its last attempt rather than looping.
+func (r *jobRepository) RequeueRunning(now time.Time) (int, int, error) {
+	_, err := r.db.Exec(`
+		UPDATE jobs SET status = ?, finished_at = ?, payload = NULL
+		WHERE status = ? AND cancel_requested = 1
+	`, models.JobCancelled, now, models.JobRunning)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to cancel interrupted jobs: %w", err)
+	}
+
+	result, err := r.db.Exec(`
+		UPDATE jobs SET status = ?, finished_at = ?, payload = NULL,
+			last_error = 'the server stopped while the job was running'
+		WHERE status = ? AND attempts >= max_attempts
+	`, models.JobFailed, now, models.JobRunning)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to fail interrupted jobs: %w", err)
+	}
+	failed, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count failed jobs: %w", err)
+	}
+
+	result, err = r.db.Exec(`
+		UPDATE jobs SET status = ?, run_after = ?
+		WHERE status = ?
+	`, models.JobQueued, now, models.JobRunning)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to requeue interrupted jobs: %w", err)
+	}
+
+	requeued, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count requeued jobs: %w", err)
+	}
+
+	return int(requeued), int(failed), nil
+}
+
+// jobScanner is a single row or a result set positioned on a row
+type jobScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanJob reads the jobSelectList of a row, followed by any extra columns
+func scanJob(row jobScanner, extra ...interface{}) (*models.Job, error) {
+	var job models.Job
+	var result, jobErrors, lastError sql.NullString
+	dest := append(jobColumns.targets(&job), &result, &jobErrors, &lastError)
+	dest = append(dest, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	if result.Valid {
+		job.Result = json.RawMessage(result.String)
+	}
+	job.Errors = []*models.JobError{}
+	if jobErrors.Valid {
+		if err := json.Unmarshal([]byte(jobErrors.String), &job.Errors); err != nil {
+			return nil, fmt.Errorf("failed to decode job errors: %w", err)
+		}
+	}
+	job.LastError = lastError.String
+
+	return &job, nil
+}
+
+// nullableJSON stores an empty JSON value as NULL
+func nullableJSON(value json.RawMessage) interface{} {
+	if len(value) == 0 {
+		return nil
+	}
+	return string(value)
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"sync"
+	"time"
+
+	"sports-backend/clock"
+)
+
+// maxLookupCacheEntries bounds a lookup cache. When it fills, expired entries are dropped, and
+// if that frees nothing the cache starts over.
+const maxLookupCacheEntries = 10000
+
+// lookupCache holds values by ID until they expire or are invalidated
+type lookupCache[T any] struct {
+	ttl   time.Duration
+	clock clock.Clock
+
+	mu      sync.Mutex
+	entries map[int]lookupEntry[T]
+}
+
+// lookupEntry is a cached value and when it stops being used
+type lookupEntry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+// newLookupCache creates an empty cache keeping values for ttl
+func newLookupCache[T any](ttl time.Duration, clock clock.Clock) *lookupCache[T] {
+	return &lookupCache[T]{ttl: ttl, clock: clock, entries: make(map[int]lookupEntry[T])}
+}
+
+// get returns the value cached for id, if it hasn't expired
+func (c *lookupCache[T]) get(id int) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[id]
+	if !ok || !c.clock.Now().Before(entry.expires) {
+		var zero T
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// set caches value for id
+func (c *lookupCache[T]) set(id int, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	if len(c.entries) >= maxLookupCacheEntries {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, key)
+			}
+		}
+		if len(c.entries) >= maxLookupCacheEntries {
+			c.entries = make(map[int]lookupEntry[T])
+		}
+	}
+	c.entries[id] = lookupEntry[T]{value: value, expires: now.Add(c.ttl)}
+}
+
+// invalidate drops the values cached for ids
+func (c *lookupCache[T]) invalidate(ids ...int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		delete(c.entries, id)
+	}
+}
+
+// clear drops every cached value
+func (c *lookupCache[T]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[int]lookupEntry[T])
+}
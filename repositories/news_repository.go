@@ -0,0 +1,252 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/news_repository.go -pkg mocks . NewsRepository
+
+// NewsRepository defines the interface for news item data access
+type NewsRepository interface {
+	UpsertMany(items []*models.NewsItem) (int, error)
+	GetByID(id int) (*models.NewsItem, error)
+	List(playerID, teamID int, since *time.Time, limit int) ([]*models.NewsItem, error)
+	Delete(id int) error
+}
+
+// newsRepository implements NewsRepository interface
+type newsRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewNewsRepository creates a new news repository
+func NewNewsRepository(db *TimeoutDB, clock clock.Clock) NewsRepository {
+	return &newsRepository{db: db, clock: clock}
+}
+
+// UpsertMany writes news items in one transaction, replacing those with the same source URL
+// along with their links. It returns how many were new.
+func (r *newsRepository) UpsertMany(items []*models.NewsItem) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	currentTime := r.clock.Now()
+	created := 0
+	for _, item := range items {
+		isNew, err := upsertNewsItem(tx, item, currentTime)
+		if err != nil {
+			return 0, err
+		}
+		if isNew {
+			created++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit news items: %w", err)
+	}
+
+	return created, nil
+}
+
+// upsertNewsItem writes one news item and its links inside the transaction
+func upsertNewsItem(tx *sql.Tx, item *models.NewsItem, currentTime time.Time) (bool, error) {
+	// Publication times are stored in UTC, so they sort and compare as text
+	item.PublishedAt = item.PublishedAt.UTC()
+
+	isNew := false
+	err := tx.QueryRow("SELECT id, created_at FROM news_items WHERE source_url = ?", item.SourceURL).Scan(&item.ID, &item.CreatedAt)
+	switch {
+	case err == sql.ErrNoRows:
+		result, err := tx.Exec(`
+			INSERT INTO news_items (headline, body, source_url, published_at, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, item.Headline, item.Body, item.SourceURL, item.PublishedAt, currentTime, currentTime)
+		if err != nil {
+			return false, fmt.Errorf("failed to create news item: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return false, fmt.Errorf("failed to get news item ID: %w", err)
+		}
+		item.ID = int(id)
+		item.CreatedAt = currentTime
+		isNew = true
+	case err != nil:
+		return false, fmt.Errorf("failed to check existing news item: %w", err)
+	default:
+		_, err := tx.Exec(`
+			UPDATE news_items SET headline = ?, body = ?, published_at = ?, updated_at = ?
+			WHERE id = ?
+		`, item.Headline, item.Body, item.PublishedAt, currentTime, item.ID)
+		if err != nil {
+			return false, fmt.Errorf("failed to update news item: %w", err)
+		}
+		if _, err := tx.Exec("DELETE FROM news_item_players WHERE news_item_id = ?", item.ID); err != nil {
+			return false, fmt.Errorf("failed to unlink news item players: %w", err)
+		}
+		if _, err := tx.Exec("DELETE FROM news_item_teams WHERE news_item_id = ?", item.ID); err != nil {
+			return false, fmt.Errorf("failed to unlink news item teams: %w", err)
+		}
+	}
+	item.UpdatedAt = currentTime
+
+	for _, playerID := range item.PlayerIDs {
+		if _, err := tx.Exec("INSERT INTO news_item_players (news_item_id, player_id) VALUES (?, ?)", item.ID, playerID); err != nil {
+			return false, fmt.Errorf("failed to link news item to player %d: %w", playerID, err)
+		}
+	}
+	for _, teamID := range item.TeamIDs {
+		if _, err := tx.Exec("INSERT INTO news_item_teams (news_item_id, team_id) VALUES (?, ?)", item.ID, teamID); err != nil {
+			return false, fmt.Errorf("failed to link news item to team %d: %w", teamID, err)
+		}
+	}
+
+	return isNew, nil
+}
+
+// newsItemColumns maps the news_items columns to the NewsItem fields, without its links
+var newsItemColumns = newRowMapper[models.NewsItem]()
+
+// GetByID retrieves a news item with its links
+func (r *newsRepository) GetByID(id int) (*models.NewsItem, error) {
+	items, err := r.query("SELECT "+newsItemColumns.selectList("n")+" FROM news_items n WHERE n.id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("news item with ID %d not found", id)
+	}
+	return items[0], nil
+}
+
+// List retrieves the most recently published news items, optionally only those linked to a
+// player or a team, or published at or after since
+func (r *newsRepository) List(playerID, teamID int, since *time.Time, limit int) ([]*models.NewsItem, error) {
+	var conditions []string
+	var args []interface{}
+	if playerID > 0 {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM news_item_players l WHERE l.news_item_id = n.id AND l.player_id = ?)")
+		args = append(args, playerID)
+	}
+	if teamID > 0 {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM news_item_teams l WHERE l.news_item_id = n.id AND l.team_id = ?)")
+		args = append(args, teamID)
+	}
+	if since != nil {
+		conditions = append(conditions, "n.published_at >= ?")
+		args = append(args, since.UTC())
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, limit)
+
+	query := `
+		SELECT ` + newsItemColumns.selectList("n") + `
+		FROM news_items n
+		` + where + `
+		ORDER BY n.published_at DESC, n.id DESC
+		LIMIT ?
+	`
+	return r.query(query, args...)
+}
+
+// Delete removes a news item and its links
+func (r *newsRepository) Delete(id int) error {
+	result, err := r.db.Exec("DELETE FROM news_items WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete news item: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("news item with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// query runs a news item query, scans its rows and loads their links
+func (r *newsRepository) query(query string, args ...interface{}) ([]*models.NewsItem, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query news items: %w", err)
+	}
+	defer rows.Close()
+
+	items := []*models.NewsItem{}
+	byID := make(map[int]*models.NewsItem)
+	for rows.Next() {
+		item := &models.NewsItem{PlayerIDs: []int{}, TeamIDs: []int{}}
+		if err := rows.Scan(newsItemColumns.targets(item)...); err != nil {
+			return nil, fmt.Errorf("failed to scan news item: %w", err)
+		}
+		items = append(items, item)
+		byID[item.ID] = item
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating news items: %w", err)
+	}
+	rows.Close()
+
+	if len(items) == 0 {
+		return items, nil
+	}
+	if err := r.loadLinks(byID, "news_item_players", "player_id", func(item *models.NewsItem, id int) {
+		item.PlayerIDs = append(item.PlayerIDs, id)
+	}); err != nil {
+		return nil, err
+	}
+	if err := r.loadLinks(byID, "news_item_teams", "team_id", func(item *models.NewsItem, id int) {
+		item.TeamIDs = append(item.TeamIDs, id)
+	}); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// loadLinks reads the links of the news items from a link table in one query, passing each
+// linked ID to add in ID order
+func (r *newsRepository) loadLinks(byID map[int]*models.NewsItem, table, column string, add func(*models.NewsItem, int)) error {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(byID)), ", ")
+	args := make([]interface{}, 0, len(byID))
+	for id := range byID {
+		args = append(args, id)
+	}
+
+	query := fmt.Sprintf("SELECT news_item_id, %s FROM %s WHERE news_item_id IN (%s) ORDER BY %s", column, table, placeholders, column)
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query news item links: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var itemID, linkedID int
+		if err := rows.Scan(&itemID, &linkedID); err != nil {
+			return fmt.Errorf("failed to scan news item link: %w", err)
+		}
+		add(byID[itemID], linkedID)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating news item links: %w", err)
+	}
+
+	return nil
+}
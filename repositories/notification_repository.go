@@ -0,0 +1,197 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/notification_repository.go -pkg mocks . NotificationRepository
+
+// NotificationRepository defines the interface for notification subscription data operations
+type NotificationRepository interface {
+	GetByID(id int) (*models.NotificationSubscription, error)
+	GetAll() ([]*models.NotificationSubscription, error)
+	Create(subscription *models.NotificationSubscription) error
+	Update(subscription *models.NotificationSubscription) error
+	Delete(id int) error
+}
+
+// notificationRepository implements NotificationRepository interface
+type notificationRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *TimeoutDB, clock clock.Clock) NotificationRepository {
+	return &notificationRepository{db: db, clock: clock}
+}
+
+// notificationSubscriptionColumns maps the notification_subscriptions columns to the
+// NotificationSubscription fields
+var notificationSubscriptionColumns = newRowMapper[models.NotificationSubscription]()
+
+// notificationSubscriptionSelectList lists the mapped columns followed by the event types, which
+// are stored as JSON
+var notificationSubscriptionSelectList = notificationSubscriptionColumns.columnList() + ", event_types"
+
+// GetByID retrieves a subscription by its ID
+func (r *notificationRepository) GetByID(id int) (*models.NotificationSubscription, error) {
+	query := "SELECT " + notificationSubscriptionSelectList + " FROM notification_subscriptions WHERE id = ?"
+
+	subscription, err := scanNotificationSubscription(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("notification subscription with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to get notification subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// GetAll retrieves all subscriptions, oldest first
+func (r *notificationRepository) GetAll() ([]*models.NotificationSubscription, error) {
+	query := "SELECT " + notificationSubscriptionSelectList + " FROM notification_subscriptions ORDER BY id ASC"
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := []*models.NotificationSubscription{}
+	for rows.Next() {
+		subscription, err := scanNotificationSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notification subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// Create adds a new subscription to the database
+func (r *notificationRepository) Create(subscription *models.NotificationSubscription) error {
+	query := `
+		INSERT INTO notification_subscriptions (name, channel, target, event_types, enabled, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	eventTypes, err := encodeEventTypes(subscription.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(query,
+		subscription.Name, subscription.Channel, subscription.Target, eventTypes,
+		subscription.Enabled, currentTime, currentTime,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create notification subscription: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get notification subscription ID: %w", err)
+	}
+
+	subscription.ID = int(id)
+	subscription.CreatedAt = currentTime
+	subscription.UpdatedAt = currentTime
+
+	return nil
+}
+
+// Update modifies an existing subscription. Its channel can't change.
+func (r *notificationRepository) Update(subscription *models.NotificationSubscription) error {
+	query := `
+		UPDATE notification_subscriptions
+		SET name = ?, target = ?, event_types = ?, enabled = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	eventTypes, err := encodeEventTypes(subscription.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(query,
+		subscription.Name, subscription.Target, eventTypes, subscription.Enabled, currentTime, subscription.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update notification subscription: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("notification subscription with ID %d not found", subscription.ID)
+	}
+
+	subscription.UpdatedAt = currentTime
+	return nil
+}
+
+// Delete removes a subscription from the database
+func (r *notificationRepository) Delete(id int) error {
+	query := "DELETE FROM notification_subscriptions WHERE id = ?"
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete notification subscription: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("notification subscription with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// scanNotificationSubscription scans one subscription row selected with
+// notificationSubscriptionSelectList, decoding its event types
+func scanNotificationSubscription(scanner interface{ Scan(...interface{}) error }) (*models.NotificationSubscription, error) {
+	var subscription models.NotificationSubscription
+	var eventTypes string
+	if err := scanner.Scan(append(notificationSubscriptionColumns.targets(&subscription), &eventTypes)...); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(eventTypes), &subscription.EventTypes); err != nil {
+		return nil, fmt.Errorf("invalid event types for notification subscription %d: %w", subscription.ID, err)
+	}
+	if subscription.EventTypes == nil {
+		subscription.EventTypes = []string{}
+	}
+	return &subscription, nil
+}
+
+// encodeEventTypes encodes a subscription's event types for storage
+func encodeEventTypes(eventTypes []string) (string, error) {
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	encoded, err := json.Marshal(eventTypes)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode event types: %w", err)
+	}
+	return string(encoded), nil
+}
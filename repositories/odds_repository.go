@@ -0,0 +1,170 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/odds_repository.go -pkg mocks . OddsRepository
+
+// OddsRepository defines the interface for betting line data operations
+type OddsRepository interface {
+	GetByGameID(gameID int) ([]*models.GameOdds, error)
+	GetLatestByGameIDs(gameIDs []int) (map[int]*models.GameOdds, error)
+	Create(odds *models.GameOdds) error
+	CreateMany(oddsList []*models.GameOdds) error
+}
+
+// insertOddsQuery adds one betting line
+const insertOddsQuery = `
+	INSERT INTO game_odds (game_id, spread, total, home_moneyline, away_moneyline,
+	                       source, captured_at, created_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+// oddsColumns maps the game_odds columns to the GameOdds fields
+var oddsColumns = newRowMapper[models.GameOdds]()
+
+// oddsRepository implements OddsRepository interface
+type oddsRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewOddsRepository creates a new odds repository
+func NewOddsRepository(db *TimeoutDB, clock clock.Clock) OddsRepository {
+	return &oddsRepository{db: db, clock: clock}
+}
+
+// GetByGameID retrieves the full line history for a game, newest first
+func (r *oddsRepository) GetByGameID(gameID int) ([]*models.GameOdds, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM game_odds o
+		WHERE o.game_id = ?
+		ORDER BY o.captured_at DESC, o.id DESC
+	`, oddsColumns.selectList("o"))
+
+	rows, err := r.db.Query(query, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query game odds: %w", err)
+	}
+	defer rows.Close()
+
+	var oddsList []*models.GameOdds
+	for rows.Next() {
+		var odds models.GameOdds
+		if err := rows.Scan(oddsColumns.targets(&odds)...); err != nil {
+			return nil, fmt.Errorf("failed to scan game odds: %w", err)
+		}
+		oddsList = append(oddsList, &odds)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating game odds: %w", err)
+	}
+
+	return oddsList, nil
+}
+
+// GetLatestByGameIDs retrieves the most recently captured line for each of the games
+func (r *oddsRepository) GetLatestByGameIDs(gameIDs []int) (map[int]*models.GameOdds, error) {
+	latest := make(map[int]*models.GameOdds)
+	if len(gameIDs) == 0 {
+		return latest, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(gameIDs)), ", ")
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY game_id ORDER BY captured_at DESC, id DESC) AS rank
+			FROM game_odds
+			WHERE game_id IN (%s)
+		) o
+		WHERE o.rank = 1
+	`, oddsColumns.selectList("o"), placeholders)
+
+	args := make([]interface{}, len(gameIDs))
+	for i, id := range gameIDs {
+		args[i] = id
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query latest game odds: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var odds models.GameOdds
+		if err := rows.Scan(oddsColumns.targets(&odds)...); err != nil {
+			return nil, fmt.Errorf("failed to scan game odds: %w", err)
+		}
+		latest[odds.GameID] = &odds
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating game odds: %w", err)
+	}
+
+	return latest, nil
+}
+
+// Create adds a new betting line to the database
+func (r *oddsRepository) Create(odds *models.GameOdds) error {
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(insertOddsQuery,
+		odds.GameID, odds.Spread, odds.Total, odds.HomeMoneyline, odds.AwayMoneyline,
+		odds.Source, odds.CapturedAt, currentTime,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create game odds: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get game odds ID: %w", err)
+	}
+
+	odds.ID = int(id)
+	odds.CreatedAt = currentTime
+
+	return nil
+}
+
+// CreateMany adds several betting lines in a single transaction
+func (r *oddsRepository) CreateMany(oddsList []*models.GameOdds) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	currentTime := r.clock.Now()
+	for _, odds := range oddsList {
+		result, err := tx.Exec(insertOddsQuery,
+			odds.GameID, odds.Spread, odds.Total, odds.HomeMoneyline, odds.AwayMoneyline,
+			odds.Source, odds.CapturedAt, currentTime,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create game odds: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get game odds ID: %w", err)
+		}
+		odds.ID = int(id)
+		odds.CreatedAt = currentTime
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit game odds: %w", err)
+	}
+
+	return nil
+}
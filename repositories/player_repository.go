@@ -3,50 +3,57 @@ package repositories
 import (
 	"database/sql"
 	"fmt"
-	"time"
+	"strings"
 
+	"sports-backend/clock"
 	"sports-backend/models"
 )
 
+//go:generate moq -out mocks/player_repository.go -pkg mocks . PlayerRepository
+
 // PlayerRepository defines the interface for player data operations
 type PlayerRepository interface {
 	GetByID(id int) (*models.Player, error)
 	GetAll() ([]*models.Player, error)
+	GetAllByADP(season, format string) ([]*models.Player, error)
 	GetByTeamID(teamID int) ([]*models.Player, error)
+	SearchByName(query string, limit int) ([]*models.Player, error)
 	Create(player *models.Player) error
 	Update(player *models.Player) error
 	Delete(id int) error
+	Restore(id int) error
+	Purge(id int) error
 	Exists(id int) (bool, error)
+	ExistsMany(ids []int) (map[int]bool, error)
+	Merge(keepID, duplicateID int) (*models.PlayerMergeResult, error)
+	ApplyInjuryChanges(changes []*models.InjuryChange) error
 }
 
+// playerColumns maps the players columns to the Player fields
+var playerColumns = newRowMapper[models.Player]()
+
 // playerRepository implements PlayerRepository interface
 type playerRepository struct {
-	db *sql.DB
+	db    *TimeoutDB
+	clock clock.Clock
 }
 
 // NewPlayerRepository creates a new player repository
-func NewPlayerRepository(db *sql.DB) PlayerRepository {
-	return &playerRepository{db: db}
+func NewPlayerRepository(db *TimeoutDB, clock clock.Clock) PlayerRepository {
+	return &playerRepository{db: db, clock: clock}
 }
 
 // GetByID retrieves a player by their ID
 func (r *playerRepository) GetByID(id int) (*models.Player, error) {
-	query := `
-		SELECT p.id, p.team_id, p.first_name, p.last_name, p.position, 
-		       p.jersey_number, p.height, p.weight, p.created_at, p.updated_at,
-		       t.name as team_name, t.city as team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM players p
 		JOIN teams t ON p.team_id = t.id
-		WHERE p.id = ?
-	`
+		WHERE p.id = ? AND p.deleted_at IS NULL
+	`, playerColumns.selectList("p"))
 
 	var player models.Player
-	var teamName, teamCity string
-	err := r.db.QueryRow(query, id).Scan(
-		&player.ID, &player.TeamID, &player.FirstName, &player.LastName, &player.Position,
-		&player.JerseyNumber, &player.Height, &player.Weight, &player.CreatedAt, &player.UpdatedAt,
-		&teamName, &teamCity,
-	)
+	err := r.db.QueryRow(query, id).Scan(playerColumns.targets(&player)...)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -60,14 +67,13 @@ func (r *playerRepository) GetByID(id int) (*models.Player, error) {
 
 // GetAll retrieves all players
 func (r *playerRepository) GetAll() ([]*models.Player, error) {
-	query := `
-		SELECT p.id, p.team_id, p.first_name, p.last_name, p.position, 
-		       p.jersey_number, p.height, p.weight, p.created_at, p.updated_at,
-		       t.name as team_name, t.city as team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM players p
 		JOIN teams t ON p.team_id = t.id
+		WHERE p.deleted_at IS NULL
 		ORDER BY p.last_name ASC, p.first_name ASC
-	`
+	`, playerColumns.selectList("p"))
 
 	rows, err := r.db.Query(query)
 	if err != nil {
@@ -75,16 +81,47 @@ func (r *playerRepository) GetAll() ([]*models.Player, error) {
 	}
 	defer rows.Close()
 
+	return scanPlayers(rows)
+}
+
+// GetAllByADP retrieves all players ordered by their consensus ADP, the average across
+// sources, in the format and season. An empty season uses the latest season with ADP in the
+// format. Players without ADP follow, by name.
+func (r *playerRepository) GetAllByADP(season, format string) ([]*models.Player, error) {
+	seasonExpr := "?"
+	args := []interface{}{format}
+	if season == "" {
+		seasonExpr = "(SELECT MAX(season) FROM adp WHERE format = ?)"
+		args = append(args, format)
+	} else {
+		args = append(args, season)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, a.adp
+		FROM players p
+		JOIN teams t ON p.team_id = t.id
+		LEFT JOIN (
+			SELECT player_id, ROUND(AVG(adp), 2) AS adp
+			FROM adp
+			WHERE format = ? AND season = %s
+			GROUP BY player_id
+		) a ON a.player_id = p.id
+		WHERE p.deleted_at IS NULL
+		ORDER BY a.adp ASC NULLS LAST, p.last_name ASC, p.first_name ASC
+	`, playerColumns.selectList("p"), seasonExpr)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query players: %w", err)
+	}
+	defer rows.Close()
+
 	var players []*models.Player
 	for rows.Next() {
 		var player models.Player
-		var teamName, teamCity string
-		err := rows.Scan(
-			&player.ID, &player.TeamID, &player.FirstName, &player.LastName, &player.Position,
-			&player.JerseyNumber, &player.Height, &player.Weight, &player.CreatedAt, &player.UpdatedAt,
-			&teamName, &teamCity,
-		)
-		if err != nil {
+		targets := append(playerColumns.targets(&player), &player.ADP)
+		if err := rows.Scan(targets...); err != nil {
 			return nil, fmt.Errorf("failed to scan player: %w", err)
 		}
 		players = append(players, &player)
@@ -99,15 +136,13 @@ func (r *playerRepository) GetAll() ([]*models.Player, error) {
 
 // GetByTeamID retrieves all players for a specific team
 func (r *playerRepository) GetByTeamID(teamID int) ([]*models.Player, error) {
-	query := `
-		SELECT p.id, p.team_id, p.first_name, p.last_name, p.position, 
-		       p.jersey_number, p.height, p.weight, p.created_at, p.updated_at,
-		       t.name as team_name, t.city as team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM players p
 		JOIN teams t ON p.team_id = t.id
-		WHERE p.team_id = ?
+		WHERE p.team_id = ? AND p.deleted_at IS NULL
 		ORDER BY p.position ASC, p.jersey_number ASC
-	`
+	`, playerColumns.selectList("p"))
 
 	rows, err := r.db.Query(query, teamID)
 	if err != nil {
@@ -115,41 +150,90 @@ func (r *playerRepository) GetByTeamID(teamID int) ([]*models.Player, error) {
 	}
 	defer rows.Close()
 
+	return scanPlayers(rows)
+}
+
+// SearchByName retrieves players whose first or last name starts with the query.
+// A query containing a space matches "first last" prefixes instead. Matches are ranked before
+// the limit applies, so an exact match is kept however many players sort before it: exact full
+// or last names first, then full name prefixes, last name prefixes and first name prefixes.
+func (r *playerRepository) SearchByName(query string, limit int) ([]*models.Player, error) {
+	where := `(p.first_name LIKE ? ESCAPE '\' OR p.last_name LIKE ? ESCAPE '\')`
+	args := []interface{}{prefixPattern(query), prefixPattern(query)}
+
+	first, last, spaced := strings.Cut(query, " ")
+	if spaced {
+		where = `p.first_name LIKE ? ESCAPE '\' AND p.last_name LIKE ? ESCAPE '\'`
+		args = []interface{}{prefixPattern(first), prefixPattern(strings.TrimSpace(last))}
+	}
+
+	fullName := `p.first_name || ' ' || p.last_name`
+	rank, rankArgs := searchRank(query, spaced, fullName, "p.last_name", "p.first_name")
+	sqlQuery := fmt.Sprintf(`
+		SELECT %s
+		FROM players p
+		WHERE %s AND p.deleted_at IS NULL
+		ORDER BY %s, p.last_name ASC, p.first_name ASC
+		LIMIT ?
+	`, playerColumns.selectList("p"), where, rank)
+	args = append(append(args, rankArgs...), limit)
+
+	rows, err := r.db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search players: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPlayers(rows)
+}
+
+// scanPlayers reads every row of a query selecting playerColumns
+func scanPlayers(rows *Rows) ([]*models.Player, error) {
 	var players []*models.Player
 	for rows.Next() {
 		var player models.Player
-		var teamName, teamCity string
-		err := rows.Scan(
-			&player.ID, &player.TeamID, &player.FirstName, &player.LastName, &player.Position,
-			&player.JerseyNumber, &player.Height, &player.Weight, &player.CreatedAt, &player.UpdatedAt,
-			&teamName, &teamCity,
-		)
-		if err != nil {
+		if err := rows.Scan(playerColumns.targets(&player)...); err != nil {
 			return nil, fmt.Errorf("failed to scan player: %w", err)
 		}
 		players = append(players, &player)
 	}
 
-	if err = rows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating players: %w", err)
 	}
 
 	return players, nil
 }
 
-// Create adds a new player to the database
+// Create adds a new player to the database, with the provider IDs in its ExternalIDs, in one
+// transaction
 func (r *playerRepository) Create(player *models.Player) error {
 	query := `
-		INSERT INTO players (team_id, first_name, last_name, position, jersey_number, height, weight, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO players (team_id, first_name, last_name, position, jersey_number, height, weight,
+		                     birth_date, college, years_experience, headshot_url, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	currentTime := time.Now()
-	result, err := r.db.Exec(query,
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkJerseyAvailable(tx, player.TeamID, player.JerseyNumber, 0); err != nil {
+		return err
+	}
+
+	currentTime := r.clock.Now()
+	result, err := tx.Exec(query,
 		player.TeamID, player.FirstName, player.LastName, player.Position,
-		player.JerseyNumber, player.Height, player.Weight, currentTime, currentTime,
+		player.JerseyNumber, player.Height, player.Weight, player.BirthDate, player.College,
+		player.YearsExperience, player.HeadshotURL, currentTime, currentTime,
 	)
 	if err != nil {
+		if conflict := uniqueViolation(err, "player"); conflict != nil {
+			return conflict
+		}
 		return fmt.Errorf("failed to create player: %w", err)
 	}
 
@@ -157,6 +241,13 @@ func (r *playerRepository) Create(player *models.Player) error {
 	if err != nil {
 		return fmt.Errorf("failed to get player ID: %w", err)
 	}
+	if err := insertExternalIDs(tx, "player", int(id), player.ExternalIDs, currentTime); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
 	player.ID = int(id)
 	player.CreatedAt = currentTime
@@ -170,36 +261,50 @@ func (r *playerRepository) Update(player *models.Player) error {
 	query := `
 		UPDATE players 
 		SET team_id = ?, first_name = ?, last_name = ?, position = ?, 
-		    jersey_number = ?, height = ?, weight = ?, updated_at = ?
-		WHERE id = ?
+		    jersey_number = ?, height = ?, weight = ?, birth_date = ?, college = ?,
+		    years_experience = ?, headshot_url = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	currentTime := time.Now()
-	result, err := r.db.Exec(query,
-		player.TeamID, player.FirstName, player.LastName, player.Position,
-		player.JerseyNumber, player.Height, player.Weight, currentTime, player.ID,
-	)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to update player: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	if err := checkJerseyAvailable(tx, player.TeamID, player.JerseyNumber, player.ID); err != nil {
+		return err
+	}
+
+	currentTime := r.clock.Now()
+	rowsAffected, err := execRowsAffected(tx, query,
+		player.TeamID, player.FirstName, player.LastName, player.Position,
+		player.JerseyNumber, player.Height, player.Weight, player.BirthDate, player.College,
+		player.YearsExperience, player.HeadshotURL, currentTime, player.ID,
+	)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		if conflict := uniqueViolation(err, "player"); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to update player: %w", err)
 	}
 
 	if rowsAffected == 0 {
 		return fmt.Errorf("player with ID %d not found", player.ID)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	player.UpdatedAt = currentTime
 	return nil
 }
 
-// Delete removes a player from the database
+// Delete soft-deletes a player so it can later be restored
 func (r *playerRepository) Delete(id int) error {
-	query := "DELETE FROM players WHERE id = ?"
-	result, err := r.db.Exec(query, id)
+	query := "UPDATE players SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL"
+	result, err := r.db.Exec(query, r.clock.Now(), id)
 	if err != nil {
 		return fmt.Errorf("failed to delete player: %w", err)
 	}
@@ -216,9 +321,87 @@ func (r *playerRepository) Delete(id int) error {
 	return nil
 }
 
+// Restore brings back a soft-deleted player
+func (r *playerRepository) Restore(id int) error {
+	query := "UPDATE players SET deleted_at = NULL, updated_at = ? WHERE id = ? AND deleted_at IS NOT NULL"
+	result, err := r.db.Exec(query, r.clock.Now(), id)
+	if err != nil {
+		if conflict := uniqueViolation(err, "player"); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to restore player: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("deleted player with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// Purge permanently removes a soft-deleted player along with its stats, projections, ADP,
+// DFS salaries, external IDs, injury history, advanced stats and news links
+func (r *playerRepository) Purge(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkPurgeable(tx, "players", "player", id); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM player_stats WHERE player_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete player stats: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM external_ids WHERE entity_type = 'player' AND entity_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete player external IDs: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM projections WHERE player_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete player projections: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM adp WHERE player_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete player ADP: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM dfs_salaries WHERE player_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete player DFS salaries: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM player_injury_changes WHERE player_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete player injury history: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM news_item_players WHERE player_id = ?", id); err != nil {
+		return fmt.Errorf("failed to unlink player news: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM player_advanced_stats WHERE player_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete player advanced stats: %w", err)
+	}
+	if _, err := tx.Exec("UPDATE draft_picks SET player_id = NULL, updated_at = ? WHERE player_id = ?", r.clock.Now(), id); err != nil {
+		return fmt.Errorf("failed to release draft pick: %w", err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM players WHERE id = ?", id); err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("player with ID %d cannot be purged because other records still reference it", id)
+		}
+		return fmt.Errorf("failed to purge player: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 // Exists checks if a player exists by ID
 func (r *playerRepository) Exists(id int) (bool, error) {
-	query := "SELECT 1 FROM players WHERE id = ? LIMIT 1"
+	query := "SELECT 1 FROM players WHERE id = ? AND deleted_at IS NULL LIMIT 1"
 	var exists int
 	err := r.db.QueryRow(query, id).Scan(&exists)
 	if err != nil {
@@ -229,3 +412,290 @@ func (r *playerRepository) Exists(id int) (bool, error) {
 	}
 	return true, nil
 }
+
+// ExistsMany reports which of the players exist, checking them in batches rather than one query
+// each. IDs that don't exist are absent from the result.
+func (r *playerRepository) ExistsMany(ids []int) (map[int]bool, error) {
+	found, err := existsMany(r.db, "players", ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check player existence: %w", err)
+	}
+	return found, nil
+}
+
+// Merge folds the duplicate player into the kept one in a single transaction. Stats,
+// provider IDs and the draft pick are re-pointed to the kept player unless it already
+// has its own for the same game or provider, empty fields of the kept player are
+// filled from the duplicate, and the duplicate is deleted.
+func (r *playerRepository) Merge(keepID, duplicateID int) (*models.PlayerMergeResult, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, id := range []int{keepID, duplicateID} {
+		var exists int
+		if err := tx.QueryRow("SELECT 1 FROM players WHERE id = ? AND deleted_at IS NULL", id).Scan(&exists); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("player with ID %d not found", id)
+			}
+			return nil, fmt.Errorf("failed to check player existence: %w", err)
+		}
+	}
+
+	result := &models.PlayerMergeResult{MergedPlayerID: duplicateID}
+	currentTime := r.clock.Now()
+
+	// Stats: the kept player's line wins when both played in the same game
+	discarded, err := execRowsAffected(tx, `
+		DELETE FROM player_stats
+		WHERE player_id = ? AND game_id IN (SELECT game_id FROM player_stats WHERE player_id = ?)
+	`, duplicateID, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard overlapping stats: %w", err)
+	}
+	result.StatsDiscarded = discarded
+
+	moved, err := execRowsAffected(tx, "UPDATE player_stats SET player_id = ?, updated_at = ? WHERE player_id = ?",
+		keepID, currentTime, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move stats: %w", err)
+	}
+	result.StatsMoved = moved
+
+	// Projections: the kept player's projection wins when a source projected both for the same week
+	discarded, err = execRowsAffected(tx, `
+		DELETE FROM projections
+		WHERE player_id = ? AND EXISTS (
+			SELECT 1 FROM projections kept
+			WHERE kept.player_id = ? AND kept.season = projections.season
+			  AND kept.week = projections.week AND kept.source = projections.source
+		)
+	`, duplicateID, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard overlapping projections: %w", err)
+	}
+	result.ProjectionsDiscarded = discarded
+
+	moved, err = execRowsAffected(tx, "UPDATE projections SET player_id = ?, updated_at = ? WHERE player_id = ?",
+		keepID, currentTime, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move projections: %w", err)
+	}
+	result.ProjectionsMoved = moved
+
+	// ADP: the kept player's value wins when a source ranked both for the same season and format
+	discarded, err = execRowsAffected(tx, `
+		DELETE FROM adp
+		WHERE player_id = ? AND EXISTS (
+			SELECT 1 FROM adp kept
+			WHERE kept.player_id = ? AND kept.season = adp.season
+			  AND kept.format = adp.format AND kept.source = adp.source
+		)
+	`, duplicateID, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard overlapping ADP: %w", err)
+	}
+	result.ADPDiscarded = discarded
+
+	moved, err = execRowsAffected(tx, "UPDATE adp SET player_id = ?, updated_at = ? WHERE player_id = ?",
+		keepID, currentTime, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move ADP: %w", err)
+	}
+	result.ADPMoved = moved
+
+	// DFS salaries: the kept player's salary wins when a site priced both for the same week
+	discarded, err = execRowsAffected(tx, `
+		DELETE FROM dfs_salaries
+		WHERE player_id = ? AND EXISTS (
+			SELECT 1 FROM dfs_salaries kept
+			WHERE kept.player_id = ? AND kept.season = dfs_salaries.season
+			  AND kept.week = dfs_salaries.week AND kept.site = dfs_salaries.site
+		)
+	`, duplicateID, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard overlapping DFS salaries: %w", err)
+	}
+	result.SalariesDiscarded = discarded
+
+	moved, err = execRowsAffected(tx, "UPDATE dfs_salaries SET player_id = ?, updated_at = ? WHERE player_id = ?",
+		keepID, currentTime, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move DFS salaries: %w", err)
+	}
+	result.SalariesMoved = moved
+
+	// Provider IDs: the kept player's ID wins when both have one from the same provider
+	discarded, err = execRowsAffected(tx, `
+		DELETE FROM external_ids
+		WHERE entity_type = 'player' AND entity_id = ?
+		  AND provider IN (SELECT provider FROM external_ids WHERE entity_type = 'player' AND entity_id = ?)
+	`, duplicateID, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard overlapping external IDs: %w", err)
+	}
+	result.ExternalIDsDiscarded = discarded
+
+	moved, err = execRowsAffected(tx, "UPDATE external_ids SET entity_id = ? WHERE entity_type = 'player' AND entity_id = ?",
+		keepID, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move external IDs: %w", err)
+	}
+	result.ExternalIDsMoved = moved
+
+	moved, err = execRowsAffected(tx, "UPDATE player_injury_changes SET player_id = ? WHERE player_id = ?", keepID, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move injury history: %w", err)
+	}
+	result.InjuryChangesMoved = moved
+
+	// Advanced stats: the kept player's row wins when both have one for the same game
+	discarded, err = execRowsAffected(tx, `
+		DELETE FROM player_advanced_stats
+		WHERE player_id = ? AND game_id IN (SELECT game_id FROM player_advanced_stats WHERE player_id = ?)
+	`, duplicateID, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard overlapping advanced stats: %w", err)
+	}
+	result.AdvancedStatsDiscarded = discarded
+
+	moved, err = execRowsAffected(tx, "UPDATE player_advanced_stats SET player_id = ?, updated_at = ? WHERE player_id = ?",
+		keepID, currentTime, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move advanced stats: %w", err)
+	}
+	result.AdvancedStatsMoved = moved
+
+	// News: items linked to both players stay linked to the kept player once
+	moved, err = execRowsAffected(tx, "UPDATE OR IGNORE news_item_players SET player_id = ? WHERE player_id = ?", keepID, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move news links: %w", err)
+	}
+	result.NewsMoved = moved
+	if _, err := tx.Exec("DELETE FROM news_item_players WHERE player_id = ?", duplicateID); err != nil {
+		return nil, fmt.Errorf("failed to unlink duplicate player news: %w", err)
+	}
+
+	// Draft pick: moved unless the kept player already has one, in which case the
+	// duplicate's pick is kept as a slot without a player
+	moved, err = execRowsAffected(tx, `
+		UPDATE draft_picks SET player_id = ?, updated_at = ?
+		WHERE player_id = ? AND NOT EXISTS (SELECT 1 FROM draft_picks WHERE player_id = ?)
+	`, keepID, currentTime, duplicateID, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move draft pick: %w", err)
+	}
+	result.DraftPickMoved = moved > 0
+
+	if _, err := tx.Exec("UPDATE draft_picks SET player_id = NULL, updated_at = ? WHERE player_id = ?", currentTime, duplicateID); err != nil {
+		return nil, fmt.Errorf("failed to release draft pick: %w", err)
+	}
+
+	// Read the duplicate's details, then remove it so its jersey number and name no
+	// longer count against the kept player
+	var duplicate models.Player
+	query := "SELECT " + playerColumns.columnList() + " FROM players WHERE id = ?"
+	err = tx.QueryRow(query, duplicateID).Scan(playerColumns.targets(&duplicate)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read duplicate player: %w", err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM players WHERE id = ?", duplicateID); err != nil {
+		return nil, fmt.Errorf("failed to delete duplicate player: %w", err)
+	}
+
+	// Fill empty fields of the kept player from the duplicate. The jersey number only
+	// carries over when nobody on the kept player's team already wears it.
+	_, err = tx.Exec(`
+		UPDATE players SET
+			jersey_number = COALESCE(jersey_number, (
+				SELECT ? WHERE NOT EXISTS (
+					SELECT 1 FROM players o
+					WHERE o.team_id = players.team_id AND o.jersey_number = ? AND o.deleted_at IS NULL
+				)
+			)),
+			height = COALESCE(height, ?),
+			weight = COALESCE(weight, ?),
+			birth_date = COALESCE(birth_date, ?),
+			college = COALESCE(college, ?),
+			years_experience = COALESCE(years_experience, ?),
+			headshot_url = COALESCE(headshot_url, ?),
+			updated_at = ?
+		WHERE id = ?
+	`, duplicate.JerseyNumber, duplicate.JerseyNumber, duplicate.Height, duplicate.Weight, duplicate.BirthDate,
+		duplicate.College, duplicate.YearsExperience, duplicate.HeadshotURL, currentTime, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge player details: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit player merge: %w", err)
+	}
+
+	return result, nil
+}
+
+// checkJerseyAvailable fails with a ConflictError when another active player on the team
+// already wears the jersey number
+func checkJerseyAvailable(tx *sql.Tx, teamID int, jerseyNumber *int, playerID int) error {
+	if jerseyNumber == nil {
+		return nil
+	}
+
+	query := `
+		SELECT 1 FROM players
+		WHERE team_id = ? AND jersey_number = ? AND id != ? AND deleted_at IS NULL
+		LIMIT 1
+	`
+	var exists int
+	err := tx.QueryRow(query, teamID, *jerseyNumber, playerID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check jersey number: %w", err)
+	}
+
+	return &models.ConflictError{Entity: "player", Fields: []string{"team_id", "jersey_number"}}
+}
+
+// ApplyInjuryChanges sets each changed player's designation and injury and records the changes,
+// all in one transaction
+func (r *playerRepository) ApplyInjuryChanges(changes []*models.InjuryChange) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	currentTime := r.clock.Now()
+	for _, change := range changes {
+		_, err := tx.Exec("UPDATE players SET injury_status = ?, injury = ?, updated_at = ? WHERE id = ?",
+			change.Status, change.Injury, currentTime, change.PlayerID)
+		if err != nil {
+			return fmt.Errorf("failed to update player injury: %w", err)
+		}
+
+		change.ChangedAt = currentTime
+		result, err := tx.Exec(`
+			INSERT INTO player_injury_changes (player_id, season, week, previous_status, status, injury, changed_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			change.PlayerID, change.Season, change.Week, change.PreviousStatus, change.Status, change.Injury, change.ChangedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record injury change: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get injury change ID: %w", err)
+		}
+		change.ID = int(id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
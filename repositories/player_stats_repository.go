@@ -3,76 +3,72 @@ package repositories
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
+	"sports-backend/clock"
 	"sports-backend/models"
 )
 
+//go:generate moq -out mocks/player_stats_repository.go -pkg mocks . PlayerStatsRepository
+
 // PlayerStatsRepository defines the interface for player stats data operations
 type PlayerStatsRepository interface {
 	GetByID(id int) (*models.PlayerStats, error)
 	GetAll() ([]*models.PlayerStats, error)
 	GetByPlayerID(playerID int) ([]*models.PlayerStats, error)
 	GetByGameID(gameID int) ([]*models.PlayerStats, error)
+	GetByPlayerIDs(playerIDs []int) (map[int][]*models.PlayerStats, error)
+	GetByGameIDs(gameIDs []int) (map[int][]*models.PlayerStats, error)
+	GetPage(after *models.PageCursor, limit int) ([]*models.PlayerStats, error)
 	GetByPlayerAndGame(playerID, gameID int) (*models.PlayerStats, error)
+	GetByWeek(season string, week int) ([]*models.PlayerStats, error)
+	GetByWeekWithPlayers(season string, week int) ([]*models.PlayerStats, map[int]*models.Player, error)
+	ForEachBySeason(season string, fn func(*models.PlayerStats) error) error
 	Create(stats *models.PlayerStats) error
 	Update(stats *models.PlayerStats) error
+	UpdateMany(statsList []*models.PlayerStats) error
+	Upsert(stats *models.PlayerStats) (bool, error)
+	UpsertMany(statsList []*models.PlayerStats) (int, error)
 	Delete(id int) error
 	Exists(id int) (bool, error)
 	ExistsByPlayerAndGame(playerID, gameID int) (bool, error)
 }
 
+// playerStatsColumns maps the player_stats columns to the PlayerStats fields, so adding a stat
+// column only needs the field and its migration
+var playerStatsColumns = newRowMapper[models.PlayerStats]()
+
+var (
+	// playerStatsInsertColumns are written when a stat line is created
+	playerStatsInsertColumns = playerStatsColumns.columnsExcept("id")
+	// playerStatsUpdateColumns are written when a stat line is replaced
+	playerStatsUpdateColumns = playerStatsColumns.columnsExcept("id", "player_id", "game_id", "created_at")
+)
+
 // playerStatsRepository implements PlayerStatsRepository interface
 type playerStatsRepository struct {
-	db *sql.DB
+	db    *TimeoutDB
+	clock clock.Clock
 }
 
 // NewPlayerStatsRepository creates a new player stats repository
-func NewPlayerStatsRepository(db *sql.DB) PlayerStatsRepository {
-	return &playerStatsRepository{db: db}
+func NewPlayerStatsRepository(db *TimeoutDB, clock clock.Clock) PlayerStatsRepository {
+	return &playerStatsRepository{db: db, clock: clock}
 }
 
 // GetByID retrieves player stats by ID
 func (r *playerStatsRepository) GetByID(id int) (*models.PlayerStats, error) {
-	query := `
-		SELECT ps.id, ps.player_id, ps.game_id,
-		       ps.passing_attempts, ps.passing_completions, ps.passing_yards, ps.passing_touchdowns, ps.passing_interceptions,
-		       ps.rushing_attempts, ps.rushing_yards, ps.rushing_touchdowns,
-		       ps.receiving_targets, ps.receptions, ps.receiving_yards, ps.receiving_touchdowns,
-		       ps.fumbles, ps.fumbles_lost,
-		       ps.tackles, ps.solo_tackles, ps.assisted_tackles, ps.sacks, ps.defensive_interceptions,
-		       ps.pass_deflections, ps.forced_fumbles, ps.fumble_recoveries, ps.defensive_touchdowns,
-		       ps.field_goals_attempted, ps.field_goals_made, ps.extra_points_attempted, ps.extra_points_made,
-		       ps.punts, ps.punt_yards, ps.kick_returns, ps.kick_return_yards, ps.kick_return_touchdowns,
-		       ps.punt_returns, ps.punt_return_yards, ps.punt_return_touchdowns,
-		       ps.created_at, ps.updated_at,
-		       p.first_name, p.last_name, p.position, p.jersey_number,
-		       t.name as team_name, t.city as team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM player_stats ps
 		JOIN players p ON ps.player_id = p.id
 		JOIN teams t ON p.team_id = t.id
 		WHERE ps.id = ?
-	`
+	`, playerStatsColumns.selectList("ps"))
 
 	var stats models.PlayerStats
-	var firstName, lastName, position, teamName, teamCity string
-	var jerseyNumber *int
-
-	err := r.db.QueryRow(query, id).Scan(
-		&stats.ID, &stats.PlayerID, &stats.GameID,
-		&stats.PassingAttempts, &stats.PassingCompletions, &stats.PassingYards, &stats.PassingTouchdowns, &stats.PassingInterceptions,
-		&stats.RushingAttempts, &stats.RushingYards, &stats.RushingTouchdowns,
-		&stats.ReceivingTargets, &stats.Receptions, &stats.ReceivingYards, &stats.ReceivingTouchdowns,
-		&stats.Fumbles, &stats.FumblesLost,
-		&stats.Tackles, &stats.SoloTackles, &stats.AssistedTackles, &stats.Sacks, &stats.DefensiveInterceptions,
-		&stats.PassDeflections, &stats.ForcedFumbles, &stats.FumbleRecoveries, &stats.DefensiveTouchdowns,
-		&stats.FieldGoalsAttempted, &stats.FieldGoalsMade, &stats.ExtraPointsAttempted, &stats.ExtraPointsMade,
-		&stats.Punts, &stats.PuntYards, &stats.KickReturns, &stats.KickReturnYards, &stats.KickReturnTouchdowns,
-		&stats.PuntReturns, &stats.PuntReturnYards, &stats.PuntReturnTouchdowns,
-		&stats.CreatedAt, &stats.UpdatedAt,
-		&firstName, &lastName, &position, &jerseyNumber, &teamName, &teamCity,
-	)
-
+	err := r.db.QueryRow(query, id).Scan(playerStatsColumns.targets(&stats)...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("player stats with ID %d not found", id)
@@ -85,25 +81,14 @@ func (r *playerStatsRepository) GetByID(id int) (*models.PlayerStats, error) {
 
 // GetAll retrieves all player stats
 func (r *playerStatsRepository) GetAll() ([]*models.PlayerStats, error) {
-	query := `
-		SELECT ps.id, ps.player_id, ps.game_id,
-		       ps.passing_attempts, ps.passing_completions, ps.passing_yards, ps.passing_touchdowns, ps.passing_interceptions,
-		       ps.rushing_attempts, ps.rushing_yards, ps.rushing_touchdowns,
-		       ps.receiving_targets, ps.receptions, ps.receiving_yards, ps.receiving_touchdowns,
-		       ps.fumbles, ps.fumbles_lost,
-		       ps.tackles, ps.solo_tackles, ps.assisted_tackles, ps.sacks, ps.defensive_interceptions,
-		       ps.pass_deflections, ps.forced_fumbles, ps.fumble_recoveries, ps.defensive_touchdowns,
-		       ps.field_goals_attempted, ps.field_goals_made, ps.extra_points_attempted, ps.extra_points_made,
-		       ps.punts, ps.punt_yards, ps.kick_returns, ps.kick_return_yards, ps.kick_return_touchdowns,
-		       ps.punt_returns, ps.punt_return_yards, ps.punt_return_touchdowns,
-		       ps.created_at, ps.updated_at,
-		       p.first_name, p.last_name, p.position, p.jersey_number,
-		       t.name as team_name, t.city as team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM player_stats ps
 		JOIN players p ON ps.player_id = p.id
 		JOIN teams t ON p.team_id = t.id
+		WHERE p.deleted_at IS NULL
 		ORDER BY ps.created_at DESC
-	`
+	`, playerStatsColumns.selectList("ps"))
 
 	rows, err := r.db.Query(query)
 	if err != nil {
@@ -111,61 +96,19 @@ func (r *playerStatsRepository) GetAll() ([]*models.PlayerStats, error) {
 	}
 	defer rows.Close()
 
-	var statsList []*models.PlayerStats
-	for rows.Next() {
-		var stats models.PlayerStats
-		var firstName, lastName, position, teamName, teamCity string
-		var jerseyNumber *int
-
-		err := rows.Scan(
-			&stats.ID, &stats.PlayerID, &stats.GameID,
-			&stats.PassingAttempts, &stats.PassingCompletions, &stats.PassingYards, &stats.PassingTouchdowns, &stats.PassingInterceptions,
-			&stats.RushingAttempts, &stats.RushingYards, &stats.RushingTouchdowns,
-			&stats.ReceivingTargets, &stats.Receptions, &stats.ReceivingYards, &stats.ReceivingTouchdowns,
-			&stats.Fumbles, &stats.FumblesLost,
-			&stats.Tackles, &stats.SoloTackles, &stats.AssistedTackles, &stats.Sacks, &stats.DefensiveInterceptions,
-			&stats.PassDeflections, &stats.ForcedFumbles, &stats.FumbleRecoveries, &stats.DefensiveTouchdowns,
-			&stats.FieldGoalsAttempted, &stats.FieldGoalsMade, &stats.ExtraPointsAttempted, &stats.ExtraPointsMade,
-			&stats.Punts, &stats.PuntYards, &stats.KickReturns, &stats.KickReturnYards, &stats.KickReturnTouchdowns,
-			&stats.PuntReturns, &stats.PuntReturnYards, &stats.PuntReturnTouchdowns,
-			&stats.CreatedAt, &stats.UpdatedAt,
-			&firstName, &lastName, &position, &jerseyNumber, &teamName, &teamCity,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan player stats: %w", err)
-		}
-		statsList = append(statsList, &stats)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating player stats: %w", err)
-	}
-
-	return statsList, nil
+	return scanPlayerStatsList(rows)
 }
 
 // GetByPlayerID retrieves all stats for a specific player
 func (r *playerStatsRepository) GetByPlayerID(playerID int) ([]*models.PlayerStats, error) {
-	query := `
-		SELECT ps.id, ps.player_id, ps.game_id,
-		       ps.passing_attempts, ps.passing_completions, ps.passing_yards, ps.passing_touchdowns, ps.passing_interceptions,
-		       ps.rushing_attempts, ps.rushing_yards, ps.rushing_touchdowns,
-		       ps.receiving_targets, ps.receptions, ps.receiving_yards, ps.receiving_touchdowns,
-		       ps.fumbles, ps.fumbles_lost,
-		       ps.tackles, ps.solo_tackles, ps.assisted_tackles, ps.sacks, ps.defensive_interceptions,
-		       ps.pass_deflections, ps.forced_fumbles, ps.fumble_recoveries, ps.defensive_touchdowns,
-		       ps.field_goals_attempted, ps.field_goals_made, ps.extra_points_attempted, ps.extra_points_made,
-		       ps.punts, ps.punt_yards, ps.kick_returns, ps.kick_return_yards, ps.kick_return_touchdowns,
-		       ps.punt_returns, ps.punt_return_yards, ps.punt_return_touchdowns,
-		       ps.created_at, ps.updated_at,
-		       p.first_name, p.last_name, p.position, p.jersey_number,
-		       t.name as team_name, t.city as team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM player_stats ps
 		JOIN players p ON ps.player_id = p.id
 		JOIN teams t ON p.team_id = t.id
 		WHERE ps.player_id = ?
 		ORDER BY ps.created_at DESC
-	`
+	`, playerStatsColumns.selectList("ps"))
 
 	rows, err := r.db.Query(query, playerID)
 	if err != nil {
@@ -173,142 +116,250 @@ func (r *playerStatsRepository) GetByPlayerID(playerID int) ([]*models.PlayerSta
 	}
 	defer rows.Close()
 
+	return scanPlayerStatsList(rows)
+}
+
+// GetByGameID retrieves all stats for a specific game
+func (r *playerStatsRepository) GetByGameID(gameID int) ([]*models.PlayerStats, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM player_stats ps
+		JOIN players p ON ps.player_id = p.id
+		JOIN teams t ON p.team_id = t.id
+		WHERE ps.game_id = ? AND p.deleted_at IS NULL
+		ORDER BY t.name ASC, p.last_name ASC, p.first_name ASC
+	`, playerStatsColumns.selectList("ps"))
+
+	rows, err := r.db.Query(query, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query player stats by game: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPlayerStatsList(rows)
+}
+
+// GetByPlayerIDs retrieves all stats for each of the players, keyed by player ID, newest first
+func (r *playerStatsRepository) GetByPlayerIDs(playerIDs []int) (map[int][]*models.PlayerStats, error) {
+	statsList, err := r.getByIDsIn("ps.player_id", playerIDs, "ps.created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := make(map[int][]*models.PlayerStats)
+	for _, stats := range statsList {
+		grouped[stats.PlayerID] = append(grouped[stats.PlayerID], stats)
+	}
+	return grouped, nil
+}
+
+// GetByGameIDs retrieves the stats of active players in each of the games, keyed by game ID,
+// in the same order as GetByGameID
+func (r *playerStatsRepository) GetByGameIDs(gameIDs []int) (map[int][]*models.PlayerStats, error) {
+	statsList, err := r.getByIDsIn("ps.game_id", gameIDs, "t.name ASC, p.last_name ASC, p.first_name ASC", "p.deleted_at IS NULL")
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := make(map[int][]*models.PlayerStats)
+	for _, stats := range statsList {
+		grouped[stats.GameID] = append(grouped[stats.GameID], stats)
+	}
+	return grouped, nil
+}
+
+// getByIDsIn retrieves the stats whose column is one of ids and that match every extra condition
+func (r *playerStatsRepository) getByIDsIn(column string, ids []int, orderBy string, conditions ...string) ([]*models.PlayerStats, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	where := append([]string{fmt.Sprintf("%s IN (%s)", column, placeholders)}, conditions...)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM player_stats ps
+		JOIN players p ON ps.player_id = p.id
+		JOIN teams t ON p.team_id = t.id
+		WHERE %s
+		ORDER BY %s
+	`, playerStatsColumns.selectList("ps"), strings.Join(where, " AND "), orderBy)
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query player stats: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPlayerStatsList(rows)
+}
+
+// GetPage retrieves up to limit stat lines of active players, newest first, starting after the
+// cursor position when one is given. Ordering on (created_at, id) keeps pages stable while
+// lines are inserted.
+func (r *playerStatsRepository) GetPage(after *models.PageCursor, limit int) ([]*models.PlayerStats, error) {
+	where := "p.deleted_at IS NULL"
+	args := []interface{}{}
+	if after != nil {
+		where += " AND (ps.created_at < ? OR (ps.created_at = ? AND ps.id < ?))"
+		args = append(args, after.CreatedAt, after.CreatedAt, after.ID)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM player_stats ps
+		JOIN players p ON ps.player_id = p.id
+		WHERE %s
+		ORDER BY ps.created_at DESC, ps.id DESC
+		LIMIT ?
+	`, playerStatsColumns.selectList("ps"), where)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query player stats page: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPlayerStatsList(rows)
+}
+
+// scanPlayerStatsList reads stat lines selected with playerStatsColumns
+func scanPlayerStatsList(rows *Rows) ([]*models.PlayerStats, error) {
 	var statsList []*models.PlayerStats
 	for rows.Next() {
 		var stats models.PlayerStats
-		var firstName, lastName, position, teamName, teamCity string
-		var jerseyNumber *int
-
-		err := rows.Scan(
-			&stats.ID, &stats.PlayerID, &stats.GameID,
-			&stats.PassingAttempts, &stats.PassingCompletions, &stats.PassingYards, &stats.PassingTouchdowns, &stats.PassingInterceptions,
-			&stats.RushingAttempts, &stats.RushingYards, &stats.RushingTouchdowns,
-			&stats.ReceivingTargets, &stats.Receptions, &stats.ReceivingYards, &stats.ReceivingTouchdowns,
-			&stats.Fumbles, &stats.FumblesLost,
-			&stats.Tackles, &stats.SoloTackles, &stats.AssistedTackles, &stats.Sacks, &stats.DefensiveInterceptions,
-			&stats.PassDeflections, &stats.ForcedFumbles, &stats.FumbleRecoveries, &stats.DefensiveTouchdowns,
-			&stats.FieldGoalsAttempted, &stats.FieldGoalsMade, &stats.ExtraPointsAttempted, &stats.ExtraPointsMade,
-			&stats.Punts, &stats.PuntYards, &stats.KickReturns, &stats.KickReturnYards, &stats.KickReturnTouchdowns,
-			&stats.PuntReturns, &stats.PuntReturnYards, &stats.PuntReturnTouchdowns,
-			&stats.CreatedAt, &stats.UpdatedAt,
-			&firstName, &lastName, &position, &jerseyNumber, &teamName, &teamCity,
-		)
-		if err != nil {
+		if err := rows.Scan(playerStatsColumns.targets(&stats)...); err != nil {
 			return nil, fmt.Errorf("failed to scan player stats: %w", err)
 		}
 		statsList = append(statsList, &stats)
 	}
 
-	if err = rows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating player stats: %w", err)
 	}
 
 	return statsList, nil
 }
 
-// GetByGameID retrieves all stats for a specific game
-func (r *playerStatsRepository) GetByGameID(gameID int) ([]*models.PlayerStats, error) {
-	query := `
-		SELECT ps.id, ps.player_id, ps.game_id,
-		       ps.passing_attempts, ps.passing_completions, ps.passing_yards, ps.passing_touchdowns, ps.passing_interceptions,
-		       ps.rushing_attempts, ps.rushing_yards, ps.rushing_touchdowns,
-		       ps.receiving_targets, ps.receptions, ps.receiving_yards, ps.receiving_touchdowns,
-		       ps.fumbles, ps.fumbles_lost,
-		       ps.tackles, ps.solo_tackles, ps.assisted_tackles, ps.sacks, ps.defensive_interceptions,
-		       ps.pass_deflections, ps.forced_fumbles, ps.fumble_recoveries, ps.defensive_touchdowns,
-		       ps.field_goals_attempted, ps.field_goals_made, ps.extra_points_attempted, ps.extra_points_made,
-		       ps.punts, ps.punt_yards, ps.kick_returns, ps.kick_return_yards, ps.kick_return_touchdowns,
-		       ps.punt_returns, ps.punt_return_yards, ps.punt_return_touchdowns,
-		       ps.created_at, ps.updated_at,
-		       p.first_name, p.last_name, p.position, p.jersey_number,
-		       t.name as team_name, t.city as team_city
-		FROM player_stats ps
-		JOIN players p ON ps.player_id = p.id
-		JOIN teams t ON p.team_id = t.id
-		WHERE ps.game_id = ?
-		ORDER BY t.name ASC, p.last_name ASC, p.first_name ASC
-	`
+// GetByWeek retrieves all stats recorded in regular season games of a specific week in a
+// season. Preseason and playoff weeks reuse the same numbers, so their games are left out.
+func (r *playerStatsRepository) GetByWeek(season string, week int) ([]*models.PlayerStats, error) {
+	query := fmt.Sprintf(weekStatsQuery, playerStatsColumns.selectList("ps"))
 
-	rows, err := r.db.Query(query, gameID)
+	rows, err := r.db.Query(query, season, week)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query player stats by game: %w", err)
+		return nil, fmt.Errorf("failed to query player stats by week: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPlayerStatsList(rows)
+}
+
+// GetByWeekWithPlayers retrieves the stats GetByWeek does along with the players who recorded
+// them, keyed by player ID, read from the same query
+func (r *playerStatsRepository) GetByWeekWithPlayers(season string, week int) ([]*models.PlayerStats, map[int]*models.Player, error) {
+	query := fmt.Sprintf(weekStatsQuery, playerStatsColumns.selectList("ps")+", "+playerColumns.selectList("p"))
+
+	rows, err := r.db.Query(query, season, week)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query player stats by week: %w", err)
 	}
 	defer rows.Close()
 
 	var statsList []*models.PlayerStats
+	players := make(map[int]*models.Player)
 	for rows.Next() {
 		var stats models.PlayerStats
-		var firstName, lastName, position, teamName, teamCity string
-		var jerseyNumber *int
-
-		err := rows.Scan(
-			&stats.ID, &stats.PlayerID, &stats.GameID,
-			&stats.PassingAttempts, &stats.PassingCompletions, &stats.PassingYards, &stats.PassingTouchdowns, &stats.PassingInterceptions,
-			&stats.RushingAttempts, &stats.RushingYards, &stats.RushingTouchdowns,
-			&stats.ReceivingTargets, &stats.Receptions, &stats.ReceivingYards, &stats.ReceivingTouchdowns,
-			&stats.Fumbles, &stats.FumblesLost,
-			&stats.Tackles, &stats.SoloTackles, &stats.AssistedTackles, &stats.Sacks, &stats.DefensiveInterceptions,
-			&stats.PassDeflections, &stats.ForcedFumbles, &stats.FumbleRecoveries, &stats.DefensiveTouchdowns,
-			&stats.FieldGoalsAttempted, &stats.FieldGoalsMade, &stats.ExtraPointsAttempted, &stats.ExtraPointsMade,
-			&stats.Punts, &stats.PuntYards, &stats.KickReturns, &stats.KickReturnYards, &stats.KickReturnTouchdowns,
-			&stats.PuntReturns, &stats.PuntReturnYards, &stats.PuntReturnTouchdowns,
-			&stats.CreatedAt, &stats.UpdatedAt,
-			&firstName, &lastName, &position, &jerseyNumber, &teamName, &teamCity,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan player stats: %w", err)
+		var player models.Player
+		targets := append(playerStatsColumns.targets(&stats), playerColumns.targets(&player)...)
+		if err := rows.Scan(targets...); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan player stats: %w", err)
 		}
 		statsList = append(statsList, &stats)
+		players[player.ID] = &player
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating player stats: %w", err)
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error iterating player stats: %w", err)
 	}
 
-	return statsList, nil
+	return statsList, players, nil
+}
+
+// weekStatsQuery selects the given columns of the stat lines of regular season games of a week
+const weekStatsQuery = `
+	SELECT %s
+	FROM player_stats ps
+	JOIN players p ON ps.player_id = p.id
+	JOIN teams t ON p.team_id = t.id
+	JOIN games g ON ps.game_id = g.id
+	WHERE g.season = ? AND g.week = ? AND g.game_type = 'regular' AND p.deleted_at IS NULL AND g.deleted_at IS NULL
+	ORDER BY g.game_date ASC, t.name ASC, p.last_name ASC, p.first_name ASC
+`
+
+// ForEachBySeason calls fn with each stat line from games in a season, or in every season
+// when season is empty, in game order. Lines are read one at a time instead of collected, so
+// memory stays flat however many there are. An error from fn stops the iteration and is
+// returned as is. The iteration runs under the stream timeout, since fn may be writing each line
+// out to a slow client.
+func (r *playerStatsRepository) ForEachBySeason(season string, fn func(*models.PlayerStats) error) error {
+	where := "p.deleted_at IS NULL AND g.deleted_at IS NULL"
+	args := []interface{}{}
+	if season != "" {
+		where += " AND g.season = ?"
+		args = append(args, season)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM player_stats ps
+		JOIN players p ON ps.player_id = p.id
+		JOIN games g ON ps.game_id = g.id
+		WHERE %s
+		ORDER BY g.game_date ASC, g.id ASC, ps.id ASC
+	`, playerStatsColumns.selectList("ps"), where)
+
+	rows, err := r.db.Stream(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query player stats by season: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var stats models.PlayerStats
+		if err := rows.Scan(playerStatsColumns.targets(&stats)...); err != nil {
+			return fmt.Errorf("failed to scan player stats: %w", err)
+		}
+		if err := fn(&stats); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating player stats: %w", err)
+	}
+
+	return nil
 }
 
 // GetByPlayerAndGame retrieves stats for a specific player in a specific game
 func (r *playerStatsRepository) GetByPlayerAndGame(playerID, gameID int) (*models.PlayerStats, error) {
-	query := `
-		SELECT ps.id, ps.player_id, ps.game_id,
-		       ps.passing_attempts, ps.passing_completions, ps.passing_yards, ps.passing_touchdowns, ps.passing_interceptions,
-		       ps.rushing_attempts, ps.rushing_yards, ps.rushing_touchdowns,
-		       ps.receiving_targets, ps.receptions, ps.receiving_yards, ps.receiving_touchdowns,
-		       ps.fumbles, ps.fumbles_lost,
-		       ps.tackles, ps.solo_tackles, ps.assisted_tackles, ps.sacks, ps.defensive_interceptions,
-		       ps.pass_deflections, ps.forced_fumbles, ps.fumble_recoveries, ps.defensive_touchdowns,
-		       ps.field_goals_attempted, ps.field_goals_made, ps.extra_points_attempted, ps.extra_points_made,
-		       ps.punts, ps.punt_yards, ps.kick_returns, ps.kick_return_yards, ps.kick_return_touchdowns,
-		       ps.punt_returns, ps.punt_return_yards, ps.punt_return_touchdowns,
-		       ps.created_at, ps.updated_at,
-		       p.first_name, p.last_name, p.position, p.jersey_number,
-		       t.name as team_name, t.city as team_city
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM player_stats ps
 		JOIN players p ON ps.player_id = p.id
 		JOIN teams t ON p.team_id = t.id
 		WHERE ps.player_id = ? AND ps.game_id = ?
-	`
+	`, playerStatsColumns.selectList("ps"))
 
 	var stats models.PlayerStats
-	var firstName, lastName, position, teamName, teamCity string
-	var jerseyNumber *int
-
-	err := r.db.QueryRow(query, playerID, gameID).Scan(
-		&stats.ID, &stats.PlayerID, &stats.GameID,
-		&stats.PassingAttempts, &stats.PassingCompletions, &stats.PassingYards, &stats.PassingTouchdowns, &stats.PassingInterceptions,
-		&stats.RushingAttempts, &stats.RushingYards, &stats.RushingTouchdowns,
-		&stats.ReceivingTargets, &stats.Receptions, &stats.ReceivingYards, &stats.ReceivingTouchdowns,
-		&stats.Fumbles, &stats.FumblesLost,
-		&stats.Tackles, &stats.SoloTackles, &stats.AssistedTackles, &stats.Sacks, &stats.DefensiveInterceptions,
-		&stats.PassDeflections, &stats.ForcedFumbles, &stats.FumbleRecoveries, &stats.DefensiveTouchdowns,
-		&stats.FieldGoalsAttempted, &stats.FieldGoalsMade, &stats.ExtraPointsAttempted, &stats.ExtraPointsMade,
-		&stats.Punts, &stats.PuntYards, &stats.KickReturns, &stats.KickReturnYards, &stats.KickReturnTouchdowns,
-		&stats.PuntReturns, &stats.PuntReturnYards, &stats.PuntReturnTouchdowns,
-		&stats.CreatedAt, &stats.UpdatedAt,
-		&firstName, &lastName, &position, &jerseyNumber, &teamName, &teamCity,
-	)
-
+	err := r.db.QueryRow(query, playerID, gameID).Scan(playerStatsColumns.targets(&stats)...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("player stats for player %d in game %d not found", playerID, gameID)
@@ -319,41 +370,24 @@ func (r *playerStatsRepository) GetByPlayerAndGame(playerID, gameID int) (*model
 	return &stats, nil
 }
 
+// insertPlayerStatsQuery is shared by Create and Upsert
+var insertPlayerStatsQuery = insertQuery("player_stats", playerStatsInsertColumns)
+
+// insertPlayerStatsArgs returns the arguments for insertPlayerStatsQuery
+func insertPlayerStatsArgs(stats *models.PlayerStats, createdAt time.Time) []interface{} {
+	row := *stats
+	row.CreatedAt, row.UpdatedAt = createdAt, createdAt
+	return playerStatsColumns.values(&row, playerStatsInsertColumns)
+}
+
 // Create adds new player stats to the database
 func (r *playerStatsRepository) Create(stats *models.PlayerStats) error {
-	query := `
-		INSERT INTO player_stats (
-			player_id, game_id,
-			passing_attempts, passing_completions, passing_yards, passing_touchdowns, passing_interceptions,
-			rushing_attempts, rushing_yards, rushing_touchdowns,
-			receiving_targets, receptions, receiving_yards, receiving_touchdowns,
-			fumbles, fumbles_lost,
-			tackles, solo_tackles, assisted_tackles, sacks, defensive_interceptions,
-			pass_deflections, forced_fumbles, fumble_recoveries, defensive_touchdowns,
-			field_goals_attempted, field_goals_made, extra_points_attempted, extra_points_made,
-			punts, punt_yards, kick_returns, kick_return_yards, kick_return_touchdowns,
-			punt_returns, punt_return_yards, punt_return_touchdowns,
-			created_at, updated_at
-		) VALUES (
-			?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
-		)
-	`
-
-	currentTime := time.Now()
-	result, err := r.db.Exec(query,
-		stats.PlayerID, stats.GameID,
-		stats.PassingAttempts, stats.PassingCompletions, stats.PassingYards, stats.PassingTouchdowns, stats.PassingInterceptions,
-		stats.RushingAttempts, stats.RushingYards, stats.RushingTouchdowns,
-		stats.ReceivingTargets, stats.Receptions, stats.ReceivingYards, stats.ReceivingTouchdowns,
-		stats.Fumbles, stats.FumblesLost,
-		stats.Tackles, stats.SoloTackles, stats.AssistedTackles, stats.Sacks, stats.DefensiveInterceptions,
-		stats.PassDeflections, stats.ForcedFumbles, stats.FumbleRecoveries, stats.DefensiveTouchdowns,
-		stats.FieldGoalsAttempted, stats.FieldGoalsMade, stats.ExtraPointsAttempted, stats.ExtraPointsMade,
-		stats.Punts, stats.PuntYards, stats.KickReturns, stats.KickReturnYards, stats.KickReturnTouchdowns,
-		stats.PuntReturns, stats.PuntReturnYards, stats.PuntReturnTouchdowns,
-		currentTime, currentTime,
-	)
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(insertPlayerStatsQuery, insertPlayerStatsArgs(stats, currentTime)...)
 	if err != nil {
+		if conflict := uniqueViolation(err, "player stats"); conflict != nil {
+			return conflict
+		}
 		return fmt.Errorf("failed to create player stats: %w", err)
 	}
 
@@ -369,37 +403,115 @@ func (r *playerStatsRepository) Create(stats *models.PlayerStats) error {
 	return nil
 }
 
+// Upsert creates the stat line for the player and game, or replaces every stat on the existing one.
+// It reports whether a new row was created.
+func (r *playerStatsRepository) Upsert(stats *models.PlayerStats) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	currentTime := r.clock.Now()
+	created, err := upsertPlayerStats(tx, stats, currentTime)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	stats.UpdatedAt = currentTime
+	return created, nil
+}
+
+// UpsertMany upserts every stat line in a single transaction and returns how many were created
+func (r *playerStatsRepository) UpsertMany(statsList []*models.PlayerStats) (int, error) {
+	if len(statsList) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	currentTime := r.clock.Now()
+	created := 0
+	for _, stats := range statsList {
+		isNew, err := upsertPlayerStats(tx, stats, currentTime)
+		if err != nil {
+			return 0, err
+		}
+		if isNew {
+			created++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit player stats: %w", err)
+	}
+
+	for _, stats := range statsList {
+		stats.UpdatedAt = currentTime
+	}
+
+	return created, nil
+}
+
+// upsertPlayerStats writes one stat line inside the transaction
+func upsertPlayerStats(tx *sql.Tx, stats *models.PlayerStats, currentTime time.Time) (bool, error) {
+	err := tx.QueryRow("SELECT id, created_at FROM player_stats WHERE player_id = ? AND game_id = ?",
+		stats.PlayerID, stats.GameID).Scan(&stats.ID, &stats.CreatedAt)
+	switch {
+	case err == sql.ErrNoRows:
+		result, err := tx.Exec(insertPlayerStatsQuery, insertPlayerStatsArgs(stats, currentTime)...)
+		if err != nil {
+			if conflict := uniqueViolation(err, "player stats"); conflict != nil {
+				return false, conflict
+			}
+			return false, fmt.Errorf("failed to create player stats: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return false, fmt.Errorf("failed to get player stats ID: %w", err)
+		}
+		stats.ID = int(id)
+		stats.CreatedAt = currentTime
+		return true, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to look up player stats: %w", err)
+	}
+
+	if _, err := tx.Exec(updatePlayerStatsQuery, updatePlayerStatsArgs(stats, currentTime)...); err != nil {
+		if conflict := uniqueViolation(err, "player stats"); conflict != nil {
+			return false, conflict
+		}
+		return false, fmt.Errorf("failed to replace player stats: %w", err)
+	}
+	return false, nil
+}
+
+// updatePlayerStatsQuery is shared by Update and UpdateMany
+var updatePlayerStatsQuery = updateQuery("player_stats", playerStatsUpdateColumns)
+
+// updatePlayerStatsArgs returns the arguments for updatePlayerStatsQuery
+func updatePlayerStatsArgs(stats *models.PlayerStats, updatedAt time.Time) []interface{} {
+	row := *stats
+	row.UpdatedAt = updatedAt
+	return append(playerStatsColumns.values(&row, playerStatsUpdateColumns), stats.ID)
+}
+
 // Update modifies existing player stats
 func (r *playerStatsRepository) Update(stats *models.PlayerStats) error {
-	query := `
-		UPDATE player_stats SET
-			passing_attempts = ?, passing_completions = ?, passing_yards = ?, passing_touchdowns = ?, passing_interceptions = ?,
-			rushing_attempts = ?, rushing_yards = ?, rushing_touchdowns = ?,
-			receiving_targets = ?, receptions = ?, receiving_yards = ?, receiving_touchdowns = ?,
-			fumbles = ?, fumbles_lost = ?,
-			tackles = ?, solo_tackles = ?, assisted_tackles = ?, sacks = ?, defensive_interceptions = ?,
-			pass_deflections = ?, forced_fumbles = ?, fumble_recoveries = ?, defensive_touchdowns = ?,
-			field_goals_attempted = ?, field_goals_made = ?, extra_points_attempted = ?, extra_points_made = ?,
-			punts = ?, punt_yards = ?, kick_returns = ?, kick_return_yards = ?, kick_return_touchdowns = ?,
-			punt_returns = ?, punt_return_yards = ?, punt_return_touchdowns = ?,
-			updated_at = ?
-		WHERE id = ?
-	`
-
-	currentTime := time.Now()
-	result, err := r.db.Exec(query,
-		stats.PassingAttempts, stats.PassingCompletions, stats.PassingYards, stats.PassingTouchdowns, stats.PassingInterceptions,
-		stats.RushingAttempts, stats.RushingYards, stats.RushingTouchdowns,
-		stats.ReceivingTargets, stats.Receptions, stats.ReceivingYards, stats.ReceivingTouchdowns,
-		stats.Fumbles, stats.FumblesLost,
-		stats.Tackles, stats.SoloTackles, stats.AssistedTackles, stats.Sacks, stats.DefensiveInterceptions,
-		stats.PassDeflections, stats.ForcedFumbles, stats.FumbleRecoveries, stats.DefensiveTouchdowns,
-		stats.FieldGoalsAttempted, stats.FieldGoalsMade, stats.ExtraPointsAttempted, stats.ExtraPointsMade,
-		stats.Punts, stats.PuntYards, stats.KickReturns, stats.KickReturnYards, stats.KickReturnTouchdowns,
-		stats.PuntReturns, stats.PuntReturnYards, stats.PuntReturnTouchdowns,
-		currentTime, stats.ID,
-	)
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(updatePlayerStatsQuery, updatePlayerStatsArgs(stats, currentTime)...)
 	if err != nil {
+		if conflict := uniqueViolation(err, "player stats"); conflict != nil {
+			return conflict
+		}
 		return fmt.Errorf("failed to update player stats: %w", err)
 	}
 
@@ -416,6 +528,43 @@ func (r *playerStatsRepository) Update(stats *models.PlayerStats) error {
 	return nil
 }
 
+// UpdateMany modifies several player stats rows in a single transaction.
+// Rows that no longer exist are skipped rather than failing the batch.
+func (r *playerStatsRepository) UpdateMany(statsList []*models.PlayerStats) error {
+	if len(statsList) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(updatePlayerStatsQuery)
+	if err != nil {
+		return fmt.Errorf("failed to prepare player stats update: %w", err)
+	}
+	defer stmt.Close()
+
+	currentTime := r.clock.Now()
+	for _, stats := range statsList {
+		if _, err := stmt.Exec(updatePlayerStatsArgs(stats, currentTime)...); err != nil {
+			return fmt.Errorf("failed to update player stats %d: %w", stats.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit player stats updates: %w", err)
+	}
+
+	for _, stats := range statsList {
+		stats.UpdatedAt = currentTime
+	}
+
+	return nil
+}
+
 // Delete removes player stats from the database
 func (r *playerStatsRepository) Delete(id int) error {
 	query := "DELETE FROM player_stats WHERE id = ?"
@@ -0,0 +1,365 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/projection_repository.go -pkg mocks . ProjectionRepository
+
+// ProjectionRepository defines the interface for projection data operations
+type ProjectionRepository interface {
+	GetByID(id int) (*models.Projection, error)
+	GetByPlayerID(playerID int, season string, week int, source string) ([]*models.Projection, error)
+	GetLeaders(season string, week int, source, position string, weights map[string]float64, limit int) ([]*models.ProjectionLeader, error)
+	GetScored(season string, fromWeek, toWeek int, source, position string) ([]*models.ScoredProjection, error)
+	Upsert(projection *models.Projection) (bool, error)
+	UpsertMany(projections []*models.Projection) (int, error)
+	Delete(id int) error
+}
+
+// projectionRepository implements ProjectionRepository interface
+type projectionRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewProjectionRepository creates a new projection repository
+func NewProjectionRepository(db *TimeoutDB, clock clock.Clock) ProjectionRepository {
+	return &projectionRepository{db: db, clock: clock}
+}
+
+// projectionColumns maps the projections columns to the Projection fields
+var projectionColumns = newRowMapper[models.Projection]()
+
+// projectionSelectList is the select list shared by the projection queries: the mapped columns,
+// the projected stats and stats_id, the player's actual stat line in a live regular season game
+// of the projected week, if there is one, the earliest when the player has several.
+var projectionSelectList = projectionColumns.selectList("pr") + `, pr.stats,
+	(SELECT ps.id FROM player_stats ps JOIN games g ON ps.game_id = g.id
+	 WHERE ps.player_id = pr.player_id AND g.season = pr.season AND g.week = pr.week
+	   AND g.game_type = 'regular' AND g.deleted_at IS NULL
+	 ORDER BY g.game_date ASC, ps.id ASC
+	 LIMIT 1) AS stats_id`
+
+// scanProjection scans a row selected with projectionSelectList
+func scanProjection(scanner interface{ Scan(...interface{}) error }) (*models.Projection, error) {
+	var projection models.Projection
+	var stats string
+
+	targets := append(projectionColumns.targets(&projection), &stats, &projection.StatsID)
+	if err := scanner.Scan(targets...); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(stats), &projection.Stats); err != nil {
+		return nil, fmt.Errorf("failed to decode projected stats: %w", err)
+	}
+
+	return &projection, nil
+}
+
+// GetByID retrieves a projection by ID
+func (r *projectionRepository) GetByID(id int) (*models.Projection, error) {
+	query := `SELECT ` + projectionSelectList + `
+		FROM projections pr
+		WHERE pr.id = ?
+	`
+
+	projection, err := scanProjection(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("projection with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to get projection: %w", err)
+	}
+
+	return projection, nil
+}
+
+// GetByPlayerID retrieves a player's projections, newest week first. An empty season or
+// source, or a zero week, matches every value.
+func (r *projectionRepository) GetByPlayerID(playerID int, season string, week int, source string) ([]*models.Projection, error) {
+	conditions := []string{"pr.player_id = ?"}
+	args := []interface{}{playerID}
+	if season != "" {
+		conditions = append(conditions, "pr.season = ?")
+		args = append(args, season)
+	}
+	if week != 0 {
+		conditions = append(conditions, "pr.week = ?")
+		args = append(args, week)
+	}
+	if source != "" {
+		conditions = append(conditions, "pr.source = ?")
+		args = append(args, source)
+	}
+
+	query := `SELECT ` + projectionSelectList + `
+		FROM projections pr
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY pr.season DESC, pr.week DESC, pr.source ASC
+	`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query projections: %w", err)
+	}
+	defer rows.Close()
+
+	var projections []*models.Projection
+	for rows.Next() {
+		projection, err := scanProjection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan projection: %w", err)
+		}
+		projections = append(projections, projection)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating projections: %w", err)
+	}
+
+	return projections, nil
+}
+
+// GetLeaders ranks the week's active players by projected points. Without a source the
+// points are averaged across every source that projected the player, weighted by source when
+// weights are given; sources missing from the weights count with weight 1.
+func (r *projectionRepository) GetLeaders(season string, week int, source, position string, weights map[string]float64, limit int) ([]*models.ProjectionLeader, error) {
+	weight := "1.0"
+	var weightArgs []interface{}
+	if len(weights) > 0 {
+		cases := make([]string, 0, len(weights))
+		for name, value := range weights {
+			cases = append(cases, "WHEN ? THEN ?")
+			weightArgs = append(weightArgs, name, value)
+		}
+		weight = "(CASE pr.source " + strings.Join(cases, " ") + " ELSE 1.0 END)"
+	}
+	points := fmt.Sprintf("SUM(pr.points * %[1]s) / SUM(%[1]s)", weight)
+
+	// The points expression is used for the rank and the value, each with the weight twice
+	var args []interface{}
+	for i := 0; i < 4; i++ {
+		args = append(args, weightArgs...)
+	}
+
+	conditions := []string{"pr.season = ?", "pr.week = ?", "p.deleted_at IS NULL"}
+	args = append(args, season, week)
+	if source != "" {
+		conditions = append(conditions, "pr.source = ?")
+		args = append(args, source)
+	}
+	if position != "" {
+		conditions = append(conditions, "p.position = ?")
+		args = append(args, position)
+	}
+	args = append(args, limit)
+
+	query := `
+		SELECT RANK() OVER (ORDER BY ` + points + ` DESC) AS rank,
+		       pr.player_id, p.first_name, p.last_name, p.position, p.team_id,
+		       COUNT(*), ROUND(` + points + `, 2)
+		FROM projections pr
+		JOIN players p ON pr.player_id = p.id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		GROUP BY pr.player_id
+		ORDER BY rank, p.last_name, p.first_name
+		LIMIT ?
+	`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query projection leaders: %w", err)
+	}
+	defer rows.Close()
+
+	var leaders []*models.ProjectionLeader
+	for rows.Next() {
+		var leader models.ProjectionLeader
+		err := rows.Scan(
+			&leader.Rank, &leader.PlayerID, &leader.FirstName, &leader.LastName, &leader.Position, &leader.TeamID,
+			&leader.Sources, &leader.ProjectedPoints,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan projection leader: %w", err)
+		}
+		leaders = append(leaders, &leader)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating projection leaders: %w", err)
+	}
+
+	return leaders, nil
+}
+
+// GetScored retrieves the projections for weeks in the range that the player has played in the
+// regular season, with the player's position and the stat line they are measured against. Empty
+// source or position match every value.
+func (r *projectionRepository) GetScored(season string, fromWeek, toWeek int, source, position string) ([]*models.ScoredProjection, error) {
+	conditions := []string{"pr.season = ?", "pr.week BETWEEN ? AND ?", "g.game_type = 'regular'", "g.deleted_at IS NULL"}
+	args := []interface{}{season, fromWeek, toWeek}
+	if source != "" {
+		conditions = append(conditions, "pr.source = ?")
+		args = append(args, source)
+	}
+	if position != "" {
+		conditions = append(conditions, "p.position = ?")
+		args = append(args, position)
+	}
+
+	query := `
+		SELECT pr.player_id, p.position, pr.week, pr.source, pr.points, ps.id
+		FROM projections pr
+		JOIN players p ON pr.player_id = p.id
+		JOIN player_stats ps ON ps.player_id = pr.player_id
+		JOIN games g ON ps.game_id = g.id AND g.season = pr.season AND g.week = pr.week
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY pr.source, pr.week, pr.player_id
+	`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scored projections: %w", err)
+	}
+	defer rows.Close()
+
+	var scored []*models.ScoredProjection
+	for rows.Next() {
+		var projection models.ScoredProjection
+		err := rows.Scan(
+			&projection.PlayerID, &projection.Position, &projection.Week, &projection.Source,
+			&projection.Points, &projection.StatsID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scored projection: %w", err)
+		}
+		scored = append(scored, &projection)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating scored projections: %w", err)
+	}
+
+	return scored, nil
+}
+
+// Upsert creates the projection, or replaces the source's existing projection of the player
+// for the same week. It reports whether a new projection was created.
+func (r *projectionRepository) Upsert(projection *models.Projection) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	created, err := upsertProjection(tx, projection, r.clock.Now())
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit projection: %w", err)
+	}
+
+	return created, nil
+}
+
+// UpsertMany upserts every projection in a single transaction and returns how many were created
+func (r *projectionRepository) UpsertMany(projections []*models.Projection) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	created := 0
+	for _, projection := range projections {
+		isNew, err := upsertProjection(tx, projection, r.clock.Now())
+		if err != nil {
+			return 0, err
+		}
+		if isNew {
+			created++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit projections: %w", err)
+	}
+
+	return created, nil
+}
+
+// upsertProjection writes one projection inside the transaction
+func upsertProjection(tx *sql.Tx, projection *models.Projection, currentTime time.Time) (bool, error) {
+	stats, err := json.Marshal(projection.Stats)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode projected stats: %w", err)
+	}
+
+	err = tx.QueryRow(
+		"SELECT id, created_at FROM projections WHERE player_id = ? AND season = ? AND week = ? AND source = ?",
+		projection.PlayerID, projection.Season, projection.Week, projection.Source,
+	).Scan(&projection.ID, &projection.CreatedAt)
+
+	if err == sql.ErrNoRows {
+		result, err := tx.Exec(`
+			INSERT INTO projections (player_id, season, week, source, stats, points, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`, projection.PlayerID, projection.Season, projection.Week, projection.Source, string(stats), projection.Points,
+			currentTime, currentTime)
+		if err != nil {
+			return false, fmt.Errorf("failed to create projection: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return false, fmt.Errorf("failed to get projection ID: %w", err)
+		}
+
+		projection.ID = int(id)
+		projection.CreatedAt = currentTime
+		projection.UpdatedAt = currentTime
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check existing projection: %w", err)
+	}
+
+	_, err = tx.Exec("UPDATE projections SET stats = ?, points = ?, updated_at = ? WHERE id = ?",
+		string(stats), projection.Points, currentTime, projection.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update projection: %w", err)
+	}
+
+	projection.UpdatedAt = currentTime
+	return false, nil
+}
+
+// Delete removes a projection
+func (r *projectionRepository) Delete(id int) error {
+	result, err := r.db.Exec("DELETE FROM projections WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete projection: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("projection with ID %d not found", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"fmt"
+
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/record_repository.go -pkg mocks . RecordRepository
+
+// RecordRepository defines the interface for record book data operations
+type RecordRepository interface {
+	GetStatRecords(scope string) ([]*models.StatRecord, error)
+}
+
+// recordRepository implements RecordRepository interface. The records themselves are kept by
+// triggers on player_stats, player_season_stats and games, see database/migrations.go.
+type recordRepository struct {
+	db *TimeoutDB
+}
+
+// NewRecordRepository creates a new record repository
+func NewRecordRepository(db *TimeoutDB) RecordRepository {
+	return &recordRepository{db: db}
+}
+
+// GetStatRecords retrieves the set records of a scope, game or season, with their holders
+func (r *recordRepository) GetStatRecords(scope string) ([]*models.StatRecord, error) {
+	query := `
+		SELECT r.stat, r.value, r.player_id, p.first_name, p.last_name, p.position, r.season,
+		       ps.game_id, g.week, g.game_date
+		FROM stat_records r
+		JOIN players p ON p.id = r.player_id
+		LEFT JOIN player_stats ps ON ps.id = r.stats_id
+		LEFT JOIN games g ON g.id = ps.game_id
+		WHERE r.scope = ? AND r.value IS NOT NULL
+	`
+
+	rows, err := r.db.Query(query, scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stat records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*models.StatRecord
+	for rows.Next() {
+		var record models.StatRecord
+		var firstName, lastName string
+		if err := rows.Scan(
+			&record.Stat, &record.Value, &record.PlayerID, &firstName, &lastName, &record.Position, &record.Season,
+			&record.GameID, &record.Week, &record.GameDate,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan stat record: %w", err)
+		}
+		record.PlayerName = firstName + " " + lastName
+		records = append(records, &record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stat records: %w", err)
+	}
+
+	return records, nil
+}
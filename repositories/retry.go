@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	// retryBaseDelay is the wait before the first retry, doubled for each one after
+	retryBaseDelay = 10 * time.Millisecond
+	// retryMaxDelay caps the wait between retries
+	retryMaxDelay = 250 * time.Millisecond
+)
+
+// isTransient reports whether err is a failure that may clear up by itself if the query is run
+// again: SQLite finding the database locked by another connection's write, once the driver's
+// busy timeout has run out or when SQLite gives up at once to avoid a deadlock. The statement
+// did not run, so running it again is safe.
+func isTransient(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+}
+
+// retryDelay is the wait before retry number attempt (from 0): exponential backoff with half of
+// it jittered, so queries that collided don't retry in lockstep
+func retryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay << attempt
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// retry decides whether a query that failed with err on the given attempt (from 0) runs again,
+// and if so waits out the backoff. It gives up on errors that aren't transient, once the
+// retries are used up, and when the query's deadline passes during the wait.
+func (d *TimeoutDB) retry(ctx context.Context, query string, attempt int, err error) bool {
+	if err == nil || !isTransient(err) {
+		return false
+	}
+	if attempt >= d.retries {
+		if d.retries > 0 {
+			d.retriesExhausted.Add(1)
+			log.Printf("Query failed after %d retries: %v: %s", d.retries, err, strings.Join(strings.Fields(query), " "))
+		}
+		return false
+	}
+
+	d.retried.Add(1)
+	timer := time.NewTimer(retryDelay(attempt))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// rowMapper maps the db-tagged fields of a model struct to table columns, so queries can
+// select, scan, insert and update every column without listing them by hand. Columns are
+// in field order; fields tagged db:"-" or without a db tag are skipped.
+type rowMapper[T any] struct {
+	columns []string
+	fields  map[string][]int // field index path by column
+}
+
+// newRowMapper builds the mapper for the model type T, which must be a struct
+func newRowMapper[T any]() *rowMapper[T] {
+	modelType := reflect.TypeOf((*T)(nil)).Elem()
+	mapper := &rowMapper[T]{fields: make(map[string][]int)}
+	for i := 0; i < modelType.NumField(); i++ {
+		field := modelType.Field(i)
+		column := field.Tag.Get("db")
+		if column == "" || column == "-" {
+			continue
+		}
+		mapper.columns = append(mapper.columns, column)
+		mapper.fields[column] = field.Index
+	}
+	return mapper
+}
+
+// selectList lists every column prefixed with the table alias, for a SELECT clause
+func (m *rowMapper[T]) selectList(alias string) string {
+	qualified := make([]string, len(m.columns))
+	for i, column := range m.columns {
+		qualified[i] = alias + "." + column
+	}
+	return strings.Join(qualified, ", ")
+}
+
+// columnList lists every column unqualified, for a single-table SELECT or a RETURNING clause
+func (m *rowMapper[T]) columnList() string {
+	return strings.Join(m.columns, ", ")
+}
+
+// columnsExcept lists every column but the excluded ones, in field order
+func (m *rowMapper[T]) columnsExcept(excluded ...string) []string {
+	skip := make(map[string]bool, len(excluded))
+	for _, column := range excluded {
+		skip[column] = true
+	}
+
+	var columns []string
+	for _, column := range m.columns {
+		if !skip[column] {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// targets returns pointers to every mapped field of model, in column order, for Scan
+func (m *rowMapper[T]) targets(model *T) []interface{} {
+	value := reflect.ValueOf(model).Elem()
+	targets := make([]interface{}, len(m.columns))
+	for i, column := range m.columns {
+		targets[i] = value.FieldByIndex(m.fields[column]).Addr().Interface()
+	}
+	return targets
+}
+
+// values returns the values of the named columns of model, for statement arguments
+func (m *rowMapper[T]) values(model *T, columns []string) []interface{} {
+	value := reflect.ValueOf(model).Elem()
+	values := make([]interface{}, len(columns))
+	for i, column := range columns {
+		index, ok := m.fields[column]
+		if !ok {
+			panic(fmt.Sprintf("no field is mapped to column %q", column))
+		}
+		values[i] = value.FieldByIndex(index).Interface()
+	}
+	return values
+}
+
+// insertQuery builds an INSERT of the columns into table
+func insertQuery(table string, columns []string) string {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(columns, ", "), placeholders)
+}
+
+// updateQuery builds an UPDATE setting the columns of the table row matched by id
+func updateQuery(table string, columns []string) string {
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		assignments[i] = column + " = ?"
+	}
+	return fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", table, strings.Join(assignments, ", "))
+}
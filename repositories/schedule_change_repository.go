@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/schedule_change_repository.go -pkg mocks . ScheduleChangeRepository
+
+// ScheduleChangeRepository defines the interface for moving kickoffs and reading their history
+type ScheduleChangeRepository interface {
+	Reschedule(gameID int, newDate time.Time, reason *string) (*models.ScheduleChange, error)
+	GetByGameID(gameID int) ([]*models.ScheduleChange, error)
+	List(season string, since *time.Time, limit int) ([]*models.ScheduleChange, error)
+}
+
+// scheduleChangeRepository implements ScheduleChangeRepository interface
+type scheduleChangeRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewScheduleChangeRepository creates a new schedule change repository
+func NewScheduleChangeRepository(db *TimeoutDB, clock clock.Clock) ScheduleChangeRepository {
+	return &scheduleChangeRepository{db: db, clock: clock}
+}
+
+// scheduleChangeColumns maps the game_schedule_changes columns to the ScheduleChange fields
+var scheduleChangeColumns = newRowMapper[models.ScheduleChange]()
+
+// scheduleChangeSelectList selects a change with its game's season, week and teams
+var scheduleChangeSelectList = scheduleChangeColumns.selectList("c") + ", g.season, g.week, g.home_team_id, g.away_team_id"
+
+// Reschedule moves a game's kickoff to newDate and records the move in one transaction. The
+// previous kickoff is read inside the transaction, so the history stays accurate when two
+// moves race.
+func (r *scheduleChangeRepository) Reschedule(gameID int, newDate time.Time, reason *string) (*models.ScheduleChange, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	change := &models.ScheduleChange{
+		GameID: gameID,
+		// Kickoffs are stored in UTC, so they sort and compare as text
+		NewDate:   newDate.UTC(),
+		Reason:    reason,
+		ChangedAt: r.clock.Now().UTC(),
+	}
+	err = tx.QueryRow(
+		"SELECT season, week, home_team_id, away_team_id, game_date FROM games WHERE id = ? AND deleted_at IS NULL",
+		gameID,
+	).Scan(&change.Season, &change.Week, &change.HomeTeamID, &change.AwayTeamID, &change.PreviousDate)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("game with ID %d not found", gameID)
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	if _, err := tx.Exec("UPDATE games SET game_date = ?, updated_at = ? WHERE id = ?", change.NewDate, r.clock.Now(), gameID); err != nil {
+		return nil, fmt.Errorf("failed to move game: %w", err)
+	}
+
+	result, err := tx.Exec(
+		"INSERT INTO game_schedule_changes (game_id, previous_date, new_date, reason, changed_at) VALUES (?, ?, ?, ?, ?)",
+		gameID, change.PreviousDate.UTC(), change.NewDate, change.Reason, change.ChangedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record schedule change: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule change ID: %w", err)
+	}
+	change.ID = int(id)
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return change, nil
+}
+
+// GetByGameID retrieves every move of a game's kickoff, newest first
+func (r *scheduleChangeRepository) GetByGameID(gameID int) ([]*models.ScheduleChange, error) {
+	query := `
+		SELECT ` + scheduleChangeSelectList + `
+		FROM game_schedule_changes c
+		JOIN games g ON g.id = c.game_id
+		WHERE c.game_id = ?
+		ORDER BY c.id DESC
+	`
+	return r.query(query, gameID)
+}
+
+// List retrieves the most recent kickoff moves across games, newest first, optionally only
+// those of one season or made at or after since
+func (r *scheduleChangeRepository) List(season string, since *time.Time, limit int) ([]*models.ScheduleChange, error) {
+	conditions := []string{"g.deleted_at IS NULL"}
+	var args []interface{}
+	if season != "" {
+		conditions = append(conditions, "g.season = ?")
+		args = append(args, season)
+	}
+	if since != nil {
+		conditions = append(conditions, "c.changed_at >= ?")
+		args = append(args, since.UTC())
+	}
+	args = append(args, limit)
+
+	query := `
+		SELECT ` + scheduleChangeSelectList + `
+		FROM game_schedule_changes c
+		JOIN games g ON g.id = c.game_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY c.changed_at DESC, c.id DESC
+		LIMIT ?
+	`
+	return r.query(query, args...)
+}
+
+// query runs a schedule change query and scans its rows
+func (r *scheduleChangeRepository) query(query string, args ...interface{}) ([]*models.ScheduleChange, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schedule changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*models.ScheduleChange{}
+	for rows.Next() {
+		var change models.ScheduleChange
+		targets := append(scheduleChangeColumns.targets(&change), &change.Season, &change.Week, &change.HomeTeamID, &change.AwayTeamID)
+		if err := rows.Scan(targets...); err != nil {
+			return nil, fmt.Errorf("failed to scan schedule change: %w", err)
+		}
+		changes = append(changes, &change)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating schedule changes: %w", err)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/schedule_strength_repository.go -pkg mocks . ScheduleStrengthRepository
+
+// ScheduleStrengthRepository defines the interface for opponent strength data operations
+type ScheduleStrengthRepository interface {
+	GetPointsAllowed(season, position string, scoring map[string]float64) ([]*models.DefensePointsAllowed, error)
+}
+
+// scheduleStrengthRepository implements ScheduleStrengthRepository interface
+type scheduleStrengthRepository struct {
+	db *TimeoutDB
+}
+
+// NewScheduleStrengthRepository creates a new schedule strength repository
+func NewScheduleStrengthRepository(db *TimeoutDB) ScheduleStrengthRepository {
+	return &scheduleStrengthRepository{db: db}
+}
+
+// GetPointsAllowed totals, for every active team, the fantasy points scored against it by
+// players of the position in the season's completed regular season games, using the given points per stat.
+// A stat line counts against the team its player's club faced; players whose current team
+// did not play in the game, after a trade, are left out.
+func (r *scheduleStrengthRepository) GetPointsAllowed(season, position string, scoring map[string]float64) ([]*models.DefensePointsAllowed, error) {
+	stats := make([]string, 0, len(scoring))
+	for stat := range scoring {
+		if !isSeasonStat(stat) {
+			return nil, fmt.Errorf("invalid stat: %s", stat)
+		}
+		stats = append(stats, stat)
+	}
+	sort.Strings(stats)
+
+	// Arguments follow the query: the season in the games join, then the points per stat
+	// and the position in the stat line subquery. Stats that were not recorded are NULL.
+	terms := []string{"0"}
+	args := []interface{}{season}
+	for _, stat := range stats {
+		terms = append(terms, "COALESCE(ps."+stat+", 0) * ?")
+		args = append(args, scoring[stat])
+	}
+	args = append(args, position)
+
+	query := `
+		SELECT t.id, t.name, t.city, COUNT(g.id), COALESCE(SUM(s.points), 0)
+		FROM teams t
+		LEFT JOIN games g ON (g.home_team_id = t.id OR g.away_team_id = t.id)
+			AND g.season = ? AND g.status = 'completed' AND g.game_type = 'regular' AND g.deleted_at IS NULL
+		LEFT JOIN (
+			SELECT ps.game_id, p.team_id, SUM(` + strings.Join(terms, " + ") + `) AS points
+			FROM player_stats ps
+			JOIN players p ON ps.player_id = p.id
+			WHERE p.position = ?
+			GROUP BY ps.game_id, p.team_id
+		) s ON s.game_id = g.id AND s.team_id <> t.id AND s.team_id IN (g.home_team_id, g.away_team_id)
+		WHERE t.deleted_at IS NULL
+		GROUP BY t.id
+		ORDER BY t.id
+	`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query points allowed: %w", err)
+	}
+	defer rows.Close()
+
+	var defenses []*models.DefensePointsAllowed
+	for rows.Next() {
+		var defense models.DefensePointsAllowed
+		err := rows.Scan(&defense.TeamID, &defense.TeamName, &defense.TeamCity, &defense.GamesPlayed, &defense.Points)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan points allowed: %w", err)
+		}
+		defenses = append(defenses, &defense)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating points allowed: %w", err)
+	}
+
+	return defenses, nil
+}
@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/schema_repository.go -pkg mocks . SchemaRepository
+
+// SchemaRepository defines the interface for reading the database schema
+type SchemaRepository interface {
+	GetSchema() (*models.Schema, error)
+}
+
+// schemaRepository implements SchemaRepository interface
+type schemaRepository struct {
+	db *TimeoutDB
+}
+
+// NewSchemaRepository creates a new schema repository
+func NewSchemaRepository(db *TimeoutDB) SchemaRepository {
+	return &schemaRepository{db: db}
+}
+
+// applicationTable matches the application's tables in sqlite_master m, leaving out SQLite's own
+const applicationTable = `m.type = 'table' AND m.name NOT LIKE 'sqlite\_%' ESCAPE '\'`
+
+// GetSchema introspects the tables, columns, foreign keys and unique constraints of the
+// database, so it reflects every migration that has run
+func (r *schemaRepository) GetSchema() (*models.Schema, error) {
+	schema := &models.Schema{Tables: []*models.SchemaTable{}}
+	tables := make(map[string]*models.SchemaTable)
+
+	rows, err := r.db.Query(`
+		SELECT m.name, c.name, c.type, c."notnull", c.dflt_value, c.pk
+		FROM sqlite_master m
+		JOIN pragma_table_info(m.name) c
+		WHERE ` + applicationTable + `
+		ORDER BY m.name, c.cid
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query columns: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableName string
+		var column models.SchemaColumn
+		var notNull bool
+		var defaultValue sql.NullString
+		var primaryKey int
+		if err := rows.Scan(&tableName, &column.Name, &column.Type, &notNull, &defaultValue, &primaryKey); err != nil {
+			return nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+		column.Nullable = !notNull && primaryKey == 0
+		column.PrimaryKey = primaryKey > 0
+		if defaultValue.Valid {
+			column.Default = &defaultValue.String
+		}
+
+		table, ok := tables[tableName]
+		if !ok {
+			table = &models.SchemaTable{
+				Name:        tableName,
+				ForeignKeys: []*models.SchemaForeignKey{},
+				Unique:      [][]string{},
+			}
+			tables[tableName] = table
+			schema.Tables = append(schema.Tables, table)
+		}
+		table.Columns = append(table.Columns, &column)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating columns: %w", err)
+	}
+
+	if err := r.addForeignKeys(tables); err != nil {
+		return nil, err
+	}
+	if err := r.addUniqueConstraints(tables); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// addForeignKeys adds each table's foreign keys, with the columns of composite keys in order
+func (r *schemaRepository) addForeignKeys(tables map[string]*models.SchemaTable) error {
+	rows, err := r.db.Query(`
+		SELECT m.name, f.id, f."table", f."from", f."to", f.on_delete
+		FROM sqlite_master m
+		JOIN pragma_foreign_key_list(m.name) f
+		WHERE ` + applicationTable + `
+		ORDER BY m.name, f.id, f.seq
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to query foreign keys: %w", err)
+	}
+	defer rows.Close()
+
+	var current *models.SchemaForeignKey
+	currentTable, currentID := "", -1
+	for rows.Next() {
+		var tableName, referenced, from, onDelete string
+		var id int
+		var to sql.NullString // unset when the key references the other table's primary key
+		if err := rows.Scan(&tableName, &id, &referenced, &from, &to, &onDelete); err != nil {
+			return fmt.Errorf("failed to scan foreign key: %w", err)
+		}
+		table, ok := tables[tableName]
+		if !ok {
+			continue
+		}
+
+		if tableName != currentTable || id != currentID {
+			current = &models.SchemaForeignKey{Table: referenced, OnDelete: onDelete}
+			table.ForeignKeys = append(table.ForeignKeys, current)
+			currentTable, currentID = tableName, id
+		}
+		current.Columns = append(current.Columns, from)
+		references := to.String
+		if !to.Valid || references == "" {
+			// The key refers to the other table's primary key, column for column
+			if primaryKey := primaryKeyColumns(tables[referenced]); len(current.Columns) <= len(primaryKey) {
+				references = primaryKey[len(current.Columns)-1]
+			}
+		}
+		current.References = append(current.References, references)
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating foreign keys: %w", err)
+	}
+
+	return nil
+}
+
+// primaryKeyColumns lists the primary key columns of a table, nil if it isn't known
+func primaryKeyColumns(table *models.SchemaTable) []string {
+	if table == nil {
+		return nil
+	}
+	var columns []string
+	for _, column := range table.Columns {
+		if column.PrimaryKey {
+			columns = append(columns, column.Name)
+		}
+	}
+	return columns
+}
+
+// addUniqueConstraints adds the column sets of each table's UNIQUE constraints and unique
+// indexes. Partial indexes are left out, since they only apply to some rows, as are indexes
+// on expressions.
+func (r *schemaRepository) addUniqueConstraints(tables map[string]*models.SchemaTable) error {
+	rows, err := r.db.Query(`
+		SELECT m.name, i.name, c.name
+		FROM sqlite_master m
+		JOIN pragma_index_list(m.name) i
+		JOIN pragma_index_info(i.name) c
+		WHERE ` + applicationTable + ` AND i."unique" = 1 AND i.origin != 'pk' AND i.partial = 0
+		  AND NOT EXISTS (SELECT 1 FROM pragma_index_info(i.name) e WHERE e.cid < 0)
+		ORDER BY m.name, i.name, c.seqno
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to query unique constraints: %w", err)
+	}
+	defer rows.Close()
+
+	currentTable, currentIndex := "", ""
+	for rows.Next() {
+		var tableName, indexName, column string
+		if err := rows.Scan(&tableName, &indexName, &column); err != nil {
+			return fmt.Errorf("failed to scan unique constraint: %w", err)
+		}
+		table, ok := tables[tableName]
+		if !ok {
+			continue
+		}
+
+		if tableName != currentTable || indexName != currentIndex {
+			table.Unique = append(table.Unique, []string{})
+			currentTable, currentIndex = tableName, indexName
+		}
+		last := len(table.Unique) - 1
+		table.Unique[last] = append(table.Unique[last], column)
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating unique constraints: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/season_stats_repository.go -pkg mocks . SeasonStatsRepository
+
+// SeasonStatsRepository defines the interface for season rollup data operations
+type SeasonStatsRepository interface {
+	GetByPlayerID(playerID int) ([]*models.PlayerSeasonStats, error)
+	GetByPlayerAndSeason(playerID int, season string) (*models.PlayerSeasonStats, error)
+	GetRanks(playerID int, season string) (map[string]int, error)
+	GetLeaders(season, stat, position string, perGame bool, limit int) ([]*models.SeasonStatLeader, error)
+	Rebuild() (int, error)
+}
+
+// seasonStatsRepository implements SeasonStatsRepository interface. The rows themselves are
+// written by triggers on player_stats and games, see database/migrations.go.
+type seasonStatsRepository struct {
+	db *TimeoutDB
+}
+
+// NewSeasonStatsRepository creates a new season stats repository
+func NewSeasonStatsRepository(db *TimeoutDB) SeasonStatsRepository {
+	return &seasonStatsRepository{db: db}
+}
+
+// seasonStatsColumns is the select list shared by the season stats queries
+var seasonStatsColumns = "ss.player_id, ss.season, ss.games_played, ss." +
+	strings.Join(models.SeasonStatNames, ", ss.") + ", ss.updated_at"
+
+// isSeasonStat reports whether stat is one of the rollup columns
+func isSeasonStat(stat string) bool {
+	for _, name := range models.SeasonStatNames {
+		if name == stat {
+			return true
+		}
+	}
+	return false
+}
+
+// scanSeasonStats scans a row selected with seasonStatsColumns
+func scanSeasonStats(scanner interface{ Scan(...interface{}) error }) (*models.PlayerSeasonStats, error) {
+	var stats models.PlayerSeasonStats
+	values := make([]float64, len(models.SeasonStatNames))
+
+	dest := []interface{}{&stats.PlayerID, &stats.Season, &stats.GamesPlayed}
+	for i := range values {
+		dest = append(dest, &values[i])
+	}
+	dest = append(dest, &stats.UpdatedAt)
+
+	if err := scanner.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	stats.Totals = make(map[string]float64, len(values))
+	for i, name := range models.SeasonStatNames {
+		stats.Totals[name] = values[i]
+	}
+
+	return &stats, nil
+}
+
+// GetByPlayerID retrieves a player's totals for every season they have stats in, newest first
+func (r *seasonStatsRepository) GetByPlayerID(playerID int) ([]*models.PlayerSeasonStats, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM player_season_stats ss
+		WHERE ss.player_id = ?
+		ORDER BY ss.season DESC
+	`, seasonStatsColumns)
+
+	rows, err := r.db.Query(query, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query season stats: %w", err)
+	}
+	defer rows.Close()
+
+	var seasons []*models.PlayerSeasonStats
+	for rows.Next() {
+		stats, err := scanSeasonStats(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan season stats: %w", err)
+		}
+		seasons = append(seasons, stats)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating season stats: %w", err)
+	}
+
+	return seasons, nil
+}
+
+// GetByPlayerAndSeason retrieves a player's totals for one season
+func (r *seasonStatsRepository) GetByPlayerAndSeason(playerID int, season string) (*models.PlayerSeasonStats, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM player_season_stats ss
+		WHERE ss.player_id = ? AND ss.season = ?
+	`, seasonStatsColumns)
+
+	stats, err := scanSeasonStats(r.db.QueryRow(query, playerID, season))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("season stats for player %d in season %s not found", playerID, season)
+		}
+		return nil, fmt.Errorf("failed to get season stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetRanks retrieves the player's rank among the season's active players for each stat
+// they recorded. Tied players share a rank.
+func (r *seasonStatsRepository) GetRanks(playerID int, season string) (map[string]int, error) {
+	rankColumns := make([]string, len(models.SeasonStatNames))
+	for i, name := range models.SeasonStatNames {
+		rankColumns[i] = fmt.Sprintf("CASE WHEN ss.%[1]s > 0 THEN RANK() OVER (ORDER BY ss.%[1]s DESC) END", name)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT * FROM (
+			SELECT ss.player_id, %s
+			FROM player_season_stats ss
+			JOIN players p ON ss.player_id = p.id
+			WHERE ss.season = ? AND p.deleted_at IS NULL
+		)
+		WHERE player_id = ?
+	`, strings.Join(rankColumns, ",\n\t\t\t       "))
+
+	var id int
+	ranks := make([]*int, len(models.SeasonStatNames))
+	dest := []interface{}{&id}
+	for i := range ranks {
+		dest = append(dest, &ranks[i])
+	}
+
+	err := r.db.QueryRow(query, season, playerID).Scan(dest...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("season stats for player %d in season %s not found", playerID, season)
+		}
+		return nil, fmt.Errorf("failed to get season ranks: %w", err)
+	}
+
+	result := make(map[string]int)
+	for i, name := range models.SeasonStatNames {
+		if ranks[i] != nil {
+			result[name] = *ranks[i]
+		}
+	}
+
+	return result, nil
+}
+
+// GetLeaders ranks the season's active players by one stat, by total or by per-game average.
+// Players who did not record the stat are left out, and a position narrows the ranking to it.
+func (r *seasonStatsRepository) GetLeaders(season, stat, position string, perGame bool, limit int) ([]*models.SeasonStatLeader, error) {
+	if !isSeasonStat(stat) {
+		return nil, fmt.Errorf("unknown season stat %q", stat)
+	}
+
+	orderBy := "ss." + stat
+	if perGame {
+		orderBy = fmt.Sprintf("CAST(ss.%s AS REAL) / ss.games_played", stat)
+	}
+
+	conditions := []string{"ss.season = ?", "p.deleted_at IS NULL", fmt.Sprintf("ss.%s > 0", stat)}
+	args := []interface{}{season}
+	if position != "" {
+		conditions = append(conditions, "p.position = ?")
+		args = append(args, position)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+		SELECT RANK() OVER (ORDER BY %[1]s DESC) AS rank,
+		       ss.player_id, p.first_name, p.last_name, p.position, p.team_id,
+		       ss.games_played, ss.%[2]s, ROUND(CAST(ss.%[2]s AS REAL) / ss.games_played, 2)
+		FROM player_season_stats ss
+		JOIN players p ON ss.player_id = p.id
+		WHERE %[3]s
+		ORDER BY rank, p.last_name, p.first_name
+		LIMIT ?
+	`, orderBy, stat, strings.Join(conditions, " AND "))
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query season leaders: %w", err)
+	}
+	defer rows.Close()
+
+	var leaders []*models.SeasonStatLeader
+	for rows.Next() {
+		var leader models.SeasonStatLeader
+		err := rows.Scan(
+			&leader.Rank, &leader.PlayerID, &leader.FirstName, &leader.LastName, &leader.Position, &leader.TeamID,
+			&leader.GamesPlayed, &leader.Total, &leader.PerGame,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan season leader: %w", err)
+		}
+		leaders = append(leaders, &leader)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating season leaders: %w", err)
+	}
+
+	return leaders, nil
+}
+
+// Rebuild recomputes every season total from the stat lines and returns the number of rows
+func (r *seasonStatsRepository) Rebuild() (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM player_season_stats"); err != nil {
+		return 0, fmt.Errorf("failed to clear season stats: %w", err)
+	}
+
+	rows, err := execRowsAffected(tx, "INSERT INTO player_season_stats SELECT *, CURRENT_TIMESTAMP FROM player_season_totals")
+	if err != nil {
+		return 0, fmt.Errorf("failed to rebuild season stats: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit season stats rebuild: %w", err)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/sport_repository.go -pkg mocks . SportRepository
+
+// SportRepository defines the interface for sport and stat definition data operations
+type SportRepository interface {
+	GetAll() ([]*models.Sport, error)
+	GetByCode(code string) (*models.Sport, error)
+	GetByPlayerID(playerID int) (*models.Sport, error)
+}
+
+// sportColumns maps the sports columns to the Sport fields
+var sportColumns = newRowMapper[models.Sport]()
+
+// statDefinitionColumns maps the stat_definitions columns to the StatDefinition fields
+var statDefinitionColumns = newRowMapper[models.StatDefinition]()
+
+// sportRepository implements SportRepository interface
+type sportRepository struct {
+	db *TimeoutDB
+}
+
+// NewSportRepository creates a new sport repository
+func NewSportRepository(db *TimeoutDB) SportRepository {
+	return &sportRepository{db: db}
+}
+
+// GetAll retrieves every sport without its stat definitions, ordered by code
+func (r *sportRepository) GetAll() ([]*models.Sport, error) {
+	rows, err := r.db.Query("SELECT " + sportColumns.columnList() + " FROM sports ORDER BY code ASC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sports: %w", err)
+	}
+	defer rows.Close()
+
+	var sports []*models.Sport
+	for rows.Next() {
+		var sport models.Sport
+		if err := rows.Scan(sportColumns.targets(&sport)...); err != nil {
+			return nil, fmt.Errorf("failed to scan sport: %w", err)
+		}
+		sports = append(sports, &sport)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sports: %w", err)
+	}
+
+	return sports, nil
+}
+
+// GetByCode retrieves a sport with its stat definitions
+func (r *sportRepository) GetByCode(code string) (*models.Sport, error) {
+	var sport models.Sport
+	query := "SELECT " + sportColumns.columnList() + " FROM sports WHERE code = ?"
+	err := r.db.QueryRow(query, code).Scan(sportColumns.targets(&sport)...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("sport %q not found", code)
+		}
+		return nil, fmt.Errorf("failed to get sport: %w", err)
+	}
+
+	if sport.Stats, err = r.getStatDefinitions(sport.Code); err != nil {
+		return nil, err
+	}
+
+	return &sport, nil
+}
+
+// GetByPlayerID retrieves the sport of the player's team, with its stat definitions
+func (r *sportRepository) GetByPlayerID(playerID int) (*models.Sport, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM players p
+		JOIN teams t ON p.team_id = t.id
+		JOIN sports s ON t.sport = s.code
+		WHERE p.id = ?
+	`, sportColumns.selectList("s"))
+
+	var sport models.Sport
+	err := r.db.QueryRow(query, playerID).Scan(sportColumns.targets(&sport)...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("sport for player with ID %d not found", playerID)
+		}
+		return nil, fmt.Errorf("failed to get player sport: %w", err)
+	}
+
+	if sport.Stats, err = r.getStatDefinitions(sport.Code); err != nil {
+		return nil, err
+	}
+
+	return &sport, nil
+}
+
+// getStatDefinitions retrieves a sport's stat definitions in display order
+func (r *sportRepository) getStatDefinitions(sport string) ([]*models.StatDefinition, error) {
+	query := `
+		SELECT ` + statDefinitionColumns.columnList() + `
+		FROM stat_definitions
+		WHERE sport = ?
+		ORDER BY sort_order ASC, name ASC
+	`
+
+	rows, err := r.db.Query(query, sport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stat definitions: %w", err)
+	}
+	defer rows.Close()
+
+	var definitions []*models.StatDefinition
+	for rows.Next() {
+		var definition models.StatDefinition
+		if err := rows.Scan(statDefinitionColumns.targets(&definition)...); err != nil {
+			return nil, fmt.Errorf("failed to scan stat definition: %w", err)
+		}
+		definitions = append(definitions, &definition)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stat definitions: %w", err)
+	}
+
+	return definitions, nil
+}
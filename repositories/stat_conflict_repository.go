@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/stat_conflict_repository.go -pkg mocks . StatConflictRepository
+
+// StatConflictRepository defines the interface for stat conflict data operations
+type StatConflictRepository interface {
+	GetBySource(source string, statsIDs []int) ([]*models.StatConflict, error)
+	List(gameID int, source string, limit int) ([]*models.StatConflict, error)
+	Save(conflicts []*models.StatConflict, resolved []int) error
+}
+
+// statConflictRepository implements StatConflictRepository interface
+type statConflictRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewStatConflictRepository creates a new stat conflict repository
+func NewStatConflictRepository(db *TimeoutDB, clock clock.Clock) StatConflictRepository {
+	return &statConflictRepository{db: db, clock: clock}
+}
+
+// statConflictColumns maps the stat_conflicts columns to the StatConflict fields
+var statConflictColumns = newRowMapper[models.StatConflict]()
+
+// statConflictSelectList selects a conflict with its stat line's player and game
+var statConflictSelectList = statConflictColumns.selectList("c") + ", ps.player_id, ps.game_id"
+
+// GetBySource retrieves the open conflicts of values a source reported for the stat lines
+func (r *statConflictRepository) GetBySource(source string, statsIDs []int) ([]*models.StatConflict, error) {
+	if len(statsIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(statsIDs)), ", ")
+	args := []interface{}{source}
+	for _, id := range statsIDs {
+		args = append(args, id)
+	}
+
+	query := `
+		SELECT ` + statConflictSelectList + `
+		FROM stat_conflicts c
+		JOIN player_stats ps ON ps.id = c.stats_id
+		WHERE c.rejected_source = ? AND c.stats_id IN (` + placeholders + `)
+	`
+	return r.query(query, args...)
+}
+
+// List retrieves the most recently detected open conflicts, optionally only those of one game
+// or of values one source reported
+func (r *statConflictRepository) List(gameID int, source string, limit int) ([]*models.StatConflict, error) {
+	var conditions []string
+	var args []interface{}
+	if gameID != 0 {
+		conditions = append(conditions, "ps.game_id = ?")
+		args = append(args, gameID)
+	}
+	if source != "" {
+		conditions = append(conditions, "c.rejected_source = ?")
+		args = append(args, source)
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, limit)
+
+	query := `
+		SELECT ` + statConflictSelectList + `
+		FROM stat_conflicts c
+		JOIN player_stats ps ON ps.id = c.stats_id
+		` + where + `
+		ORDER BY c.detected_at DESC, c.id DESC
+		LIMIT ?
+	`
+	return r.query(query, args...)
+}
+
+// Save records new conflicts, or replaces the values of the open conflict of the same source on
+// the same stat, and deletes the resolved conflicts by ID, all in one transaction. The detection
+// time of saved conflicts is set to now.
+func (r *statConflictRepository) Save(conflicts []*models.StatConflict, resolved []int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, id := range resolved {
+		if _, err := tx.Exec("DELETE FROM stat_conflicts WHERE id = ?", id); err != nil {
+			return fmt.Errorf("failed to resolve stat conflict: %w", err)
+		}
+	}
+
+	currentTime := r.clock.Now()
+	for _, conflict := range conflicts {
+		conflict.DetectedAt = currentTime
+		err := tx.QueryRow(`
+			INSERT INTO stat_conflicts (stats_id, stat, kept_value, kept_source, rejected_value, rejected_source, detected_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (stats_id, stat, rejected_source) DO UPDATE SET
+				kept_value = excluded.kept_value,
+				kept_source = excluded.kept_source,
+				rejected_value = excluded.rejected_value,
+				detected_at = excluded.detected_at
+			RETURNING id`,
+			conflict.StatsID, conflict.Stat, conflict.KeptValue, conflict.KeptSource,
+			conflict.RejectedValue, conflict.RejectedSource, conflict.DetectedAt,
+		).Scan(&conflict.ID)
+		if err != nil {
+			return fmt.Errorf("failed to save stat conflict: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// query runs a stat conflict query and scans its rows
+func (r *statConflictRepository) query(query string, args ...interface{}) ([]*models.StatConflict, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stat conflicts: %w", err)
+	}
+	defer rows.Close()
+
+	conflicts := []*models.StatConflict{}
+	for rows.Next() {
+		var conflict models.StatConflict
+		targets := append(statConflictColumns.targets(&conflict), &conflict.PlayerID, &conflict.GameID)
+		if err := rows.Scan(targets...); err != nil {
+			return nil, fmt.Errorf("failed to scan stat conflict: %w", err)
+		}
+		conflicts = append(conflicts, &conflict)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stat conflicts: %w", err)
+	}
+
+	return conflicts, nil
+}
@@ -0,0 +1,130 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories"
+	"sports-backend/testutil"
+)
+
+// benchmarkPositions is the roster each benchmark team carries, cycled through its players
+var benchmarkPositions = []string{"QB", "RB", "RB", "WR", "WR", "WR", "TE", "K", "LB", "LB", "CB", "S"}
+
+// newBenchmarkSeason fills a database with a full regular season: 32 teams of 12 players, and
+// 17 weeks of 16 completed games with a stat line for every player on both teams, about 6,500
+// lines in all. The season_stats rollup is kept by the triggers as the lines are written.
+func newBenchmarkSeason(b *testing.B) *repositories.TimeoutDB {
+	b.Helper()
+
+	db := testutil.NewDB(b)
+	factory := testutil.NewFactory(b, db)
+
+	teams := make([]*models.Team, 32)
+	rosters := make(map[int][]*models.Player, len(teams))
+	for i := range teams {
+		teams[i] = factory.Team()
+		for _, position := range benchmarkPositions {
+			position := position
+			rosters[teams[i].ID] = append(rosters[teams[i].ID], factory.Player(teams[i].ID, func(p *models.Player) {
+				p.Position = position
+			}))
+		}
+	}
+
+	for week := 1; week <= 17; week++ {
+		// Rotate the away teams so each week has new matchups
+		for i := 0; i < len(teams)/2; i++ {
+			home := teams[i]
+			away := teams[len(teams)/2+(i+week)%(len(teams)/2)]
+			game := factory.Game(home.ID, away.ID, func(g *models.Game) {
+				g.Week = week
+				g.Status = "completed"
+				g.HomeScore = testutil.Int(17 + (week+i)%14)
+				g.AwayScore = testutil.Int(13 + (week*i)%17)
+			})
+
+			for _, team := range []*models.Team{home, away} {
+				for _, player := range rosters[team.ID] {
+					n := player.ID + week
+					factory.Stats(player.ID, game.ID, func(s *models.PlayerStats) {
+						switch player.Position {
+						case "QB":
+							s.PassingAttempts = testutil.Int(28 + n%12)
+							s.PassingCompletions = testutil.Int(18 + n%9)
+							s.PassingYards = testutil.Int(180 + n%160)
+							s.PassingTouchdowns = testutil.Int(n % 4)
+						case "RB":
+							s.RushingAttempts = testutil.Int(8 + n%14)
+							s.RushingYards = testutil.Int(30 + n%90)
+							s.RushingTouchdowns = testutil.Int(n % 2)
+						case "WR", "TE":
+							s.ReceivingTargets = testutil.Int(3 + n%8)
+							s.Receptions = testutil.Int(2 + n%6)
+							s.ReceivingYards = testutil.Int(20 + n%100)
+						default:
+							s.Tackles = testutil.Int(n % 9)
+						}
+					})
+				}
+			}
+		}
+	}
+	return db
+}
+
+func BenchmarkPlayerStatsGetByWeek(b *testing.B) {
+	repo := repositories.NewPlayerStatsRepository(newBenchmarkSeason(b), clock.System)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stats, err := repo.GetByWeek("2026", 9)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(stats) == 0 {
+			b.Fatal("week 9 has no stat lines")
+		}
+	}
+}
+
+func BenchmarkSeasonStatsGetLeaders(b *testing.B) {
+	repo := repositories.NewSeasonStatsRepository(newBenchmarkSeason(b))
+	b.ResetTimer()
+
+	b.Run("totals", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.GetLeaders("2026", "passing_yards", "", false, 10); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("per game by position", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.GetLeaders("2026", "rushing_yards", "RB", true, 10); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkSeasonStatsTotals(b *testing.B) {
+	repo := repositories.NewSeasonStatsRepository(newBenchmarkSeason(b))
+	b.ResetTimer()
+
+	b.Run("season", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.GetByPlayerAndSeason(1, "2026"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("career", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.GetByPlayerID(1); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -3,45 +3,56 @@ package repositories
 import (
 	"database/sql"
 	"fmt"
-	"time"
+	"strings"
 
+	"sports-backend/clock"
 	"sports-backend/models"
 )
 
+//go:generate moq -out mocks/team_repository.go -pkg mocks . TeamRepository
+
 // TeamRepository defines the interface for team data operations
 type TeamRepository interface {
 	GetByID(id int) (*models.Team, error)
+	GetByIDs(ids []int) (map[int]*models.Team, error)
 	GetAll() ([]*models.Team, error)
 	GetByConference(conference string) ([]*models.Team, error)
 	GetByDivision(division string) ([]*models.Team, error)
+	SearchByName(query string, limit int) ([]*models.Team, error)
 	Create(team *models.Team) error
 	Update(team *models.Team) error
 	Delete(id int) error
+	Restore(id int) error
+	Purge(id int) error
 	Exists(id int) (bool, error)
+	ExistsMany(ids []int) (map[int]bool, error)
+	HasPlayers(id int) (bool, error)
+	HasGames(id int) (bool, error)
 }
 
+// teamColumns maps the teams columns to the Team fields
+var teamColumns = newRowMapper[models.Team]()
+
 // teamRepository implements TeamRepository interface
 type teamRepository struct {
-	db *sql.DB
+	db    *TimeoutDB
+	clock clock.Clock
 }
 
 // NewTeamRepository creates a new team repository
-func NewTeamRepository(db *sql.DB) TeamRepository {
-	return &teamRepository{db: db}
+func NewTeamRepository(db *TimeoutDB, clock clock.Clock) TeamRepository {
+	return &teamRepository{db: db, clock: clock}
 }
 
 // GetByID retrieves a team by their ID
 func (r *teamRepository) GetByID(id int) (*models.Team, error) {
-	query := `
-		SELECT id, name, city, conference, division, created_at, updated_at
-		FROM teams WHERE id = ?
-	`
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM teams t WHERE t.id = ? AND t.deleted_at IS NULL
+	`, teamColumns.selectList("t"))
 
 	var team models.Team
-	err := r.db.QueryRow(query, id).Scan(
-		&team.ID, &team.Name, &team.City, &team.Conference,
-		&team.Division, &team.CreatedAt, &team.UpdatedAt,
-	)
+	err := r.db.QueryRow(query, id).Scan(teamColumns.targets(&team)...)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -53,48 +64,67 @@ func (r *teamRepository) GetByID(id int) (*models.Team, error) {
 	return &team, nil
 }
 
-// GetAll retrieves all teams
-func (r *teamRepository) GetAll() ([]*models.Team, error) {
-	query := `
-		SELECT id, name, city, conference, division, created_at, updated_at
-		FROM teams
-		ORDER BY conference ASC, division ASC, name ASC
-	`
+// GetByIDs retrieves the active teams with the given IDs, keyed by ID
+func (r *teamRepository) GetByIDs(ids []int) (map[int]*models.Team, error) {
+	teams := make(map[int]*models.Team)
+	if len(ids) == 0 {
+		return teams, nil
+	}
 
-	rows, err := r.db.Query(query)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM teams t WHERE t.id IN (%s) AND t.deleted_at IS NULL
+	`, teamColumns.selectList("t"), placeholders)
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query teams: %w", err)
 	}
 	defer rows.Close()
 
-	var teams []*models.Team
-	for rows.Next() {
-		var team models.Team
-		err := rows.Scan(
-			&team.ID, &team.Name, &team.City, &team.Conference,
-			&team.Division, &team.CreatedAt, &team.UpdatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan team: %w", err)
-		}
-		teams = append(teams, &team)
+	list, err := scanTeams(rows)
+	if err != nil {
+		return nil, err
 	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating teams: %w", err)
+	for _, team := range list {
+		teams[team.ID] = team
 	}
 
 	return teams, nil
 }
 
+// GetAll retrieves all teams
+func (r *teamRepository) GetAll() ([]*models.Team, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM teams t
+		WHERE t.deleted_at IS NULL
+		ORDER BY t.conference ASC, t.division ASC, t.name ASC
+	`, teamColumns.selectList("t"))
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query teams: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTeams(rows)
+}
+
 // GetByConference retrieves all teams in a specific conference
 func (r *teamRepository) GetByConference(conference string) ([]*models.Team, error) {
-	query := `
-		SELECT id, name, city, conference, division, created_at, updated_at
-		FROM teams
-		WHERE conference = ?
-		ORDER BY division ASC, name ASC
-	`
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM teams t
+		WHERE t.conference = ? AND t.deleted_at IS NULL
+		ORDER BY t.division ASC, t.name ASC
+	`, teamColumns.selectList("t"))
 
 	rows, err := r.db.Query(query, conference)
 	if err != nil {
@@ -102,34 +132,17 @@ func (r *teamRepository) GetByConference(conference string) ([]*models.Team, err
 	}
 	defer rows.Close()
 
-	var teams []*models.Team
-	for rows.Next() {
-		var team models.Team
-		err := rows.Scan(
-			&team.ID, &team.Name, &team.City, &team.Conference,
-			&team.Division, &team.CreatedAt, &team.UpdatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan team: %w", err)
-		}
-		teams = append(teams, &team)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating teams: %w", err)
-	}
-
-	return teams, nil
+	return scanTeams(rows)
 }
 
 // GetByDivision retrieves all teams in a specific division
 func (r *teamRepository) GetByDivision(division string) ([]*models.Team, error) {
-	query := `
-		SELECT id, name, city, conference, division, created_at, updated_at
-		FROM teams
-		WHERE division = ?
-		ORDER BY name ASC
-	`
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM teams t
+		WHERE t.division = ? AND t.deleted_at IS NULL
+		ORDER BY t.name ASC
+	`, teamColumns.selectList("t"))
 
 	rows, err := r.db.Query(query, division)
 	if err != nil {
@@ -137,38 +150,73 @@ func (r *teamRepository) GetByDivision(division string) ([]*models.Team, error)
 	}
 	defer rows.Close()
 
+	return scanTeams(rows)
+}
+
+// SearchByName retrieves teams whose name or city starts with the query. Matches are ranked
+// before the limit applies: exact "city name" or names first, then "city name" prefixes, name
+// prefixes and city prefixes.
+func (r *teamRepository) SearchByName(query string, limit int) ([]*models.Team, error) {
+	rank, rankArgs := searchRank(query, strings.Contains(query, " "), `t.city || ' ' || t.name`, "t.name", "t.city")
+	sqlQuery := fmt.Sprintf(`
+		SELECT %s
+		FROM teams t
+		WHERE (t.name LIKE ? ESCAPE '\' OR t.city LIKE ? ESCAPE '\') AND t.deleted_at IS NULL
+		ORDER BY %s, t.name ASC
+		LIMIT ?
+	`, teamColumns.selectList("t"), rank)
+
+	pattern := prefixPattern(query)
+	args := append([]interface{}{pattern, pattern}, rankArgs...)
+	rows, err := r.db.Query(sqlQuery, append(args, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search teams: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTeams(rows)
+}
+
+// scanTeams reads teams selected with teamColumns
+func scanTeams(rows *Rows) ([]*models.Team, error) {
 	var teams []*models.Team
 	for rows.Next() {
 		var team models.Team
-		err := rows.Scan(
-			&team.ID, &team.Name, &team.City, &team.Conference,
-			&team.Division, &team.CreatedAt, &team.UpdatedAt,
-		)
-		if err != nil {
+		if err := rows.Scan(teamColumns.targets(&team)...); err != nil {
 			return nil, fmt.Errorf("failed to scan team: %w", err)
 		}
 		teams = append(teams, &team)
 	}
 
-	if err = rows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating teams: %w", err)
 	}
 
 	return teams, nil
 }
 
-// Create adds a new team to the database
+// Create adds a new team to the database, with the provider IDs in its ExternalIDs, in one
+// transaction
 func (r *teamRepository) Create(team *models.Team) error {
 	query := `
-		INSERT INTO teams (name, city, conference, division, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?)
+		INSERT INTO teams (name, city, conference, division, sport, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 	`
 
-	currentTime := time.Now()
-	result, err := r.db.Exec(query,
-		team.Name, team.City, team.Conference, team.Division, currentTime, currentTime,
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	currentTime := r.clock.Now()
+	result, err := tx.Exec(query,
+		team.Name, team.City, team.Conference, team.Division, team.Sport, currentTime, currentTime,
 	)
 	if err != nil {
+		if conflict := uniqueViolation(err, "team"); conflict != nil {
+			return conflict
+		}
 		return fmt.Errorf("failed to create team: %w", err)
 	}
 
@@ -176,6 +224,13 @@ func (r *teamRepository) Create(team *models.Team) error {
 	if err != nil {
 		return fmt.Errorf("failed to get team ID: %w", err)
 	}
+	if err := insertExternalIDs(tx, "team", int(id), team.ExternalIDs, currentTime); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
 	team.ID = int(id)
 	team.CreatedAt = currentTime
@@ -188,15 +243,18 @@ func (r *teamRepository) Create(team *models.Team) error {
 func (r *teamRepository) Update(team *models.Team) error {
 	query := `
 		UPDATE teams 
-		SET name = ?, city = ?, conference = ?, division = ?, updated_at = ?
-		WHERE id = ?
+		SET name = ?, city = ?, conference = ?, division = ?, logo_url = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	currentTime := time.Now()
+	currentTime := r.clock.Now()
 	result, err := r.db.Exec(query,
-		team.Name, team.City, team.Conference, team.Division, currentTime, team.ID,
+		team.Name, team.City, team.Conference, team.Division, team.LogoURL, currentTime, team.ID,
 	)
 	if err != nil {
+		if conflict := uniqueViolation(err, "team"); conflict != nil {
+			return conflict
+		}
 		return fmt.Errorf("failed to update team: %w", err)
 	}
 
@@ -213,10 +271,10 @@ func (r *teamRepository) Update(team *models.Team) error {
 	return nil
 }
 
-// Delete removes a team from the database
+// Delete soft-deletes a team so it can later be restored
 func (r *teamRepository) Delete(id int) error {
-	query := "DELETE FROM teams WHERE id = ?"
-	result, err := r.db.Exec(query, id)
+	query := "UPDATE teams SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL"
+	result, err := r.db.Exec(query, r.clock.Now(), id)
 	if err != nil {
 		return fmt.Errorf("failed to delete team: %w", err)
 	}
@@ -233,9 +291,76 @@ func (r *teamRepository) Delete(id int) error {
 	return nil
 }
 
+// Restore brings back a soft-deleted team
+func (r *teamRepository) Restore(id int) error {
+	query := "UPDATE teams SET deleted_at = NULL, updated_at = ? WHERE id = ? AND deleted_at IS NOT NULL"
+	result, err := r.db.Exec(query, r.clock.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to restore team: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("deleted team with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// Purge permanently removes a soft-deleted team that nothing references any more, along with
+// its external IDs and news links
+func (r *teamRepository) Purge(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkPurgeable(tx, "teams", "team", id); err != nil {
+		return err
+	}
+
+	var referenced int
+	err = tx.QueryRow(`
+		SELECT EXISTS (SELECT 1 FROM players WHERE team_id = ?)
+		    OR EXISTS (SELECT 1 FROM games WHERE home_team_id = ? OR away_team_id = ?)
+		    OR EXISTS (SELECT 1 FROM draft_picks WHERE team_id = ?)
+	`, id, id, id, id).Scan(&referenced)
+	if err != nil {
+		return fmt.Errorf("failed to check team references: %w", err)
+	}
+	if referenced == 1 {
+		return fmt.Errorf("team with ID %d cannot be purged because players, games or draft picks still reference it", id)
+	}
+
+	if _, err := tx.Exec("DELETE FROM external_ids WHERE entity_type = 'team' AND entity_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete team external IDs: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM news_item_teams WHERE team_id = ?", id); err != nil {
+		return fmt.Errorf("failed to unlink team news: %w", err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM teams WHERE id = ?", id); err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("team with ID %d cannot be purged because other records still reference it", id)
+		}
+		return fmt.Errorf("failed to purge team: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 // Exists checks if a team exists by ID
 func (r *teamRepository) Exists(id int) (bool, error) {
-	query := "SELECT 1 FROM teams WHERE id = ? LIMIT 1"
+	query := "SELECT 1 FROM teams WHERE id = ? AND deleted_at IS NULL LIMIT 1"
 	var exists int
 	err := r.db.QueryRow(query, id).Scan(&exists)
 	if err != nil {
@@ -246,3 +371,41 @@ func (r *teamRepository) Exists(id int) (bool, error) {
 	}
 	return true, nil
 }
+
+// ExistsMany reports which of the teams exist, checking them in batches rather than one query
+// each. IDs that don't exist are absent from the result.
+func (r *teamRepository) ExistsMany(ids []int) (map[int]bool, error) {
+	found, err := existsMany(r.db, "teams", ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	return found, nil
+}
+
+// HasPlayers checks if any active player is on the team's roster
+func (r *teamRepository) HasPlayers(id int) (bool, error) {
+	query := "SELECT 1 FROM players WHERE team_id = ? AND deleted_at IS NULL LIMIT 1"
+	var exists int
+	err := r.db.QueryRow(query, id).Scan(&exists)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check team players: %w", err)
+	}
+	return true, nil
+}
+
+// HasGames checks if the team plays in any active game
+func (r *teamRepository) HasGames(id int) (bool, error) {
+	query := "SELECT 1 FROM games WHERE (home_team_id = ? OR away_team_id = ?) AND deleted_at IS NULL LIMIT 1"
+	var exists int
+	err := r.db.QueryRow(query, id, id).Scan(&exists)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check team games: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,310 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sports-backend/models"
+)
+
+// TimeoutDB is the database handle the repositories query through. Each query runs under its
+// own deadline, so a pathological scan is interrupted instead of holding a connection
+// indefinitely. Every query is timed: queries slower than the slow query threshold are logged,
+// and counts, durations and timeouts are kept per query. Queries that find the database locked
+// are retried with backoff within their deadline. Queries that stream their rows to a caller,
+// such as exports, run under a longer deadline of their own and are not logged as slow, since
+// reading them takes as long as the caller does. Transactions are not bounded by the deadline,
+// timed or retried.
+type TimeoutDB struct {
+	db            *sql.DB
+	timeout       time.Duration
+	streamTimeout time.Duration
+	slowThreshold time.Duration
+	retries       int
+
+	queries          atomic.Int64
+	timedOut         atomic.Int64
+	slow             atomic.Int64
+	retried          atomic.Int64
+	retriesExhausted atomic.Int64
+
+	mu     sync.Mutex
+	byText map[string]*queryStats
+}
+
+// queryStats accumulates the runs of one query
+type queryStats struct {
+	count    int64
+	total    time.Duration
+	max      time.Duration
+	slow     int64
+	timedOut int64
+}
+
+// NewTimeoutDB wraps db so each query is cancelled after timeout, or streamTimeout for streamed
+// queries, and logged when it takes longer than slowThreshold, retrying up to retries times on
+// transient errors. A timeout of 0 lets queries run to completion, a slowThreshold of 0 turns
+// off the slow query log, and retries of 0 fails queries on the first error.
+func NewTimeoutDB(db *sql.DB, timeout, streamTimeout, slowThreshold time.Duration, retries int) *TimeoutDB {
+	return &TimeoutDB{
+		db:            db,
+		timeout:       timeout,
+		streamTimeout: streamTimeout,
+		slowThreshold: slowThreshold,
+		retries:       retries,
+		byText:        make(map[string]*queryStats),
+	}
+}
+
+// Rows is a result set whose query deadline is released when it is closed
+type Rows struct {
+	*sql.Rows
+	finish func(error)
+}
+
+// Close closes the result set, recording it as timed out if iteration was cut short by the deadline
+func (r *Rows) Close() error {
+	iterErr := r.Rows.Err()
+	err := r.Rows.Close()
+	r.finish(iterErr)
+	return err
+}
+
+// Row is a single-row result whose query deadline is released once it is scanned
+type Row struct {
+	*sql.Row
+	finish func(error)
+
+	// The query is run again from Scan, where its error surfaces, if that error is transient
+	db    *TimeoutDB
+	ctx   context.Context
+	query string
+	args  []interface{}
+}
+
+// Scan copies the row into dest, recording the query as timed out if the deadline cut it short
+func (r *Row) Scan(dest ...interface{}) error {
+	for attempt := 0; ; attempt++ {
+		err := r.Row.Scan(dest...)
+		if r.db.retry(r.ctx, r.query, attempt, err) {
+			r.Row = r.db.db.QueryRowContext(r.ctx, r.query, r.args...)
+			continue
+		}
+		r.finish(err)
+		return err
+	}
+}
+
+// Exec runs a statement under the query deadline
+func (d *TimeoutDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	ctx, finish := d.start(query, args, d.timeout, d.slowThreshold)
+	for attempt := 0; ; attempt++ {
+		result, err := d.db.ExecContext(ctx, query, args...)
+		if d.retry(ctx, query, attempt, err) {
+			continue
+		}
+		finish(err)
+		return result, err
+	}
+}
+
+// Query runs a query under the query deadline. The deadline covers iterating the rows,
+// and the caller must close them. Only errors starting the query are retried, not those
+// reading the rows.
+func (d *TimeoutDB) Query(query string, args ...interface{}) (*Rows, error) {
+	return d.query(query, args, d.timeout, d.slowThreshold)
+}
+
+// Stream runs a query whose rows are handed on to a caller as they are read, such as an
+// export, under the stream deadline rather than the query deadline. It is never logged as slow.
+func (d *TimeoutDB) Stream(query string, args ...interface{}) (*Rows, error) {
+	return d.query(query, args, d.streamTimeout, 0)
+}
+
+// query runs a query under a deadline, as Query does
+func (d *TimeoutDB) query(query string, args []interface{}, timeout, slowThreshold time.Duration) (*Rows, error) {
+	ctx, finish := d.start(query, args, timeout, slowThreshold)
+	for attempt := 0; ; attempt++ {
+		rows, err := d.db.QueryContext(ctx, query, args...)
+		if d.retry(ctx, query, attempt, err) {
+			continue
+		}
+		if err != nil {
+			finish(err)
+			return nil, err
+		}
+		return &Rows{Rows: rows, finish: finish}, nil
+	}
+}
+
+// QueryRow runs a query expected to return at most one row under the query deadline
+func (d *TimeoutDB) QueryRow(query string, args ...interface{}) *Row {
+	ctx, finish := d.start(query, args, d.timeout, d.slowThreshold)
+	return &Row{
+		Row:    d.db.QueryRowContext(ctx, query, args...),
+		finish: finish,
+		db:     d,
+		ctx:    ctx,
+		query:  query,
+		args:   args,
+	}
+}
+
+// Ping checks that the database can be reached, under the query deadline. Pings are not
+// counted as queries.
+func (d *TimeoutDB) Ping() error {
+	if d.timeout <= 0 {
+		return d.db.Ping()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+	return d.db.PingContext(ctx)
+}
+
+// Begin starts a transaction. Statements in the transaction are not bounded by the query deadline.
+func (d *TimeoutDB) Begin() (*sql.Tx, error) {
+	return d.db.Begin()
+}
+
+// Metrics reports how many queries have run, how long each query has taken and which
+// queries were slow, were retried or hit the deadline, along with the connection pool statistics
+func (d *TimeoutDB) Metrics() *models.QueryMetrics {
+	metrics := &models.QueryMetrics{
+		Timeout:            d.timeout.String(),
+		StreamTimeout:      d.streamTimeout.String(),
+		SlowQueryThreshold: d.slowThreshold.String(),
+		Queries:            d.queries.Load(),
+		TimedOut:           d.timedOut.Load(),
+		Slow:               d.slow.Load(),
+		Retries:            d.retries,
+		Retried:            d.retried.Load(),
+		RetriesExhausted:   d.retriesExhausted.Load(),
+	}
+
+	pool := d.db.Stats()
+	metrics.Pool = &models.PoolStats{
+		MaxOpen:           pool.MaxOpenConnections,
+		Open:              pool.OpenConnections,
+		InUse:             pool.InUse,
+		Idle:              pool.Idle,
+		WaitCount:         pool.WaitCount,
+		WaitMs:            milliseconds(pool.WaitDuration),
+		MaxIdleClosed:     pool.MaxIdleClosed,
+		MaxIdleTimeClosed: pool.MaxIdleTimeClosed,
+		MaxLifetimeClosed: pool.MaxLifetimeClosed,
+	}
+
+	d.mu.Lock()
+	for text, stats := range d.byText {
+		metrics.Statements = append(metrics.Statements, &models.QueryStats{
+			Query:    text,
+			Count:    stats.count,
+			TotalMs:  milliseconds(stats.total),
+			MeanMs:   milliseconds(stats.total / time.Duration(stats.count)),
+			MaxMs:    milliseconds(stats.max),
+			Slow:     stats.slow,
+			TimedOut: stats.timedOut,
+		})
+		if stats.timedOut > 0 {
+			metrics.TimedOutQueries = append(metrics.TimedOutQueries, &models.TimedOutQuery{Query: text, Count: stats.timedOut})
+		}
+	}
+	d.mu.Unlock()
+
+	sort.Slice(metrics.Statements, func(i, j int) bool {
+		a, b := metrics.Statements[i], metrics.Statements[j]
+		if a.TotalMs != b.TotalMs {
+			return a.TotalMs > b.TotalMs
+		}
+		return a.Query < b.Query
+	})
+	sort.Slice(metrics.TimedOutQueries, func(i, j int) bool {
+		a, b := metrics.TimedOutQueries[i], metrics.TimedOutQueries[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Query < b.Query
+	})
+
+	return metrics
+}
+
+// start derives the context for one query, under timeout. The returned finish must be called
+// exactly once with the query's error when the query is done; it releases the deadline and
+// records the query's duration, logging it if it took longer than slowThreshold, and whether
+// it timed out.
+func (d *TimeoutDB) start(query string, args []interface{}, timeout, slowThreshold time.Duration) (context.Context, func(error)) {
+	d.queries.Add(1)
+	started := time.Now()
+
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	return ctx, func(err error) {
+		elapsed := time.Since(started)
+		// SQLite reports an interrupted statement with its own error, so the context is checked too
+		timedOut := err != nil && ctx.Err() == context.DeadlineExceeded
+		cancel()
+		d.record(query, args, elapsed, timedOut, timeout, slowThreshold > 0 && elapsed >= slowThreshold)
+	}
+}
+
+// record adds one run of a query to its stats and logs it if it was slow or cancelled at the deadline
+func (d *TimeoutDB) record(query string, args []interface{}, elapsed time.Duration, timedOut bool, timeout time.Duration, slow bool) {
+	text := strings.Join(strings.Fields(query), " ")
+
+	d.mu.Lock()
+	stats, ok := d.byText[text]
+	if !ok {
+		stats = &queryStats{}
+		d.byText[text] = stats
+	}
+	stats.count++
+	stats.total += elapsed
+	if elapsed > stats.max {
+		stats.max = elapsed
+	}
+	if slow {
+		stats.slow++
+	}
+	if timedOut {
+		stats.timedOut++
+	}
+	d.mu.Unlock()
+
+	if timedOut {
+		d.timedOut.Add(1)
+		log.Printf("Query cancelled after %s: %s", timeout, text)
+	}
+	if slow {
+		d.slow.Add(1)
+		log.Printf("Slow query took %s: %s %s", elapsed.Round(time.Microsecond), text, redactArgs(args))
+	}
+}
+
+// redactArgs describes query arguments by type only, so slow query logs never contain
+// the values, which may be user data
+func redactArgs(args []interface{}) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		if arg == nil {
+			types[i] = "NULL"
+			continue
+		}
+		types[i] = fmt.Sprintf("%T", arg)
+	}
+	return "args=[" + strings.Join(types, ", ") + "]"
+}
+
+// milliseconds converts a duration to fractional milliseconds for reporting
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
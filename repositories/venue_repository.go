@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/venue_repository.go -pkg mocks . VenueRepository
+
+// VenueRepository defines the interface for venue data operations
+type VenueRepository interface {
+	GetByID(id int) (*models.Venue, error)
+	GetAll() ([]*models.Venue, error)
+	Create(venue *models.Venue) error
+	Update(venue *models.Venue) error
+	Delete(id int) error
+	Exists(id int) (bool, error)
+	HasGames(id int) (bool, error)
+}
+
+// venueColumns maps the venues columns to the Venue fields
+var venueColumns = newRowMapper[models.Venue]()
+
+// venueRepository implements VenueRepository interface
+type venueRepository struct {
+	db    *TimeoutDB
+	clock clock.Clock
+}
+
+// NewVenueRepository creates a new venue repository
+func NewVenueRepository(db *TimeoutDB, clock clock.Clock) VenueRepository {
+	return &venueRepository{db: db, clock: clock}
+}
+
+// GetByID retrieves a venue by its ID
+func (r *venueRepository) GetByID(id int) (*models.Venue, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM venues v WHERE v.id = ?
+	`, venueColumns.selectList("v"))
+
+	var venue models.Venue
+	err := r.db.QueryRow(query, id).Scan(venueColumns.targets(&venue)...)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("venue with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+
+	return &venue, nil
+}
+
+// GetAll retrieves all venues
+func (r *venueRepository) GetAll() ([]*models.Venue, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM venues v
+		ORDER BY v.name ASC
+	`, venueColumns.selectList("v"))
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query venues: %w", err)
+	}
+	defer rows.Close()
+
+	var venues []*models.Venue
+	for rows.Next() {
+		var venue models.Venue
+		if err := rows.Scan(venueColumns.targets(&venue)...); err != nil {
+			return nil, fmt.Errorf("failed to scan venue: %w", err)
+		}
+		venues = append(venues, &venue)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating venues: %w", err)
+	}
+
+	return venues, nil
+}
+
+// Create adds a new venue to the database
+func (r *venueRepository) Create(venue *models.Venue) error {
+	query := `
+		INSERT INTO venues (name, city, country, surface, roof_type, capacity, timezone,
+		                    latitude, longitude, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(query,
+		venue.Name, venue.City, venue.Country, venue.Surface, venue.RoofType, venue.Capacity,
+		venue.Timezone, venue.Latitude, venue.Longitude, currentTime, currentTime,
+	)
+	if err != nil {
+		if conflict := uniqueViolation(err, "venue"); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to create venue: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get venue ID: %w", err)
+	}
+
+	venue.ID = int(id)
+	venue.CreatedAt = currentTime
+	venue.UpdatedAt = currentTime
+
+	return nil
+}
+
+// Update modifies an existing venue
+func (r *venueRepository) Update(venue *models.Venue) error {
+	query := `
+		UPDATE venues
+		SET name = ?, city = ?, country = ?, surface = ?, roof_type = ?, capacity = ?,
+		    timezone = ?, latitude = ?, longitude = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	currentTime := r.clock.Now()
+	result, err := r.db.Exec(query,
+		venue.Name, venue.City, venue.Country, venue.Surface, venue.RoofType, venue.Capacity,
+		venue.Timezone, venue.Latitude, venue.Longitude, currentTime, venue.ID,
+	)
+	if err != nil {
+		if conflict := uniqueViolation(err, "venue"); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to update venue: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("venue with ID %d not found", venue.ID)
+	}
+
+	venue.UpdatedAt = currentTime
+	return nil
+}
+
+// Delete removes a venue from the database
+func (r *venueRepository) Delete(id int) error {
+	query := "DELETE FROM venues WHERE id = ?"
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("venue with ID %d cannot be deleted because games reference it", id)
+		}
+		return fmt.Errorf("failed to delete venue: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("venue with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// Exists checks if a venue exists by ID
+func (r *venueRepository) Exists(id int) (bool, error) {
+	query := "SELECT 1 FROM venues WHERE id = ? LIMIT 1"
+	var exists int
+	err := r.db.QueryRow(query, id).Scan(&exists)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check venue existence: %w", err)
+	}
+	return true, nil
+}
+
+// HasGames checks if any game is scheduled at the venue
+func (r *venueRepository) HasGames(id int) (bool, error) {
+	query := "SELECT 1 FROM games WHERE venue_id = ? LIMIT 1"
+	var exists int
+	err := r.db.QueryRow(query, id).Scan(&exists)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check venue games: %w", err)
+	}
+	return true, nil
+}
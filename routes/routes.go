@@ -0,0 +1,53 @@
+// Package routes mounts the API's route groups. Each handler registers its own routes; this
+// package decides where they are served and which middleware wraps them.
+package routes
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// APIPrefix is the path every API route is served under
+	APIPrefix = "/api"
+	// Version is the current API version. Routes are served under APIPrefix/Version, and under
+	// APIPrefix alone for clients written before versioning.
+	Version = "v1"
+)
+
+// Registrar is a group of routes, usually one handler's, that registers itself on a router.
+// Paths are relative to the API prefix, e.g. /teams/{id}.
+type Registrar interface {
+	RegisterRoutes(r *mux.Router)
+}
+
+// MountAPI registers each group under the versioned and unversioned API prefixes, with the
+// middleware applied in order, the first outermost. Groups are registered in order, so an
+// earlier route wins when two match the same request.
+func MountAPI(router *mux.Router, middleware []mux.MiddlewareFunc, registrars ...Registrar) {
+	// The versioned prefix goes first, as the bare prefix also matches its paths
+	for _, prefix := range []string{APIPrefix + "/" + Version, APIPrefix} {
+		api := router.PathPrefix(prefix).Subrouter()
+		api.Use(middleware...)
+		for _, registrar := range registrars {
+			registrar.RegisterRoutes(api)
+		}
+	}
+}
+
+// Template is the path template of the API route a request matched, without the API prefix
+// or version, e.g. /games/{id}/odds. ok is false outside an API route.
+func Template(r *http.Request) (template string, ok bool) {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return "", false
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil || !strings.HasPrefix(template, APIPrefix+"/") {
+		return "", false
+	}
+	template = strings.TrimPrefix(template, APIPrefix)
+	return strings.TrimPrefix(template, "/"+Version), true
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"sports-backend/handlers"
+	"sports-backend/routes"
+
+	"github.com/gorilla/mux"
+)
+
+// runServe migrates the database and serves the API until interrupted
+func runServe(args []string) {
+	if args = parseFlags("serve", "", "Run the HTTP API.", args); len(args) > 0 {
+		usageError("serve", "", "serve takes no arguments")
+	}
+
+	a := newApp()
+	defer a.Close()
+	a.migrate()
+	if err := a.jobService.Start(); err != nil {
+		log.Fatal("Failed to start job workers:", err)
+	}
+	if err := a.notificationService.Start(); err != nil {
+		log.Fatal("Failed to start notifications:", err)
+	}
+	if a.backupInterval > 0 {
+		a.backupService.Start(a.backupInterval)
+		log.Printf("Backing up the database every %s", a.backupInterval)
+	}
+	if a.liveService != nil {
+		a.liveService.Start(a.livePoll)
+		log.Printf("Polling games in progress every %s", a.livePoll)
+	}
+	if a.injurySync > 0 {
+		a.injuryService.Start(a.injurySync)
+		log.Printf("Syncing the injury report every %s", a.injurySync)
+	}
+
+	router := a.newRouter()
+
+	// Get port from environment or use default
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+	}
+
+	log.Printf("Server starting on port %s", port)
+	log.Printf("API endpoints available at http://localhost:%s/api", port)
+	log.Printf("Health checks available at http://localhost:%s/livez and /readyz", port)
+
+	server := &http.Server{Addr: ":" + port, Handler: router}
+	// Event streams never finish on their own, so they are ended for shutdown to complete
+	server.RegisterOnShutdown(a.eventService.Close)
+	go func() {
+		if serverError := server.ListenAndServe(); serverError != nil && serverError != http.ErrServerClosed {
+			log.Fatal("Server failed to start:", serverError)
+		}
+	}()
+
+	// Shut down gracefully so buffered writes are flushed before exit
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+
+	log.Println("Shutting down server")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("Server shutdown failed: %v", err)
+	}
+}
+
+// newRouter builds the HTTP handler for every route: the API under each prefix with its
+// middleware, and the health checks
+func (a *app) newRouter() *mux.Router {
+	// Initialize handlers
+	teamHandler := handlers.NewTeamHandler(a.teamService)
+	playerHandler := handlers.NewPlayerHandler(a.playerService, a.playerStatsService)
+	gameHandler := handlers.NewGameHandler(a.gameService)
+	highlightHandler := handlers.NewHighlightHandler(a.highlightService)
+	searchHandler := handlers.NewSearchHandler(a.searchService)
+	venueHandler := handlers.NewVenueHandler(a.venueService)
+	oddsHandler := handlers.NewOddsHandler(a.oddsService)
+	analyticsHandler := handlers.NewAnalyticsHandler(a.analyticsService)
+	rateLimitHandler := handlers.NewRateLimitHandler(a.rateLimitService, a.trustProxy)
+	draftPickHandler := handlers.NewDraftPickHandler(a.draftPickService)
+	externalIDHandler := handlers.NewExternalIDHandler(a.externalIDService)
+	seasonStatsHandler := handlers.NewSeasonStatsHandler(a.seasonStatsService)
+	projectionHandler := handlers.NewProjectionHandler(a.projectionService)
+	adpHandler := handlers.NewADPHandler(a.adpService)
+	scheduleStrengthHandler := handlers.NewScheduleStrengthHandler(a.scheduleStrengthService)
+	ratingHandler := handlers.NewRatingHandler(a.ratingService)
+	simulationHandler := handlers.NewSimulationHandler(a.simulationService)
+	recordHandler := handlers.NewRecordHandler(a.recordService)
+	scoringHandler := handlers.NewScoringHandler(a.scoringService)
+	dfsHandler := handlers.NewDFSHandler(a.dfsService)
+	sportHandler := handlers.NewSportHandler(a.sportService)
+	queryMetricsHandler := handlers.NewQueryMetricsHandler(a.queryMetricsService)
+	schemaHandler := handlers.NewSchemaHandler(a.schemaService)
+	reconciliationHandler := handlers.NewReconciliationHandler(a.reconciliationService)
+	statConflictHandler := handlers.NewStatConflictHandler(a.statConflictService)
+	injuryHandler := handlers.NewInjuryHandler(a.injuryService)
+	newsHandler := handlers.NewNewsHandler(a.newsService)
+	advancedStatsHandler := handlers.NewAdvancedStatsHandler(a.advancedStatsService)
+	seedHandler := handlers.NewSeedHandler(a.seedService)
+	healthHandler := handlers.NewHealthHandler(a.healthService)
+	jobHandler := handlers.NewJobHandler(a.jobService, a.importJobService)
+	exportHandler := handlers.NewExportHandler(a.exportService)
+	mediaHandler := handlers.NewMediaHandler(a.mediaService, a.maxUploadBytes)
+	backupHandler := handlers.NewBackupHandler(a.backupService)
+	scheduleChangeHandler := handlers.NewScheduleChangeHandler(a.scheduleChangeService)
+	eventHandler := handlers.NewEventHandler(a.eventService)
+	notificationHandler := handlers.NewNotificationHandler(a.notificationService)
+
+	// Create router
+	router := mux.NewRouter()
+
+	// Add CORS middleware
+	router.Use(corsMiddleware)
+
+	// API middleware, outermost first
+	var middleware []mux.MiddlewareFunc
+	if a.rateLimitService.Enabled() {
+		middleware = append(middleware, rateLimitHandler.Middleware)
+	}
+	if a.analyticsService.Enabled() {
+		middleware = append(middleware, analyticsHandler.Middleware)
+	}
+	middleware = append(middleware, handlers.PathIDs)
+
+	// API routes, each handler registering its own
+	registrars := []routes.Registrar{
+		teamHandler, mediaHandler, playerHandler, gameHandler, scheduleChangeHandler, eventHandler,
+		reconciliationHandler, statConflictHandler, injuryHandler, newsHandler, oddsHandler, venueHandler, draftPickHandler, externalIDHandler,
+		seasonStatsHandler, advancedStatsHandler, projectionHandler, adpHandler, scheduleStrengthHandler, ratingHandler,
+		simulationHandler, recordHandler, scoringHandler, dfsHandler, jobHandler, exportHandler,
+		notificationHandler, sportHandler, schemaHandler, highlightHandler, searchHandler,
+		analyticsHandler, queryMetricsHandler, rateLimitHandler, backupHandler,
+	}
+	if a.devMode {
+		registrars = append(registrars, seedHandler)
+	}
+	routes.MountAPI(router, middleware, registrars...)
+
+	// Health check endpoints
+	healthHandler.RegisterRoutes(router)
+
+	return router
+}
+
+// corsMiddleware adds CORS headers to allow frontend connections
+func corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/adp_service.go -pkg mocks . ADPService
+
+// ADPService defines the interface for average draft position business logic
+type ADPService interface {
+	GetPlayerADP(playerID int) ([]*models.ADP, error)
+	ImportADP(reqs []*models.CreateADPRequest) (*models.ImportResult, error)
+}
+
+// adpService implements ADPService interface
+type adpService struct {
+	adpRepo    repositories.ADPRepository
+	playerRepo repositories.PlayerRepository
+}
+
+// NewADPService creates a new ADP service
+func NewADPService(adpRepo repositories.ADPRepository, playerRepo repositories.PlayerRepository) ADPService {
+	return &adpService{
+		adpRepo:    adpRepo,
+		playerRepo: playerRepo,
+	}
+}
+
+// GetPlayerADP retrieves a player's ADP across seasons, formats and sources
+func (s *adpService) GetPlayerADP(playerID int) ([]*models.ADP, error) {
+	if playerID <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", playerID)
+	}
+
+	exists, err := s.playerRepo.Exists(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check player existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("player with ID %d not found", playerID)
+	}
+
+	entries, err := s.adpRepo.GetByPlayerID(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ADP: %w", err)
+	}
+	if entries == nil {
+		entries = []*models.ADP{}
+	}
+
+	return entries, nil
+}
+
+// ImportADP creates or replaces many ADP values in one transaction. Nothing is written
+// unless every entry is valid and refers to an existing player.
+func (s *adpService) ImportADP(reqs []*models.CreateADPRequest) (*models.ImportResult, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("validation failed: at least one ADP entry must be provided")
+	}
+
+	playerIDs := make([]int, len(reqs))
+	entries := make([]*models.ADP, 0, len(reqs))
+	for i, req := range reqs {
+		if req == nil {
+			return nil, fmt.Errorf("validation failed: entry %d is null", i)
+		}
+		if err := validateCreateADPRequest(req); err != nil {
+			return nil, fmt.Errorf("validation failed: entry %d: %w", i, err)
+		}
+		playerIDs[i] = req.PlayerID
+
+		entries = append(entries, &models.ADP{
+			PlayerID: req.PlayerID,
+			Season:   strings.TrimSpace(req.Season),
+			Format:   strings.ToLower(strings.TrimSpace(req.Format)),
+			Source:   strings.ToLower(strings.TrimSpace(req.Source)),
+			Value:    req.Value,
+		})
+	}
+
+	if err := checkPlayersExist(s.playerRepo, playerIDs, "entry"); err != nil {
+		return nil, err
+	}
+
+	created, err := s.adpRepo.UpsertMany(entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import ADP: %w", err)
+	}
+
+	return &models.ImportResult{Created: created, Updated: len(entries) - created}, nil
+}
+
+// validateCreateADPRequest validates one ADP entry
+func validateCreateADPRequest(req *models.CreateADPRequest) error {
+	if req.PlayerID <= 0 {
+		return fmt.Errorf("player ID is required and must be positive")
+	}
+
+	if strings.TrimSpace(req.Season) == "" {
+		return fmt.Errorf("season is required")
+	}
+
+	if err := validateOneOf("format", req.Format, models.ADPFormats); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(req.Source) == "" {
+		return fmt.Errorf("source is required")
+	}
+
+	if req.Value < 1 {
+		return fmt.Errorf("adp must be at least 1")
+	}
+
+	return nil
+}
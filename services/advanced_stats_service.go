@@ -0,0 +1,307 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/advanced_stats_service.go -pkg mocks . AdvancedStatsService
+
+// AdvancedStatsService defines the interface for advanced stats business logic
+type AdvancedStatsService interface {
+	ImportAdvancedStats(reqs []*models.CreateAdvancedStatsRequest) (*models.ImportResult, error)
+	GetPlayerAdvancedStats(playerID int, season string) ([]*models.AdvancedStats, error)
+	GetGameAdvancedStats(gameID int) ([]*models.AdvancedStats, error)
+	GetPlayerAdvancedSeason(playerID int, season string) (*models.PlayerAdvancedSeason, error)
+	GetAdvancedLeaders(season, stat, position string, limit int) (*models.AdvancedLeadersResponse, error)
+}
+
+// advancedStatsService implements AdvancedStatsService interface
+type advancedStatsService struct {
+	advancedStatsRepo repositories.AdvancedStatsRepository
+	playerRepo        repositories.PlayerRepository
+	gameRepo          repositories.GameRepository
+}
+
+// NewAdvancedStatsService creates a new advanced stats service
+func NewAdvancedStatsService(advancedStatsRepo repositories.AdvancedStatsRepository, playerRepo repositories.PlayerRepository, gameRepo repositories.GameRepository) AdvancedStatsService {
+	return &advancedStatsService{
+		advancedStatsRepo: advancedStatsRepo,
+		playerRepo:        playerRepo,
+		gameRepo:          gameRepo,
+	}
+}
+
+// ImportAdvancedStats validates and writes a batch of advanced stats rows. Nothing is written
+// unless every row is valid.
+func (s *advancedStatsService) ImportAdvancedStats(reqs []*models.CreateAdvancedStatsRequest) (*models.ImportResult, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("validation failed: at least one advanced stats row must be provided")
+	}
+
+	playerIDs := make([]int, len(reqs))
+	gameIDs := make([]int, len(reqs))
+	statsList := make([]*models.AdvancedStats, 0, len(reqs))
+	seen := make(map[[2]int]int, len(reqs))
+	for i, req := range reqs {
+		if req == nil {
+			return nil, fmt.Errorf("validation failed: advanced stats %d is null", i)
+		}
+		if err := validateCreateAdvancedStatsRequest(req); err != nil {
+			return nil, fmt.Errorf("validation failed: advanced stats %d: %w", i, err)
+		}
+		key := [2]int{req.PlayerID, req.GameID}
+		if first, ok := seen[key]; ok {
+			return nil, fmt.Errorf("validation failed: advanced stats %d: player %d and game %d are the same as advanced stats %d", i, req.PlayerID, req.GameID, first)
+		}
+		seen[key] = i
+		playerIDs[i] = req.PlayerID
+		gameIDs[i] = req.GameID
+		statsList = append(statsList, newAdvancedStatsFromRequest(req))
+	}
+
+	if err := checkPlayersExist(s.playerRepo, playerIDs, "advanced stats"); err != nil {
+		return nil, err
+	}
+	games, err := s.gameRepo.ExistsMany(gameIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check games: %w", err)
+	}
+	for i, id := range gameIDs {
+		if !games[id] {
+			return nil, fmt.Errorf("validation failed: advanced stats %d: game with ID %d not found", i, id)
+		}
+	}
+
+	created, err := s.advancedStatsRepo.UpsertMany(statsList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import advanced stats: %w", err)
+	}
+
+	return &models.ImportResult{Created: created, Updated: len(statsList) - created}, nil
+}
+
+// GetPlayerAdvancedStats retrieves a player's advanced stats game by game, optionally only
+// those of one season
+func (s *advancedStatsService) GetPlayerAdvancedStats(playerID int, season string) ([]*models.AdvancedStats, error) {
+	if err := s.checkPlayer(playerID); err != nil {
+		return nil, err
+	}
+	return s.advancedStatsRepo.GetByPlayerID(playerID, strings.TrimSpace(season))
+}
+
+// GetGameAdvancedStats retrieves the advanced stats of a game's players
+func (s *advancedStatsService) GetGameAdvancedStats(gameID int) ([]*models.AdvancedStats, error) {
+	if gameID <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+	if _, err := s.gameRepo.GetByID(gameID); err != nil {
+		return nil, err
+	}
+	return s.advancedStatsRepo.GetByGameID(gameID)
+}
+
+// GetPlayerAdvancedSeason retrieves a player's advanced stats added up over a season
+func (s *advancedStatsService) GetPlayerAdvancedSeason(playerID int, season string) (*models.PlayerAdvancedSeason, error) {
+	season = strings.TrimSpace(season)
+	if season == "" {
+		return nil, fmt.Errorf("season cannot be empty")
+	}
+	if err := s.checkPlayer(playerID); err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.advancedStatsRepo.GetSeasons(season, playerID, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(seasons) == 0 {
+		return nil, fmt.Errorf("advanced stats for player %d in season %s not found", playerID, season)
+	}
+	return seasons[0], nil
+}
+
+// GetAdvancedLeaders ranks the season's players by an advanced metric, highest first. Players
+// without the metric are left out.
+func (s *advancedStatsService) GetAdvancedLeaders(season, stat, position string, limit int) (*models.AdvancedLeadersResponse, error) {
+	season = strings.TrimSpace(season)
+	if season == "" {
+		return nil, fmt.Errorf("season cannot be empty")
+	}
+
+	stat = strings.ToLower(strings.TrimSpace(stat))
+	if err := validateOneOf("stat", stat, models.AdvancedStatNames); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if position != "" {
+		code, ok := models.NormalizePosition(position)
+		if !ok {
+			return nil, fmt.Errorf("validation failed: position must be one of: %v", models.Positions)
+		}
+		position = code
+	}
+
+	if limit < 1 || limit > 100 {
+		return nil, fmt.Errorf("validation failed: limit must be between 1 and 100, got %d", limit)
+	}
+
+	seasons, err := s.advancedStatsRepo.GetSeasons(season, 0, position)
+	if err != nil {
+		return nil, err
+	}
+
+	type ranked struct {
+		stats *models.PlayerAdvancedSeason
+		value float64
+	}
+	var leaders []ranked
+	for _, stats := range seasons {
+		if value, ok := advancedStatValue(stats, stat); ok {
+			leaders = append(leaders, ranked{stats: stats, value: value})
+		}
+	}
+	sort.SliceStable(leaders, func(i, j int) bool {
+		return leaders[i].value > leaders[j].value
+	})
+
+	response := &models.AdvancedLeadersResponse{Season: season, Stat: stat, Position: position, Leaders: []*models.PlayerAdvancedSeason{}}
+	for i, leader := range leaders {
+		if i == limit {
+			break
+		}
+		// Ties share the rank of the first player with the value
+		leader.stats.Rank = i + 1
+		if i > 0 && leader.value == leaders[i-1].value {
+			leader.stats.Rank = leaders[i-1].stats.Rank
+		}
+		response.Leaders = append(response.Leaders, leader.stats)
+	}
+
+	return response, nil
+}
+
+// checkPlayer verifies that a player exists
+func (s *advancedStatsService) checkPlayer(playerID int) error {
+	if playerID <= 0 {
+		return fmt.Errorf("invalid player ID: %d", playerID)
+	}
+	exists, err := s.playerRepo.Exists(playerID)
+	if err != nil {
+		return fmt.Errorf("failed to check player existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("player with ID %d not found", playerID)
+	}
+	return nil
+}
+
+// advancedStatValue reads a season metric by name, reporting whether the player has it
+func advancedStatValue(stats *models.PlayerAdvancedSeason, stat string) (float64, bool) {
+	counts := map[string]*int{
+		"offense_snaps":       stats.OffenseSnaps,
+		"defense_snaps":       stats.DefenseSnaps,
+		"special_teams_snaps": stats.SpecialTeamsSnaps,
+		"routes_run":          stats.RoutesRun,
+		"air_yards":           stats.AirYards,
+		"red_zone_touches":    stats.RedZoneTouches,
+	}
+	if value, ok := counts[stat]; ok {
+		if value == nil {
+			return 0, false
+		}
+		return float64(*value), true
+	}
+
+	decimals := map[string]*float64{
+		"offense_snap_share":      stats.OffenseSnapShare,
+		"target_share":            stats.TargetShare,
+		"average_depth_of_target": stats.AverageDepthOfTarget,
+		"yards_per_route_run":     stats.YardsPerRouteRun,
+		"targets_per_route_run":   stats.TargetsPerRouteRun,
+	}
+	if value := decimals[stat]; value != nil {
+		return *value, true
+	}
+	return 0, false
+}
+
+// validateCreateAdvancedStatsRequest validates one advanced stats row and normalizes its source
+func validateCreateAdvancedStatsRequest(req *models.CreateAdvancedStatsRequest) error {
+	if req.PlayerID <= 0 {
+		return fmt.Errorf("player ID is required and must be positive")
+	}
+	if req.GameID <= 0 {
+		return fmt.Errorf("game ID is required and must be positive")
+	}
+	req.Source = strings.ToLower(strings.TrimSpace(req.Source))
+	if req.Source == "" {
+		return fmt.Errorf("source is required")
+	}
+
+	counts := []struct {
+		name     string
+		value    *int
+		min, max int
+	}{
+		{"offense_snaps", req.OffenseSnaps, 0, 150},
+		{"defense_snaps", req.DefenseSnaps, 0, 150},
+		{"special_teams_snaps", req.SpecialTeamsSnaps, 0, 60},
+		{"routes_run", req.RoutesRun, 0, 100},
+		{"air_yards", req.AirYards, -100, 1000},
+		{"red_zone_touches", req.RedZoneTouches, 0, 40},
+	}
+	set := false
+	for _, count := range counts {
+		if count.value == nil {
+			continue
+		}
+		set = true
+		if *count.value < count.min || *count.value > count.max {
+			return fmt.Errorf("%s must be between %d and %d, got %d", count.name, count.min, count.max, *count.value)
+		}
+	}
+
+	shares := []struct {
+		name  string
+		value *float64
+	}{
+		{"offense_snap_share", req.OffenseSnapShare},
+		{"target_share", req.TargetShare},
+	}
+	for _, share := range shares {
+		if share.value == nil {
+			continue
+		}
+		set = true
+		if *share.value < 0 || *share.value > 1 {
+			return fmt.Errorf("%s must be a fraction between 0 and 1, got %g", share.name, *share.value)
+		}
+	}
+
+	if !set {
+		return fmt.Errorf("at least one advanced stat must be provided")
+	}
+	return nil
+}
+
+// newAdvancedStatsFromRequest builds the row a validated request writes
+func newAdvancedStatsFromRequest(req *models.CreateAdvancedStatsRequest) *models.AdvancedStats {
+	return &models.AdvancedStats{
+		PlayerID:          req.PlayerID,
+		GameID:            req.GameID,
+		OffenseSnaps:      req.OffenseSnaps,
+		DefenseSnaps:      req.DefenseSnaps,
+		SpecialTeamsSnaps: req.SpecialTeamsSnaps,
+		OffenseSnapShare:  req.OffenseSnapShare,
+		RoutesRun:         req.RoutesRun,
+		AirYards:          req.AirYards,
+		RedZoneTouches:    req.RedZoneTouches,
+		TargetShare:       req.TargetShare,
+		Source:            req.Source,
+	}
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/analytics_service.go -pkg mocks . AnalyticsService
+
+const analyticsDayFormat = "2006-01-02"
+
+// AnalyticsService defines the interface for anonymous feature usage analytics
+type AnalyticsService interface {
+	Enabled() bool
+	Record(category string)
+	GetUsageReport(days int) (*models.UsageReport, error)
+	Flush() error
+	Close() error
+}
+
+// analyticsService counts requests per category in memory and periodically adds
+// the counts to the usage_analytics table. Only the category and the UTC day are
+// kept, nothing about the caller.
+type analyticsService struct {
+	analyticsRepo repositories.AnalyticsRepository
+	enabled       bool
+	clock         clock.Clock
+
+	mu      sync.Mutex
+	pending map[string]map[string]int // day -> category -> count
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewAnalyticsService creates a new analytics service. When enabled is false nothing
+// is recorded, but reports over previously collected data are still available.
+func NewAnalyticsService(analyticsRepo repositories.AnalyticsRepository, enabled bool, flushInterval time.Duration, clock clock.Clock) AnalyticsService {
+	s := &analyticsService{
+		analyticsRepo: analyticsRepo,
+		enabled:       enabled,
+		clock:         clock,
+		pending:       make(map[string]map[string]int),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
+	if enabled {
+		go s.run(flushInterval)
+	} else {
+		close(s.done)
+	}
+	return s
+}
+
+// run flushes pending counts once per interval until Close is called
+func (s *analyticsService) run(interval time.Duration) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Flush(); err != nil {
+				log.Printf("Failed to flush usage analytics: %v", err)
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// Enabled reports whether usage collection is turned on
+func (s *analyticsService) Enabled() bool {
+	return s.enabled
+}
+
+// Record counts one use of the category
+func (s *analyticsService) Record(category string) {
+	if !s.enabled || category == "" {
+		return
+	}
+
+	day := s.clock.Now().UTC().Format(analyticsDayFormat)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending[day] == nil {
+		s.pending[day] = make(map[string]int)
+	}
+	s.pending[day][category]++
+}
+
+// Flush writes all pending counts to the database
+func (s *analyticsService) Flush() error {
+	s.mu.Lock()
+	batch := s.pending
+	s.pending = make(map[string]map[string]int)
+	s.mu.Unlock()
+
+	for day, counts := range batch {
+		if err := s.analyticsRepo.IncrementMany(day, counts); err != nil {
+			// Merge the unwritten counts back so they are retried on the next flush
+			s.mu.Lock()
+			for d, c := range batch {
+				if s.pending[d] == nil {
+					s.pending[d] = make(map[string]int)
+				}
+				for category, count := range c {
+					s.pending[d][category] += count
+				}
+			}
+			s.mu.Unlock()
+			return err
+		}
+		delete(batch, day)
+	}
+
+	return nil
+}
+
+// Close stops the flush loop and writes any remaining counts
+func (s *analyticsService) Close() error {
+	if !s.enabled {
+		return nil
+	}
+	close(s.stop)
+	<-s.done
+	return s.Flush()
+}
+
+// GetUsageReport summarizes usage per category over the last N days, including today
+func (s *analyticsService) GetUsageReport(days int) (*models.UsageReport, error) {
+	if days < 1 || days > 365 {
+		return nil, fmt.Errorf("validation failed: days must be between 1 and 365, got %d", days)
+	}
+
+	// Include counts that have not been flushed yet
+	if err := s.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to flush usage analytics: %w", err)
+	}
+
+	since := s.clock.Now().UTC().AddDate(0, 0, -(days - 1)).Format(analyticsDayFormat)
+	daily, err := s.analyticsRepo.GetSince(since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage analytics: %w", err)
+	}
+
+	report := &models.UsageReport{
+		CollectionEnabled: s.enabled,
+		Since:             since,
+		Days:              days,
+		Categories:        []*models.CategoryUsage{},
+		Daily:             daily,
+	}
+	if report.Daily == nil {
+		report.Daily = []*models.UsageCount{}
+	}
+
+	totals := make(map[string]int)
+	for _, count := range daily {
+		totals[count.Category] += count.Count
+		report.TotalRequests += count.Count
+	}
+	for category, count := range totals {
+		report.Categories = append(report.Categories, &models.CategoryUsage{Category: category, Count: count})
+	}
+
+	// Most used first
+	sort.Slice(report.Categories, func(i, j int) bool {
+		if report.Categories[i].Count != report.Categories[j].Count {
+			return report.Categories[i].Count > report.Categories[j].Count
+		}
+		return report.Categories[i].Category < report.Categories[j].Category
+	})
+
+	return report, nil
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/database"
+	"sports-backend/models"
+	"sports-backend/storage"
+)
+
+//go:generate moq -out mocks/backup_service.go -pkg mocks . BackupService
+
+const (
+	// backupPrefix and backupExtension frame every backup file name; the part between is the
+	// UTC time the backup was taken
+	backupPrefix    = "sports-"
+	backupExtension = ".db"
+	backupTimestamp = "20060102T150405.000Z"
+
+	// backupKeyPrefix is where backups are kept in storage
+	backupKeyPrefix = "backups/"
+)
+
+// BackupService defines the interface for backing up and restoring the database
+type BackupService interface {
+	CreateBackup() (*models.Backup, error)
+	ListBackups() ([]*models.Backup, error)
+	RestoreBackup(name string) (*models.RestoreResult, error)
+	Start(interval time.Duration)
+	Close() error
+}
+
+// backupService keeps backups in storage under backups/, removing the oldest beyond the
+// retention count, and can take them on a schedule
+type backupService struct {
+	db      *sql.DB
+	store   storage.Storage
+	retain  int // 0 keeps every backup
+	migrate func() error
+	clock   clock.Clock
+
+	// mu serializes backups and restores, so a restore never copies over a backup in progress
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewBackupService creates a new backup service writing to store. migrate brings a restored
+// database up to the current schema, as the backup may predate recent migrations.
+func NewBackupService(db *sql.DB, store storage.Storage, retain int, migrate func() error, clock clock.Clock) BackupService {
+	return &backupService{
+		db:      db,
+		store:   store,
+		retain:  retain,
+		migrate: migrate,
+		clock:   clock,
+	}
+}
+
+// CreateBackup takes a backup now and prunes the oldest backups beyond the retention count
+func (s *backupService) CreateBackup() (*models.Backup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	backup, err := s.createBackup()
+	if err != nil {
+		return nil, err
+	}
+	s.prune()
+	return backup, nil
+}
+
+// ListBackups lists the backups, newest first
+func (s *backupService) ListBackups() ([]*models.Backup, error) {
+	objects, err := s.store.List(backupKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	backups := []*models.Backup{}
+	for _, object := range objects {
+		name := strings.TrimPrefix(object.Key, backupKeyPrefix)
+		createdAt, ok := parseBackupName(name)
+		if !ok {
+			continue
+		}
+		backups = append(backups, &models.Backup{Name: name, Size: object.Size, CreatedAt: createdAt})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+	return backups, nil
+}
+
+// RestoreBackup replaces the database with a backup, after backing up the current contents so
+// the restore can itself be undone, and migrates the restored database
+func (s *backupService) RestoreBackup(name string) (*models.RestoreResult, error) {
+	if _, ok := parseBackupName(name); !ok || filepath.Base(name) != name {
+		return nil, fmt.Errorf("validation failed: invalid backup name %q", name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// SQLite restores from a file, so the backup is downloaded first
+	dir, err := os.MkdirTemp("", "restore-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a directory for the backup: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, name)
+	if err := s.download(backupKeyPrefix+name, path); err != nil {
+		if err == storage.ErrNotFound {
+			return nil, fmt.Errorf("backup %s not found", name)
+		}
+		return nil, fmt.Errorf("failed to download backup %s: %w", name, err)
+	}
+
+	safety, err := s.createBackup()
+	if err != nil {
+		return nil, fmt.Errorf("failed to back up before restoring: %w", err)
+	}
+
+	// Pruned only after restoring, so the safety backup can't push out the one being restored
+	defer s.prune()
+
+	if err := database.Restore(s.db, path); err != nil {
+		return nil, err
+	}
+	if err := s.migrate(); err != nil {
+		return nil, fmt.Errorf("restored %s but failed to migrate it: %w", name, err)
+	}
+
+	log.Printf("Restored database from backup %s; the previous contents are in %s", name, safety.Name)
+	return &models.RestoreResult{Restored: name, SafetyBackup: safety}, nil
+}
+
+// Start takes a backup every interval until Close
+func (s *backupService) Start(interval time.Duration) {
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				backup, err := s.CreateBackup()
+				if err != nil {
+					log.Printf("Scheduled backup failed: %v", err)
+					continue
+				}
+				log.Printf("Scheduled backup written to %s (%d bytes)", backup.Name, backup.Size)
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Close stops scheduled backups
+func (s *backupService) Close() error {
+	if s.stop != nil {
+		close(s.stop)
+		<-s.done
+		s.stop = nil
+	}
+	return nil
+}
+
+// createBackup writes a new backup to a temporary file and uploads it. The caller must hold mu.
+func (s *backupService) createBackup() (*models.Backup, error) {
+	dir, err := os.MkdirTemp("", "backup-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a directory for the backup: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	createdAt := s.clock.Now().UTC()
+	name := backupPrefix + createdAt.Format(backupTimestamp) + backupExtension
+	path := filepath.Join(dir, name)
+	if err := database.Backup(s.db, path); err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup %s: %w", name, err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup %s: %w", name, err)
+	}
+
+	if err := s.store.Put(backupKeyPrefix+name, file, info.Size(), "application/vnd.sqlite3"); err != nil {
+		return nil, fmt.Errorf("failed to upload backup %s: %w", name, err)
+	}
+
+	return &models.Backup{Name: name, Size: info.Size(), CreatedAt: createdAt}, nil
+}
+
+// download copies the object under key to a new file at path
+func (s *backupService) download(key, path string) error {
+	body, err := s.store.Get(key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// prune removes the oldest backups beyond the retention count. Failures are only logged, as
+// the backup that was just taken is still good. The caller must hold mu.
+func (s *backupService) prune() {
+	if s.retain <= 0 {
+		return
+	}
+
+	backups, err := s.ListBackups()
+	if err != nil {
+		log.Printf("Failed to remove old backups: %v", err)
+		return
+	}
+	for _, backup := range backups[min(s.retain, len(backups)):] {
+		if err := s.store.Delete(backupKeyPrefix + backup.Name); err != nil {
+			log.Printf("Failed to remove old backup %s: %v", backup.Name, err)
+		}
+	}
+}
+
+// parseBackupName returns the time a backup was taken from its file name, or false if the
+// name is not a backup's
+func parseBackupName(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, backupPrefix) || !strings.HasSuffix(name, backupExtension) {
+		return time.Time{}, false
+	}
+	createdAt, err := time.Parse(backupTimestamp, strings.TrimSuffix(strings.TrimPrefix(name, backupPrefix), backupExtension))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return createdAt, true
+}
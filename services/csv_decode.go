@@ -0,0 +1,123 @@
+package services
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodeCSV reads one request per row from CSV whose header names the request's JSON fields,
+// so a CSV file and a JSON import body use the same names. Empty cells leave the field unset.
+// Columns that match no field go into the request's map[string]float64 field, such as a
+// projection's stats, when it has one; otherwise they are an error.
+func DecodeCSV[T any](r io.Reader) ([]*T, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("validation failed: CSV is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: invalid CSV header: %v", err)
+	}
+
+	requestType := reflect.TypeOf((*T)(nil)).Elem()
+	fields := make(map[string]int)
+	extraField := -1
+	for i := 0; i < requestType.NumField(); i++ {
+		field := requestType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = i
+		if field.Type == reflect.TypeOf(map[string]float64{}) {
+			extraField = i
+		}
+	}
+	for _, column := range header {
+		if _, ok := fields[strings.TrimSpace(column)]; !ok && extraField < 0 {
+			return nil, fmt.Errorf("validation failed: unknown CSV column %q", column)
+		}
+	}
+
+	var requests []*T
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("validation failed: invalid CSV: %v", err)
+		}
+
+		request := new(T)
+		value := reflect.ValueOf(request).Elem()
+		for i, cell := range record {
+			cell = strings.TrimSpace(cell)
+			if cell == "" {
+				continue
+			}
+			column := strings.TrimSpace(header[i])
+
+			index, ok := fields[column]
+			if !ok || index == extraField {
+				number, err := strconv.ParseFloat(cell, 64)
+				if err != nil {
+					return nil, fmt.Errorf("validation failed: line %d: %s must be a number", line, column)
+				}
+				extra := value.Field(extraField)
+				if extra.IsNil() {
+					extra.Set(reflect.MakeMap(extra.Type()))
+				}
+				extra.SetMapIndex(reflect.ValueOf(column), reflect.ValueOf(number))
+				continue
+			}
+
+			if err := setCSVField(value.Field(index), cell); err != nil {
+				return nil, fmt.Errorf("validation failed: line %d: %s %v", line, column, err)
+			}
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+// setCSVField parses a cell into a string, integer, float or bool field, or a pointer to one
+func setCSVField(field reflect.Value, cell string) error {
+	if field.Kind() == reflect.Pointer {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(cell)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(cell, 10, 64)
+		if err != nil {
+			return fmt.Errorf("must be a whole number")
+		}
+		field.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("cannot be set from CSV")
+	}
+	return nil
+}
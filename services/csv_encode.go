@@ -0,0 +1,96 @@
+package services
+
+import (
+	"encoding/csv"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// csvEncoder writes one row per record of type T under a header of the record's JSON field
+// names, the same names DecodeCSV reads. Fields that are not strings, numbers, bools or times,
+// or pointers to one, are left out; nil pointers are written as empty cells.
+type csvEncoder[T any] struct {
+	writer *csv.Writer
+	fields []int
+}
+
+// newCSVEncoder creates an encoder writing to w and writes the header
+func newCSVEncoder[T any](w io.Writer) (*csvEncoder[T], error) {
+	encoder := &csvEncoder[T]{writer: csv.NewWriter(w)}
+
+	recordType := reflect.TypeOf((*T)(nil)).Elem()
+	var header []string
+	for i := 0; i < recordType.NumField(); i++ {
+		field := recordType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" || !csvScalar(field.Type) {
+			continue
+		}
+		encoder.fields = append(encoder.fields, i)
+		header = append(header, name)
+	}
+
+	if err := encoder.writer.Write(header); err != nil {
+		return nil, err
+	}
+	return encoder, nil
+}
+
+// Encode writes record as one row
+func (e *csvEncoder[T]) Encode(record *T) error {
+	value := reflect.ValueOf(record).Elem()
+	row := make([]string, len(e.fields))
+	for i, index := range e.fields {
+		row[i] = csvCell(value.Field(index))
+	}
+	return e.writer.Write(row)
+}
+
+// Flush writes any buffered rows
+func (e *csvEncoder[T]) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// csvScalar reports whether fields of type t fit in one CSV cell
+func csvScalar(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Int, reflect.Int64, reflect.Float64, reflect.Bool:
+		return true
+	}
+	return false
+}
+
+// csvCell formats a field for a CSV cell
+func csvCell(field reflect.Value) string {
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+	if t, ok := field.Interface().(time.Time); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		return field.String()
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10)
+	case reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(field.Bool())
+	}
+	return ""
+}
@@ -0,0 +1,517 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/dfs_service.go -pkg mocks . DFSService
+
+// DFSService defines the interface for daily fantasy salary business logic
+type DFSService interface {
+	GetSlate(season string, week int, site, source, position string) ([]*models.DFSSlatePlayer, error)
+	ImportSalaries(reqs []*models.CreateDFSSalaryRequest) (*models.ImportResult, error)
+	ValidateLineup(req *models.DFSLineupRequest) (*models.DFSLineup, error)
+	OptimizeLineup(req *models.DFSLineupRequest) (*models.DFSLineup, error)
+}
+
+// dfsSalaryStep is the salary increment sites price in; the optimizer counts salary in steps
+const dfsSalaryStep = 100
+
+// maxDFSSlots bounds the lineup size the optimizer has to search
+const maxDFSSlots = 10
+
+// maxDFSSalaryCap bounds the salary cap, since the optimizer's tables grow with it
+const maxDFSSalaryCap = 10 * models.DefaultDFSSalaryCap
+
+// maxDFSCandidatesPerPosition keeps the optimizer's search small: only the best projected
+// players at each position are considered, plus any locked ones
+const maxDFSCandidatesPerPosition = 25
+
+// dfsService implements DFSService interface
+type dfsService struct {
+	dfsRepo    repositories.DFSRepository
+	playerRepo repositories.PlayerRepository
+}
+
+// NewDFSService creates a new DFS service
+func NewDFSService(dfsRepo repositories.DFSRepository, playerRepo repositories.PlayerRepository) DFSService {
+	return &dfsService{
+		dfsRepo:    dfsRepo,
+		playerRepo: playerRepo,
+	}
+}
+
+// GetSlate retrieves the players a site priced for the week with their projected points
+// and points per $1,000, optionally for one position
+func (s *dfsService) GetSlate(season string, week int, site, source, position string) ([]*models.DFSSlatePlayer, error) {
+	if strings.TrimSpace(site) == "" {
+		return nil, fmt.Errorf("validation failed: site is required")
+	}
+	if position != "" {
+		code, ok := models.NormalizePosition(position)
+		if !ok {
+			return nil, fmt.Errorf("validation failed: position must be one of: %v", models.Positions)
+		}
+		position = code
+	}
+
+	slate, err := s.dfsRepo.GetSlate(season, week, strings.ToLower(strings.TrimSpace(site)), strings.ToLower(strings.TrimSpace(source)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DFS slate: %w", err)
+	}
+
+	players := []*models.DFSSlatePlayer{}
+	for _, player := range slate {
+		if position != "" && player.Position != position {
+			continue
+		}
+		setSalaryValue(player)
+		players = append(players, player)
+	}
+
+	return players, nil
+}
+
+// ImportSalaries creates or replaces many salaries in one transaction. Nothing is written
+// unless every entry is valid and refers to an existing player.
+func (s *dfsService) ImportSalaries(reqs []*models.CreateDFSSalaryRequest) (*models.ImportResult, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("validation failed: at least one salary must be provided")
+	}
+
+	playerIDs := make([]int, len(reqs))
+	salaries := make([]*models.DFSSalary, 0, len(reqs))
+	for i, req := range reqs {
+		if req == nil {
+			return nil, fmt.Errorf("validation failed: salary %d is null", i)
+		}
+		if err := validateCreateDFSSalaryRequest(req); err != nil {
+			return nil, fmt.Errorf("validation failed: salary %d: %w", i, err)
+		}
+		playerIDs[i] = req.PlayerID
+
+		salaries = append(salaries, &models.DFSSalary{
+			PlayerID: req.PlayerID,
+			Season:   strings.TrimSpace(req.Season),
+			Week:     req.Week,
+			Site:     strings.ToLower(strings.TrimSpace(req.Site)),
+			Salary:   req.Salary,
+		})
+	}
+
+	if err := checkPlayersExist(s.playerRepo, playerIDs, "salary"); err != nil {
+		return nil, err
+	}
+
+	created, err := s.dfsRepo.UpsertMany(salaries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import DFS salaries: %w", err)
+	}
+
+	return &models.ImportResult{Created: created, Updated: len(salaries) - created}, nil
+}
+
+// ValidateLineup checks the requested players against the site's salaries, the cap and the
+// slots. A lineup that breaks the rules is reported with its errors rather than as an error.
+func (s *dfsService) ValidateLineup(req *models.DFSLineupRequest) (*models.DFSLineup, error) {
+	groups, err := normalizeLineupRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if len(req.PlayerIDs) == 0 {
+		return nil, fmt.Errorf("validation failed: player_ids are required")
+	}
+
+	slate, err := s.dfsRepo.GetSlate(req.Season, req.Week, req.Site, req.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DFS slate: %w", err)
+	}
+	bySlate := make(map[int]*models.DFSSlatePlayer, len(slate))
+	for _, player := range slate {
+		bySlate[player.PlayerID] = player
+	}
+
+	lineup := newDFSLineup(req)
+	seen := make(map[int]bool)
+	var players []*models.DFSSlatePlayer
+	for _, id := range req.PlayerIDs {
+		if seen[id] {
+			lineup.Errors = append(lineup.Errors, fmt.Sprintf("player with ID %d is listed more than once", id))
+			continue
+		}
+		seen[id] = true
+
+		player, ok := bySlate[id]
+		if !ok {
+			lineup.Errors = append(lineup.Errors, fmt.Sprintf("player with ID %d has no %s salary for the week", id, req.Site))
+			continue
+		}
+		players = append(players, player)
+	}
+
+	if len(req.PlayerIDs) != len(req.Slots) {
+		lineup.Errors = append(lineup.Errors, fmt.Sprintf("lineup has %d players for %d slots", len(req.PlayerIDs), len(req.Slots)))
+	}
+
+	slots, ok := assignDFSSlots(players, groups)
+	if !ok {
+		slots = nil
+		if len(players) <= len(req.Slots) {
+			lineup.Errors = append(lineup.Errors, "players do not fit the lineup slots")
+		}
+	}
+	fillDFSLineup(lineup, players, slots, groups)
+
+	if lineup.Salary > lineup.SalaryCap {
+		lineup.Errors = append(lineup.Errors, fmt.Sprintf("salary of $%d exceeds the cap of $%d", lineup.Salary, lineup.SalaryCap))
+	}
+	lineup.Valid = len(lineup.Errors) == 0
+
+	return lineup, nil
+}
+
+// OptimizeLineup finds the lineup with the most projected points that fits the slots and the
+// salary cap, keeping the locked players. Players without a projection are only used when locked.
+func (s *dfsService) OptimizeLineup(req *models.DFSLineupRequest) (*models.DFSLineup, error) {
+	groups, err := normalizeLineupRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if len(req.Slots) > maxDFSSlots {
+		return nil, fmt.Errorf("validation failed: the optimizer supports at most %d slots", maxDFSSlots)
+	}
+
+	slate, err := s.dfsRepo.GetSlate(req.Season, req.Week, req.Site, req.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DFS slate: %w", err)
+	}
+
+	locked := make(map[int]bool, len(req.Locked))
+	for _, id := range req.Locked {
+		locked[id] = true
+	}
+	excluded := make(map[int]bool, len(req.Excluded))
+	for _, id := range req.Excluded {
+		if locked[id] {
+			return nil, fmt.Errorf("validation failed: player with ID %d cannot be both locked and excluded", id)
+		}
+		excluded[id] = true
+	}
+
+	// Locked players go first so the search can require them; the rest are the best
+	// projected at each position that fits a slot
+	var required, candidates []*models.DFSSlatePlayer
+	perPosition := make(map[string]int)
+	for _, player := range slate {
+		if locked[player.PlayerID] {
+			required = append(required, player)
+			delete(locked, player.PlayerID)
+			continue
+		}
+		if excluded[player.PlayerID] || player.ProjectedPoints == nil || !fitsAnyDFSSlot(player.Position, groups) {
+			continue
+		}
+		candidates = append(candidates, player)
+	}
+	for _, id := range req.Locked {
+		if !locked[id] {
+			continue
+		}
+		return nil, fmt.Errorf("validation failed: locked player with ID %d has no %s salary for the week", id, req.Site)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return *candidates[i].ProjectedPoints > *candidates[j].ProjectedPoints
+	})
+	pool := required
+	for _, player := range candidates {
+		if perPosition[player.Position] < maxDFSCandidatesPerPosition {
+			perPosition[player.Position]++
+			pool = append(pool, player)
+		}
+	}
+
+	chosen, slots, ok := optimizeDFSLineup(pool, len(required), groups, req.SalaryCap)
+	if !ok {
+		return nil, fmt.Errorf("validation failed: no lineup fits the slots and the salary cap of $%d", req.SalaryCap)
+	}
+
+	lineup := newDFSLineup(req)
+	fillDFSLineup(lineup, chosen, slots, groups)
+	lineup.Valid = true
+
+	return lineup, nil
+}
+
+// dfsSlotGroup is a lineup slot name and how many of it the lineup has
+type dfsSlotGroup struct {
+	name  string
+	count int
+}
+
+// normalizeLineupRequest validates the request, applies the defaults and groups the slots
+// in order of first appearance
+func normalizeLineupRequest(req *models.DFSLineupRequest) ([]dfsSlotGroup, error) {
+	req.Season = strings.TrimSpace(req.Season)
+	if req.Season == "" {
+		return nil, fmt.Errorf("season is required")
+	}
+	if req.Week < 1 || req.Week > 22 {
+		return nil, fmt.Errorf("week must be between 1 and 22")
+	}
+	req.Site = strings.ToLower(strings.TrimSpace(req.Site))
+	if req.Site == "" {
+		return nil, fmt.Errorf("site is required")
+	}
+	req.Source = strings.ToLower(strings.TrimSpace(req.Source))
+
+	if req.SalaryCap == 0 {
+		req.SalaryCap = models.DefaultDFSSalaryCap
+	}
+	if req.SalaryCap < 0 || req.SalaryCap%dfsSalaryStep != 0 {
+		return nil, fmt.Errorf("salary cap must be a positive multiple of %d", dfsSalaryStep)
+	}
+	if req.SalaryCap > maxDFSSalaryCap {
+		return nil, fmt.Errorf("salary cap must be at most %d", maxDFSSalaryCap)
+	}
+
+	if len(req.Slots) == 0 {
+		req.Slots = models.DefaultDFSSlots
+	}
+
+	var groups []dfsSlotGroup
+	index := make(map[string]int)
+	for i, slot := range req.Slots {
+		slot = strings.ToUpper(strings.TrimSpace(slot))
+		if _, ok := models.DFSSlotPositions[slot]; !ok {
+			return nil, fmt.Errorf("slot %q is not one of QB, RB, WR, TE, K, FLEX or SUPERFLEX", req.Slots[i])
+		}
+		if at, ok := index[slot]; ok {
+			groups[at].count++
+			continue
+		}
+		index[slot] = len(groups)
+		groups = append(groups, dfsSlotGroup{name: slot, count: 1})
+	}
+
+	return groups, nil
+}
+
+// fitsDFSSlot reports whether a player of the position can fill the slot
+func fitsDFSSlot(position, slot string) bool {
+	for _, allowed := range models.DFSSlotPositions[slot] {
+		if allowed == position {
+			return true
+		}
+	}
+	return false
+}
+
+func fitsAnyDFSSlot(position string, groups []dfsSlotGroup) bool {
+	for _, group := range groups {
+		if fitsDFSSlot(position, group.name) {
+			return true
+		}
+	}
+	return false
+}
+
+// assignDFSSlots places each player in a slot group with room, backtracking when a flexible
+// slot was taken too early. It returns each player's group index.
+func assignDFSSlots(players []*models.DFSSlatePlayer, groups []dfsSlotGroup) ([]int, bool) {
+	slots := make([]int, len(players))
+	open := make([]int, len(groups))
+	for i, group := range groups {
+		open[i] = group.count
+	}
+
+	var place func(i int) bool
+	place = func(i int) bool {
+		if i == len(players) {
+			return true
+		}
+		for g, group := range groups {
+			if open[g] == 0 || !fitsDFSSlot(players[i].Position, group.name) {
+				continue
+			}
+			open[g]--
+			slots[i] = g
+			if place(i + 1) {
+				return true
+			}
+			open[g]++
+		}
+		return false
+	}
+
+	return slots, place(0)
+}
+
+// optimizeDFSLineup searches for the most projected points over the lineups that fill every
+// slot under the cap. The first required players must be in the lineup. The state is how
+// many of each slot group are filled and the salary spent in $100 steps, so the search is
+// exact and grows with players times slot combinations times the cap.
+func optimizeDFSLineup(pool []*models.DFSSlatePlayer, required int, groups []dfsSlotGroup, salaryCap int) ([]*models.DFSSlatePlayer, []int, bool) {
+	// Each state packs the filled count of every group in mixed radix
+	radix := make([]int, len(groups))
+	states, full := 1, 0
+	for g, group := range groups {
+		radix[g] = states
+		full += group.count * states
+		states *= group.count + 1
+	}
+	budget := salaryCap/dfsSalaryStep + 1
+
+	best := make([]float64, states*budget)
+	for i := range best {
+		best[i] = math.Inf(-1)
+	}
+	best[0] = 0
+
+	// choice[i] records, for each state, the group player i was placed in plus one, or zero
+	choice := make([][]int8, len(pool))
+	for i, player := range pool {
+		cost := player.Salary / dfsSalaryStep
+		points := 0.0
+		if player.ProjectedPoints != nil {
+			points = *player.ProjectedPoints
+		}
+
+		next := make([]float64, len(best))
+		if i < required {
+			for k := range next {
+				next[k] = math.Inf(-1)
+			}
+		} else {
+			copy(next, best)
+		}
+		choice[i] = make([]int8, len(best))
+
+		for state := 0; state < states; state++ {
+			for spent := 0; spent+cost < budget; spent++ {
+				value := best[state*budget+spent]
+				if math.IsInf(value, -1) {
+					continue
+				}
+				for g, group := range groups {
+					if (state/radix[g])%(group.count+1) == group.count || !fitsDFSSlot(player.Position, group.name) {
+						continue
+					}
+					k := (state+radix[g])*budget + spent + cost
+					if value+points > next[k] {
+						next[k] = value + points
+						choice[i][k] = int8(g + 1)
+					}
+				}
+			}
+		}
+		best = next
+	}
+
+	spent := -1
+	for u := 0; u < budget; u++ {
+		if !math.IsInf(best[full*budget+u], -1) && (spent < 0 || best[full*budget+u] > best[full*budget+spent]) {
+			spent = u
+		}
+	}
+	if spent < 0 {
+		return nil, nil, false
+	}
+
+	var chosen []*models.DFSSlatePlayer
+	var slots []int
+	state := full
+	for i := len(pool) - 1; i >= 0; i-- {
+		g := int(choice[i][state*budget+spent]) - 1
+		if g < 0 {
+			continue
+		}
+		chosen = append(chosen, pool[i])
+		slots = append(slots, g)
+		state -= radix[g]
+		spent -= pool[i].Salary / dfsSalaryStep
+	}
+
+	return chosen, slots, true
+}
+
+// newDFSLineup starts an empty lineup for the request
+func newDFSLineup(req *models.DFSLineupRequest) *models.DFSLineup {
+	return &models.DFSLineup{
+		Season:    req.Season,
+		Week:      req.Week,
+		Site:      req.Site,
+		SalaryCap: req.SalaryCap,
+		Players:   []*models.DFSLineupSlot{},
+	}
+}
+
+// fillDFSLineup adds the players in slot order and totals salary and projected points. The
+// slots are only used when there is one per player.
+func fillDFSLineup(lineup *models.DFSLineup, players []*models.DFSSlatePlayer, slots []int, groups []dfsSlotGroup) {
+	points := 0.0
+	for i, player := range players {
+		setSalaryValue(player)
+		entry := &models.DFSLineupSlot{DFSSlatePlayer: player}
+		if len(slots) == len(players) {
+			entry.Slot = groups[slots[i]].name
+		}
+		lineup.Players = append(lineup.Players, entry)
+		lineup.Salary += player.Salary
+		if player.ProjectedPoints != nil {
+			points += *player.ProjectedPoints
+		}
+	}
+	lineup.ProjectedPoints = roundPoints(points)
+
+	order := make(map[string]int, len(groups))
+	for g, group := range groups {
+		order[group.name] = g
+	}
+	sort.SliceStable(lineup.Players, func(i, j int) bool {
+		if order[lineup.Players[i].Slot] != order[lineup.Players[j].Slot] {
+			return order[lineup.Players[i].Slot] < order[lineup.Players[j].Slot]
+		}
+		return lineup.Players[i].Salary > lineup.Players[j].Salary
+	})
+}
+
+// setSalaryValue sets the projected points per $1,000 of salary
+func setSalaryValue(player *models.DFSSlatePlayer) {
+	if player.ProjectedPoints == nil || player.Salary <= 0 {
+		return
+	}
+	value := roundPoints(*player.ProjectedPoints / float64(player.Salary) * 1000)
+	player.Value = &value
+}
+
+// validateCreateDFSSalaryRequest validates one salary
+func validateCreateDFSSalaryRequest(req *models.CreateDFSSalaryRequest) error {
+	if req.PlayerID <= 0 {
+		return fmt.Errorf("player ID is required and must be positive")
+	}
+
+	if strings.TrimSpace(req.Season) == "" {
+		return fmt.Errorf("season is required")
+	}
+
+	if req.Week < 1 || req.Week > 22 {
+		return fmt.Errorf("week must be between 1 and 22")
+	}
+
+	if strings.TrimSpace(req.Site) == "" {
+		return fmt.Errorf("site is required")
+	}
+
+	if req.Salary <= 0 || req.Salary%dfsSalaryStep != 0 {
+		return fmt.Errorf("salary must be a positive multiple of %d", dfsSalaryStep)
+	}
+
+	return nil
+}
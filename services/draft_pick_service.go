@@ -0,0 +1,311 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/draft_pick_service.go -pkg mocks . DraftPickService
+
+const (
+	firstDraftYear  = 1936
+	maxDraftRounds  = 30
+	maxPicksInRound = 64
+)
+
+// DraftPickService defines the interface for draft pick business logic
+type DraftPickService interface {
+	GetDraftPick(id int) (*models.DraftPick, error)
+	GetDraftPicks(year *int) ([]*models.DraftPick, error)
+	GetDraftPicksByTeam(teamID int) ([]*models.DraftPick, error)
+	GetPlayerDraftInfo(playerID int) (*models.DraftPick, error)
+	CreateDraftPick(req *models.CreateDraftPickRequest) (*models.DraftPick, error)
+	UpdateDraftPick(id int, req *models.UpdateDraftPickRequest) (*models.DraftPick, error)
+	DeleteDraftPick(id int) error
+}
+
+// draftPickService implements DraftPickService interface
+type draftPickService struct {
+	draftPickRepo repositories.DraftPickRepository
+	teamRepo      repositories.TeamRepository
+	playerRepo    repositories.PlayerRepository
+	clock         clock.Clock
+}
+
+// NewDraftPickService creates a new draft pick service
+func NewDraftPickService(draftPickRepo repositories.DraftPickRepository, teamRepo repositories.TeamRepository, playerRepo repositories.PlayerRepository, clock clock.Clock) DraftPickService {
+	return &draftPickService{
+		draftPickRepo: draftPickRepo,
+		teamRepo:      teamRepo,
+		playerRepo:    playerRepo,
+		clock:         clock,
+	}
+}
+
+// GetDraftPick retrieves a draft pick by ID
+func (s *draftPickService) GetDraftPick(id int) (*models.DraftPick, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid draft pick ID: %d", id)
+	}
+
+	draftPick, err := s.draftPickRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft pick: %w", err)
+	}
+
+	return draftPick, nil
+}
+
+// GetDraftPicks retrieves all draft picks, optionally limited to one draft year
+func (s *draftPickService) GetDraftPicks(year *int) ([]*models.DraftPick, error) {
+	var draftPicks []*models.DraftPick
+	var err error
+	if year != nil {
+		draftPicks, err = s.draftPickRepo.GetByYear(*year)
+	} else {
+		draftPicks, err = s.draftPickRepo.GetAll()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft picks: %w", err)
+	}
+
+	return draftPicks, nil
+}
+
+// GetDraftPicksByTeam retrieves all draft picks held by a team
+func (s *draftPickService) GetDraftPicksByTeam(teamID int) ([]*models.DraftPick, error) {
+	if teamID <= 0 {
+		return nil, fmt.Errorf("invalid team ID: %d", teamID)
+	}
+
+	// Verify team exists
+	exists, err := s.teamRepo.Exists(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify team existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("team with ID %d not found", teamID)
+	}
+
+	draftPicks, err := s.draftPickRepo.GetByTeamID(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft picks by team: %w", err)
+	}
+
+	return draftPicks, nil
+}
+
+// GetPlayerDraftInfo retrieves the pick a player was drafted with
+func (s *draftPickService) GetPlayerDraftInfo(playerID int) (*models.DraftPick, error) {
+	if playerID <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", playerID)
+	}
+
+	// Verify player exists
+	exists, err := s.playerRepo.Exists(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify player existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("player with ID %d not found", playerID)
+	}
+
+	draftPick, err := s.draftPickRepo.GetByPlayerID(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft info: %w", err)
+	}
+
+	return draftPick, nil
+}
+
+// CreateDraftPick creates a new draft pick
+func (s *draftPickService) CreateDraftPick(req *models.CreateDraftPickRequest) (*models.DraftPick, error) {
+	// Validate request
+	if err := s.validateCreateDraftPickRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	draftPick := &models.DraftPick{
+		Year:     req.Year,
+		Round:    req.Round,
+		Pick:     req.Pick,
+		TeamID:   req.TeamID,
+		PlayerID: req.PlayerID,
+	}
+
+	if err := s.checkReferences(draftPick); err != nil {
+		return nil, err
+	}
+
+	if err := s.draftPickRepo.Create(draftPick); err != nil {
+		return nil, fmt.Errorf("failed to create draft pick: %w", err)
+	}
+
+	return draftPick, nil
+}
+
+// UpdateDraftPick updates an existing draft pick, e.g. to record a trade or the player selected
+func (s *draftPickService) UpdateDraftPick(id int, req *models.UpdateDraftPickRequest) (*models.DraftPick, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid draft pick ID: %d", id)
+	}
+
+	// Validate request
+	if err := s.validateUpdateDraftPickRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Get existing draft pick
+	draftPick, err := s.draftPickRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft pick: %w", err)
+	}
+
+	// Update fields if provided
+	if req.Year != nil {
+		draftPick.Year = *req.Year
+	}
+	if req.Round != nil {
+		draftPick.Round = *req.Round
+	}
+	if req.Pick != nil {
+		draftPick.Pick = *req.Pick
+	}
+	if req.TeamID != nil {
+		draftPick.TeamID = *req.TeamID
+	}
+	if req.PlayerID != nil {
+		draftPick.PlayerID = req.PlayerID
+	}
+
+	if err := s.checkReferences(draftPick); err != nil {
+		return nil, err
+	}
+
+	if err := s.draftPickRepo.Update(draftPick); err != nil {
+		return nil, fmt.Errorf("failed to update draft pick: %w", err)
+	}
+
+	return draftPick, nil
+}
+
+// DeleteDraftPick deletes a draft pick
+func (s *draftPickService) DeleteDraftPick(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid draft pick ID: %d", id)
+	}
+
+	// Check if draft pick exists
+	exists, err := s.draftPickRepo.Exists(id)
+	if err != nil {
+		return fmt.Errorf("failed to check draft pick existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("draft pick with ID %d not found", id)
+	}
+
+	if err := s.draftPickRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete draft pick: %w", err)
+	}
+
+	return nil
+}
+
+// checkReferences verifies the team and player exist, the slot is free and the
+// player has not already been drafted with another pick
+func (s *draftPickService) checkReferences(draftPick *models.DraftPick) error {
+	exists, err := s.teamRepo.Exists(draftPick.TeamID)
+	if err != nil {
+		return fmt.Errorf("failed to verify team existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("team with ID %d not found", draftPick.TeamID)
+	}
+
+	taken, err := s.draftPickRepo.GetBySlot(draftPick.Year, draftPick.Round, draftPick.Pick)
+	if err != nil {
+		return fmt.Errorf("failed to check existing draft picks: %w", err)
+	}
+	if taken != nil && taken.ID != draftPick.ID {
+		return fmt.Errorf("round %d pick %d of the %d draft already exists", draftPick.Round, draftPick.Pick, draftPick.Year)
+	}
+
+	if draftPick.PlayerID == nil {
+		return nil
+	}
+
+	exists, err = s.playerRepo.Exists(*draftPick.PlayerID)
+	if err != nil {
+		return fmt.Errorf("failed to verify player existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("player with ID %d not found", *draftPick.PlayerID)
+	}
+
+	existing, err := s.draftPickRepo.GetByPlayerID(*draftPick.PlayerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return fmt.Errorf("failed to check existing draft picks: %w", err)
+	}
+	if existing.ID != draftPick.ID {
+		return fmt.Errorf("player with ID %d was already drafted with draft pick ID %d", *draftPick.PlayerID, existing.ID)
+	}
+
+	return nil
+}
+
+// validateCreateDraftPickRequest validates the create draft pick request
+func (s *draftPickService) validateCreateDraftPickRequest(req *models.CreateDraftPickRequest) error {
+	if req.TeamID <= 0 {
+		return fmt.Errorf("team ID is required and must be positive")
+	}
+
+	if req.PlayerID != nil && *req.PlayerID <= 0 {
+		return fmt.Errorf("player ID must be positive")
+	}
+
+	return s.validateDraftSlot(&req.Year, &req.Round, &req.Pick)
+}
+
+// validateUpdateDraftPickRequest validates the update draft pick request
+func (s *draftPickService) validateUpdateDraftPickRequest(req *models.UpdateDraftPickRequest) error {
+	// Check if at least one field is being updated
+	if req.Year == nil && req.Round == nil && req.Pick == nil && req.TeamID == nil && req.PlayerID == nil {
+		return fmt.Errorf("at least one field must be provided for update")
+	}
+
+	if req.TeamID != nil && *req.TeamID <= 0 {
+		return fmt.Errorf("team ID must be positive")
+	}
+
+	if req.PlayerID != nil && *req.PlayerID <= 0 {
+		return fmt.Errorf("player ID must be positive")
+	}
+
+	return s.validateDraftSlot(req.Year, req.Round, req.Pick)
+}
+
+// validateDraftSlot validates the year, round and pick of a draft pick. Future years
+// are allowed so traded picks can be tracked before the draft happens.
+func (s *draftPickService) validateDraftSlot(year, round, pick *int) error {
+	maxYear := s.clock.Now().Year() + 5
+	if year != nil && (*year < firstDraftYear || *year > maxYear) {
+		return fmt.Errorf("year must be between %d and %d", firstDraftYear, maxYear)
+	}
+
+	if round != nil && (*round < 1 || *round > maxDraftRounds) {
+		return fmt.Errorf("round must be between 1 and %d", maxDraftRounds)
+	}
+
+	if pick != nil && (*pick < 1 || *pick > maxPicksInRound) {
+		return fmt.Errorf("pick must be between 1 and %d", maxPicksInRound)
+	}
+
+	return nil
+}
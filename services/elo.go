@@ -0,0 +1,168 @@
+package services
+
+import (
+	"math"
+	"sort"
+
+	"sports-backend/models"
+)
+
+// Elo parameters, following the widely published NFL Elo model
+const (
+	// eloInitial is the rating of a team before its first game, and the mean ratings regress to
+	eloInitial = 1500.0
+	// eloK scales how far one game moves a rating
+	eloK = 20.0
+	// eloHomeAdvantage is added to the home team's rating when predicting a game, except at a
+	// neutral site
+	eloHomeAdvantage = 48.0
+	// eloRegression is the share of a rating's distance from the mean given back between seasons
+	eloRegression = 1.0 / 3
+	// eloPointsPerSpreadPoint converts a point spread to a rating difference
+	eloPointsPerSpreadPoint = 25.0
+	// marketWeight is the share of a blended win probability taken from the betting market
+	marketWeight = 0.5
+)
+
+// eloTeam is one team's running rating
+type eloTeam struct {
+	rating float64
+	season string // season of the last rated game
+}
+
+// eloRatings holds the ratings reached by replaying completed games in kickoff order
+type eloRatings struct {
+	teams map[int]*eloTeam
+}
+
+// replayElo rates the completed regular season and playoff games of every season up to and
+// including season, or of every season when season is empty, oldest kickoff first
+func replayElo(games []*models.Game, season string) (*eloRatings, []*models.RatingChange, []*models.RatingChange) {
+	rated := make([]*models.Game, 0, len(games))
+	for _, game := range games {
+		if eloRated(game) && (season == "" || game.Season <= season) {
+			rated = append(rated, game)
+		}
+	}
+	sort.SliceStable(rated, func(i, j int) bool {
+		if !rated[i].GameDate.Equal(rated[j].GameDate) {
+			return rated[i].GameDate.Before(rated[j].GameDate)
+		}
+		return rated[i].ID < rated[j].ID
+	})
+
+	ratings := &eloRatings{teams: make(map[int]*eloTeam)}
+	homeChanges := make([]*models.RatingChange, len(rated))
+	awayChanges := make([]*models.RatingChange, len(rated))
+	for i, game := range rated {
+		homeChanges[i], awayChanges[i] = ratings.apply(game)
+	}
+	return ratings, homeChanges, awayChanges
+}
+
+// eloRated reports whether a game moves ratings: completed with both scores, and not preseason
+func eloRated(game *models.Game) bool {
+	return game.Status == "completed" && game.HomeScore != nil && game.AwayScore != nil &&
+		game.GameType != models.GameTypePreseason
+}
+
+// rating is the team's rating entering a game of season. A team whose last rated game was in
+// an earlier season is regressed toward the mean first.
+func (e *eloRatings) rating(teamID int, season string) float64 {
+	team, ok := e.teams[teamID]
+	if !ok {
+		return eloInitial
+	}
+	if team.season != season && season != "" {
+		return eloInitial + (team.rating-eloInitial)*(1-eloRegression)
+	}
+	return team.rating
+}
+
+// homeWinProbability is the chance the home team wins a game between the teams' current ratings
+func (e *eloRatings) homeWinProbability(game *models.Game) float64 {
+	return eloWinProbability(e.rating(game.HomeTeamID, game.Season) + eloHomeEdge(game) - e.rating(game.AwayTeamID, game.Season))
+}
+
+// apply rates a completed game and returns the change to each team's rating. Wins by more
+// points move ratings further, damped when the favorite wins so blowouts by strong teams do
+// not inflate their ratings.
+func (e *eloRatings) apply(game *models.Game) (*models.RatingChange, *models.RatingChange) {
+	home := e.rating(game.HomeTeamID, game.Season)
+	away := e.rating(game.AwayTeamID, game.Season)
+	diff := home + eloHomeEdge(game) - away
+	expected := eloWinProbability(diff)
+
+	margin := *game.HomeScore - *game.AwayScore
+	result, damping := 0.5, 1.0
+	switch {
+	case margin > 0:
+		result, damping = 1, diff*0.001+2.2
+	case margin < 0:
+		result, damping = 0, -diff*0.001+2.2
+	}
+	multiplier := math.Log(math.Max(math.Abs(float64(margin)), 1)+1) * 2.2 / damping
+	shift := eloK * multiplier * (result - expected)
+
+	e.teams[game.HomeTeamID] = &eloTeam{rating: home + shift, season: game.Season}
+	e.teams[game.AwayTeamID] = &eloTeam{rating: away - shift, season: game.Season}
+
+	change := func(opponentID int, isHome bool, score, opponentScore int, before, probability, delta float64) *models.RatingChange {
+		return &models.RatingChange{
+			GameID:         game.ID,
+			Season:         game.Season,
+			Week:           game.Week,
+			GameDate:       game.GameDate,
+			OpponentID:     opponentID,
+			Home:           isHome,
+			Score:          score,
+			OpponentScore:  opponentScore,
+			RatingBefore:   roundPoints(before),
+			WinProbability: roundProbability(probability),
+			RatingAfter:    roundPoints(before + delta),
+			Change:         roundPoints(delta),
+		}
+	}
+	return change(game.AwayTeamID, true, *game.HomeScore, *game.AwayScore, home, expected, shift),
+		change(game.HomeTeamID, false, *game.AwayScore, *game.HomeScore, away, 1-expected, -shift)
+}
+
+// eloHomeEdge is the home team's rating advantage in a game
+func eloHomeEdge(game *models.Game) float64 {
+	if game.NeutralSite {
+		return 0
+	}
+	return eloHomeAdvantage
+}
+
+// eloWinProbability is the chance of winning for a team rated diff points above its opponent
+func eloWinProbability(diff float64) float64 {
+	return 1 / (1 + math.Pow(10, -diff/400))
+}
+
+// marketHomeWinProbability is the home team's chance of winning implied by a betting line:
+// the moneylines with the bookmaker's margin removed, or else the spread. ok is false when the
+// line has neither.
+func marketHomeWinProbability(odds *models.GameOdds) (float64, bool) {
+	if odds.HomeMoneyline != nil && odds.AwayMoneyline != nil {
+		home, away := moneylineProbability(*odds.HomeMoneyline), moneylineProbability(*odds.AwayMoneyline)
+		return home / (home + away), true
+	}
+	if odds.Spread != nil {
+		return eloWinProbability(-*odds.Spread * eloPointsPerSpreadPoint), true
+	}
+	return 0, false
+}
+
+// moneylineProbability is the break-even probability of American odds, margin included
+func moneylineProbability(moneyline int) float64 {
+	if moneyline < 0 {
+		return float64(-moneyline) / float64(-moneyline+100)
+	}
+	return 100 / float64(moneyline+100)
+}
+
+// roundProbability rounds a probability to three decimal places
+func roundProbability(p float64) float64 {
+	return math.Round(p*1000) / 1000
+}
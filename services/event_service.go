@@ -0,0 +1,123 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/event_service.go -pkg mocks . EventService
+
+// eventBuffer is how many events a subscriber can fall behind before it misses events
+const eventBuffer = 64
+
+// EventService defines the interface for publishing changes to live subscribers
+type EventService interface {
+	Publish(eventType string, data interface{})
+	Subscribe(types []string) (*Subscription, error)
+	Close()
+}
+
+// Subscription receives the published events of the types it subscribed to. Events is closed
+// when the subscription ends.
+type Subscription struct {
+	Events <-chan *models.Event
+
+	events  chan *models.Event
+	types   map[string]bool // nil for every type
+	service *eventService
+}
+
+// Unsubscribe ends the subscription
+func (sub *Subscription) Unsubscribe() {
+	sub.service.remove(sub)
+}
+
+// eventService fans events out to subscribers in memory. Publishing never blocks: a subscriber
+// that is eventBuffer events behind misses the events it has no room for.
+type eventService struct {
+	clock clock.Clock
+
+	mu          sync.Mutex
+	nextID      int64
+	subscribers map[*Subscription]bool
+	closed      bool
+}
+
+// NewEventService creates a new event service
+func NewEventService(clock clock.Clock) EventService {
+	return &eventService{subscribers: make(map[*Subscription]bool), clock: clock}
+}
+
+// Publish sends an event to every subscriber of its type
+func (s *eventService) Publish(eventType string, data interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	s.nextID++
+	event := &models.Event{ID: s.nextID, Type: eventType, Time: s.clock.Now().UTC(), Data: data}
+	for sub := range s.subscribers {
+		if sub.types != nil && !sub.types[eventType] {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			log.Printf("Event subscriber is behind, dropped %s event %d", eventType, event.ID)
+		}
+	}
+}
+
+// Subscribe starts receiving events of the given types, or of every type when types is empty
+func (s *eventService) Subscribe(types []string) (*Subscription, error) {
+	sub := &Subscription{service: s}
+	for _, eventType := range types {
+		eventType = strings.ToLower(strings.TrimSpace(eventType))
+		if err := validateOneOf("event type", eventType, models.EventTypes); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+		if sub.types == nil {
+			sub.types = make(map[string]bool)
+		}
+		sub.types[eventType] = true
+	}
+	sub.events = make(chan *models.Event, eventBuffer)
+	sub.Events = sub.events
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		close(sub.events)
+		return sub, nil
+	}
+	s.subscribers[sub] = true
+	return sub, nil
+}
+
+// Close ends every subscription, so streams finish and the server can shut down
+func (s *eventService) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for sub := range s.subscribers {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// remove ends one subscription unless Close already has
+func (s *eventService) remove(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subscribers[sub] {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
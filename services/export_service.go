@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/storage"
+)
+
+//go:generate moq -out mocks/export_service.go -pkg mocks . ExportService
+
+const (
+	// exportKeyPrefix is where exports are kept in storage
+	exportKeyPrefix = "exports/"
+	// exportJobAttempts is how many times an export is tried before it fails
+	exportJobAttempts = 3
+	// exportTimestamp is the UTC time in an export's name
+	exportTimestamp = "20060102T150405Z"
+)
+
+// exportSeason matches the seasons an export can be limited to. The season is part of the
+// export's name, so it can't hold a path separator or dots.
+var exportSeason = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// ExportService defines the interface for writing CSV exports to storage as background jobs
+type ExportService interface {
+	SubmitPlayerStatsExport(season string) (*models.Job, error)
+	ListExports() ([]*models.Export, error)
+	OpenExport(name string) (io.ReadCloser, error)
+}
+
+// exportService queues exports on the job service and reads them back from storage
+type exportService struct {
+	jobService JobService
+	store      storage.Storage
+	clock      clock.Clock
+}
+
+// playerStatsExportPayload is what a player stats export job exports
+type playerStatsExportPayload struct {
+	Season string `json:"season,omitempty"`
+}
+
+// NewExportService creates a new export service and registers the export job kinds
+func NewExportService(jobService JobService, playerStatsService PlayerStatsService, store storage.Storage, clock clock.Clock) ExportService {
+	s := &exportService{jobService: jobService, store: store, clock: clock}
+
+	// Exports are small enough to write again from the start when an attempt fails
+	jobService.Register(models.JobExportPlayerStats, exportJobAttempts, func(ctx context.Context, run *JobRun) error {
+		var payload playerStatsExportPayload
+		if err := run.Payload(&payload); err != nil {
+			return err
+		}
+
+		scope := payload.Season
+		if scope == "" {
+			scope = "all"
+		}
+		name := fmt.Sprintf("player-stats-%s-%s.csv", scope, s.clock.Now().UTC().Format(exportTimestamp))
+
+		result, err := s.write(name, func(w io.Writer) (int, error) {
+			encoder, err := newCSVEncoder[models.PlayerStats](w)
+			if err != nil {
+				return 0, err
+			}
+			rows := 0
+			err = playerStatsService.ExportPlayerStats(payload.Season, func(stats *models.PlayerStats) error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				rows++
+				return encoder.Encode(stats)
+			})
+			if err != nil {
+				return 0, err
+			}
+			return rows, encoder.Flush()
+		})
+		if err != nil {
+			return err
+		}
+		return run.Progress(result.Rows, result, run.Job.Errors)
+	})
+
+	return s
+}
+
+// SubmitPlayerStatsExport queues a CSV export of a season's player stats, or of every season
+// when season is empty
+func (s *exportService) SubmitPlayerStatsExport(season string) (*models.Job, error) {
+	season = strings.TrimSpace(season)
+	if season != "" && !exportSeason.MatchString(season) {
+		return nil, fmt.Errorf("validation failed: season must be at most 32 letters, digits, hyphens or underscores")
+	}
+	return s.jobService.Submit(models.JobExportPlayerStats, &playerStatsExportPayload{Season: season}, 0)
+}
+
+// ListExports lists the exports in storage, newest first
+func (s *exportService) ListExports() ([]*models.Export, error) {
+	objects, err := s.store.List(exportKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exports: %w", err)
+	}
+
+	exports := []*models.Export{}
+	for _, object := range objects {
+		exports = append(exports, newExport(strings.TrimPrefix(object.Key, exportKeyPrefix), object.Size, object.ModTime))
+	}
+	sort.SliceStable(exports, func(i, j int) bool {
+		return exports[i].CreatedAt.After(exports[j].CreatedAt)
+	})
+	return exports, nil
+}
+
+// OpenExport opens an export for download; the caller must close it
+func (s *exportService) OpenExport(name string) (io.ReadCloser, error) {
+	if name == "" || path.Base(name) != name || !strings.HasSuffix(name, ".csv") {
+		return nil, fmt.Errorf("validation failed: invalid export name %q", name)
+	}
+
+	body, err := s.store.Get(exportKeyPrefix + name)
+	if err == storage.ErrNotFound {
+		return nil, fmt.Errorf("export %s not found", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open export %s: %w", name, err)
+	}
+	return body, nil
+}
+
+// write spools an export to a temporary file, so its size is known, and uploads it
+func (s *exportService) write(name string, encode func(w io.Writer) (int, error)) (*models.ExportResult, error) {
+	file, err := os.CreateTemp("", "export-*.csv")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export file: %w", err)
+	}
+	defer func() {
+		file.Close()
+		if err := os.Remove(file.Name()); err != nil {
+			log.Printf("Failed to remove export file %s: %v", file.Name(), err)
+		}
+	}()
+
+	rows, err := encode(file)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read export file: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read export file: %w", err)
+	}
+	if err := s.store.Put(exportKeyPrefix+name, file, size, "text/csv"); err != nil {
+		return nil, fmt.Errorf("failed to upload export %s: %w", name, err)
+	}
+
+	return &models.ExportResult{Export: newExport(name, size, s.clock.Now().UTC()), Rows: rows}, nil
+}
+
+// newExport describes a stored export with its download path
+func newExport(name string, size int64, createdAt time.Time) *models.Export {
+	return &models.Export{Name: name, Size: size, CreatedAt: createdAt, URL: "/api/exports/" + name}
+}
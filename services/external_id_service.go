@@ -0,0 +1,228 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/external_id_service.go -pkg mocks . ExternalIDService
+
+const (
+	entityTypePlayer = "player"
+	entityTypeTeam   = "team"
+	entityTypeGame   = "game"
+)
+
+var validEntityTypes = []string{entityTypePlayer, entityTypeTeam, entityTypeGame}
+
+// ExternalIDService defines the interface for cross-provider identity mapping
+type ExternalIDService interface {
+	GetExternalIDs(entityType string, entityID int) ([]*models.ExternalID, error)
+	LookupExternalID(entityType, provider, externalID string) (*models.ExternalID, error)
+	CreateExternalID(req *models.CreateExternalIDRequest) (*models.ExternalID, error)
+	DeleteExternalID(id int) error
+}
+
+// externalIDService implements ExternalIDService interface
+type externalIDService struct {
+	externalIDRepo repositories.ExternalIDRepository
+	playerRepo     repositories.PlayerRepository
+	teamRepo       repositories.TeamRepository
+	gameRepo       repositories.GameRepository
+}
+
+// NewExternalIDService creates a new external ID service
+func NewExternalIDService(externalIDRepo repositories.ExternalIDRepository, playerRepo repositories.PlayerRepository, teamRepo repositories.TeamRepository, gameRepo repositories.GameRepository) ExternalIDService {
+	return &externalIDService{
+		externalIDRepo: externalIDRepo,
+		playerRepo:     playerRepo,
+		teamRepo:       teamRepo,
+		gameRepo:       gameRepo,
+	}
+}
+
+// GetExternalIDs retrieves all provider IDs of a player, team or game
+func (s *externalIDService) GetExternalIDs(entityType string, entityID int) ([]*models.ExternalID, error) {
+	if err := s.verifyEntity(entityType, entityID); err != nil {
+		return nil, err
+	}
+
+	externalIDs, err := s.externalIDRepo.GetByEntity(entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external IDs: %w", err)
+	}
+
+	return externalIDs, nil
+}
+
+// LookupExternalID resolves a provider ID to the internal entity
+func (s *externalIDService) LookupExternalID(entityType, provider, externalID string) (*models.ExternalID, error) {
+	entityType = strings.ToLower(strings.TrimSpace(entityType))
+	provider = strings.ToLower(strings.TrimSpace(provider))
+	externalID = strings.TrimSpace(externalID)
+
+	if err := validateOneOf("entity type", entityType, validEntityTypes); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := validateOneOf("provider", provider, models.ExternalIDProviders); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if externalID == "" {
+		return nil, fmt.Errorf("validation failed: external ID is required")
+	}
+
+	mapping, err := s.externalIDRepo.Lookup(entityType, provider, externalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up external ID: %w", err)
+	}
+
+	return mapping, nil
+}
+
+// CreateExternalID maps a provider ID to an existing player, team or game
+func (s *externalIDService) CreateExternalID(req *models.CreateExternalIDRequest) (*models.ExternalID, error) {
+	// Validate request
+	if err := s.validateCreateExternalIDRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	entityType := strings.ToLower(strings.TrimSpace(req.EntityType))
+	if err := s.verifyEntity(entityType, req.EntityID); err != nil {
+		return nil, err
+	}
+
+	ids := map[string]string{req.Provider: req.ExternalID}
+	if err := checkExternalIDsUnclaimed(s.externalIDRepo, entityType, req.EntityID, ids); err != nil {
+		return nil, err
+	}
+
+	// An entity has at most one ID per provider
+	existing, err := s.externalIDRepo.GetByEntity(entityType, req.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external IDs: %w", err)
+	}
+	for _, mapping := range existing {
+		if strings.EqualFold(mapping.Provider, strings.TrimSpace(req.Provider)) {
+			return nil, fmt.Errorf("%s with ID %d already has %s ID %s", entityType, req.EntityID, mapping.Provider, mapping.ExternalID)
+		}
+	}
+
+	externalID := &models.ExternalID{
+		EntityType: entityType,
+		EntityID:   req.EntityID,
+		Provider:   strings.ToLower(strings.TrimSpace(req.Provider)),
+		ExternalID: strings.TrimSpace(req.ExternalID),
+	}
+
+	if err := s.externalIDRepo.Create(externalID); err != nil {
+		return nil, fmt.Errorf("failed to create external ID: %w", err)
+	}
+
+	return externalID, nil
+}
+
+// DeleteExternalID removes an external ID mapping
+func (s *externalIDService) DeleteExternalID(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid external ID: %d", id)
+	}
+
+	if err := s.externalIDRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete external ID: %w", err)
+	}
+
+	return nil
+}
+
+// verifyEntity checks that the player, team or game exists
+func (s *externalIDService) verifyEntity(entityType string, entityID int) error {
+	if entityID <= 0 {
+		return fmt.Errorf("invalid %s ID: %d", entityType, entityID)
+	}
+
+	var exists bool
+	var err error
+	switch entityType {
+	case entityTypePlayer:
+		exists, err = s.playerRepo.Exists(entityID)
+	case entityTypeTeam:
+		exists, err = s.teamRepo.Exists(entityID)
+	case entityTypeGame:
+		exists, err = s.gameRepo.Exists(entityID)
+	default:
+		return fmt.Errorf("validation failed: entity type must be one of: %v", validEntityTypes)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to verify %s existence: %w", entityType, err)
+	}
+	if !exists {
+		return fmt.Errorf("%s with ID %d not found", entityType, entityID)
+	}
+
+	return nil
+}
+
+// validateCreateExternalIDRequest validates the create external ID request
+func (s *externalIDService) validateCreateExternalIDRequest(req *models.CreateExternalIDRequest) error {
+	if err := validateOneOf("entity type", req.EntityType, validEntityTypes); err != nil {
+		return err
+	}
+
+	if req.EntityID <= 0 {
+		return fmt.Errorf("entity ID is required and must be positive")
+	}
+
+	return validateExternalIDs(map[string]string{req.Provider: req.ExternalID})
+}
+
+// validateExternalIDs validates a provider to external ID map as accepted on create requests
+func validateExternalIDs(ids map[string]string) error {
+	for provider, externalID := range ids {
+		if err := validateOneOf("provider", provider, models.ExternalIDProviders); err != nil {
+			return err
+		}
+		if strings.TrimSpace(externalID) == "" {
+			return fmt.Errorf("%s ID cannot be empty", provider)
+		}
+	}
+	return nil
+}
+
+// checkExternalIDsUnclaimed fails when any of the provider IDs already maps to a different
+// entity of the same type. This is what keeps repeated imports from creating duplicates:
+// the caller is told which existing entity the provider ID belongs to.
+func checkExternalIDsUnclaimed(repo repositories.ExternalIDRepository, entityType string, entityID int, ids map[string]string) error {
+	for provider, externalID := range ids {
+		provider = strings.ToLower(strings.TrimSpace(provider))
+		externalID = strings.TrimSpace(externalID)
+
+		existing, err := repo.Lookup(entityType, provider, externalID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				continue
+			}
+			return fmt.Errorf("failed to check external IDs: %w", err)
+		}
+		if existing.EntityID != entityID {
+			return fmt.Errorf("%s with %s ID %s already exists with ID %d", entityType, provider, externalID, existing.EntityID)
+		}
+		return fmt.Errorf("%s with ID %d already has %s ID %s", entityType, entityID, provider, externalID)
+	}
+	return nil
+}
+
+// normalizedExternalIDs returns the provider IDs of a create request as they are stored, for
+// the repository to write along with the new entity
+func normalizedExternalIDs(ids map[string]string) map[string]string {
+	if len(ids) == 0 {
+		return nil
+	}
+	normalized := make(map[string]string, len(ids))
+	for provider, externalID := range ids {
+		normalized[strings.ToLower(strings.TrimSpace(provider))] = strings.TrimSpace(externalID)
+	}
+	return normalized
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"math"
+
+	"sports-backend/models"
+)
+
+// defaultScoring is the fantasy points awarded per unit of each stat, using common PPR rules.
+// Stats that score nothing are left out.
+var defaultScoring = scoringWeights(models.FootballStats)
+
+// fantasyPoints scores a stat line keyed by stat name, rounded to two decimals
+func fantasyPoints(values map[string]float64) float64 {
+	points := 0.0
+	for stat, value := range values {
+		points += value * defaultScoring[stat]
+	}
+	return roundPoints(points)
+}
+
+// roundPoints rounds fantasy points to two decimals
+func roundPoints(points float64) float64 {
+	return math.Round(points*100) / 100
+}
+
+// scoringWeights maps each stat that scores under the default rules to its points per unit
+func scoringWeights(definitions []*models.StatDefinition) map[string]float64 {
+	weights := make(map[string]float64)
+	for _, definition := range definitions {
+		if definition.DefaultPoints != 0 {
+			weights[definition.Name] = definition.DefaultPoints
+		}
+	}
+	return weights
+}
@@ -2,11 +2,15 @@ package services
 
 import (
 	"fmt"
+	"sort"
+	"sports-backend/clock"
 	"sports-backend/models"
 	"sports-backend/repositories"
-	"time"
+	"strings"
 )
 
+//go:generate moq -out mocks/game_service.go -pkg mocks . GameService
+
 // GameService defines the interface for game business logic
 type GameService interface {
 	GetAllGames() ([]*models.Game, error)
@@ -14,28 +18,49 @@ type GameService interface {
 	CreateGame(req *models.CreateGameRequest) (*models.Game, error)
 	UpdateGame(id int, req *models.UpdateGameRequest) (*models.Game, error)
 	DeleteGame(id int) error
+	RestoreGame(id int) (*models.Game, error)
+	PurgeGame(id int) error
 	GetGamesByTeam(teamID int) ([]*models.Game, error)
+	GetTeamSchedule(teamID int) (*models.Team, []*models.Game, error)
 	GetGamesBySeason(season string) ([]*models.Game, error)
 	GetGamesByWeek(season string, week int) ([]*models.Game, error)
+	IncludeRelated(games []*models.Game, include string) error
+	FilterByGameType(games []*models.Game, gameType string) ([]*models.Game, error)
+	FilterByResult(games []*models.Game, teamID int, result string) ([]*models.Game, error)
 }
 
 // gameService implements the GameService interface
 type gameService struct {
-	gameRepo repositories.GameRepository
-	teamRepo repositories.TeamRepository
+	gameRepo        repositories.GameRepository
+	teamRepo        repositories.TeamRepository
+	venueRepo       repositories.VenueRepository
+	oddsRepo        repositories.OddsRepository
+	externalIDRepo  repositories.ExternalIDRepository
+	playerStatsRepo repositories.PlayerStatsRepository
+	clock           clock.Clock
 }
 
 // NewGameService creates a new game service
-func NewGameService(gameRepo repositories.GameRepository, teamRepo repositories.TeamRepository) GameService {
+func NewGameService(gameRepo repositories.GameRepository, teamRepo repositories.TeamRepository, venueRepo repositories.VenueRepository, oddsRepo repositories.OddsRepository, externalIDRepo repositories.ExternalIDRepository, playerStatsRepo repositories.PlayerStatsRepository, clock clock.Clock) GameService {
 	return &gameService{
-		gameRepo: gameRepo,
-		teamRepo: teamRepo,
+		gameRepo:        gameRepo,
+		teamRepo:        teamRepo,
+		venueRepo:       venueRepo,
+		oddsRepo:        oddsRepo,
+		externalIDRepo:  externalIDRepo,
+		playerStatsRepo: playerStatsRepo,
+		clock:           clock,
 	}
 }
 
 // GetAllGames retrieves all games
 func (s *gameService) GetAllGames() ([]*models.Game, error) {
-	return s.gameRepo.GetAll()
+	games, err := s.gameRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.attachDetails(games)
 }
 
 // GetGameByID retrieves a game by ID
@@ -44,7 +69,16 @@ func (s *gameService) GetGameByID(id int) (*models.Game, error) {
 		return nil, fmt.Errorf("invalid game ID: %d", id)
 	}
 
-	return s.gameRepo.GetByID(id)
+	game, err := s.gameRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.attachDetails([]*models.Game{game}); err != nil {
+		return nil, err
+	}
+
+	return game, nil
 }
 
 // CreateGame creates a new game
@@ -53,21 +87,24 @@ func (s *gameService) CreateGame(req *models.CreateGameRequest) (*models.Game, e
 	if err := s.validateCreateGameRequest(req); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	if err := validateExternalIDs(req.ExternalIDs); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Provider IDs that are already mapped mean the game was imported before
+	if err := checkExternalIDsUnclaimed(s.externalIDRepo, entityTypeGame, 0, req.ExternalIDs); err != nil {
+		return nil, err
+	}
 
 	// Check if both teams exist
-	homeTeamExists, err := s.teamRepo.Exists(req.HomeTeamID)
+	teamsExist, err := s.teamRepo.ExistsMany([]int{req.HomeTeamID, req.AwayTeamID})
 	if err != nil {
-		return nil, fmt.Errorf("failed to check home team: %w", err)
+		return nil, fmt.Errorf("failed to check teams: %w", err)
 	}
-	if !homeTeamExists {
+	if !teamsExist[req.HomeTeamID] {
 		return nil, fmt.Errorf("home team with ID %d not found", req.HomeTeamID)
 	}
-
-	awayTeamExists, err := s.teamRepo.Exists(req.AwayTeamID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check away team: %w", err)
-	}
-	if !awayTeamExists {
+	if !teamsExist[req.AwayTeamID] {
 		return nil, fmt.Errorf("away team with ID %d not found", req.AwayTeamID)
 	}
 
@@ -76,28 +113,53 @@ func (s *gameService) CreateGame(req *models.CreateGameRequest) (*models.Game, e
 		return nil, fmt.Errorf("home team and away team cannot be the same")
 	}
 
+	if req.VenueID != nil {
+		if err := s.checkVenueExists(*req.VenueID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set default status if not provided
 	status := req.Status
 	if status == "" {
 		status = "scheduled"
 	}
 
+	gameType := models.GameTypeRegular
+	if req.GameType != "" {
+		gameType = strings.ToLower(strings.TrimSpace(req.GameType))
+	}
+
 	// Create the game
 	game := &models.Game{
-		HomeTeamID: req.HomeTeamID,
-		AwayTeamID: req.AwayTeamID,
-		Season:     req.Season,
-		Week:       req.Week,
-		GameDate:   req.GameDate,
-		Status:     status,
-		HomeScore:  req.HomeScore,
-		AwayScore:  req.AwayScore,
+		HomeTeamID:  req.HomeTeamID,
+		AwayTeamID:  req.AwayTeamID,
+		Season:      req.Season,
+		Week:        req.Week,
+		GameType:    gameType,
+		GameDate:    req.GameDate,
+		Status:      status,
+		HomeScore:   req.HomeScore,
+		AwayScore:   req.AwayScore,
+		VenueID:     req.VenueID,
+		NeutralSite: req.NeutralSite,
+		Overtime:    req.Overtime,
+		Periods:     req.Periods,
+		ExternalIDs: normalizedExternalIDs(req.ExternalIDs),
+	}
+
+	if err := s.checkPeriods(game, false); err != nil {
+		return nil, err
 	}
 
 	if err := s.gameRepo.Create(game); err != nil {
 		return nil, fmt.Errorf("failed to create game: %w", err)
 	}
 
+	if _, err := s.attachDetails([]*models.Game{game}); err != nil {
+		return nil, err
+	}
+
 	return game, nil
 }
 
@@ -156,6 +218,10 @@ func (s *gameService) UpdateGame(id int, req *models.UpdateGameRequest) (*models
 		game.Week = *req.Week
 	}
 
+	if req.GameType != nil {
+		game.GameType = strings.ToLower(strings.TrimSpace(*req.GameType))
+	}
+
 	if req.GameDate != nil {
 		game.GameDate = *req.GameDate
 	}
@@ -172,11 +238,38 @@ func (s *gameService) UpdateGame(id int, req *models.UpdateGameRequest) (*models
 		game.AwayScore = req.AwayScore
 	}
 
+	if req.VenueID != nil {
+		if err := s.checkVenueExists(*req.VenueID); err != nil {
+			return nil, err
+		}
+		game.VenueID = req.VenueID
+	}
+
+	if req.NeutralSite != nil {
+		game.NeutralSite = *req.NeutralSite
+	}
+
+	if req.Overtime != nil {
+		game.Overtime = *req.Overtime
+	}
+
+	if req.Periods != nil {
+		game.Periods = req.Periods
+	}
+
+	if err := s.checkPeriods(game, req.Overtime != nil && !*req.Overtime); err != nil {
+		return nil, err
+	}
+
 	// Update the game
 	if err := s.gameRepo.Update(game); err != nil {
 		return nil, fmt.Errorf("failed to update game: %w", err)
 	}
 
+	if _, err := s.attachDetails([]*models.Game{game}); err != nil {
+		return nil, err
+	}
+
 	return game, nil
 }
 
@@ -198,6 +291,28 @@ func (s *gameService) DeleteGame(id int) error {
 	return s.gameRepo.Delete(id)
 }
 
+// RestoreGame brings back a soft-deleted game
+func (s *gameService) RestoreGame(id int) (*models.Game, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", id)
+	}
+
+	if err := s.gameRepo.Restore(id); err != nil {
+		return nil, err
+	}
+
+	return s.GetGameByID(id)
+}
+
+// PurgeGame permanently removes a game that has already been deleted
+func (s *gameService) PurgeGame(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid game ID: %d", id)
+	}
+
+	return s.gameRepo.Purge(id)
+}
+
 // GetGamesByTeam retrieves all games for a specific team
 func (s *gameService) GetGamesByTeam(teamID int) ([]*models.Game, error) {
 	if teamID <= 0 {
@@ -213,7 +328,42 @@ func (s *gameService) GetGamesByTeam(teamID int) ([]*models.Game, error) {
 		return nil, fmt.Errorf("team with ID %d not found", teamID)
 	}
 
-	return s.gameRepo.GetByTeamID(teamID)
+	games, err := s.gameRepo.GetByTeamID(teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.attachDetails(games)
+}
+
+// GetTeamSchedule retrieves a team and all of its games, oldest first, with both teams and
+// the venue of each game
+func (s *gameService) GetTeamSchedule(teamID int) (*models.Team, []*models.Game, error) {
+	if teamID <= 0 {
+		return nil, nil, fmt.Errorf("invalid team ID: %d", teamID)
+	}
+
+	team, err := s.teamRepo.GetByID(teamID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	games, err := s.gameRepo.GetByTeamID(teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+	games, err = s.attachDetails(games)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.IncludeRelated(games, "home_team,away_team"); err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].GameDate.Before(games[j].GameDate)
+	})
+	return team, games, nil
 }
 
 // GetGamesBySeason retrieves all games for a specific season
@@ -222,7 +372,12 @@ func (s *gameService) GetGamesBySeason(season string) ([]*models.Game, error) {
 		return nil, fmt.Errorf("season cannot be empty")
 	}
 
-	return s.gameRepo.GetBySeason(season)
+	games, err := s.gameRepo.GetBySeason(season)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.attachDetails(games)
 }
 
 // GetGamesByWeek retrieves all games for a specific week in a season
@@ -235,7 +390,253 @@ func (s *gameService) GetGamesByWeek(season string, week int) ([]*models.Game, e
 		return nil, fmt.Errorf("week must be between 1 and 22, got %d", week)
 	}
 
-	return s.gameRepo.GetByWeek(season, week)
+	games, err := s.gameRepo.GetByWeek(season, week)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.attachDetails(games)
+}
+
+// attachDetails populates the result, venue, latest betting line and provider IDs of each game
+func (s *gameService) attachDetails(games []*models.Game) ([]*models.Game, error) {
+	if len(games) == 0 {
+		return games, nil
+	}
+
+	for _, game := range games {
+		game.Result = gameResult(game)
+	}
+
+	// Each venue is loaded once
+	venues := make(map[int]*models.Venue)
+
+	for _, game := range games {
+		if game.VenueID == nil {
+			continue
+		}
+
+		venue, ok := venues[*game.VenueID]
+		if !ok {
+			var err error
+			venue, err = s.venueRepo.GetByID(*game.VenueID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get venue for game %d: %w", game.ID, err)
+			}
+			venues[*game.VenueID] = venue
+		}
+		game.Venue = venue
+	}
+
+	gameIDs := make([]int, len(games))
+	for i, game := range games {
+		gameIDs[i] = game.ID
+	}
+
+	latestOdds, err := s.oddsRepo.GetLatestByGameIDs(gameIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest odds: %w", err)
+	}
+	for _, game := range games {
+		game.LatestOdds = latestOdds[game.ID]
+	}
+
+	externalIDs, err := s.externalIDRepo.GetByEntityIDs(entityTypeGame, gameIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external IDs: %w", err)
+	}
+	for _, game := range games {
+		game.ExternalIDs = externalIDs[game.ID]
+	}
+
+	return games, nil
+}
+
+// gameIncludes lists the related records that can be requested with games
+var gameIncludes = []string{"home_team", "away_team", "player_stats"}
+
+// IncludeRelated loads the related records named in include, a comma-separated list of
+// home_team, away_team and player_stats, with one query per kind for all the games
+func (s *gameService) IncludeRelated(games []*models.Game, include string) error {
+	includes, err := parseInclude(include, gameIncludes)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if len(includes) == 0 || len(games) == 0 {
+		return nil
+	}
+
+	if includes["home_team"] || includes["away_team"] {
+		teamIDs := make([]int, 0, 2*len(games))
+		for _, game := range games {
+			teamIDs = append(teamIDs, game.HomeTeamID, game.AwayTeamID)
+		}
+		teams, err := s.teamRepo.GetByIDs(teamIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get teams: %w", err)
+		}
+		for _, game := range games {
+			if includes["home_team"] {
+				game.HomeTeam = teams[game.HomeTeamID]
+			}
+			if includes["away_team"] {
+				game.AwayTeam = teams[game.AwayTeamID]
+			}
+		}
+	}
+
+	if includes["player_stats"] {
+		gameIDs := make([]int, len(games))
+		for i, game := range games {
+			gameIDs[i] = game.ID
+		}
+		stats, err := s.playerStatsRepo.GetByGameIDs(gameIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get player stats: %w", err)
+		}
+		for _, game := range games {
+			game.PlayerStats = stats[game.ID]
+		}
+	}
+
+	return nil
+}
+
+// FilterByGameType keeps the games of one type, or all of them when gameType is empty
+func (s *gameService) FilterByGameType(games []*models.Game, gameType string) ([]*models.Game, error) {
+	if gameType == "" {
+		return games, nil
+	}
+	if err := validateOneOf("game_type", gameType, models.GameTypes); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	filtered := make([]*models.Game, 0, len(games))
+	for _, game := range games {
+		if strings.EqualFold(game.GameType, strings.TrimSpace(gameType)) {
+			filtered = append(filtered, game)
+		}
+	}
+	return filtered, nil
+}
+
+// FilterByResult keeps the games the team won, lost or tied, or all of them when result is empty.
+// Games without a result yet are left out of every result.
+func (s *gameService) FilterByResult(games []*models.Game, teamID int, result string) ([]*models.Game, error) {
+	if result == "" {
+		return games, nil
+	}
+	if err := validateOneOf("result", result, models.GameResults); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	result = strings.ToLower(strings.TrimSpace(result))
+
+	filtered := make([]*models.Game, 0, len(games))
+	for _, game := range games {
+		if game.Result != nil && teamResult(game.Result, teamID) == result {
+			filtered = append(filtered, game)
+		}
+	}
+	return filtered, nil
+}
+
+// gameResult derives the outcome of a completed game from its score, nil until the game is
+// completed with both scores recorded
+func gameResult(game *models.Game) *models.GameResult {
+	if game.Status != "completed" || game.HomeScore == nil || game.AwayScore == nil {
+		return nil
+	}
+
+	home, away := game.HomeTeamID, game.AwayTeamID
+	switch {
+	case *game.HomeScore > *game.AwayScore:
+		return &models.GameResult{WinnerTeamID: &home, LoserTeamID: &away}
+	case *game.AwayScore > *game.HomeScore:
+		return &models.GameResult{WinnerTeamID: &away, LoserTeamID: &home}
+	default:
+		return &models.GameResult{Tie: true}
+	}
+}
+
+// teamResult is a game result from one team's point of view: win, loss or tie
+func teamResult(result *models.GameResult, teamID int) string {
+	switch {
+	case result.Tie:
+		return models.ResultTie
+	case *result.WinnerTeamID == teamID:
+		return models.ResultWin
+	default:
+		return models.ResultLoss
+	}
+}
+
+// checkPeriods checks that a game's period scores are numbered 1 to n and add up to its final
+// score, filling in the final score when only the periods are known. A game with periods past
+// regulation for its sport went to overtime, which conflicts with clearing the overtime flag.
+func (s *gameService) checkPeriods(game *models.Game, overtimeCleared bool) error {
+	if len(game.Periods) == 0 {
+		return nil
+	}
+
+	sort.Slice(game.Periods, func(i, j int) bool {
+		return game.Periods[i].Period < game.Periods[j].Period
+	})
+	home, away := 0, 0
+	for i, period := range game.Periods {
+		if period.Period != i+1 {
+			return fmt.Errorf("validation failed: periods must be numbered 1 to %d without gaps or repeats", len(game.Periods))
+		}
+		if period.HomeScore < 0 || period.AwayScore < 0 {
+			return fmt.Errorf("validation failed: period %d scores cannot be negative", period.Period)
+		}
+		home += period.HomeScore
+		away += period.AwayScore
+	}
+
+	if game.HomeScore == nil {
+		game.HomeScore = &home
+	} else if *game.HomeScore != home {
+		return fmt.Errorf("validation failed: home score %d does not match its periods, which add up to %d", *game.HomeScore, home)
+	}
+	if game.AwayScore == nil {
+		game.AwayScore = &away
+	} else if *game.AwayScore != away {
+		return fmt.Errorf("validation failed: away score %d does not match its periods, which add up to %d", *game.AwayScore, away)
+	}
+
+	homeTeam, err := s.teamRepo.GetByID(game.HomeTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get home team: %w", err)
+	}
+	rules, err := rulesForSport(homeTeam.Sport)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if len(game.Periods) > rules.RegulationPeriods() {
+		if overtimeCleared {
+			return fmt.Errorf("validation failed: a game with more than %d periods went to overtime", rules.RegulationPeriods())
+		}
+		game.Overtime = true
+	}
+
+	return nil
+}
+
+// checkVenueExists returns an error when the venue does not exist
+func (s *gameService) checkVenueExists(venueID int) error {
+	if venueID <= 0 {
+		return fmt.Errorf("validation failed: venue ID must be positive")
+	}
+
+	exists, err := s.venueRepo.Exists(venueID)
+	if err != nil {
+		return fmt.Errorf("failed to check venue: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("venue with ID %d not found", venueID)
+	}
+
+	return nil
 }
 
 // validateCreateGameRequest validates a create game request
@@ -256,18 +657,24 @@ func (s *gameService) validateCreateGameRequest(req *models.CreateGameRequest) e
 		return fmt.Errorf("week must be between 1 and 22, got %d", req.Week)
 	}
 
+	if req.GameType != "" {
+		if err := validateOneOf("game_type", req.GameType, models.GameTypes); err != nil {
+			return err
+		}
+	}
+
 	if req.GameDate.IsZero() {
 		return fmt.Errorf("game date is required")
 	}
 
 	// Check if game date is not too far in the past (more than 1 year)
-	oneYearAgo := time.Now().AddDate(-1, 0, 0)
+	oneYearAgo := s.clock.Now().AddDate(-1, 0, 0)
 	if req.GameDate.Before(oneYearAgo) {
 		return fmt.Errorf("game date cannot be more than 1 year in the past")
 	}
 
 	// Check if game date is not too far in the future (more than 2 years)
-	twoYearsFromNow := time.Now().AddDate(2, 0, 0)
+	twoYearsFromNow := s.clock.Now().AddDate(2, 0, 0)
 	if req.GameDate.After(twoYearsFromNow) {
 		return fmt.Errorf("game date cannot be more than 2 years in the future")
 	}
@@ -315,19 +722,25 @@ func (s *gameService) validateUpdateGameRequest(req *models.UpdateGameRequest) e
 		return fmt.Errorf("week must be between 1 and 22, got %d", *req.Week)
 	}
 
+	if req.GameType != nil {
+		if err := validateOneOf("game_type", *req.GameType, models.GameTypes); err != nil {
+			return err
+		}
+	}
+
 	if req.GameDate != nil {
 		if req.GameDate.IsZero() {
 			return fmt.Errorf("game date cannot be zero")
 		}
 
 		// Check if game date is not too far in the past (more than 1 year)
-		oneYearAgo := time.Now().AddDate(-1, 0, 0)
+		oneYearAgo := s.clock.Now().AddDate(-1, 0, 0)
 		if req.GameDate.Before(oneYearAgo) {
 			return fmt.Errorf("game date cannot be more than 1 year in the past")
 		}
 
 		// Check if game date is not too far in the future (more than 2 years)
-		twoYearsFromNow := time.Now().AddDate(2, 0, 0)
+		twoYearsFromNow := s.clock.Now().AddDate(2, 0, 0)
 		if req.GameDate.After(twoYearsFromNow) {
 			return fmt.Errorf("game date cannot be more than 2 years in the future")
 		}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"fmt"
+	"os"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/health_service.go -pkg mocks . HealthService
+
+// HealthService defines the interface for readiness checks
+type HealthService interface {
+	Ready() *models.HealthReport
+}
+
+// healthService implements HealthService interface
+type healthService struct {
+	db                *repositories.TimeoutDB
+	dbPath            string
+	pendingMigrations func() (int, error)
+}
+
+// NewHealthService creates a new health service. dbPath is the database file, and
+// pendingMigrations counts the migrations not yet applied to it.
+func NewHealthService(db *repositories.TimeoutDB, dbPath string, pendingMigrations func() (int, error)) HealthService {
+	return &healthService{
+		db:                db,
+		dbPath:            dbPath,
+		pendingMigrations: pendingMigrations,
+	}
+}
+
+// Ready checks every dependency the API needs to serve requests
+func (s *healthService) Ready() *models.HealthReport {
+	report := &models.HealthReport{
+		Status: models.HealthOK,
+		Checks: map[string]*models.HealthCheck{
+			"database":   healthCheck(s.checkDatabase()),
+			"migrations": healthCheck(s.checkMigrations()),
+		},
+	}
+
+	for _, check := range report.Checks {
+		if check.Status != models.HealthOK {
+			report.Status = models.HealthFail
+		}
+	}
+
+	return report
+}
+
+// checkDatabase pings the database and checks its file is still there. SQLite keeps serving
+// a deleted file from its open handle, so a ping alone would pass after the file is removed.
+func (s *healthService) checkDatabase() error {
+	if err := s.db.Ping(); err != nil {
+		return fmt.Errorf("ping failed: %v", err)
+	}
+	if _, err := os.Stat(s.dbPath); err != nil {
+		return fmt.Errorf("database file unavailable: %v", err)
+	}
+	return nil
+}
+
+// checkMigrations fails while any migration is pending
+func (s *healthService) checkMigrations() error {
+	pending, err := s.pendingMigrations()
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return fmt.Errorf("%d migrations pending", pending)
+	}
+	return nil
+}
+
+// healthCheck reports a check's result
+func healthCheck(err error) *models.HealthCheck {
+	if err != nil {
+		return &models.HealthCheck{Status: models.HealthFail, Error: err.Error()}
+	}
+	return &models.HealthCheck{Status: models.HealthOK}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/highlight_service.go -pkg mocks . HighlightService
+
+// HighlightService defines the interface for detecting notable performances
+type HighlightService interface {
+	GetWeeklyHighlights(season string, week int, thresholds models.HighlightThresholds) (*models.WeeklyHighlightsResponse, error)
+}
+
+// highlightService implements HighlightService interface
+type highlightService struct {
+	playerStatsRepo repositories.PlayerStatsRepository
+	gameRepo        repositories.GameRepository
+}
+
+// NewHighlightService creates a new highlight service
+func NewHighlightService(playerStatsRepo repositories.PlayerStatsRepository, gameRepo repositories.GameRepository) HighlightService {
+	return &highlightService{
+		playerStatsRepo: playerStatsRepo,
+		gameRepo:        gameRepo,
+	}
+}
+
+// GetWeeklyHighlights returns every stat line of the week that crosses one of the thresholds.
+// When season is empty the most recent season with games is used.
+func (s *highlightService) GetWeeklyHighlights(season string, week int, thresholds models.HighlightThresholds) (*models.WeeklyHighlightsResponse, error) {
+	if week < 1 || week > 22 {
+		return nil, fmt.Errorf("week must be between 1 and 22, got %d", week)
+	}
+
+	if err := s.validateThresholds(thresholds); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if season == "" {
+		latest, err := s.gameRepo.GetLatestSeason()
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine season: %w", err)
+		}
+		season = latest
+	}
+
+	statsList, players, err := s.playerStatsRepo.GetByWeekWithPlayers(season, week)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player stats by week: %w", err)
+	}
+
+	highlights := []*models.Highlight{}
+	for _, stats := range statsList {
+		found := detectHighlights(stats, thresholds)
+		if len(found) == 0 {
+			continue
+		}
+
+		player := players[stats.PlayerID]
+		for _, highlight := range found {
+			highlight.PlayerName = player.FirstName + " " + player.LastName
+			highlight.Position = player.Position
+			highlight.TeamID = player.TeamID
+			highlights = append(highlights, highlight)
+		}
+	}
+
+	// Biggest performances first within each category
+	sort.SliceStable(highlights, func(i, j int) bool {
+		if highlights[i].Category != highlights[j].Category {
+			return highlights[i].Category < highlights[j].Category
+		}
+		return highlights[i].Value > highlights[j].Value
+	})
+
+	return &models.WeeklyHighlightsResponse{
+		Season:     season,
+		Week:       week,
+		Thresholds: thresholds,
+		Highlights: highlights,
+	}, nil
+}
+
+// validateThresholds validates the highlight thresholds
+func (s *highlightService) validateThresholds(thresholds models.HighlightThresholds) error {
+	fields := []struct {
+		value int
+		name  string
+	}{
+		{thresholds.PassingYards, "passing yards threshold"},
+		{thresholds.RushingYards, "rushing yards threshold"},
+		{thresholds.ReceivingYards, "receiving yards threshold"},
+		{thresholds.Touchdowns, "touchdowns threshold"},
+		{thresholds.DefensiveTouchdowns, "defensive touchdowns threshold"},
+	}
+
+	for _, field := range fields {
+		if field.value <= 0 {
+			return fmt.Errorf("%s must be positive", field.name)
+		}
+	}
+
+	return nil
+}
+
+// detectHighlights returns the highlights a single stat line qualifies for
+func detectHighlights(stats *models.PlayerStats, thresholds models.HighlightThresholds) []*models.Highlight {
+	var highlights []*models.Highlight
+
+	add := func(category, description string, value int) {
+		highlights = append(highlights, &models.Highlight{
+			Category:    category,
+			Description: description,
+			Value:       value,
+			PlayerID:    stats.PlayerID,
+			GameID:      stats.GameID,
+			StatsID:     stats.ID,
+		})
+	}
+
+	if yards := intValue(stats.PassingYards); yards >= thresholds.PassingYards {
+		add("passing_yards", fmt.Sprintf("%d passing yards", yards), yards)
+	}
+
+	if yards := intValue(stats.RushingYards); yards >= thresholds.RushingYards {
+		add("rushing_yards", fmt.Sprintf("%d rushing yards", yards), yards)
+	}
+
+	if yards := intValue(stats.ReceivingYards); yards >= thresholds.ReceivingYards {
+		add("receiving_yards", fmt.Sprintf("%d receiving yards", yards), yards)
+	}
+
+	touchdowns := intValue(stats.PassingTouchdowns) + intValue(stats.RushingTouchdowns) +
+		intValue(stats.ReceivingTouchdowns) + intValue(stats.KickReturnTouchdowns) +
+		intValue(stats.PuntReturnTouchdowns)
+	if touchdowns >= thresholds.Touchdowns {
+		add("touchdowns", fmt.Sprintf("%d total touchdowns", touchdowns), touchdowns)
+	}
+
+	if scores := intValue(stats.DefensiveTouchdowns); scores >= thresholds.DefensiveTouchdowns {
+		add("defensive_score", fmt.Sprintf("%d defensive touchdowns", scores), scores)
+	}
+
+	return highlights
+}
+
+// intValue dereferences an optional stat, treating missing values as zero
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // registers GIF decoding
+	"image/jpeg"
+	"image/png"
+	"mime"
+	"net/http"
+)
+
+// maxImagePixels bounds the dimensions of an uploaded image before it is decoded, so a small
+// file that decompresses to a huge bitmap can't exhaust memory
+const maxImagePixels = 40_000_000
+
+// imageContentTypes are the upload formats accepted, as sniffed from the content
+var imageContentTypes = []string{"image/png", "image/jpeg", "image/gif"}
+
+// decodeUpload checks that data is an accepted image format, sniffing the content rather than
+// trusting the declared type, and decodes it
+func decodeUpload(data []byte, declaredType string) (image.Image, error) {
+	sniffed := http.DetectContentType(data)
+	if err := validateOneOf("image content type", sniffed, imageContentTypes); err != nil {
+		return nil, fmt.Errorf("unsupported media type: %w", err)
+	}
+	if declared, _, err := mime.ParseMediaType(declaredType); err == nil && declared != "application/octet-stream" && declared != sniffed {
+		return nil, fmt.Errorf("validation failed: file is %s but was uploaded as %s", sniffed, declared)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: invalid image: %v", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("validation failed: image is %dx%d, at most %d pixels are allowed", config.Width, config.Height, maxImagePixels)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: invalid image: %v", err)
+	}
+	return img, nil
+}
+
+// fitImage scales img to fit within width x height, keeping its aspect ratio, and centers it
+// on a transparent canvas of exactly that size
+func fitImage(img image.Image, width, height int) *image.RGBA {
+	bounds := img.Bounds()
+	scale := min(float64(width)/float64(bounds.Dx()), float64(height)/float64(bounds.Dy()))
+	scaledWidth := max(1, int(float64(bounds.Dx())*scale+0.5))
+	scaledHeight := max(1, int(float64(bounds.Dy())*scale+0.5))
+
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	offset := image.Pt((width-scaledWidth)/2, (height-scaledHeight)/2)
+	resample(canvas, image.Rectangle{Min: offset, Max: offset.Add(image.Pt(scaledWidth, scaledHeight))}, img, bounds)
+	return canvas
+}
+
+// fillImage scales img to cover width x height, keeping its aspect ratio, and crops the
+// overflow evenly from both sides. Vertical overflow is cropped from the bottom, to keep
+// the face in a portrait.
+func fillImage(img image.Image, width, height int) *image.RGBA {
+	bounds := img.Bounds()
+	crop := bounds
+	if bounds.Dx()*height > bounds.Dy()*width {
+		cropWidth := bounds.Dy() * width / height
+		crop.Min.X += (bounds.Dx() - cropWidth) / 2
+		crop.Max.X = crop.Min.X + cropWidth
+	} else {
+		crop.Max.Y = crop.Min.Y + bounds.Dx()*height/width
+	}
+
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	resample(canvas, canvas.Bounds(), img, crop)
+	return canvas
+}
+
+// resample draws the src rectangle of img into the dst rectangle of canvas. Each destination
+// pixel averages the source pixels it covers, which keeps downscaled images smooth; when
+// upscaling it takes the nearest source pixel.
+func resample(canvas *image.RGBA, dst image.Rectangle, img image.Image, src image.Rectangle) {
+	xScale := float64(src.Dx()) / float64(dst.Dx())
+	yScale := float64(src.Dy()) / float64(dst.Dy())
+
+	for y := dst.Min.Y; y < dst.Max.Y; y++ {
+		y0 := src.Min.Y + int(float64(y-dst.Min.Y)*yScale)
+		y1 := min(src.Max.Y, max(y0+1, src.Min.Y+int(float64(y-dst.Min.Y+1)*yScale)))
+		for x := dst.Min.X; x < dst.Max.X; x++ {
+			x0 := src.Min.X + int(float64(x-dst.Min.X)*xScale)
+			x1 := min(src.Max.X, max(x0+1, src.Min.X+int(float64(x-dst.Min.X+1)*xScale)))
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			canvas.Set(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)})
+		}
+	}
+}
+
+// encodePNG encodes img as PNG, keeping transparency
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeJPEG encodes img as JPEG on a white background, as JPEG has no transparency
+func encodeJPEG(img image.Image, quality int) ([]byte, error) {
+	opaque := image.NewRGBA(img.Bounds())
+	draw.Draw(opaque, opaque.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(opaque, opaque.Bounds(), img, img.Bounds().Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, opaque, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
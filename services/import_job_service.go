@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/import_job_service.go -pkg mocks . ImportJobService
+
+const (
+	// importJobBatchSize is how many records each batch of an import job writes in one transaction
+	importJobBatchSize = 500
+	// importJobAttempts is how many times an import is tried before it fails
+	importJobAttempts = 3
+)
+
+// ImportJobService defines the interface for running bulk imports as background jobs
+type ImportJobService interface {
+	SubmitADP(reqs []*models.CreateADPRequest) (*models.Job, error)
+	SubmitProjections(reqs []*models.CreateProjectionRequest) (*models.Job, error)
+	SubmitSalaries(reqs []*models.CreateDFSSalaryRequest) (*models.Job, error)
+	SubmitOdds(reqs []*models.CreateGameOddsRequest) (*models.Job, error)
+	SubmitNflverseStats(lines []*models.NflverseStatLine) (*models.Job, error)
+	SubmitAdvancedStats(reqs []*models.CreateAdvancedStatsRequest) (*models.Job, error)
+}
+
+// importJobService queues bulk imports on the job service
+type importJobService struct {
+	jobService JobService
+}
+
+// NewImportJobService creates a new import job service and registers the import job kinds,
+// which write through the same services as the synchronous bulk endpoints
+func NewImportJobService(jobService JobService, adpService ADPService, projectionService ProjectionService, dfsService DFSService, oddsService OddsService, nflverseService NflverseService, advancedStatsService AdvancedStatsService) ImportJobService {
+	jobService.Register(models.JobImportADP, importJobAttempts, func(ctx context.Context, run *JobRun) error {
+		var reqs []*models.CreateADPRequest
+		if err := run.Payload(&reqs); err != nil {
+			return err
+		}
+		return runImportBatches(ctx, run, func(start, end int) (*models.ImportResult, error) {
+			return adpService.ImportADP(reqs[start:end])
+		})
+	})
+
+	jobService.Register(models.JobImportProjections, importJobAttempts, func(ctx context.Context, run *JobRun) error {
+		var reqs []*models.CreateProjectionRequest
+		if err := run.Payload(&reqs); err != nil {
+			return err
+		}
+		return runImportBatches(ctx, run, func(start, end int) (*models.ImportResult, error) {
+			return projectionService.ImportProjections(reqs[start:end])
+		})
+	})
+
+	jobService.Register(models.JobImportDFSSalaries, importJobAttempts, func(ctx context.Context, run *JobRun) error {
+		var reqs []*models.CreateDFSSalaryRequest
+		if err := run.Payload(&reqs); err != nil {
+			return err
+		}
+		return runImportBatches(ctx, run, func(start, end int) (*models.ImportResult, error) {
+			return dfsService.ImportSalaries(reqs[start:end])
+		})
+	})
+
+	// Every betting line is a new record
+	jobService.Register(models.JobImportOdds, importJobAttempts, func(ctx context.Context, run *JobRun) error {
+		var reqs []*models.CreateGameOddsRequest
+		if err := run.Payload(&reqs); err != nil {
+			return err
+		}
+		return runImportBatches(ctx, run, func(start, end int) (*models.ImportResult, error) {
+			oddsList, err := oddsService.CreateOddsBatch(reqs[start:end])
+			if err != nil {
+				return nil, err
+			}
+			return &models.ImportResult{Created: len(oddsList)}, nil
+		})
+	})
+
+	jobService.Register(models.JobImportNflverse, importJobAttempts, func(ctx context.Context, run *JobRun) error {
+		var lines []*models.NflverseStatLine
+		if err := run.Payload(&lines); err != nil {
+			return err
+		}
+		return runImportBatches(ctx, run, func(start, end int) (*models.ImportResult, error) {
+			return nflverseService.ImportStatLines(lines[start:end])
+		})
+	})
+
+	jobService.Register(models.JobImportAdvanced, importJobAttempts, func(ctx context.Context, run *JobRun) error {
+		var reqs []*models.CreateAdvancedStatsRequest
+		if err := run.Payload(&reqs); err != nil {
+			return err
+		}
+		return runImportBatches(ctx, run, func(start, end int) (*models.ImportResult, error) {
+			return advancedStatsService.ImportAdvancedStats(reqs[start:end])
+		})
+	})
+
+	return &importJobService{jobService: jobService}
+}
+
+// SubmitADP queues an ADP import
+func (s *importJobService) SubmitADP(reqs []*models.CreateADPRequest) (*models.Job, error) {
+	return s.submit(models.JobImportADP, reqs, len(reqs))
+}
+
+// SubmitProjections queues a projection import
+func (s *importJobService) SubmitProjections(reqs []*models.CreateProjectionRequest) (*models.Job, error) {
+	return s.submit(models.JobImportProjections, reqs, len(reqs))
+}
+
+// SubmitSalaries queues a DFS salary import
+func (s *importJobService) SubmitSalaries(reqs []*models.CreateDFSSalaryRequest) (*models.Job, error) {
+	return s.submit(models.JobImportDFSSalaries, reqs, len(reqs))
+}
+
+// SubmitOdds queues a betting line import
+func (s *importJobService) SubmitOdds(reqs []*models.CreateGameOddsRequest) (*models.Job, error) {
+	return s.submit(models.JobImportOdds, reqs, len(reqs))
+}
+
+// SubmitNflverseStats queues a load of stat lines read from an nflverse file
+func (s *importJobService) SubmitNflverseStats(lines []*models.NflverseStatLine) (*models.Job, error) {
+	return s.submit(models.JobImportNflverse, lines, len(lines))
+}
+
+// SubmitAdvancedStats queues an advanced stats import
+func (s *importJobService) SubmitAdvancedStats(reqs []*models.CreateAdvancedStatsRequest) (*models.Job, error) {
+	return s.submit(models.JobImportAdvanced, reqs, len(reqs))
+}
+
+// submit queues an import of total records
+func (s *importJobService) submit(kind string, reqs interface{}, total int) (*models.Job, error) {
+	if total == 0 {
+		return nil, fmt.Errorf("validation failed: at least one record must be provided")
+	}
+	return s.jobService.Submit(kind, reqs, total)
+}
+
+// runImportBatches writes an import's records batch by batch from where earlier attempts got
+// to, saving progress after each batch. A batch with an invalid record is skipped and reported;
+// any other failure ends the attempt, so the retry starts again at that batch.
+func runImportBatches(ctx context.Context, run *JobRun, importBatch func(start, end int) (*models.ImportResult, error)) error {
+	result := &models.ImportResult{}
+	if err := run.Result(result); err != nil {
+		return fmt.Errorf("failed to decode import progress: %w", err)
+	}
+	jobErrors := run.Job.Errors
+
+	for start := run.Job.Processed; start < run.Job.Total; start += importJobBatchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := min(start+importJobBatchSize, run.Job.Total)
+		batch, err := importBatch(start, end)
+		if err != nil {
+			if !strings.Contains(err.Error(), "validation failed") {
+				return err
+			}
+			jobErrors = append(jobErrors, &models.JobError{FirstRecord: start, LastRecord: end - 1, Error: err.Error()})
+		} else {
+			result.Created += batch.Created
+			result.Updated += batch.Updated
+			result.Skipped += batch.Skipped
+		}
+
+		if err := run.Progress(end, result, jobErrors); err != nil {
+			return err
+		}
+	}
+
+	if len(jobErrors) > 0 {
+		return fmt.Errorf("validation failed: %d of the batches had invalid records and were not written", len(jobErrors))
+	}
+	return nil
+}
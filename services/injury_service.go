@@ -0,0 +1,337 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/injuries"
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/injury_service.go -pkg mocks . InjuryService
+
+const (
+	// injuryWeekAhead is how far ahead the next game is looked for to find the week whose
+	// injury report is synced
+	injuryWeekAhead = 8 * 24 * time.Hour
+	// injurySyncTimeout bounds one sync
+	injurySyncTimeout = time.Minute
+)
+
+// InjuryService defines the interface for injury report business logic
+type InjuryService interface {
+	GetInjuries(teamID int) ([]*models.Player, error)
+	GetPlayerInjuries(playerID int) ([]*models.InjuryChange, error)
+	ApplyReport(report *models.InjuryReport) (*models.InjuryReportResult, error)
+	Sync(ctx context.Context) (*models.InjuryReportResult, error)
+	Start(interval time.Duration)
+	Close() error
+}
+
+// injuryService implements InjuryService interface
+type injuryService struct {
+	provider       injuries.Provider // nil when no feed is configured
+	injuryRepo     repositories.InjuryRepository
+	playerRepo     repositories.PlayerRepository
+	gameRepo       repositories.GameRepository
+	externalIDRepo repositories.ExternalIDRepository
+	events         EventService
+	clock          clock.Clock
+
+	// mu serializes applying reports, so the designations a report is compared with are current
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewInjuryService creates a new injury service syncing reports from provider, which may be nil
+// when reports are only posted
+func NewInjuryService(provider injuries.Provider, injuryRepo repositories.InjuryRepository, playerRepo repositories.PlayerRepository, gameRepo repositories.GameRepository, externalIDRepo repositories.ExternalIDRepository, events EventService, clock clock.Clock) InjuryService {
+	return &injuryService{
+		provider:       provider,
+		injuryRepo:     injuryRepo,
+		playerRepo:     playerRepo,
+		gameRepo:       gameRepo,
+		externalIDRepo: externalIDRepo,
+		events:         events,
+		clock:          clock,
+	}
+}
+
+// GetInjuries retrieves the players with a designation on the latest injury report, optionally
+// only those of one team
+func (s *injuryService) GetInjuries(teamID int) ([]*models.Player, error) {
+	if teamID < 0 {
+		return nil, fmt.Errorf("invalid team ID: %d", teamID)
+	}
+	return s.injuryRepo.GetDesignated(teamID)
+}
+
+// GetPlayerInjuries retrieves the changes of a player's injury report line, newest first
+func (s *injuryService) GetPlayerInjuries(playerID int) ([]*models.InjuryChange, error) {
+	if playerID <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", playerID)
+	}
+	exists, err := s.playerRepo.Exists(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check player existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("player with ID %d not found", playerID)
+	}
+
+	return s.injuryRepo.GetByPlayerID(playerID)
+}
+
+// ApplyReport brings every player's designation in line with a week's report: listed players
+// get theirs and players left out have theirs cleared. Only changed lines are written, with a
+// history entry each, and each change of designation publishes a player.injury event.
+func (s *injuryService) ApplyReport(report *models.InjuryReport) (*models.InjuryReportResult, error) {
+	entries, err := validateInjuryReport(report)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	playerIDs := make([]int, len(report.Players))
+	for i, player := range report.Players {
+		playerIDs[i] = player.PlayerID
+	}
+	if err := checkPlayersExist(s.playerRepo, playerIDs, "player"); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	designated, err := s.injuryRepo.GetDesignated(0)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[int]*models.Player, len(designated))
+	for _, player := range designated {
+		current[player.ID] = player
+	}
+
+	result := &models.InjuryReportResult{Season: report.Season, Week: report.Week, Changes: []*models.InjuryChange{}}
+	for _, playerID := range playerIDs {
+		entry := entries[playerID]
+		player, ok := current[playerID]
+		delete(current, playerID)
+		if !ok {
+			if player, err = s.playerRepo.GetByID(playerID); err != nil {
+				return nil, err
+			}
+		}
+		if sameText(player.InjuryStatus, entry.status) && sameText(player.Injury, entry.injury) {
+			result.Unchanged++
+			continue
+		}
+		result.Changes = append(result.Changes, newInjuryChange(report, player, entry.status, entry.injury))
+	}
+	// Players designated before but left off this report are cleared
+	for _, player := range designated {
+		if _, ok := current[player.ID]; ok {
+			result.Changes = append(result.Changes, newInjuryChange(report, player, nil, nil))
+		}
+	}
+
+	if len(result.Changes) == 0 {
+		return result, nil
+	}
+	if err := s.playerRepo.ApplyInjuryChanges(result.Changes); err != nil {
+		return nil, err
+	}
+	for _, change := range result.Changes {
+		if !sameText(change.PreviousStatus, change.Status) {
+			s.events.Publish(models.EventPlayerInjury, change)
+		}
+	}
+
+	return result, nil
+}
+
+// Sync fetches the injury report of the week of the next game from the provider and applies it.
+// Null entries, players without an ID at the provider, and those with a designation other than
+// out, doubtful or questionable are skipped. It returns nil when no game is coming up within a
+// week.
+func (s *injuryService) Sync(ctx context.Context) (*models.InjuryReportResult, error) {
+	if s.provider == nil {
+		return nil, fmt.Errorf("no injury feed is configured")
+	}
+
+	now := s.clock.Now()
+	games, err := s.gameRepo.GetUnfinished(now.Add(-liveWindow), now.Add(injuryWeekAhead))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming games: %w", err)
+	}
+	if len(games) == 0 {
+		return nil, nil
+	}
+	season, week := games[0].Season, games[0].Week
+
+	feed, err := s.provider.Report(ctx, season, week)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.InjuryReport{Season: season, Week: week, Players: []*models.InjuryReportEntry{}}
+	skipped := 0
+	listed := make(map[int]bool)
+	for _, entry := range feed.Players {
+		if entry == nil {
+			skipped++
+			continue
+		}
+		status := strings.ToLower(strings.TrimSpace(entry.Status))
+		if status != "" && !slices.Contains(models.InjuryStatuses, status) {
+			skipped++
+			continue
+		}
+		mapping, err := s.externalIDRepo.Lookup(entityTypePlayer, s.provider.Name(), entry.PlayerID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				skipped++
+				continue
+			}
+			return nil, fmt.Errorf("failed to look up player %s: %w", entry.PlayerID, err)
+		}
+		// A player the feed lists twice keeps the first line
+		if listed[mapping.EntityID] {
+			continue
+		}
+		listed[mapping.EntityID] = true
+		report.Players = append(report.Players, &models.InjuryReportEntry{
+			PlayerID: mapping.EntityID,
+			Status:   status,
+			Injury:   trimmedOrNil(&entry.Injury),
+		})
+	}
+
+	result, err := s.ApplyReport(report)
+	if err != nil {
+		return nil, err
+	}
+	result.Skipped = skipped
+	return result, nil
+}
+
+// Start syncs now and then every interval until Close
+func (s *injuryService) Start(interval time.Duration) {
+	stop := make(chan struct{})
+	s.stop = stop
+	s.done = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			syncCtx, cancelSync := context.WithTimeout(ctx, injurySyncTimeout)
+			result, err := s.Sync(syncCtx)
+			cancelSync()
+			switch {
+			case err != nil && ctx.Err() == nil:
+				log.Printf("Injury report sync failed: %v", err)
+			case result != nil && len(result.Changes) > 0:
+				log.Printf("Injury report for season %s week %d: %d changes", result.Season, result.Week, len(result.Changes))
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Close stops syncing
+func (s *injuryService) Close() error {
+	if s.stop != nil {
+		close(s.stop)
+		<-s.done
+		s.stop = nil
+	}
+	return nil
+}
+
+// injuryEntry is a validated report line
+type injuryEntry struct {
+	status *string
+	injury *string
+}
+
+// validateInjuryReport validates a report and returns its lines by player
+func validateInjuryReport(report *models.InjuryReport) (map[int]injuryEntry, error) {
+	report.Season = strings.TrimSpace(report.Season)
+	if report.Season == "" {
+		return nil, fmt.Errorf("season is required")
+	}
+	if report.Week < 1 || report.Week > 22 {
+		return nil, fmt.Errorf("week must be between 1 and 22, got %d", report.Week)
+	}
+	if report.Players == nil {
+		return nil, fmt.Errorf("players is required, and an empty list clears every designation")
+	}
+
+	entries := make(map[int]injuryEntry, len(report.Players))
+	for i, player := range report.Players {
+		if player == nil {
+			return nil, fmt.Errorf("player %d is null", i)
+		}
+		if player.PlayerID <= 0 {
+			return nil, fmt.Errorf("player %d: player ID is required and must be positive", i)
+		}
+		if _, ok := entries[player.PlayerID]; ok {
+			return nil, fmt.Errorf("player %d: player %d is listed more than once", i, player.PlayerID)
+		}
+		status := strings.ToLower(strings.TrimSpace(player.Status))
+		if status != "" {
+			if err := validateOneOf("status", status, models.InjuryStatuses); err != nil {
+				return nil, fmt.Errorf("player %d: %w", i, err)
+			}
+		}
+		// The injury is only kept with a designation
+		entry := injuryEntry{status: trimmedOrNil(&status)}
+		if entry.status != nil {
+			entry.injury = trimmedOrNil(player.Injury)
+		}
+		entries[player.PlayerID] = entry
+	}
+	return entries, nil
+}
+
+// newInjuryChange describes a player's line changing to status and injury
+func newInjuryChange(report *models.InjuryReport, player *models.Player, status, injury *string) *models.InjuryChange {
+	return &models.InjuryChange{
+		PlayerID:       player.ID,
+		PlayerName:     player.FirstName + " " + player.LastName,
+		TeamID:         player.TeamID,
+		Season:         report.Season,
+		Week:           report.Week,
+		PreviousStatus: player.InjuryStatus,
+		Status:         status,
+		Injury:         injury,
+	}
+}
+
+// sameText reports whether two optional strings are equal
+func sameText(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
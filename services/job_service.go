@@ -0,0 +1,378 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/job_service.go -pkg mocks . JobService
+
+const (
+	// jobPollInterval is how often idle workers look for jobs that became due, such as retries
+	jobPollInterval = time.Second
+	// jobRetryDelay is the wait before the first retry; each further retry waits twice as long
+	jobRetryDelay = 10 * time.Second
+	// maxJobRetryDelay caps the wait between retries
+	maxJobRetryDelay = 10 * time.Minute
+)
+
+// JobFunc runs one attempt of a job. It should stop when ctx is cancelled, which happens when
+// the job is cancelled or the server shuts down. An error fails the attempt; errors containing
+// "validation failed" fail the job without retrying.
+type JobFunc func(ctx context.Context, run *JobRun) error
+
+// JobService defines the interface for queueing and running background jobs
+type JobService interface {
+	Register(kind string, maxAttempts int, fn JobFunc)
+	Submit(kind string, payload interface{}, total int) (*models.Job, error)
+	GetJob(id int) (*models.Job, error)
+	ListJobs(status, kind string, limit int) ([]*models.Job, error)
+	CancelJob(id int) (*models.Job, error)
+	Start() error
+	Close() error
+}
+
+// jobService keeps jobs in the jobs table, so they survive restarts, and runs them on a fixed
+// pool of workers. The queue is bounded: when queueSize jobs are waiting, submissions are
+// refused with models.ErrQueueFull rather than piling up.
+type jobService struct {
+	jobRepo   repositories.JobRepository
+	workers   int
+	queueSize int
+	kinds     map[string]*jobKind
+	clock     clock.Clock
+
+	ctx  context.Context // cancelled on Close
+	stop context.CancelFunc
+	wake chan struct{}
+	wg   sync.WaitGroup
+
+	mu      sync.Mutex
+	running map[int]context.CancelFunc // by job ID
+}
+
+// jobKind is a registered kind of job
+type jobKind struct {
+	maxAttempts int
+	fn          JobFunc
+}
+
+// NewJobService creates a new job service with the given number of workers and room for
+// queueSize jobs waiting to run. Workers start with Start, once every kind is registered.
+func NewJobService(jobRepo repositories.JobRepository, workers, queueSize int, clock clock.Clock) JobService {
+	ctx, stop := context.WithCancel(context.Background())
+	return &jobService{
+		jobRepo:   jobRepo,
+		workers:   workers,
+		queueSize: queueSize,
+		kinds:     make(map[string]*jobKind),
+		clock:     clock,
+		ctx:       ctx,
+		stop:      stop,
+		wake:      make(chan struct{}, 1),
+		running:   make(map[int]context.CancelFunc),
+	}
+}
+
+// Register sets the function that runs jobs of a kind and how many attempts they get.
+// Kinds must be registered before Start.
+func (s *jobService) Register(kind string, maxAttempts int, fn JobFunc) {
+	s.kinds[kind] = &jobKind{maxAttempts: maxAttempts, fn: fn}
+}
+
+// Submit queues a job of a registered kind. The payload is stored as JSON and handed to the
+// job through JobRun.Payload; total is the number of records it covers, for progress.
+func (s *jobService) Submit(kind string, payload interface{}, total int) (*models.Job, error) {
+	registered, ok := s.kinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("validation failed: unknown job kind %q", kind)
+	}
+
+	queued, err := s.jobRepo.CountQueued()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the job queue: %w", err)
+	}
+	if queued >= s.queueSize {
+		return nil, models.ErrQueueFull
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode job payload: %w", err)
+	}
+
+	now := s.clock.Now().UTC()
+	job := &models.Job{
+		Kind:        kind,
+		Status:      models.JobQueued,
+		Payload:     encoded,
+		Total:       total,
+		Errors:      []*models.JobError{},
+		MaxAttempts: registered.maxAttempts,
+		RunAfter:    now,
+		CreatedAt:   now,
+	}
+	if err := s.jobRepo.Create(job); err != nil {
+		return nil, fmt.Errorf("failed to queue job: %w", err)
+	}
+
+	// Wake an idle worker rather than waiting for its next poll
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+
+	return job, nil
+}
+
+// GetJob retrieves a job by ID
+func (s *jobService) GetJob(id int) (*models.Job, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid job ID: %d", id)
+	}
+
+	job, err := s.jobRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	return job, nil
+}
+
+// ListJobs retrieves up to limit jobs, newest first, optionally only those with a status or kind
+func (s *jobService) ListJobs(status, kind string, limit int) ([]*models.Job, error) {
+	if limit < 1 || limit > maxPageSize {
+		return nil, fmt.Errorf("validation failed: limit must be between 1 and %d", maxPageSize)
+	}
+	status = strings.ToLower(strings.TrimSpace(status))
+	if status != "" {
+		if err := validateOneOf("status", status, models.JobStatuses); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+	}
+
+	jobs, err := s.jobRepo.List(status, strings.TrimSpace(kind), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	if jobs == nil {
+		jobs = []*models.Job{}
+	}
+
+	return jobs, nil
+}
+
+// CancelJob cancels a queued job at once, or stops a running one after its current batch
+func (s *jobService) CancelJob(id int) (*models.Job, error) {
+	job, err := s.GetJob(id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Finished() {
+		return nil, fmt.Errorf("validation failed: job %d is already %s", id, job.Status)
+	}
+
+	if err := s.jobRepo.RequestCancel(id, s.clock.Now().UTC()); err != nil {
+		return nil, fmt.Errorf("failed to cancel job: %w", err)
+	}
+
+	s.mu.Lock()
+	if cancel, ok := s.running[id]; ok {
+		cancel()
+	}
+	s.mu.Unlock()
+
+	return s.GetJob(id)
+}
+
+// Start requeues the jobs a previous process left running and starts the workers
+func (s *jobService) Start() error {
+	requeued, failed, err := s.jobRepo.RequeueRunning(s.clock.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if requeued > 0 {
+		log.Printf("Requeued %d jobs interrupted by the last shutdown", requeued)
+	}
+	if failed > 0 {
+		log.Printf("Failed %d jobs interrupted by the last shutdown on their last attempt", failed)
+	}
+
+	for i := 0; i < s.workers; i++ {
+		s.wg.Add(1)
+		go s.work()
+	}
+	return nil
+}
+
+// Close stops the workers. Running jobs are interrupted and go back to the queue, to resume
+// on the next start.
+func (s *jobService) Close() error {
+	s.stop()
+	s.wg.Wait()
+	return nil
+}
+
+// work runs due jobs until the service is closed
+func (s *jobService) work() {
+	defer s.wg.Done()
+	for s.ctx.Err() == nil {
+		job, err := s.jobRepo.ClaimNext(s.clock.Now().UTC())
+		if err != nil {
+			log.Printf("Failed to claim a job: %v", err)
+		}
+		if job != nil {
+			s.execute(job)
+			continue
+		}
+
+		select {
+		case <-s.ctx.Done():
+		case <-s.wake:
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
+
+// execute runs one attempt of a claimed job and records how it ended
+func (s *jobService) execute(job *models.Job) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	s.mu.Lock()
+	s.running[job.ID] = cancel
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, job.ID)
+		s.mu.Unlock()
+	}()
+	if job.CancelRequested {
+		cancel()
+	}
+
+	var err error
+	panicked := false
+	if kind, ok := s.kinds[job.Kind]; ok {
+		panicked, err = runJob(ctx, kind.fn, &JobRun{Job: job, jobRepo: s.jobRepo, cancel: cancel})
+	} else {
+		err = fmt.Errorf("validation failed: no worker runs %q jobs", job.Kind)
+	}
+
+	now := s.clock.Now().UTC()
+	switch {
+	case panicked:
+		// A panic is a bug, not a passing fault: retrying it would only panic again
+		job.Status = models.JobFailed
+		job.LastError = err.Error()
+		job.FinishedAt = &now
+	case err == nil:
+		job.Status = models.JobDone
+		job.LastError = ""
+		job.FinishedAt = &now
+	case s.cancelRequested(job.ID):
+		job.Status = models.JobCancelled
+		job.FinishedAt = &now
+	case s.ctx.Err() != nil:
+		// Shutting down: the attempt was interrupted, not failed
+		job.Status = models.JobQueued
+		job.Attempts--
+		job.RunAfter = now
+	case strings.Contains(err.Error(), "validation failed") || job.Attempts >= job.MaxAttempts:
+		job.Status = models.JobFailed
+		job.LastError = err.Error()
+		job.FinishedAt = &now
+		log.Printf("Job %d (%s) failed after %d attempts: %v", job.ID, job.Kind, job.Attempts, err)
+	default:
+		job.Status = models.JobQueued
+		job.LastError = err.Error()
+		job.RunAfter = now.Add(jobBackoff(job.Attempts))
+		log.Printf("Job %d (%s) attempt %d failed, retrying at %s: %v", job.ID, job.Kind, job.Attempts, job.RunAfter.Format(time.RFC3339), err)
+	}
+
+	if err := s.jobRepo.Update(job); err != nil {
+		log.Printf("Failed to record the end of job %d: %v", job.ID, err)
+	}
+}
+
+// runJob runs one attempt of a job, turning a panic into an error so a bad job fails on its
+// own instead of taking down the process
+func runJob(ctx context.Context, fn JobFunc, run *JobRun) (panicked bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Job %d (%s) panicked: %v\n%s", run.Job.ID, run.Job.Kind, r, debug.Stack())
+			panicked, err = true, fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return false, fn(ctx, run)
+}
+
+// cancelRequested reports whether a job has been asked to cancel
+func (s *jobService) cancelRequested(id int) bool {
+	job, err := s.jobRepo.GetByID(id)
+	return err == nil && job.CancelRequested
+}
+
+// jobBackoff is the wait before the retry that follows the given attempt
+func jobBackoff(attempt int) time.Duration {
+	delay := jobRetryDelay
+	for i := 1; i < attempt && delay < maxJobRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxJobRetryDelay)
+}
+
+// JobRun is the running attempt of a job, handed to its JobFunc
+type JobRun struct {
+	Job *models.Job
+
+	jobRepo repositories.JobRepository
+	cancel  context.CancelFunc
+}
+
+// Payload decodes the job's payload into v
+func (r *JobRun) Payload(v interface{}) error {
+	if err := json.Unmarshal(r.Job.Payload, v); err != nil {
+		return fmt.Errorf("validation failed: invalid job payload: %v", err)
+	}
+	return nil
+}
+
+// Result decodes the result saved by earlier progress into v, leaving v alone when there is none
+func (r *JobRun) Result(v interface{}) error {
+	if len(r.Job.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r.Job.Result, v)
+}
+
+// Progress saves how many records are processed, the result so far and the batches that
+// failed, so a retry can resume from there. It cancels the run's context if the job has been
+// asked to cancel.
+func (r *JobRun) Progress(processed int, result interface{}, jobErrors []*models.JobError) error {
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode job result: %w", err)
+	}
+	r.Job.Processed = processed
+	r.Job.Result = encoded
+	r.Job.Errors = jobErrors
+
+	cancelRequested, err := r.jobRepo.SaveProgress(r.Job)
+	if err != nil {
+		return err
+	}
+	if cancelRequested {
+		r.cancel()
+	}
+	return nil
+}
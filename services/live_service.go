@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/live"
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/live_service.go -pkg mocks . LiveService
+
+const (
+	// liveLead is how long before kickoff a game starts being polled, in case it starts early
+	liveLead = 10 * time.Minute
+	// liveWindow is how long after kickoff a game is polled until the provider reports it
+	// completed, so a game the provider never finishes isn't polled forever
+	liveWindow = 6 * time.Hour
+	// livePollTimeout bounds polling one game
+	livePollTimeout = 30 * time.Second
+)
+
+// liveStatuses are the game statuses a provider can report
+var liveStatuses = []string{"scheduled", "in_progress", "completed"}
+
+// LiveService defines the interface for following games in progress at a live data provider
+type LiveService interface {
+	Poll(ctx context.Context) error
+	Start(interval time.Duration)
+	Close() error
+}
+
+// liveService polls the providers for the games in their window and applies what changed
+type liveService struct {
+	providers       []live.Provider
+	gameRepo        repositories.GameRepository
+	externalIDRepo  repositories.ExternalIDRepository
+	playerStatsRepo repositories.PlayerStatsRepository
+	playerRepo      repositories.PlayerRepository
+	sportRepo       repositories.SportRepository
+	conflictRepo    repositories.StatConflictRepository
+	statProfiles    map[string]*models.StatProfile
+	precedence      *StatPrecedence
+	events          EventService
+	clock           clock.Clock
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewLiveService creates a new live service reading games from providers, in the order they
+// are failed over to, and writing stats under precedence once they pass the checks manual
+// writes do
+func NewLiveService(providers []live.Provider, gameRepo repositories.GameRepository, externalIDRepo repositories.ExternalIDRepository, playerStatsRepo repositories.PlayerStatsRepository, playerRepo repositories.PlayerRepository, sportRepo repositories.SportRepository, conflictRepo repositories.StatConflictRepository, statProfiles map[string]*models.StatProfile, precedence *StatPrecedence, events EventService, clock clock.Clock) LiveService {
+	return &liveService{
+		providers:       providers,
+		gameRepo:        gameRepo,
+		externalIDRepo:  externalIDRepo,
+		playerStatsRepo: playerStatsRepo,
+		playerRepo:      playerRepo,
+		sportRepo:       sportRepo,
+		conflictRepo:    conflictRepo,
+		statProfiles:    statProfiles,
+		precedence:      precedence,
+		events:          events,
+		clock:           clock,
+	}
+}
+
+// Start polls every interval until Close. A poll in progress is interrupted by Close rather
+// than waited out.
+func (s *liveService) Start(interval time.Duration) {
+	stop := make(chan struct{})
+	s.stop = stop
+	s.done = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.Poll(ctx); err != nil && ctx.Err() == nil {
+					log.Printf("Live polling failed: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Close stops polling
+func (s *liveService) Close() error {
+	if s.stop != nil {
+		close(s.stop)
+		<-s.done
+		s.stop = nil
+	}
+	return nil
+}
+
+// Poll reads every game in its window that has an ID at a provider, from shortly before
+// kickoff until it is completed, and applies the changes. A game that fails is logged and
+// tried again on the next poll.
+func (s *liveService) Poll(ctx context.Context) error {
+	now := s.clock.Now()
+	games, err := s.gameRepo.GetUnfinished(now.Add(-liveWindow), now.Add(liveLead))
+	if err != nil {
+		return fmt.Errorf("failed to get games in progress: %w", err)
+	}
+
+	for _, game := range games {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		gameCtx, cancel := context.WithTimeout(ctx, livePollTimeout)
+		err := s.pollGame(gameCtx, game)
+		cancel()
+		if err != nil {
+			log.Printf("Live polling of game %d failed: %v", game.ID, err)
+		}
+	}
+	return nil
+}
+
+// pollGame reads one game from the first provider that has an ID for it and answers, failing
+// over to the next when one fails, and applies its stats, then its score and status, so the
+// final stats are in place by the time the game is completed
+func (s *liveService) pollGame(ctx context.Context, game *models.Game) error {
+	mappings, err := s.externalIDRepo.GetByEntity(entityTypeGame, game.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get game external IDs: %w", err)
+	}
+	providerIDs := make(map[string]string, len(mappings))
+	for _, mapping := range mappings {
+		providerIDs[mapping.Provider] = mapping.ExternalID
+	}
+
+	var failed error
+	for _, provider := range s.providers {
+		providerID, ok := providerIDs[provider.Name()]
+		if !ok {
+			continue
+		}
+		if failed != nil {
+			log.Printf("Live polling of game %d failed over to %s: %v", game.ID, provider.Name(), failed)
+		}
+
+		state, err := provider.Game(ctx, providerID)
+		if err == nil {
+			err = validateOneOf("status", state.Status, liveStatuses)
+		}
+		if err != nil {
+			failed = fmt.Errorf("%s game %s: %w", provider.Name(), providerID, err)
+			continue
+		}
+
+		if err := s.applyStats(game, provider.Name(), state.Players); err != nil {
+			return err
+		}
+		return s.applyScore(game, state)
+	}
+	return failed
+}
+
+// applyStats brings the game's stat lines up to the provider's totals. Only lines that differ
+// are written, so applying the same totals again writes nothing and publishes nothing. Stats the
+// provider doesn't report are left as they are, stats set by a source ranked above the provider
+// are kept and differing totals for them recorded as conflicts, and players without an ID at the
+// provider are skipped. A line that fails the sport's rules or the position's stat profile once
+// the provider's totals are merged in is logged and left as it was.
+func (s *liveService) applyStats(game *models.Game, source string, players []*live.PlayerStats) error {
+	if len(players) == 0 {
+		return nil
+	}
+
+	statsList, err := s.playerStatsRepo.GetByGameID(game.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get player stats by game: %w", err)
+	}
+	existing := make(map[int]*models.PlayerStats, len(statsList))
+	for _, stats := range statsList {
+		existing[stats.PlayerID] = stats
+	}
+
+	var changed []*models.PlayerStats
+	var deltas []*models.LiveStatsDelta
+	var conflicts []*models.StatConflict
+	var existingIDs []int
+	for _, player := range players {
+		if player == nil {
+			continue
+		}
+		mapping, err := s.externalIDRepo.Lookup(entityTypePlayer, source, player.PlayerID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				continue
+			}
+			return fmt.Errorf("failed to look up player %s: %w", player.PlayerID, err)
+		}
+
+		stats := &models.PlayerStats{PlayerID: mapping.EntityID, GameID: game.ID}
+		before := map[string]float64{}
+		if current, ok := existing[mapping.EntityID]; ok {
+			copied := *current
+			copied.Sources = maps.Clone(current.Sources)
+			stats = &copied
+			before = statValues(current)
+			existingIDs = append(existingIDs, current.ID)
+		}
+		lineChanged, lineConflicts := s.precedence.merge(stats, player.Stats, source)
+		conflicts = append(conflicts, lineConflicts...)
+		if !lineChanged {
+			continue
+		}
+		if err := s.validateStats(stats); err != nil {
+			if !strings.Contains(err.Error(), "validation failed") {
+				return err
+			}
+			log.Printf("Skipped %s stats for player %d in game %d: %v", source, stats.PlayerID, game.ID, err)
+			continue
+		}
+
+		// A line whose stats only changed source is written without a delta
+		changed = append(changed, stats)
+		if delta := statDelta(before, statValues(stats)); len(delta) > 0 {
+			deltas = append(deltas, &models.LiveStatsDelta{PlayerID: stats.PlayerID, Delta: delta})
+		}
+	}
+
+	if len(changed) > 0 {
+		if _, err := s.playerStatsRepo.UpsertMany(changed); err != nil {
+			return fmt.Errorf("failed to save live stats: %w", err)
+		}
+	}
+	if err := recordStatConflicts(s.conflictRepo, source, existingIDs, conflicts); err != nil {
+		return err
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+	statsIDs := make(map[int]int, len(changed))
+	for _, stats := range changed {
+		statsIDs[stats.PlayerID] = stats.ID
+	}
+	for _, delta := range deltas {
+		delta.StatsID = statsIDs[delta.PlayerID]
+	}
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i].PlayerID < deltas[j].PlayerID })
+
+	s.events.Publish(models.EventGameStats, &models.LiveStatsUpdate{GameID: game.ID, Players: deltas})
+	return nil
+}
+
+// validateStats checks a merged stat line as a manual write is checked: against the rules of the
+// player's sport and the stat profile of the player's position. The provider can't confirm an
+// implausible line, so profile problems reject it rather than flagging it.
+func (s *liveService) validateStats(stats *models.PlayerStats) error {
+	player, err := s.playerRepo.GetByID(stats.PlayerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player %d: %w", stats.PlayerID, err)
+	}
+	sport, err := s.sportRepo.GetByPlayerID(stats.PlayerID)
+	if err != nil {
+		return fmt.Errorf("failed to get the sport of player %d: %w", stats.PlayerID, err)
+	}
+
+	if err := validateStatLine(sport, statValues(stats)); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if problems := checkStatProfile(s.statProfiles, player.Position, stats); len(problems) > 0 {
+		return fmt.Errorf("validation failed: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// applyScore updates the game's score and status when the provider's differ
+func (s *liveService) applyScore(game *models.Game, state *live.Game) error {
+	homeScore, awayScore := game.HomeScore, game.AwayScore
+	if state.HomeScore != nil {
+		homeScore = state.HomeScore
+	}
+	if state.AwayScore != nil {
+		awayScore = state.AwayScore
+	}
+	if state.Status == game.Status && sameScore(homeScore, game.HomeScore) && sameScore(awayScore, game.AwayScore) {
+		return nil
+	}
+
+	update := &models.LiveScoreUpdate{
+		GameID:            game.ID,
+		HomeTeamID:        game.HomeTeamID,
+		AwayTeamID:        game.AwayTeamID,
+		Status:            state.Status,
+		HomeScore:         homeScore,
+		AwayScore:         awayScore,
+		PreviousStatus:    game.Status,
+		PreviousHomeScore: game.HomeScore,
+		PreviousAwayScore: game.AwayScore,
+	}
+	game.Status, game.HomeScore, game.AwayScore = state.Status, homeScore, awayScore
+	if err := s.gameRepo.Update(game); err != nil {
+		return fmt.Errorf("failed to save live score: %w", err)
+	}
+
+	s.events.Publish(models.EventGameScore, update)
+	return nil
+}
+
+// statDelta returns how each stat changed from before to after, leaving out unchanged stats
+func statDelta(before, after map[string]float64) map[string]float64 {
+	delta := make(map[string]float64)
+	for name, value := range after {
+		if value != before[name] {
+			delta[name] = value - before[name]
+		}
+	}
+	return delta
+}
+
+// sameScore reports whether two optional scores are equal
+func sameScore(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories"
+	"sports-backend/storage"
+)
+
+//go:generate moq -out mocks/media_service.go -pkg mocks . MediaService
+
+const (
+	// Logos are fitted onto a transparent square, so they line up in standings and schedules
+	logoSize = 256
+	// Headshots are cropped to a 3:4 portrait
+	headshotWidth   = 300
+	headshotHeight  = 400
+	headshotQuality = 85
+)
+
+// MediaService defines the interface for uploading and serving team logos and player headshots
+type MediaService interface {
+	UploadTeamLogo(teamID int, data []byte, contentType string) (*models.Team, error)
+	GetTeamLogo(teamID int) (io.ReadCloser, string, error)
+	DeleteTeamLogo(teamID int) error
+	UploadPlayerHeadshot(playerID int, data []byte, contentType string) (*models.Player, error)
+	GetPlayerHeadshot(playerID int) (io.ReadCloser, string, error)
+	DeletePlayerHeadshot(playerID int) error
+}
+
+// mediaService resizes uploads to standard dimensions and keeps them in storage, recording the
+// URL they are served from on the team or player
+type mediaService struct {
+	teamRepo   repositories.TeamRepository
+	playerRepo repositories.PlayerRepository
+	store      storage.Storage
+	clock      clock.Clock
+}
+
+// NewMediaService creates a new media service
+func NewMediaService(teamRepo repositories.TeamRepository, playerRepo repositories.PlayerRepository, store storage.Storage, clock clock.Clock) MediaService {
+	return &mediaService{
+		teamRepo:   teamRepo,
+		playerRepo: playerRepo,
+		store:      store,
+		clock:      clock,
+	}
+}
+
+// UploadTeamLogo resizes an uploaded logo to a logoSize PNG square and sets it as the team's logo
+func (s *mediaService) UploadTeamLogo(teamID int, data []byte, contentType string) (*models.Team, error) {
+	if teamID <= 0 {
+		return nil, fmt.Errorf("invalid team ID: %d", teamID)
+	}
+	team, err := s.teamRepo.GetByID(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	img, err := decodeUpload(data, contentType)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := encodePNG(fitImage(img, logoSize, logoSize))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(teamLogoKey(teamID), bytes.NewReader(encoded), int64(len(encoded)), "image/png"); err != nil {
+		return nil, fmt.Errorf("failed to store logo: %w", err)
+	}
+
+	team.LogoURL = s.mediaURL(fmt.Sprintf("/api/teams/%d/logo", teamID))
+	if err := s.teamRepo.Update(team); err != nil {
+		return nil, fmt.Errorf("failed to update team: %w", err)
+	}
+	return team, nil
+}
+
+// GetTeamLogo opens a team's logo and returns it with its content type; the caller must close it
+func (s *mediaService) GetTeamLogo(teamID int) (io.ReadCloser, string, error) {
+	if teamID <= 0 {
+		return nil, "", fmt.Errorf("invalid team ID: %d", teamID)
+	}
+	team, err := s.teamRepo.GetByID(teamID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get team: %w", err)
+	}
+	if team.LogoURL == nil {
+		return nil, "", fmt.Errorf("logo for team with ID %d not found", teamID)
+	}
+
+	body, err := s.open(teamLogoKey(teamID), fmt.Sprintf("logo for team with ID %d", teamID))
+	return body, "image/png", err
+}
+
+// DeleteTeamLogo removes a team's logo
+func (s *mediaService) DeleteTeamLogo(teamID int) error {
+	if teamID <= 0 {
+		return fmt.Errorf("invalid team ID: %d", teamID)
+	}
+	team, err := s.teamRepo.GetByID(teamID)
+	if err != nil {
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+	if team.LogoURL == nil {
+		return fmt.Errorf("logo for team with ID %d not found", teamID)
+	}
+
+	team.LogoURL = nil
+	if err := s.teamRepo.Update(team); err != nil {
+		return fmt.Errorf("failed to update team: %w", err)
+	}
+	if err := s.store.Delete(teamLogoKey(teamID)); err != nil {
+		return fmt.Errorf("failed to delete logo: %w", err)
+	}
+	return nil
+}
+
+// UploadPlayerHeadshot crops and resizes an uploaded photo to a headshot JPEG and sets it as
+// the player's headshot, replacing any headshot URL set from a provider
+func (s *mediaService) UploadPlayerHeadshot(playerID int, data []byte, contentType string) (*models.Player, error) {
+	if playerID <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", playerID)
+	}
+	player, err := s.playerRepo.GetByID(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+
+	img, err := decodeUpload(data, contentType)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := encodeJPEG(fillImage(img, headshotWidth, headshotHeight), headshotQuality)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(playerHeadshotKey(playerID), bytes.NewReader(encoded), int64(len(encoded)), "image/jpeg"); err != nil {
+		return nil, fmt.Errorf("failed to store headshot: %w", err)
+	}
+
+	player.HeadshotURL = s.mediaURL(fmt.Sprintf("/api/players/%d/headshot", playerID))
+	if err := s.playerRepo.Update(player); err != nil {
+		return nil, fmt.Errorf("failed to update player: %w", err)
+	}
+	return player, nil
+}
+
+// GetPlayerHeadshot opens a player's uploaded headshot and returns it with its content type;
+// the caller must close it. Headshots linked from a provider are not served here.
+func (s *mediaService) GetPlayerHeadshot(playerID int) (io.ReadCloser, string, error) {
+	if playerID <= 0 {
+		return nil, "", fmt.Errorf("invalid player ID: %d", playerID)
+	}
+	if _, err := s.playerRepo.GetByID(playerID); err != nil {
+		return nil, "", fmt.Errorf("failed to get player: %w", err)
+	}
+
+	body, err := s.open(playerHeadshotKey(playerID), fmt.Sprintf("headshot for player with ID %d", playerID))
+	return body, "image/jpeg", err
+}
+
+// DeletePlayerHeadshot removes a player's uploaded headshot and clears their headshot URL
+func (s *mediaService) DeletePlayerHeadshot(playerID int) error {
+	if playerID <= 0 {
+		return fmt.Errorf("invalid player ID: %d", playerID)
+	}
+	player, err := s.playerRepo.GetByID(playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player: %w", err)
+	}
+
+	body, err := s.open(playerHeadshotKey(playerID), fmt.Sprintf("headshot for player with ID %d", playerID))
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	player.HeadshotURL = nil
+	if err := s.playerRepo.Update(player); err != nil {
+		return fmt.Errorf("failed to update player: %w", err)
+	}
+	if err := s.store.Delete(playerHeadshotKey(playerID)); err != nil {
+		return fmt.Errorf("failed to delete headshot: %w", err)
+	}
+	return nil
+}
+
+// open opens a stored image, reporting a missing one as what not found
+func (s *mediaService) open(key, what string) (io.ReadCloser, error) {
+	body, err := s.store.Get(key)
+	if err == storage.ErrNotFound {
+		return nil, fmt.Errorf("%s not found", what)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", what, err)
+	}
+	return body, nil
+}
+
+// mediaURL versions the path an image is served from with the upload time, so clients and
+// caches fetch a replaced image instead of reusing the old one
+func (s *mediaService) mediaURL(path string) *string {
+	url := fmt.Sprintf("%s?v=%d", path, s.clock.Now().Unix())
+	return &url
+}
+
+// teamLogoKey is where a team's logo is kept in storage
+func teamLogoKey(teamID int) string {
+	return fmt.Sprintf("logos/team-%d.png", teamID)
+}
+
+// playerHeadshotKey is where a player's uploaded headshot is kept in storage
+func playerHeadshotKey(playerID int) string {
+	return fmt.Sprintf("headshots/player-%d.jpg", playerID)
+}
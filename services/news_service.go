@@ -0,0 +1,235 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/news_service.go -pkg mocks . NewsService
+
+// NewsService defines the interface for news item business logic
+type NewsService interface {
+	ImportNews(reqs []*models.CreateNewsItemRequest) (*models.ImportResult, error)
+	GetNewsItem(id int) (*models.NewsItem, error)
+	ListNews(since *time.Time, limit int) ([]*models.NewsItem, error)
+	GetPlayerNews(playerID int, since *time.Time, limit int) ([]*models.NewsItem, error)
+	GetTeamNews(teamID int, since *time.Time, limit int) ([]*models.NewsItem, error)
+	DeleteNewsItem(id int) error
+}
+
+// maxHeadlineLength caps a news item's headline, in characters
+const maxHeadlineLength = 300
+
+// newsService implements NewsService interface
+type newsService struct {
+	newsRepo   repositories.NewsRepository
+	playerRepo repositories.PlayerRepository
+	teamRepo   repositories.TeamRepository
+}
+
+// NewNewsService creates a new news service
+func NewNewsService(newsRepo repositories.NewsRepository, playerRepo repositories.PlayerRepository, teamRepo repositories.TeamRepository) NewsService {
+	return &newsService{
+		newsRepo:   newsRepo,
+		playerRepo: playerRepo,
+		teamRepo:   teamRepo,
+	}
+}
+
+// ImportNews validates and writes a batch of news items, replacing those already ingested
+// from the same source URL. Nothing is written unless every item is valid.
+func (s *newsService) ImportNews(reqs []*models.CreateNewsItemRequest) (*models.ImportResult, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("validation failed: at least one news item must be provided")
+	}
+
+	items := make([]*models.NewsItem, 0, len(reqs))
+	sourceURLs := make(map[string]int, len(reqs))
+	for i, req := range reqs {
+		if req == nil {
+			return nil, fmt.Errorf("validation failed: news item %d is null", i)
+		}
+		item, err := newNewsItemFromRequest(req)
+		if err != nil {
+			return nil, fmt.Errorf("validation failed: news item %d: %w", i, err)
+		}
+		if first, ok := sourceURLs[item.SourceURL]; ok {
+			return nil, fmt.Errorf("validation failed: news item %d: source URL is the same as news item %d", i, first)
+		}
+		sourceURLs[item.SourceURL] = i
+		items = append(items, item)
+	}
+
+	if err := s.checkLinks(items); err != nil {
+		return nil, err
+	}
+
+	created, err := s.newsRepo.UpsertMany(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import news: %w", err)
+	}
+
+	return &models.ImportResult{Created: created, Updated: len(items) - created}, nil
+}
+
+// GetNewsItem retrieves a news item
+func (s *newsService) GetNewsItem(id int) (*models.NewsItem, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid news item ID: %d", id)
+	}
+	return s.newsRepo.GetByID(id)
+}
+
+// ListNews retrieves up to limit news items, most recently published first, optionally only
+// those published at or after since
+func (s *newsService) ListNews(since *time.Time, limit int) ([]*models.NewsItem, error) {
+	if err := validateNewsLimit(limit); err != nil {
+		return nil, err
+	}
+	return s.newsRepo.List(0, 0, since, limit)
+}
+
+// GetPlayerNews retrieves up to limit news items linked to a player, most recently published first
+func (s *newsService) GetPlayerNews(playerID int, since *time.Time, limit int) ([]*models.NewsItem, error) {
+	if playerID <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", playerID)
+	}
+	if err := validateNewsLimit(limit); err != nil {
+		return nil, err
+	}
+	exists, err := s.playerRepo.Exists(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check player existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("player with ID %d not found", playerID)
+	}
+
+	return s.newsRepo.List(playerID, 0, since, limit)
+}
+
+// GetTeamNews retrieves up to limit news items linked to a team, most recently published first
+func (s *newsService) GetTeamNews(teamID int, since *time.Time, limit int) ([]*models.NewsItem, error) {
+	if teamID <= 0 {
+		return nil, fmt.Errorf("invalid team ID: %d", teamID)
+	}
+	if err := validateNewsLimit(limit); err != nil {
+		return nil, err
+	}
+	exists, err := s.teamRepo.Exists(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("team with ID %d not found", teamID)
+	}
+
+	return s.newsRepo.List(0, teamID, since, limit)
+}
+
+// DeleteNewsItem deletes a news item and its links
+func (s *newsService) DeleteNewsItem(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid news item ID: %d", id)
+	}
+	return s.newsRepo.Delete(id)
+}
+
+// checkLinks verifies in one batched lookup per kind that every player and team the items link
+// to exists, reporting the first item with a missing one
+func (s *newsService) checkLinks(items []*models.NewsItem) error {
+	var playerIDs, teamIDs []int
+	for _, item := range items {
+		playerIDs = append(playerIDs, item.PlayerIDs...)
+		teamIDs = append(teamIDs, item.TeamIDs...)
+	}
+
+	players, err := s.playerRepo.ExistsMany(playerIDs)
+	if err != nil {
+		return fmt.Errorf("failed to check player existence: %w", err)
+	}
+	teams, err := s.teamRepo.ExistsMany(teamIDs)
+	if err != nil {
+		return fmt.Errorf("failed to check team existence: %w", err)
+	}
+
+	for i, item := range items {
+		for _, id := range item.PlayerIDs {
+			if !players[id] {
+				return fmt.Errorf("validation failed: news item %d: player with ID %d not found", i, id)
+			}
+		}
+		for _, id := range item.TeamIDs {
+			if !teams[id] {
+				return fmt.Errorf("validation failed: news item %d: team with ID %d not found", i, id)
+			}
+		}
+	}
+	return nil
+}
+
+// newNewsItemFromRequest validates a news item and normalizes its fields and links
+func newNewsItemFromRequest(req *models.CreateNewsItemRequest) (*models.NewsItem, error) {
+	headline := strings.TrimSpace(req.Headline)
+	if headline == "" {
+		return nil, fmt.Errorf("headline is required")
+	}
+	if len([]rune(headline)) > maxHeadlineLength {
+		return nil, fmt.Errorf("headline must be at most %d characters", maxHeadlineLength)
+	}
+
+	sourceURL := strings.TrimSpace(req.SourceURL)
+	parsed, err := url.Parse(sourceURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("source URL must be an absolute http or https URL")
+	}
+
+	if req.PublishedAt == nil || req.PublishedAt.IsZero() {
+		return nil, fmt.Errorf("published_at is required")
+	}
+
+	playerIDs, err := newsLinkIDs("player", req.PlayerIDs)
+	if err != nil {
+		return nil, err
+	}
+	teamIDs, err := newsLinkIDs("team", req.TeamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NewsItem{
+		Headline:    headline,
+		Body:        trimmedOrNil(req.Body),
+		SourceURL:   sourceURL,
+		PublishedAt: *req.PublishedAt,
+		PlayerIDs:   playerIDs,
+		TeamIDs:     teamIDs,
+	}, nil
+}
+
+// newsLinkIDs checks the linked IDs of one kind and returns them sorted without repeats
+func newsLinkIDs(kind string, ids []int) ([]int, error) {
+	linked := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("%s IDs must be positive, got %d", kind, id)
+		}
+		linked = append(linked, id)
+	}
+	slices.Sort(linked)
+	return slices.Compact(linked), nil
+}
+
+// validateNewsLimit checks the page size of a news listing
+func validateNewsLimit(limit int) error {
+	if limit < 1 || limit > maxPageSize {
+		return fmt.Errorf("validation failed: limit must be between 1 and %d", maxPageSize)
+	}
+	return nil
+}
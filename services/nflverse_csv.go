@@ -0,0 +1,340 @@
+package services
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+)
+
+// nflverseWeeklyColumns maps each player stats field to the columns of an nflverse weekly player
+// stats file it is the sum of. Older and newer releases name some columns differently, and a
+// file missing all of a field's columns leaves the field out.
+var nflverseWeeklyColumns = map[string][]string{
+	"passing_attempts":        {"attempts"},
+	"passing_completions":     {"completions"},
+	"passing_yards":           {"passing_yards"},
+	"passing_touchdowns":      {"passing_tds"},
+	"passing_interceptions":   {"interceptions", "passing_interceptions"},
+	"rushing_attempts":        {"carries"},
+	"rushing_yards":           {"rushing_yards"},
+	"rushing_touchdowns":      {"rushing_tds"},
+	"receiving_targets":       {"targets"},
+	"receptions":              {"receptions"},
+	"receiving_yards":         {"receiving_yards"},
+	"receiving_touchdowns":    {"receiving_tds"},
+	"fumbles":                 {"sack_fumbles", "rushing_fumbles", "receiving_fumbles"},
+	"fumbles_lost":            {"sack_fumbles_lost", "rushing_fumbles_lost", "receiving_fumbles_lost"},
+	"tackles":                 {"def_tackles_solo", "def_tackle_assists"},
+	"solo_tackles":            {"def_tackles_solo"},
+	"assisted_tackles":        {"def_tackle_assists"},
+	"sacks":                   {"def_sacks"},
+	"defensive_interceptions": {"def_interceptions"},
+	"pass_deflections":        {"def_pass_defended"},
+	"forced_fumbles":          {"def_fumbles_forced"},
+	"fumble_recoveries":       {"fumble_recovery_opp"},
+	"defensive_touchdowns":    {"def_tds"},
+	"field_goals_attempted":   {"fg_att"},
+	"field_goals_made":        {"fg_made"},
+	"extra_points_attempted":  {"pat_att"},
+	"extra_points_made":       {"pat_made"},
+}
+
+// nflverseWeeklyAdvancedColumns maps each advanced stat to its column in an nflverse weekly
+// player stats file
+var nflverseWeeklyAdvancedColumns = map[string]string{
+	"air_yards":    "receiving_air_yards",
+	"target_share": "target_share",
+}
+
+// nflverseRow reads the cells of one row of an nflverse file by column name
+type nflverseRow struct {
+	columns map[string]int
+	record  []string
+	line    int
+}
+
+// cell returns the named cell, or "" when the file has no such column or the value is missing,
+// which nflverse writes as NA
+func (r *nflverseRow) cell(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	value := strings.TrimSpace(r.record[i])
+	if value == "NA" {
+		return ""
+	}
+	return value
+}
+
+// number returns the named cell as a number, reporting whether it has a value
+func (r *nflverseRow) number(column string) (float64, bool, error) {
+	value := r.cell(column)
+	if value == "" {
+		return 0, false, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("validation failed: line %d: %s must be a number", r.line, column)
+	}
+	return number, true, nil
+}
+
+// flag reports whether the named 0/1 cell is set
+func (r *nflverseRow) flag(column string) (bool, error) {
+	number, _, err := r.number(column)
+	return number == 1, err
+}
+
+// week returns the row's season and week
+func (r *nflverseRow) week() (string, int, error) {
+	season := r.cell("season")
+	if season == "" {
+		return "", 0, fmt.Errorf("validation failed: line %d: season is missing", r.line)
+	}
+	week, err := strconv.Atoi(r.cell("week"))
+	if err != nil {
+		return "", 0, fmt.Errorf("validation failed: line %d: week must be a whole number", r.line)
+	}
+	return season, week, nil
+}
+
+// DecodeNflverseCSV reads the stat lines of a public nflverse (nflfastR) CSV file, either weekly
+// player stats, one row per player and week, or play-by-play, whose plays are added up into a
+// line per player and week. A play-by-play file is recognized by its play_id column. Columns the
+// loader doesn't use are ignored, so files of any release can be loaded as downloaded.
+func DecodeNflverseCSV(r io.Reader) ([]*models.NflverseStatLine, error) {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("validation failed: CSV is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: invalid CSV header: %v", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.Trim(strings.TrimSpace(column), `"`)] = i
+	}
+
+	playByPlay := false
+	required := []string{"player_id", "season", "week"}
+	if _, ok := columns["play_id"]; ok {
+		playByPlay = true
+		required = []string{"season", "week", "posteam"}
+	}
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("validation failed: nflverse file has no %s column", column)
+		}
+	}
+
+	var lines []*models.NflverseStatLine
+	byPlayerWeek := make(map[string]*models.NflverseStatLine)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("validation failed: invalid CSV: %v", err)
+		}
+
+		row := &nflverseRow{columns: columns, record: record, line: line}
+		if !playByPlay {
+			statLine, err := decodeNflverseWeeklyRow(row)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, statLine)
+			continue
+		}
+
+		// Lines are created in order of the players' first plays
+		lineFor := func(playerID, season string, week int, team string) *models.NflverseStatLine {
+			key := fmt.Sprintf("%s/%s/%d", playerID, season, week)
+			statLine, ok := byPlayerWeek[key]
+			if !ok {
+				statLine = &models.NflverseStatLine{PlayerID: playerID, Season: season, Week: week, Team: team, Stats: map[string]float64{}}
+				byPlayerWeek[key] = statLine
+				lines = append(lines, statLine)
+			}
+			return statLine
+		}
+		if err := addNflversePlay(row, lineFor); err != nil {
+			return nil, err
+		}
+	}
+
+	if playByPlay {
+		addNflverseTargetShares(lines)
+	}
+	return lines, nil
+}
+
+// decodeNflverseWeeklyRow reads one row of a weekly player stats file
+func decodeNflverseWeeklyRow(row *nflverseRow) (*models.NflverseStatLine, error) {
+	season, week, err := row.week()
+	if err != nil {
+		return nil, err
+	}
+	statLine := &models.NflverseStatLine{
+		PlayerID: row.cell("player_id"),
+		Season:   season,
+		Week:     week,
+		Team:     row.cell("recent_team"),
+		Stats:    map[string]float64{},
+	}
+	if statLine.PlayerID == "" {
+		return nil, fmt.Errorf("validation failed: line %d: player_id is missing", row.line)
+	}
+	if statLine.Team == "" {
+		statLine.Team = row.cell("team")
+	}
+
+	for stat, sources := range nflverseWeeklyColumns {
+		for _, column := range sources {
+			value, ok, err := row.number(column)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				statLine.Stats[stat] += value
+			}
+		}
+	}
+
+	// Air yards and target share are only kept for players who were targeted
+	if statLine.Stats["receiving_targets"] > 0 {
+		for stat, column := range nflverseWeeklyAdvancedColumns {
+			value, ok, err := row.number(column)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				addNflverseAdvanced(statLine, stat, value)
+			}
+		}
+	}
+
+	return statLine, nil
+}
+
+// addNflversePlay credits one play of a play-by-play file to the passer, receiver, rusher and
+// fumbler, as the official box score would. Sacks and two-point conversions don't count toward
+// passing or rushing, and plays without a team in possession, such as timeouts, are skipped.
+func addNflversePlay(row *nflverseRow, lineFor func(playerID, season string, week int, team string) *models.NflverseStatLine) error {
+	team := row.cell("posteam")
+	twoPoint, err := row.flag("two_point_attempt")
+	if err != nil || team == "" || twoPoint {
+		return err
+	}
+	season, week, err := row.week()
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]float64)
+	for _, column := range []string{
+		"pass_attempt", "sack", "complete_pass", "passing_yards", "receiving_yards", "pass_touchdown",
+		"interception", "rush_attempt", "rushing_yards", "rush_touchdown", "fumble_lost",
+	} {
+		if values[column], _, err = row.number(column); err != nil {
+			return err
+		}
+	}
+	airYards, hasAirYards, err := row.number("air_yards")
+	if err != nil {
+		return err
+	}
+	yardline, hasYardline, err := row.number("yardline_100")
+	if err != nil {
+		return err
+	}
+	inRedZone := hasYardline && yardline <= 20
+
+	credit := func(playerID string, stats map[string]float64) {
+		if playerID == "" {
+			return
+		}
+		statLine := lineFor(playerID, season, week, team)
+		for stat, value := range stats {
+			statLine.Stats[stat] += value
+		}
+	}
+
+	if values["pass_attempt"] == 1 && values["sack"] != 1 {
+		credit(row.cell("passer_player_id"), map[string]float64{
+			"passing_attempts":      1,
+			"passing_completions":   values["complete_pass"],
+			"passing_yards":         values["passing_yards"],
+			"passing_touchdowns":    values["pass_touchdown"],
+			"passing_interceptions": values["interception"],
+		})
+		receiver := row.cell("receiver_player_id")
+		credit(receiver, map[string]float64{
+			"receiving_targets":    1,
+			"receptions":           values["complete_pass"],
+			"receiving_yards":      values["receiving_yards"],
+			"receiving_touchdowns": values["pass_touchdown"],
+		})
+		if receiver != "" {
+			statLine := lineFor(receiver, season, week, team)
+			if hasAirYards {
+				addNflverseAdvanced(statLine, "air_yards", airYards)
+			}
+			if inRedZone && values["complete_pass"] == 1 {
+				addNflverseAdvanced(statLine, "red_zone_touches", 1)
+			}
+		}
+	}
+	if values["rush_attempt"] == 1 {
+		rusher := row.cell("rusher_player_id")
+		credit(rusher, map[string]float64{
+			"rushing_attempts":   1,
+			"rushing_yards":      values["rushing_yards"],
+			"rushing_touchdowns": values["rush_touchdown"],
+		})
+		if rusher != "" && inRedZone {
+			addNflverseAdvanced(lineFor(rusher, season, week, team), "red_zone_touches", 1)
+		}
+	}
+	credit(row.cell("fumbled_1_player_id"), map[string]float64{
+		"fumbles":      1,
+		"fumbles_lost": values["fumble_lost"],
+	})
+
+	return nil
+}
+
+// addNflverseAdvanced adds to an advanced stat of a line
+func addNflverseAdvanced(statLine *models.NflverseStatLine, stat string, value float64) {
+	if statLine.Advanced == nil {
+		statLine.Advanced = map[string]float64{}
+	}
+	statLine.Advanced[stat] += value
+}
+
+// addNflverseTargetShares works out the target share of each targeted player of a play-by-play
+// file from the targets of the player's team that week
+func addNflverseTargetShares(lines []*models.NflverseStatLine) {
+	teamTargets := make(map[string]float64)
+	teamKey := func(line *models.NflverseStatLine) string {
+		return fmt.Sprintf("%s/%d/%s", line.Season, line.Week, line.Team)
+	}
+	for _, line := range lines {
+		teamTargets[teamKey(line)] += line.Stats["receiving_targets"]
+	}
+	for _, line := range lines {
+		if targets := line.Stats["receiving_targets"]; targets > 0 {
+			addNflverseAdvanced(line, "target_share", math.Round(targets/teamTargets[teamKey(line)]*1000)/1000)
+		}
+	}
+}
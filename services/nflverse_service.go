@@ -0,0 +1,271 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/nflverse_service.go -pkg mocks . NflverseService
+
+// nflverseProvider is the provider whose IDs nflverse files use for players and teams
+const nflverseProvider = "gsis"
+
+// NflverseService defines the interface for loading past seasons' stats from nflverse files
+type NflverseService interface {
+	ImportStatLines(lines []*models.NflverseStatLine) (*models.ImportResult, error)
+}
+
+// nflverseService implements NflverseService interface
+type nflverseService struct {
+	externalIDRepo  repositories.ExternalIDRepository
+	playerRepo      repositories.PlayerRepository
+	gameRepo        repositories.GameRepository
+	playerStatsRepo repositories.PlayerStatsRepository
+	conflictRepo    repositories.StatConflictRepository
+	advancedRepo    repositories.AdvancedStatsRepository
+	precedence      *StatPrecedence
+}
+
+// NewNflverseService creates a new nflverse service writing stats under precedence
+func NewNflverseService(externalIDRepo repositories.ExternalIDRepository, playerRepo repositories.PlayerRepository, gameRepo repositories.GameRepository, playerStatsRepo repositories.PlayerStatsRepository, conflictRepo repositories.StatConflictRepository, advancedRepo repositories.AdvancedStatsRepository, precedence *StatPrecedence) NflverseService {
+	return &nflverseService{
+		externalIDRepo:  externalIDRepo,
+		playerRepo:      playerRepo,
+		gameRepo:        gameRepo,
+		playerStatsRepo: playerStatsRepo,
+		conflictRepo:    conflictRepo,
+		advancedRepo:    advancedRepo,
+		precedence:      precedence,
+	}
+}
+
+// ImportStatLines writes nflverse stat lines to player_stats in one transaction. A line's player
+// is found by GSIS ID and its game as the week's game of the line's team, by the team's GSIS ID
+// or, for teams without one, the player's current team. The stats a line carries replace those
+// of an existing stat line and the others are kept, except stats set by a source ranked above
+// nflverse, whose differing values are recorded as conflicts. A line's advanced stats are written
+// to the player's advanced stats for the game in the same way, keeping metrics the file doesn't
+// carry. Lines whose player or game isn't in the database are skipped, since a file covers the
+// whole league.
+func (s *nflverseService) ImportStatLines(lines []*models.NflverseStatLine) (*models.ImportResult, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("validation failed: at least one stat line must be provided")
+	}
+	for i, line := range lines {
+		if err := validateNflverseStatLine(line); err != nil {
+			return nil, fmt.Errorf("validation failed: stat line %d: %w", i, err)
+		}
+	}
+
+	players := make(map[string]*models.Player)
+	teams := make(map[string]int)
+	weeks := make(map[string][]*models.Game)
+	result := &models.ImportResult{}
+
+	type match struct {
+		line     *models.NflverseStatLine
+		playerID int
+		gameID   int
+	}
+	var matches []match
+	var gameIDs []int
+	for _, line := range lines {
+		player, err := s.player(players, line.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		if player == nil {
+			result.Skipped++
+			continue
+		}
+		teamID, err := s.team(teams, line.Team)
+		if err != nil {
+			return nil, err
+		}
+		if teamID == 0 {
+			teamID = player.TeamID
+		}
+		game, err := s.teamGame(weeks, line.Season, line.Week, teamID)
+		if err != nil {
+			return nil, err
+		}
+		if game == nil {
+			result.Skipped++
+			continue
+		}
+		matches = append(matches, match{line: line, playerID: player.ID, gameID: game.ID})
+		gameIDs = append(gameIDs, game.ID)
+	}
+
+	existing, err := s.playerStatsRepo.GetByGameIDs(gameIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player stats by game: %w", err)
+	}
+	byPlayerGame := make(map[[2]int]*models.PlayerStats)
+	for gameID, statsList := range existing {
+		for _, stats := range statsList {
+			byPlayerGame[[2]int{stats.PlayerID, gameID}] = stats
+		}
+	}
+
+	// Lines for the same player and game, which a file shouldn't have, are merged in order
+	var statsList []*models.PlayerStats
+	var conflicts []*models.StatConflict
+	var existingIDs []int
+	written := make(map[[2]int]bool)
+	advanced := make(map[[2]int]*models.AdvancedStats)
+	var advancedList []*models.AdvancedStats
+	for _, m := range matches {
+		key := [2]int{m.playerID, m.gameID}
+		stats, ok := byPlayerGame[key]
+		if !ok {
+			stats = &models.PlayerStats{PlayerID: m.playerID, GameID: m.gameID}
+			byPlayerGame[key] = stats
+		}
+		_, lineConflicts := s.precedence.merge(stats, m.line.Stats, models.StatSourceNflverse)
+		conflicts = append(conflicts, lineConflicts...)
+		if !written[key] {
+			written[key] = true
+			statsList = append(statsList, stats)
+			if ok {
+				existingIDs = append(existingIDs, stats.ID)
+			}
+		}
+
+		if len(m.line.Advanced) > 0 {
+			row, ok := advanced[key]
+			if !ok {
+				row = &models.AdvancedStats{PlayerID: m.playerID, GameID: m.gameID, Source: models.StatSourceNflverse}
+				advanced[key] = row
+				advancedList = append(advancedList, row)
+			}
+			setNflverseAdvanced(row, m.line.Advanced)
+		}
+	}
+
+	created, err := s.playerStatsRepo.UpsertMany(statsList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import stat lines: %w", err)
+	}
+	result.Created = created
+	result.Updated = len(statsList) - created
+
+	if err := recordStatConflicts(s.conflictRepo, models.StatSourceNflverse, existingIDs, conflicts); err != nil {
+		return nil, err
+	}
+
+	if len(advancedList) > 0 {
+		if _, err := s.advancedRepo.UpsertMany(advancedList); err != nil {
+			return nil, fmt.Errorf("failed to import advanced stats: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// player finds the player with a GSIS ID, nil when there is none
+func (s *nflverseService) player(players map[string]*models.Player, gsisID string) (*models.Player, error) {
+	if player, ok := players[gsisID]; ok {
+		return player, nil
+	}
+
+	var player *models.Player
+	mapping, err := s.externalIDRepo.Lookup("player", nflverseProvider, gsisID)
+	if err == nil {
+		player, err = s.playerRepo.GetByID(mapping.EntityID)
+	}
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return nil, fmt.Errorf("failed to look up player %s: %w", gsisID, err)
+	}
+
+	players[gsisID] = player
+	return player, nil
+}
+
+// team finds the ID of the team with a GSIS ID, 0 when there is none
+func (s *nflverseService) team(teams map[string]int, abbreviation string) (int, error) {
+	if teamID, ok := teams[abbreviation]; ok || abbreviation == "" {
+		return teamID, nil
+	}
+
+	mapping, err := s.externalIDRepo.Lookup("team", nflverseProvider, abbreviation)
+	if err != nil {
+		if !strings.Contains(err.Error(), "not found") {
+			return 0, fmt.Errorf("failed to look up team %s: %w", abbreviation, err)
+		}
+		teams[abbreviation] = 0
+		return 0, nil
+	}
+
+	teams[abbreviation] = mapping.EntityID
+	return mapping.EntityID, nil
+}
+
+// teamGame finds the team's game of the week, nil when it has none
+func (s *nflverseService) teamGame(weeks map[string][]*models.Game, season string, week, teamID int) (*models.Game, error) {
+	key := fmt.Sprintf("%s/%d", season, week)
+	games, ok := weeks[key]
+	if !ok {
+		var err error
+		if games, err = s.gameRepo.GetByWeek(season, week); err != nil {
+			return nil, fmt.Errorf("failed to get games by week: %w", err)
+		}
+		weeks[key] = games
+	}
+
+	for _, game := range games {
+		if game.HomeTeamID == teamID || game.AwayTeamID == teamID {
+			return game, nil
+		}
+	}
+	return nil, nil
+}
+
+// validateNflverseStatLine validates a stat line before it is matched to the database
+func validateNflverseStatLine(line *models.NflverseStatLine) error {
+	if strings.TrimSpace(line.PlayerID) == "" {
+		return fmt.Errorf("player_id is required")
+	}
+	if strings.TrimSpace(line.Season) == "" {
+		return fmt.Errorf("season is required")
+	}
+	if line.Week < 1 || line.Week > 22 {
+		return fmt.Errorf("week must be between 1 and 22, got %d", line.Week)
+	}
+	for name := range line.Stats {
+		if !setStat(&models.PlayerStats{}, name, 0) {
+			return fmt.Errorf("unknown stat %q", name)
+		}
+	}
+	for name, value := range line.Advanced {
+		if !slices.Contains(models.NflverseAdvancedStats, name) {
+			return fmt.Errorf("unknown advanced stat %q: must be one of %v", name, models.NflverseAdvancedStats)
+		}
+		if name == "target_share" && (value < 0 || value > 1) {
+			return fmt.Errorf("target_share must be a fraction between 0 and 1, got %g", value)
+		}
+	}
+	return nil
+}
+
+// setNflverseAdvanced sets the advanced stats a line carries on the player's row for the game
+func setNflverseAdvanced(row *models.AdvancedStats, values map[string]float64) {
+	for name, value := range values {
+		switch name {
+		case "air_yards":
+			airYards := int(math.Round(value))
+			row.AirYards = &airYards
+		case "red_zone_touches":
+			touches := int(math.Round(value))
+			row.RedZoneTouches = &touches
+		case "target_share":
+			share := value
+			row.TargetShare = &share
+		}
+	}
+}
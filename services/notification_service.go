@@ -0,0 +1,392 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"sports-backend/models"
+	"sports-backend/notify"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/notification_service.go -pkg mocks . NotificationService
+
+const (
+	// notificationJobAttempts is how many times a delivery is tried before it fails
+	notificationJobAttempts = 5
+	// notificationTestEvent is the event type of test notifications
+	notificationTestEvent = "test"
+)
+
+// NotificationService defines the interface for sending events to notification subscriptions
+type NotificationService interface {
+	GetSubscription(id int) (*models.NotificationSubscription, error)
+	GetAllSubscriptions() ([]*models.NotificationSubscription, error)
+	CreateSubscription(req *models.CreateNotificationSubscriptionRequest) (*models.NotificationSubscription, error)
+	UpdateSubscription(id int, req *models.UpdateNotificationSubscriptionRequest) (*models.NotificationSubscription, error)
+	DeleteSubscription(id int) error
+	SendTest(id int) (*models.Job, error)
+	Start() error
+}
+
+// notificationService listens for published events and queues one delivery job per matching
+// subscription, so a slow or failing channel is retried without holding up the others
+type notificationService struct {
+	notificationRepo repositories.NotificationRepository
+	jobService       JobService
+	eventService     EventService
+	channels         map[string]notify.Channel // configured channels by name
+}
+
+// notificationPayload is what a notification job delivers, and to which subscription
+type notificationPayload struct {
+	SubscriptionID int             `json:"subscription_id"`
+	Message        *notify.Message `json:"message"`
+	Test           bool            `json:"test,omitempty"` // sent even when the subscription is disabled
+}
+
+// notificationResult is the result of a notification job
+type notificationResult struct {
+	SubscriptionID int    `json:"subscription_id"`
+	Channel        string `json:"channel,omitempty"`
+	Delivered      bool   `json:"delivered"`
+	Skipped        string `json:"skipped,omitempty"` // why nothing was sent
+}
+
+// NewNotificationService creates a new notification service over the configured channels and
+// registers the notification job kind
+func NewNotificationService(notificationRepo repositories.NotificationRepository, jobService JobService, eventService EventService, channels map[string]notify.Channel) NotificationService {
+	s := &notificationService{
+		notificationRepo: notificationRepo,
+		jobService:       jobService,
+		eventService:     eventService,
+		channels:         channels,
+	}
+
+	jobService.Register(models.JobSendNotification, notificationJobAttempts, func(ctx context.Context, run *JobRun) error {
+		var payload notificationPayload
+		if err := run.Payload(&payload); err != nil {
+			return err
+		}
+		if payload.Message == nil {
+			return fmt.Errorf("validation failed: notification has no message")
+		}
+
+		result := &notificationResult{SubscriptionID: payload.SubscriptionID}
+
+		// The subscription may have changed since the event was queued
+		subscription, err := s.notificationRepo.GetByID(payload.SubscriptionID)
+		switch {
+		case err != nil && strings.Contains(err.Error(), "not found"):
+			result.Skipped = "subscription deleted"
+		case err != nil:
+			return err
+		case !subscription.Enabled && !payload.Test:
+			result.Channel = subscription.Channel
+			result.Skipped = "subscription disabled"
+		default:
+			result.Channel = subscription.Channel
+			channel, ok := s.channels[subscription.Channel]
+			if !ok {
+				return fmt.Errorf("validation failed: the %s channel is not configured", subscription.Channel)
+			}
+			if err := channel.Send(ctx, subscription.Target, payload.Message); err != nil {
+				return err
+			}
+			result.Delivered = true
+		}
+		return run.Progress(1, result, run.Job.Errors)
+	})
+
+	return s
+}
+
+// GetSubscription retrieves a subscription by ID
+func (s *notificationService) GetSubscription(id int) (*models.NotificationSubscription, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid notification subscription ID: %d", id)
+	}
+
+	subscription, err := s.notificationRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// GetAllSubscriptions retrieves all subscriptions
+func (s *notificationService) GetAllSubscriptions() ([]*models.NotificationSubscription, error) {
+	subscriptions, err := s.notificationRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// CreateSubscription creates a new subscription on a configured channel
+func (s *notificationService) CreateSubscription(req *models.CreateNotificationSubscriptionRequest) (*models.NotificationSubscription, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("validation failed: subscription name is required")
+	}
+
+	channelName := strings.ToLower(strings.TrimSpace(req.Channel))
+	if err := validateOneOf("channel", channelName, models.NotificationChannels); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	channel, ok := s.channels[channelName]
+	if !ok {
+		return nil, fmt.Errorf("validation failed: the %s channel is not configured on this server", channelName)
+	}
+
+	target := strings.TrimSpace(req.Target)
+	if err := channel.ValidateTarget(target); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	eventTypes, err := normalizeEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	subscription := &models.NotificationSubscription{
+		Name:       name,
+		Channel:    channelName,
+		Target:     target,
+		EventTypes: eventTypes,
+		Enabled:    req.Enabled == nil || *req.Enabled,
+	}
+
+	if err := s.notificationRepo.Create(subscription); err != nil {
+		return nil, fmt.Errorf("failed to create notification subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// UpdateSubscription updates an existing subscription. Its channel can't change; create a new
+// subscription instead.
+func (s *notificationService) UpdateSubscription(id int, req *models.UpdateNotificationSubscriptionRequest) (*models.NotificationSubscription, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid notification subscription ID: %d", id)
+	}
+
+	if req.Name == nil && req.Target == nil && req.EventTypes == nil && req.Enabled == nil {
+		return nil, fmt.Errorf("validation failed: at least one field must be provided for update")
+	}
+
+	subscription, err := s.notificationRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification subscription: %w", err)
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, fmt.Errorf("validation failed: subscription name cannot be empty")
+		}
+		subscription.Name = name
+	}
+	if req.Target != nil {
+		channel, ok := s.channels[subscription.Channel]
+		if !ok {
+			return nil, fmt.Errorf("validation failed: the %s channel is not configured on this server", subscription.Channel)
+		}
+		target := strings.TrimSpace(*req.Target)
+		if err := channel.ValidateTarget(target); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+		subscription.Target = target
+	}
+	if req.EventTypes != nil {
+		eventTypes, err := normalizeEventTypes(*req.EventTypes)
+		if err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+		subscription.EventTypes = eventTypes
+	}
+	if req.Enabled != nil {
+		subscription.Enabled = *req.Enabled
+	}
+
+	if err := s.notificationRepo.Update(subscription); err != nil {
+		return nil, fmt.Errorf("failed to update notification subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// DeleteSubscription deletes a subscription. Deliveries already queued for it are skipped.
+func (s *notificationService) DeleteSubscription(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid notification subscription ID: %d", id)
+	}
+
+	if err := s.notificationRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete notification subscription: %w", err)
+	}
+
+	return nil
+}
+
+// SendTest queues a test notification to a subscription, even a disabled one, so its target
+// can be checked. The job's result reports whether it was delivered.
+func (s *notificationService) SendTest(id int) (*models.Job, error) {
+	subscription, err := s.GetSubscription(id)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &notify.Message{
+		EventType: notificationTestEvent,
+		Subject:   "Test notification",
+		Body:      fmt.Sprintf("This is a test of the notification subscription %q.", subscription.Name),
+	}
+	return s.jobService.Submit(models.JobSendNotification, &notificationPayload{
+		SubscriptionID: subscription.ID,
+		Message:        message,
+		Test:           true,
+	}, 1)
+}
+
+// Start listens for published events until the event service closes. The job service must be
+// started too for notifications to be delivered.
+func (s *notificationService) Start() error {
+	sub, err := s.eventService.Subscribe(nil)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	go func() {
+		for event := range sub.Events {
+			s.dispatch(event)
+		}
+	}()
+	return nil
+}
+
+// dispatch queues a delivery of event to each enabled subscription to its type
+func (s *notificationService) dispatch(event *models.Event) {
+	subscriptions, err := s.notificationRepo.GetAll()
+	if err != nil {
+		log.Printf("Failed to load notification subscriptions for %s event %d: %v", event.Type, event.ID, err)
+		return
+	}
+
+	var message *notify.Message
+	for _, subscription := range subscriptions {
+		if !subscription.Enabled || !subscribedTo(subscription, event.Type) {
+			continue
+		}
+		if message == nil {
+			if message, err = notificationMessage(event); err != nil {
+				log.Printf("Failed to build notification for %s event %d: %v", event.Type, event.ID, err)
+				return
+			}
+		}
+
+		_, err := s.jobService.Submit(models.JobSendNotification, &notificationPayload{
+			SubscriptionID: subscription.ID,
+			Message:        message,
+		}, 1)
+		if err != nil {
+			if errors.Is(err, models.ErrQueueFull) {
+				log.Printf("Job queue is full, dropped %s event %d for notification subscription %d", event.Type, event.ID, subscription.ID)
+				continue
+			}
+			log.Printf("Failed to queue %s event %d for notification subscription %d: %v", event.Type, event.ID, subscription.ID, err)
+		}
+	}
+}
+
+// subscribedTo reports whether a subscription receives events of eventType
+func subscribedTo(subscription *models.NotificationSubscription, eventType string) bool {
+	if len(subscription.EventTypes) == 0 {
+		return true
+	}
+	for _, subscribed := range subscription.EventTypes {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// notificationMessage describes an event for people, keeping its data for webhooks
+func notificationMessage(event *models.Event) (*notify.Message, error) {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event data: %w", err)
+	}
+	message := &notify.Message{EventType: event.Type, Data: data}
+
+	switch change := event.Data.(type) {
+	case *models.ScheduleChange:
+		message.Subject = fmt.Sprintf("Game rescheduled: season %s week %d", change.Season, change.Week)
+		message.Body = fmt.Sprintf("Game %d has moved from %s to %s.",
+			change.GameID, change.PreviousDate.UTC().Format(time.RFC1123), change.NewDate.UTC().Format(time.RFC1123))
+		if change.Reason != nil {
+			message.Body += "\nReason: " + *change.Reason
+		}
+	case *models.LiveScoreUpdate:
+		message.Subject = fmt.Sprintf("Game %d: %s", change.GameID, strings.ReplaceAll(change.Status, "_", " "))
+		message.Body = fmt.Sprintf("Team %d %s, team %d %s.",
+			change.AwayTeamID, scoreText(change.AwayScore), change.HomeTeamID, scoreText(change.HomeScore))
+	case *models.LiveStatsUpdate:
+		message.Subject = fmt.Sprintf("Game %d: stats updated", change.GameID)
+		message.Body = fmt.Sprintf("%d players' stat lines changed.", len(change.Players))
+	case *models.InjuryChange:
+		message.Subject = fmt.Sprintf("Injury report: %s %s", change.PlayerName, injuryStatusText(change.Status))
+		message.Body = fmt.Sprintf("%s (player %d, team %d) is %s for season %s week %d, previously %s.",
+			change.PlayerName, change.PlayerID, change.TeamID, injuryStatusText(change.Status),
+			change.Season, change.Week, injuryStatusText(change.PreviousStatus))
+		if change.Injury != nil {
+			message.Body += "\nInjury: " + *change.Injury
+		}
+	default:
+		message.Subject = event.Type
+		message.Body = string(data)
+	}
+	return message, nil
+}
+
+// scoreText formats an optional score
+func scoreText(score *int) string {
+	if score == nil {
+		return "no score"
+	}
+	return strconv.Itoa(*score)
+}
+
+// injuryStatusText formats an optional injury report designation
+func injuryStatusText(status *string) string {
+	if status == nil {
+		return "not designated"
+	}
+	return *status
+}
+
+// normalizeEventTypes lowercases and de-duplicates event types, checking each can be published
+func normalizeEventTypes(eventTypes []string) ([]string, error) {
+	normalized := []string{}
+	seen := make(map[string]bool)
+	for _, eventType := range eventTypes {
+		eventType = strings.ToLower(strings.TrimSpace(eventType))
+		if err := validateOneOf("event type", eventType, models.EventTypes); err != nil {
+			return nil, err
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			normalized = append(normalized, eventType)
+		}
+	}
+	return normalized, nil
+}
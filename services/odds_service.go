@@ -0,0 +1,183 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/odds_service.go -pkg mocks . OddsService
+
+// OddsService defines the interface for betting line business logic
+type OddsService interface {
+	GetOddsByGame(gameID int) ([]*models.GameOdds, error)
+	CreateOdds(req *models.CreateGameOddsRequest) (*models.GameOdds, error)
+	CreateOddsBatch(reqs []*models.CreateGameOddsRequest) ([]*models.GameOdds, error)
+}
+
+// oddsService implements OddsService interface
+type oddsService struct {
+	oddsRepo repositories.OddsRepository
+	gameRepo repositories.GameRepository
+	clock    clock.Clock
+}
+
+// NewOddsService creates a new odds service
+func NewOddsService(oddsRepo repositories.OddsRepository, gameRepo repositories.GameRepository, clock clock.Clock) OddsService {
+	return &oddsService{
+		oddsRepo: oddsRepo,
+		gameRepo: gameRepo,
+		clock:    clock,
+	}
+}
+
+// GetOddsByGame retrieves the line history for a game
+func (s *oddsService) GetOddsByGame(gameID int) ([]*models.GameOdds, error) {
+	if gameID <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+
+	exists, err := s.gameRepo.Exists(gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if game exists: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("game with ID %d not found", gameID)
+	}
+
+	oddsList, err := s.oddsRepo.GetByGameID(gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game odds: %w", err)
+	}
+
+	return oddsList, nil
+}
+
+// CreateOdds records a new betting line for a game
+func (s *oddsService) CreateOdds(req *models.CreateGameOddsRequest) (*models.GameOdds, error) {
+	if err := s.validateCreateGameOddsRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	exists, err := s.gameRepo.Exists(req.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if game exists: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("game with ID %d not found", req.GameID)
+	}
+
+	odds := newGameOdds(req, s.clock.Now())
+	if err := s.oddsRepo.Create(odds); err != nil {
+		return nil, fmt.Errorf("failed to create game odds: %w", err)
+	}
+
+	return odds, nil
+}
+
+// CreateOddsBatch records several betting lines in one transaction, so either every line is
+// written or none is
+func (s *oddsService) CreateOddsBatch(reqs []*models.CreateGameOddsRequest) ([]*models.GameOdds, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("validation failed: at least one line must be provided")
+	}
+
+	// Validate everything up front so a bad entry doesn't leave a partial import
+	gameIDs := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		if req == nil {
+			return nil, fmt.Errorf("validation failed: line %d is null", i)
+		}
+		if err := s.validateCreateGameOddsRequest(req); err != nil {
+			return nil, fmt.Errorf("validation failed: line %d: %w", i, err)
+		}
+		gameIDs = append(gameIDs, req.GameID)
+	}
+
+	games, err := s.gameRepo.ExistsMany(gameIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if games exist: %w", err)
+	}
+	for i, req := range reqs {
+		if !games[req.GameID] {
+			return nil, fmt.Errorf("line %d: game with ID %d not found", i, req.GameID)
+		}
+	}
+
+	now := s.clock.Now()
+	created := make([]*models.GameOdds, len(reqs))
+	for i, req := range reqs {
+		created[i] = newGameOdds(req, now)
+	}
+	if err := s.oddsRepo.CreateMany(created); err != nil {
+		return nil, fmt.Errorf("failed to create game odds: %w", err)
+	}
+
+	return created, nil
+}
+
+// newGameOdds builds the betting line a request describes, captured now unless it says when
+func newGameOdds(req *models.CreateGameOddsRequest, now time.Time) *models.GameOdds {
+	capturedAt := now
+	if req.CapturedAt != nil {
+		capturedAt = *req.CapturedAt
+	}
+
+	return &models.GameOdds{
+		GameID:        req.GameID,
+		Spread:        req.Spread,
+		Total:         req.Total,
+		HomeMoneyline: req.HomeMoneyline,
+		AwayMoneyline: req.AwayMoneyline,
+		Source:        strings.TrimSpace(req.Source),
+		CapturedAt:    capturedAt,
+	}
+}
+
+// validateCreateGameOddsRequest validates a create game odds request
+func (s *oddsService) validateCreateGameOddsRequest(req *models.CreateGameOddsRequest) error {
+	if req.GameID <= 0 {
+		return fmt.Errorf("game ID is required and must be positive")
+	}
+
+	if strings.TrimSpace(req.Source) == "" {
+		return fmt.Errorf("source is required")
+	}
+
+	if req.Spread == nil && req.Total == nil && req.HomeMoneyline == nil && req.AwayMoneyline == nil {
+		return fmt.Errorf("at least one of spread, total or moneyline must be provided")
+	}
+
+	if req.Spread != nil && math.Abs(*req.Spread) > 100 {
+		return fmt.Errorf("spread must be between -100 and 100")
+	}
+
+	if req.Total != nil && (*req.Total <= 0 || *req.Total > 200) {
+		return fmt.Errorf("total must be between 0 and 200")
+	}
+
+	// American odds are never between -100 and +100
+	moneylines := []struct {
+		value *int
+		name  string
+	}{
+		{req.HomeMoneyline, "home moneyline"},
+		{req.AwayMoneyline, "away moneyline"},
+	}
+	for _, moneyline := range moneylines {
+		if moneyline.value != nil && *moneyline.value > -100 && *moneyline.value < 100 {
+			return fmt.Errorf("%s must be at most -100 or at least +100", moneyline.name)
+		}
+	}
+
+	if req.CapturedAt != nil && req.CapturedAt.After(s.clock.Now().Add(time.Minute)) {
+		return fmt.Errorf("captured at cannot be in the future")
+	}
+
+	return nil
+}
@@ -2,33 +2,55 @@ package services
 
 import (
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
+	"time"
+	"unicode"
 
+	"sports-backend/clock"
 	"sports-backend/models"
 	"sports-backend/repositories"
 )
 
+//go:generate moq -out mocks/player_service.go -pkg mocks . PlayerService
+
 // PlayerService defines the interface for player business logic
 type PlayerService interface {
 	GetPlayer(id int) (*models.Player, error)
 	GetAllPlayers() ([]*models.Player, error)
+	GetPlayersByADP(season, format string) ([]*models.Player, error)
 	GetPlayersByTeam(teamID int) ([]*models.Player, error)
 	CreatePlayer(req *models.CreatePlayerRequest) (*models.Player, error)
 	UpdatePlayer(id int, req *models.UpdatePlayerRequest) (*models.Player, error)
 	DeletePlayer(id int) error
+	RestorePlayer(id int) (*models.Player, error)
+	PurgePlayer(id int) error
+	BackfillPlayerBio(entries []*models.PlayerBioBackfill, overwrite bool) (*models.PlayerBioBackfillResult, error)
+	FindDuplicatePlayers() ([]*models.PlayerDuplicateGroup, error)
+	MergePlayers(keepID, duplicateID int) (*models.PlayerMergeResult, error)
+	IncludeRelated(players []*models.Player, include string) error
 }
 
 // playerService implements PlayerService interface
 type playerService struct {
-	playerRepo repositories.PlayerRepository
-	teamRepo   repositories.TeamRepository
+	playerRepo      repositories.PlayerRepository
+	teamRepo        repositories.TeamRepository
+	draftPickRepo   repositories.DraftPickRepository
+	externalIDRepo  repositories.ExternalIDRepository
+	playerStatsRepo repositories.PlayerStatsRepository
+	clock           clock.Clock
 }
 
 // NewPlayerService creates a new player service
-func NewPlayerService(playerRepo repositories.PlayerRepository, teamRepo repositories.TeamRepository) PlayerService {
+func NewPlayerService(playerRepo repositories.PlayerRepository, teamRepo repositories.TeamRepository, draftPickRepo repositories.DraftPickRepository, externalIDRepo repositories.ExternalIDRepository, playerStatsRepo repositories.PlayerStatsRepository, clock clock.Clock) PlayerService {
 	return &playerService{
-		playerRepo: playerRepo,
-		teamRepo:   teamRepo,
+		playerRepo:      playerRepo,
+		teamRepo:        teamRepo,
+		draftPickRepo:   draftPickRepo,
+		externalIDRepo:  externalIDRepo,
+		playerStatsRepo: playerStatsRepo,
+		clock:           clock,
 	}
 }
 
@@ -43,6 +65,10 @@ func (s *playerService) GetPlayer(id int) (*models.Player, error) {
 		return nil, fmt.Errorf("failed to get player: %w", err)
 	}
 
+	if err := s.attachDetails([]*models.Player{player}); err != nil {
+		return nil, err
+	}
+
 	return player, nil
 }
 
@@ -53,6 +79,28 @@ func (s *playerService) GetAllPlayers() ([]*models.Player, error) {
 		return nil, fmt.Errorf("failed to get players: %w", err)
 	}
 
+	if err := s.attachDetails(players); err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+// GetPlayersByADP retrieves all players ordered by consensus ADP in the format, for a draft board
+func (s *playerService) GetPlayersByADP(season, format string) ([]*models.Player, error) {
+	if err := validateOneOf("format", format, models.ADPFormats); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	players, err := s.playerRepo.GetAllByADP(strings.TrimSpace(season), strings.ToLower(strings.TrimSpace(format)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	if err := s.attachDetails(players); err != nil {
+		return nil, err
+	}
+
 	return players, nil
 }
 
@@ -76,6 +124,10 @@ func (s *playerService) GetPlayersByTeam(teamID int) ([]*models.Player, error) {
 		return nil, fmt.Errorf("failed to get players by team: %w", err)
 	}
 
+	if err := s.attachDetails(players); err != nil {
+		return nil, err
+	}
+
 	return players, nil
 }
 
@@ -85,6 +137,14 @@ func (s *playerService) CreatePlayer(req *models.CreatePlayerRequest) (*models.P
 	if err := s.validateCreatePlayerRequest(req); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	if err := validateExternalIDs(req.ExternalIDs); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Provider IDs that are already mapped mean the player was imported before
+	if err := checkExternalIDsUnclaimed(s.externalIDRepo, entityTypePlayer, 0, req.ExternalIDs); err != nil {
+		return nil, err
+	}
 
 	// Verify team exists
 	exists, err := s.teamRepo.Exists(req.TeamID)
@@ -95,34 +155,29 @@ func (s *playerService) CreatePlayer(req *models.CreatePlayerRequest) (*models.P
 		return nil, fmt.Errorf("team with ID %d not found", req.TeamID)
 	}
 
-	// Check if jersey number is already taken by another player on the same team
-	if req.JerseyNumber != nil {
-		players, err := s.playerRepo.GetByTeamID(req.TeamID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to check existing players: %w", err)
-		}
-
-		for _, player := range players {
-			if player.JerseyNumber != nil && *player.JerseyNumber == *req.JerseyNumber {
-				return nil, fmt.Errorf("jersey number %d is already taken by another player on this team", *req.JerseyNumber)
-			}
-		}
-	}
-
 	// Create player
 	player := &models.Player{
 		TeamID:       req.TeamID,
 		FirstName:    strings.TrimSpace(req.FirstName),
 		LastName:     strings.TrimSpace(req.LastName),
-		Position:     strings.TrimSpace(req.Position),
+		Position:     normalizedPosition(req.Position),
 		JerseyNumber: req.JerseyNumber,
 		Height:       req.Height,
 		Weight:       req.Weight,
+		// Biographical and career metadata
+		BirthDate:       trimmedOrNil(req.BirthDate),
+		College:         trimmedOrNil(req.College),
+		YearsExperience: req.YearsExperience,
+		HeadshotURL:     trimmedOrNil(req.HeadshotURL),
+		ExternalIDs:     normalizedExternalIDs(req.ExternalIDs),
 	}
 
 	if err := s.playerRepo.Create(player); err != nil {
 		return nil, fmt.Errorf("failed to create player: %w", err)
 	}
+	if err := s.attachDetails([]*models.Player{player}); err != nil {
+		return nil, err
+	}
 
 	return player, nil
 }
@@ -152,20 +207,10 @@ func (s *playerService) UpdatePlayer(id int, req *models.UpdatePlayerRequest) (*
 		player.LastName = strings.TrimSpace(*req.LastName)
 	}
 	if req.Position != nil {
-		player.Position = strings.TrimSpace(*req.Position)
+		player.Position = normalizedPosition(*req.Position)
 	}
 	if req.JerseyNumber != nil {
-		// Check if jersey number is already taken by another player on the same team
-		players, err := s.playerRepo.GetByTeamID(player.TeamID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to check existing players: %w", err)
-		}
-
-		for _, existingPlayer := range players {
-			if existingPlayer.ID != id && existingPlayer.JerseyNumber != nil && *existingPlayer.JerseyNumber == *req.JerseyNumber {
-				return nil, fmt.Errorf("jersey number %d is already taken by another player on this team", *req.JerseyNumber)
-			}
-		}
+		// The repository rejects a number another player on the team already wears
 		player.JerseyNumber = req.JerseyNumber
 	}
 	if req.Height != nil {
@@ -174,6 +219,18 @@ func (s *playerService) UpdatePlayer(id int, req *models.UpdatePlayerRequest) (*
 	if req.Weight != nil {
 		player.Weight = req.Weight
 	}
+	if req.BirthDate != nil {
+		player.BirthDate = trimmedOrNil(req.BirthDate)
+	}
+	if req.College != nil {
+		player.College = trimmedOrNil(req.College)
+	}
+	if req.YearsExperience != nil {
+		player.YearsExperience = req.YearsExperience
+	}
+	if req.HeadshotURL != nil {
+		player.HeadshotURL = trimmedOrNil(req.HeadshotURL)
+	}
 
 	// Update player
 	if err := s.playerRepo.Update(player); err != nil {
@@ -198,8 +255,7 @@ func (s *playerService) DeletePlayer(id int) error {
 		return fmt.Errorf("player with ID %d not found", id)
 	}
 
-	// TODO: Add business logic here if needed
-	// For example: check if player has stats, prevent deletion if they do
+	// Stats stay attached to the soft-deleted player and come back on restore
 
 	if err := s.playerRepo.Delete(id); err != nil {
 		return fmt.Errorf("failed to delete player: %w", err)
@@ -208,6 +264,304 @@ func (s *playerService) DeletePlayer(id int) error {
 	return nil
 }
 
+// RestorePlayer brings back a soft-deleted player
+func (s *playerService) RestorePlayer(id int) (*models.Player, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", id)
+	}
+
+	if err := s.playerRepo.Restore(id); err != nil {
+		return nil, err
+	}
+
+	return s.GetPlayer(id)
+}
+
+// PurgePlayer permanently removes a player that has already been deleted
+func (s *playerService) PurgePlayer(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid player ID: %d", id)
+	}
+
+	return s.playerRepo.Purge(id)
+}
+
+// BackfillPlayerBio fills in biographical fields for existing players. Only fields that
+// are currently empty are set unless overwrite is true. Every entry is validated and every
+// player checked before anything is written.
+func (s *playerService) BackfillPlayerBio(entries []*models.PlayerBioBackfill, overwrite bool) (*models.PlayerBioBackfillResult, error) {
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("validation failed: at least one player must be provided")
+	}
+
+	players := make([]*models.Player, len(entries))
+	for i, entry := range entries {
+		if entry == nil {
+			return nil, fmt.Errorf("validation failed: entry %d is null", i)
+		}
+		if entry.PlayerID <= 0 {
+			return nil, fmt.Errorf("validation failed: entry %d: player ID is required and must be positive", i)
+		}
+		if entry.BirthDate == nil && entry.College == nil && entry.YearsExperience == nil && entry.HeadshotURL == nil {
+			return nil, fmt.Errorf("validation failed: entry %d: at least one field must be provided", i)
+		}
+		if err := s.validatePlayerBio(entry.BirthDate, entry.College, entry.YearsExperience, entry.HeadshotURL); err != nil {
+			return nil, fmt.Errorf("validation failed: entry %d: %w", i, err)
+		}
+
+		player, err := s.playerRepo.GetByID(entry.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get player: %w", err)
+		}
+		players[i] = player
+	}
+
+	result := &models.PlayerBioBackfillResult{Changes: []*models.PlayerBioBackfillChange{}}
+	for i, entry := range entries {
+		player := players[i]
+		var fields []string
+
+		if entry.BirthDate != nil && (overwrite || player.BirthDate == nil) {
+			player.BirthDate = trimmedOrNil(entry.BirthDate)
+			fields = append(fields, "birth_date")
+		}
+		if entry.College != nil && (overwrite || player.College == nil) {
+			player.College = trimmedOrNil(entry.College)
+			fields = append(fields, "college")
+		}
+		if entry.YearsExperience != nil && (overwrite || player.YearsExperience == nil) {
+			player.YearsExperience = entry.YearsExperience
+			fields = append(fields, "years_experience")
+		}
+		if entry.HeadshotURL != nil && (overwrite || player.HeadshotURL == nil) {
+			player.HeadshotURL = trimmedOrNil(entry.HeadshotURL)
+			fields = append(fields, "headshot_url")
+		}
+
+		if len(fields) == 0 {
+			result.Unchanged++
+			continue
+		}
+
+		if err := s.playerRepo.Update(player); err != nil {
+			return nil, fmt.Errorf("failed to update player %d: %w", player.ID, err)
+		}
+		result.Updated++
+		result.Changes = append(result.Changes, &models.PlayerBioBackfillChange{PlayerID: player.ID, Fields: fields})
+	}
+
+	return result, nil
+}
+
+// FindDuplicatePlayers groups players that are likely the same person: the same name,
+// ignoring case, punctuation and suffixes such as Jr. or II, and the same birth date.
+// Players without a birth date are grouped by name alone.
+func (s *playerService) FindDuplicatePlayers() ([]*models.PlayerDuplicateGroup, error) {
+	players, err := s.playerRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	byName := make(map[string][]*models.Player)
+	var names []string
+	for _, player := range players {
+		key := normalizePlayerName(player.FirstName, player.LastName)
+		if key == "" {
+			continue
+		}
+		if _, ok := byName[key]; !ok {
+			names = append(names, key)
+		}
+		byName[key] = append(byName[key], player)
+	}
+	sort.Strings(names)
+
+	groups := []*models.PlayerDuplicateGroup{}
+	for _, name := range names {
+		candidates := byName[name]
+		if len(candidates) < 2 {
+			continue
+		}
+
+		// Split by birth date; players without one could match any of them
+		byBirthDate := make(map[string][]*models.Player)
+		var birthDates []string
+		var undated []*models.Player
+		for _, player := range candidates {
+			if player.BirthDate == nil {
+				undated = append(undated, player)
+				continue
+			}
+			if _, ok := byBirthDate[*player.BirthDate]; !ok {
+				birthDates = append(birthDates, *player.BirthDate)
+			}
+			byBirthDate[*player.BirthDate] = append(byBirthDate[*player.BirthDate], player)
+		}
+		sort.Strings(birthDates)
+
+		displayName := candidates[0].FirstName + " " + candidates[0].LastName
+		for _, birthDate := range birthDates {
+			group := append(byBirthDate[birthDate], undated...)
+			if len(group) < 2 {
+				continue
+			}
+			reason := "same name and birth date"
+			if len(undated) > 0 {
+				reason = "same name, birth date missing on some players"
+			}
+			birthDate := birthDate
+			groups = append(groups, &models.PlayerDuplicateGroup{Name: displayName, BirthDate: &birthDate, Reason: reason, Players: group})
+		}
+		if len(birthDates) == 0 && len(undated) >= 2 {
+			groups = append(groups, &models.PlayerDuplicateGroup{Name: displayName, Reason: "same name, no birth dates", Players: undated})
+		}
+	}
+
+	for _, group := range groups {
+		if err := s.attachDetails(group.Players); err != nil {
+			return nil, err
+		}
+	}
+
+	return groups, nil
+}
+
+// MergePlayers merges the duplicate player into the kept one and deletes the duplicate
+func (s *playerService) MergePlayers(keepID, duplicateID int) (*models.PlayerMergeResult, error) {
+	if keepID <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", keepID)
+	}
+	if duplicateID <= 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", duplicateID)
+	}
+	if keepID == duplicateID {
+		return nil, fmt.Errorf("validation failed: a player cannot be merged into itself")
+	}
+
+	result, err := s.playerRepo.Merge(keepID, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge players: %w", err)
+	}
+
+	result.Player, err = s.GetPlayer(keepID)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// normalizePlayerName builds a comparison key from a player's name, dropping case,
+// punctuation and generational suffixes
+func normalizePlayerName(firstName, lastName string) string {
+	var words []string
+	for _, word := range strings.Fields(strings.ToLower(firstName + " " + lastName)) {
+		word = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, word)
+		switch word {
+		case "", "jr", "sr", "ii", "iii", "iv", "v":
+			continue
+		}
+		words = append(words, word)
+	}
+	return strings.Join(words, " ")
+}
+
+// attachDetails sets the draft pick of each drafted player and the provider IDs of each player
+func (s *playerService) attachDetails(players []*models.Player) error {
+	if len(players) == 0 {
+		return nil
+	}
+
+	playerIDs := make([]int, len(players))
+	for i, player := range players {
+		playerIDs[i] = player.ID
+	}
+
+	draftPicks, err := s.draftPickRepo.GetByPlayerIDs(playerIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get draft info: %w", err)
+	}
+	for _, player := range players {
+		player.Draft = draftPicks[player.ID]
+	}
+
+	externalIDs, err := s.externalIDRepo.GetByEntityIDs(entityTypePlayer, playerIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get external IDs: %w", err)
+	}
+	for _, player := range players {
+		player.ExternalIDs = externalIDs[player.ID]
+	}
+
+	return nil
+}
+
+// playerIncludes lists the related records that can be requested with players
+var playerIncludes = []string{"team", "stats"}
+
+// IncludeRelated loads the related records named in include, a comma-separated list of
+// team and stats, with one query per kind for all the players
+func (s *playerService) IncludeRelated(players []*models.Player, include string) error {
+	includes, err := parseInclude(include, playerIncludes)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if len(includes) == 0 || len(players) == 0 {
+		return nil
+	}
+
+	if includes["team"] {
+		teamIDs := make([]int, len(players))
+		for i, player := range players {
+			teamIDs[i] = player.TeamID
+		}
+		teams, err := s.teamRepo.GetByIDs(teamIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get teams: %w", err)
+		}
+		for _, player := range players {
+			player.Team = teams[player.TeamID]
+		}
+	}
+
+	if includes["stats"] {
+		playerIDs := make([]int, len(players))
+		for i, player := range players {
+			playerIDs[i] = player.ID
+		}
+		stats, err := s.playerStatsRepo.GetByPlayerIDs(playerIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get player stats: %w", err)
+		}
+		for _, player := range players {
+			player.Stats = stats[player.ID]
+		}
+	}
+
+	return nil
+}
+
+// parseInclude reads a comma-separated include parameter into a set, rejecting names not in allowed
+func parseInclude(include string, allowed []string) (map[string]bool, error) {
+	includes := make(map[string]bool)
+	for _, name := range strings.Split(include, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if err := validateOneOf("include", name, allowed); err != nil {
+			return nil, err
+		}
+		includes[name] = true
+	}
+	return includes, nil
+}
+
 // validateCreatePlayerRequest validates the create player request
 func (s *playerService) validateCreatePlayerRequest(req *models.CreatePlayerRequest) error {
 	if req.TeamID <= 0 {
@@ -226,6 +580,10 @@ func (s *playerService) validateCreatePlayerRequest(req *models.CreatePlayerRequ
 		return fmt.Errorf("position is required")
 	}
 
+	if _, ok := models.NormalizePosition(req.Position); !ok {
+		return fmt.Errorf("position must be one of: %v", models.Positions)
+	}
+
 	// Validate jersey number if provided
 	if req.JerseyNumber != nil {
 		if *req.JerseyNumber < 0 || *req.JerseyNumber > 99 {
@@ -247,14 +605,15 @@ func (s *playerService) validateCreatePlayerRequest(req *models.CreatePlayerRequ
 		}
 	}
 
-	return nil
+	return s.validatePlayerBio(req.BirthDate, req.College, req.YearsExperience, req.HeadshotURL)
 }
 
 // validateUpdatePlayerRequest validates the update player request
 func (s *playerService) validateUpdatePlayerRequest(req *models.UpdatePlayerRequest) error {
 	// Check if at least one field is being updated
 	if req.FirstName == nil && req.LastName == nil && req.Position == nil &&
-		req.JerseyNumber == nil && req.Height == nil && req.Weight == nil {
+		req.JerseyNumber == nil && req.Height == nil && req.Weight == nil &&
+		req.BirthDate == nil && req.College == nil && req.YearsExperience == nil && req.HeadshotURL == nil {
 		return fmt.Errorf("at least one field must be provided for update")
 	}
 
@@ -267,8 +626,13 @@ func (s *playerService) validateUpdatePlayerRequest(req *models.UpdatePlayerRequ
 		return fmt.Errorf("last name cannot be empty")
 	}
 
-	if req.Position != nil && strings.TrimSpace(*req.Position) == "" {
-		return fmt.Errorf("position cannot be empty")
+	if req.Position != nil {
+		if strings.TrimSpace(*req.Position) == "" {
+			return fmt.Errorf("position cannot be empty")
+		}
+		if _, ok := models.NormalizePosition(*req.Position); !ok {
+			return fmt.Errorf("position must be one of: %v", models.Positions)
+		}
 	}
 
 	// Validate jersey number if provided
@@ -292,5 +656,78 @@ func (s *playerService) validateUpdatePlayerRequest(req *models.UpdatePlayerRequ
 		}
 	}
 
+	return s.validatePlayerBio(req.BirthDate, req.College, req.YearsExperience, req.HeadshotURL)
+}
+
+// validatePlayerBio validates the optional biographical fields of a player
+func (s *playerService) validatePlayerBio(birthDate, college *string, yearsExperience *int, headshotURL *string) error {
+	if birthDate != nil {
+		parsed, err := time.Parse("2006-01-02", strings.TrimSpace(*birthDate))
+		if err != nil {
+			return fmt.Errorf("birth date must be formatted as YYYY-MM-DD")
+		}
+		if parsed.Year() < 1900 || parsed.After(s.clock.Now()) {
+			return fmt.Errorf("birth date must be between 1900-01-01 and today")
+		}
+	}
+
+	if college != nil && strings.TrimSpace(*college) == "" {
+		return fmt.Errorf("college cannot be empty")
+	}
+
+	if yearsExperience != nil && (*yearsExperience < 0 || *yearsExperience > 30) {
+		return fmt.Errorf("years of experience must be between 0 and 30")
+	}
+
+	if headshotURL != nil {
+		parsed, err := url.Parse(strings.TrimSpace(*headshotURL))
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("headshot URL must be an absolute http or https URL")
+		}
+	}
+
+	return nil
+}
+
+// trimmedOrNil trims an optional string, treating an empty result as not set
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// normalizedPosition returns the canonical code for a position that has already been validated
+func normalizedPosition(position string) string {
+	code, _ := models.NormalizePosition(position)
+	return code
+}
+
+// checkPlayersExist verifies in one batched lookup that every row of an import refers to an
+// existing player. playerIDs holds each row's player; the first row naming a missing player is
+// reported, as "<row> <index>".
+func checkPlayersExist(playerRepo repositories.PlayerRepository, playerIDs []int, row string) error {
+	unique := make([]int, 0, len(playerIDs))
+	seen := make(map[int]bool, len(playerIDs))
+	for _, id := range playerIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	found, err := playerRepo.ExistsMany(unique)
+	if err != nil {
+		return fmt.Errorf("failed to check player existence: %w", err)
+	}
+	for i, id := range playerIDs {
+		if !found[id] {
+			return fmt.Errorf("validation failed: %s %d: player with ID %d not found", row, i, id)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,177 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories/mocks"
+	"sports-backend/services"
+)
+
+// playerServiceMocks holds the repositories a player service is built on
+type playerServiceMocks struct {
+	players     *mocks.PlayerRepositoryMock
+	teams       *mocks.TeamRepositoryMock
+	draftPicks  *mocks.DraftPickRepositoryMock
+	externalIDs *mocks.ExternalIDRepositoryMock
+	stats       *mocks.PlayerStatsRepositoryMock
+}
+
+// newPlayerService builds a player service on mocks with no drafted players and no provider IDs
+func newPlayerService() (services.PlayerService, *playerServiceMocks) {
+	m := &playerServiceMocks{
+		players: &mocks.PlayerRepositoryMock{},
+		teams:   &mocks.TeamRepositoryMock{},
+		draftPicks: &mocks.DraftPickRepositoryMock{
+			GetByPlayerIDsFunc: func(playerIDs []int) (map[int]*models.DraftPick, error) {
+				return map[int]*models.DraftPick{}, nil
+			},
+		},
+		externalIDs: noExternalIDs(),
+		stats:       &mocks.PlayerStatsRepositoryMock{},
+	}
+	service := services.NewPlayerService(m.players, m.teams, m.draftPicks, m.externalIDs, m.stats, clock.System)
+	return service, m
+}
+
+func TestCreatePlayerValidation(t *testing.T) {
+	valid := models.CreatePlayerRequest{TeamID: 1, FirstName: "Patrick", LastName: "Mahomes", Position: "QB"}
+	number := func(n int) *int { return &n }
+
+	tests := []struct {
+		name   string
+		modify func(*models.CreatePlayerRequest)
+		want   string
+	}{
+		{"missing team", func(r *models.CreatePlayerRequest) { r.TeamID = 0 }, "team ID is required"},
+		{"missing first name", func(r *models.CreatePlayerRequest) { r.FirstName = "" }, "first name is required"},
+		{"unknown position", func(r *models.CreatePlayerRequest) { r.Position = "Goalie" }, "position must be one of"},
+		{"jersey number out of range", func(r *models.CreatePlayerRequest) { r.JerseyNumber = number(100) }, "jersey number must be between 0 and 99"},
+		{"height out of range", func(r *models.CreatePlayerRequest) { r.Height = number(59) }, "height must be between 60 and 90 inches"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newPlayerService()
+			req := valid
+			tt.modify(&req)
+
+			_, err := service.CreatePlayer(&req)
+			if err == nil || !strings.Contains(err.Error(), "validation failed") || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("CreatePlayer error = %v, want validation failure containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreatePlayerRequiresTeam(t *testing.T) {
+	service, m := newPlayerService()
+	m.teams.ExistsFunc = func(id int) (bool, error) { return false, nil }
+
+	_, err := service.CreatePlayer(&models.CreatePlayerRequest{TeamID: 5, FirstName: "Patrick", LastName: "Mahomes", Position: "QB"})
+	if err == nil || !strings.Contains(err.Error(), "team with ID 5 not found") {
+		t.Fatalf("CreatePlayer error = %v, want team not found", err)
+	}
+	if len(m.players.CreateCalls()) != 0 {
+		t.Error("player was created for a missing team")
+	}
+}
+
+func TestCreatePlayerNormalizesPosition(t *testing.T) {
+	service, m := newPlayerService()
+	m.teams.ExistsFunc = func(id int) (bool, error) { return true, nil }
+	m.players.CreateFunc = func(player *models.Player) error {
+		player.ID = 12
+		return nil
+	}
+
+	player, err := service.CreatePlayer(&models.CreatePlayerRequest{TeamID: 1, FirstName: " Patrick", LastName: "Mahomes ", Position: "qb"})
+	if err != nil {
+		t.Fatalf("CreatePlayer: %v", err)
+	}
+	if player.ID != 12 || player.FirstName != "Patrick" || player.LastName != "Mahomes" || player.Position != "QB" {
+		t.Errorf("player = %+v, want ID 12, trimmed names and position QB", player)
+	}
+}
+
+func TestGetPlayerAttachesDraftPick(t *testing.T) {
+	service, m := newPlayerService()
+	m.players.GetByIDFunc = func(id int) (*models.Player, error) {
+		return &models.Player{ID: id, FirstName: "Patrick", LastName: "Mahomes"}, nil
+	}
+	m.draftPicks.GetByPlayerIDsFunc = func(playerIDs []int) (map[int]*models.DraftPick, error) {
+		return map[int]*models.DraftPick{playerIDs[0]: {Year: 2017, Round: 1, Pick: 10}}, nil
+	}
+
+	player, err := service.GetPlayer(15)
+	if err != nil {
+		t.Fatalf("GetPlayer: %v", err)
+	}
+	if player.Draft == nil || player.Draft.Year != 2017 || player.Draft.Pick != 10 {
+		t.Errorf("Draft = %+v, want the 2017 10th pick", player.Draft)
+	}
+}
+
+func TestMergePlayersValidation(t *testing.T) {
+	tests := []struct {
+		name              string
+		keepID, duplicate int
+		want              string
+	}{
+		{"invalid kept player", 0, 2, "invalid player ID: 0"},
+		{"invalid duplicate", 1, -1, "invalid player ID: -1"},
+		{"same player", 3, 3, "cannot be merged into itself"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newPlayerService()
+
+			if _, err := service.MergePlayers(tt.keepID, tt.duplicate); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("MergePlayers error = %v, want %q", err, tt.want)
+			}
+			if len(m.players.MergeCalls()) != 0 {
+				t.Error("players were merged despite invalid IDs")
+			}
+		})
+	}
+}
+
+func TestMergePlayersReturnsKeptPlayer(t *testing.T) {
+	service, m := newPlayerService()
+	m.players.MergeFunc = func(keepID, duplicateID int) (*models.PlayerMergeResult, error) {
+		return &models.PlayerMergeResult{StatsMoved: 4}, nil
+	}
+	m.players.GetByIDFunc = func(id int) (*models.Player, error) {
+		return &models.Player{ID: id}, nil
+	}
+
+	result, err := service.MergePlayers(1, 2)
+	if err != nil {
+		t.Fatalf("MergePlayers: %v", err)
+	}
+	if result.StatsMoved != 4 || result.Player == nil || result.Player.ID != 1 {
+		t.Errorf("result = %+v, want 4 stats moved and the kept player", result)
+	}
+	if calls := m.players.MergeCalls(); len(calls) != 1 || calls[0].KeepID != 1 || calls[0].DuplicateID != 2 {
+		t.Errorf("Merge calls = %+v, want keep 1 and duplicate 2", calls)
+	}
+}
+
+func TestBackfillPlayerBioRejectsNullEntry(t *testing.T) {
+	service, m := newPlayerService()
+	m.players.GetByIDFunc = func(id int) (*models.Player, error) {
+		return &models.Player{ID: id}, nil
+	}
+	college := "Texas Tech"
+
+	entries := []*models.PlayerBioBackfill{{PlayerID: 1, College: &college}, nil}
+	if _, err := service.BackfillPlayerBio(entries, false); err == nil || !strings.Contains(err.Error(), "validation failed: entry 1 is null") {
+		t.Fatalf("BackfillPlayerBio error = %v, want entry 1 rejected as null", err)
+	}
+	if len(m.players.UpdateCalls()) != 0 {
+		t.Error("players were written despite the null entry")
+	}
+}
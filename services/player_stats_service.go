@@ -2,18 +2,24 @@ package services
 
 import (
 	"fmt"
+	"strings"
 
 	"sports-backend/models"
 	"sports-backend/repositories"
 )
 
+//go:generate moq -out mocks/player_stats_service.go -pkg mocks . PlayerStatsService
+
 // PlayerStatsService defines the interface for player stats business logic
 type PlayerStatsService interface {
 	GetPlayerStats(id int) (*models.PlayerStats, error)
 	GetAllPlayerStats() ([]*models.PlayerStats, error)
+	GetPlayerStatsPage(cursor string, limit int) (*models.PlayerStatsPage, error)
 	GetPlayerStatsByPlayer(playerID int) ([]*models.PlayerStats, error)
 	GetPlayerStatsByGame(gameID int) ([]*models.PlayerStats, error)
+	ExportPlayerStats(season string, fn func(*models.PlayerStats) error) error
 	CreatePlayerStats(req *models.CreatePlayerStatsRequest) (*models.PlayerStats, error)
+	UpsertPlayerStats(playerID, gameID int, req *models.CreatePlayerStatsRequest) (*models.PlayerStats, bool, error)
 	UpdatePlayerStats(id int, req *models.UpdatePlayerStatsRequest) (*models.PlayerStats, error)
 	DeletePlayerStats(id int) error
 }
@@ -22,13 +28,20 @@ type PlayerStatsService interface {
 type playerStatsService struct {
 	playerStatsRepo repositories.PlayerStatsRepository
 	playerRepo      repositories.PlayerRepository
+	gameRepo        repositories.GameRepository
+	sportRepo       repositories.SportRepository
+	statProfiles    map[string]*models.StatProfile // keyed by position
 }
 
-// NewPlayerStatsService creates a new player stats service
-func NewPlayerStatsService(playerStatsRepo repositories.PlayerStatsRepository, playerRepo repositories.PlayerRepository) PlayerStatsService {
+// NewPlayerStatsService creates a new player stats service that checks stat lines against the
+// rules of the player's sport and the given per-position profiles
+func NewPlayerStatsService(playerStatsRepo repositories.PlayerStatsRepository, playerRepo repositories.PlayerRepository, gameRepo repositories.GameRepository, sportRepo repositories.SportRepository, statProfiles map[string]*models.StatProfile) PlayerStatsService {
 	return &playerStatsService{
 		playerStatsRepo: playerStatsRepo,
 		playerRepo:      playerRepo,
+		gameRepo:        gameRepo,
+		sportRepo:       sportRepo,
+		statProfiles:    statProfiles,
 	}
 }
 
@@ -56,6 +69,58 @@ func (s *playerStatsService) GetAllPlayerStats() ([]*models.PlayerStats, error)
 	return statsList, nil
 }
 
+// GetPlayerStatsPage retrieves a page of stat lines, newest first, after the position of an
+// opaque cursor from a previous page
+func (s *playerStatsService) GetPlayerStatsPage(cursor string, limit int) (*models.PlayerStatsPage, error) {
+	if limit < 1 || limit > maxPageSize {
+		return nil, fmt.Errorf("validation failed: limit must be between 1 and %d", maxPageSize)
+	}
+
+	var after *models.PageCursor
+	if cursor != "" {
+		var err error
+		if after, err = decodeCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// One extra row tells whether another page follows
+	statsList, err := s.playerStatsRepo.GetPage(after, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player stats: %w", err)
+	}
+
+	page := &models.PlayerStatsPage{Stats: statsList}
+	if len(statsList) > limit {
+		page.Stats = statsList[:limit]
+		last := page.Stats[limit-1]
+		page.NextCursor = encodeCursor(&models.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	if page.Stats == nil {
+		page.Stats = []*models.PlayerStats{}
+	}
+
+	return page, nil
+}
+
+// ExportPlayerStats calls fn with every stat line of a season, or of every season when season
+// is empty, without loading them all at once. Errors returned by fn are passed back unwrapped.
+func (s *playerStatsService) ExportPlayerStats(season string, fn func(*models.PlayerStats) error) error {
+	var fnErr error
+	err := s.playerStatsRepo.ForEachBySeason(season, func(stats *models.PlayerStats) error {
+		fnErr = fn(stats)
+		return fnErr
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to export player stats: %w", err)
+	}
+
+	return nil
+}
+
 // GetPlayerStatsByPlayer retrieves all stats for a specific player
 func (s *playerStatsService) GetPlayerStatsByPlayer(playerID int) ([]*models.PlayerStats, error) {
 	if playerID <= 0 {
@@ -85,8 +150,9 @@ func (s *playerStatsService) GetPlayerStatsByGame(gameID int) ([]*models.PlayerS
 		return nil, fmt.Errorf("invalid game ID: %d", gameID)
 	}
 
-	// TODO: Verify game exists when game repository is implemented
-	// For now, we'll skip this validation
+	if err := s.verifyGameExists(gameID); err != nil {
+		return nil, err
+	}
 
 	statsList, err := s.playerStatsRepo.GetByGameID(gameID)
 	if err != nil {
@@ -104,63 +170,32 @@ func (s *playerStatsService) CreatePlayerStats(req *models.CreatePlayerStatsRequ
 	}
 
 	// Verify player exists
-	exists, err := s.playerRepo.Exists(req.PlayerID)
+	player, err := s.playerRepo.GetByID(req.PlayerID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify player existence: %w", err)
+		return nil, err
 	}
-	if !exists {
-		return nil, fmt.Errorf("player with ID %d not found", req.PlayerID)
+	if err := s.checkGame(player, req.GameID, req.SkipTeamCheck); err != nil {
+		return nil, err
 	}
 
 	// Check if stats already exist for this player and game
-	exists, err = s.playerStatsRepo.ExistsByPlayerAndGame(req.PlayerID, req.GameID)
+	exists, err := s.playerStatsRepo.ExistsByPlayerAndGame(req.PlayerID, req.GameID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check existing stats: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("player stats already exist for player %d in game %d", req.PlayerID, req.GameID)
+		return nil, &models.ConflictError{Entity: "player stats", Fields: []string{"player_id", "game_id"}}
 	}
 
-	// Create player stats
-	stats := &models.PlayerStats{
-		PlayerID:               req.PlayerID,
-		GameID:                 req.GameID,
-		PassingAttempts:        req.PassingAttempts,
-		PassingCompletions:     req.PassingCompletions,
-		PassingYards:           req.PassingYards,
-		PassingTouchdowns:      req.PassingTouchdowns,
-		PassingInterceptions:   req.PassingInterceptions,
-		RushingAttempts:        req.RushingAttempts,
-		RushingYards:           req.RushingYards,
-		RushingTouchdowns:      req.RushingTouchdowns,
-		ReceivingTargets:       req.ReceivingTargets,
-		Receptions:             req.Receptions,
-		ReceivingYards:         req.ReceivingYards,
-		ReceivingTouchdowns:    req.ReceivingTouchdowns,
-		Fumbles:                req.Fumbles,
-		FumblesLost:            req.FumblesLost,
-		Tackles:                req.Tackles,
-		SoloTackles:            req.SoloTackles,
-		AssistedTackles:        req.AssistedTackles,
-		Sacks:                  req.Sacks,
-		DefensiveInterceptions: req.DefensiveInterceptions,
-		PassDeflections:        req.PassDeflections,
-		ForcedFumbles:          req.ForcedFumbles,
-		FumbleRecoveries:       req.FumbleRecoveries,
-		DefensiveTouchdowns:    req.DefensiveTouchdowns,
-		FieldGoalsAttempted:    req.FieldGoalsAttempted,
-		FieldGoalsMade:         req.FieldGoalsMade,
-		ExtraPointsAttempted:   req.ExtraPointsAttempted,
-		ExtraPointsMade:        req.ExtraPointsMade,
-		Punts:                  req.Punts,
-		PuntYards:              req.PuntYards,
-		KickReturns:            req.KickReturns,
-		KickReturnYards:        req.KickReturnYards,
-		KickReturnTouchdowns:   req.KickReturnTouchdowns,
-		PuntReturns:            req.PuntReturns,
-		PuntReturnYards:        req.PuntReturnYards,
-		PuntReturnTouchdowns:   req.PuntReturnTouchdowns,
+	stats := newPlayerStatsFromRequest(req)
+	if err := s.validateForSport(stats); err != nil {
+		return nil, err
 	}
+	if err := s.applyStatProfile(player.Position, stats, req.Override); err != nil {
+		return nil, err
+	}
+
+	markStatSources(stats, models.StatSourceManual)
 
 	if err := s.playerStatsRepo.Create(stats); err != nil {
 		return nil, fmt.Errorf("failed to create player stats: %w", err)
@@ -169,6 +204,46 @@ func (s *playerStatsService) CreatePlayerStats(req *models.CreatePlayerStatsRequ
 	return stats, nil
 }
 
+// UpsertPlayerStats creates or fully replaces the stat line for a player in a game.
+// Stats missing from the request are cleared on an existing line. It reports whether the line was created.
+func (s *playerStatsService) UpsertPlayerStats(playerID, gameID int, req *models.CreatePlayerStatsRequest) (*models.PlayerStats, bool, error) {
+	if (req.PlayerID != 0 && req.PlayerID != playerID) || (req.GameID != 0 && req.GameID != gameID) {
+		return nil, false, fmt.Errorf("validation failed: player_id and game_id in the body must match the URL")
+	}
+	req.PlayerID = playerID
+	req.GameID = gameID
+
+	if err := s.validateCreatePlayerStatsRequest(req); err != nil {
+		return nil, false, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Verify player exists
+	player, err := s.playerRepo.GetByID(playerID)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.checkGame(player, gameID, req.SkipTeamCheck); err != nil {
+		return nil, false, err
+	}
+
+	stats := newPlayerStatsFromRequest(req)
+	if err := s.validateForSport(stats); err != nil {
+		return nil, false, err
+	}
+	if err := s.applyStatProfile(player.Position, stats, req.Override); err != nil {
+		return nil, false, err
+	}
+
+	markStatSources(stats, models.StatSourceManual)
+
+	created, err := s.playerStatsRepo.Upsert(stats)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to save player stats: %w", err)
+	}
+
+	return stats, created, nil
+}
+
 // UpdatePlayerStats updates existing player stats
 func (s *playerStatsService) UpdatePlayerStats(id int, req *models.UpdatePlayerStatsRequest) (*models.PlayerStats, error) {
 	if id <= 0 {
@@ -185,6 +260,9 @@ func (s *playerStatsService) UpdatePlayerStats(id int, req *models.UpdatePlayerS
 	if err != nil {
 		return nil, fmt.Errorf("failed to get player stats: %w", err)
 	}
+	if err := s.verifyGameExists(stats.GameID); err != nil {
+		return nil, err
+	}
 
 	// Update fields if provided
 	if req.PassingAttempts != nil {
@@ -293,6 +371,20 @@ func (s *playerStatsService) UpdatePlayerStats(id int, req *models.UpdatePlayerS
 		stats.PuntReturnTouchdowns = req.PuntReturnTouchdowns
 	}
 
+	player, err := s.playerRepo.GetByID(stats.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	// The merged line is checked, so a change can't leave it inconsistent with the stats kept
+	if err := s.validateForSport(stats); err != nil {
+		return nil, err
+	}
+	if err := s.applyStatProfile(player.Position, stats, req.Override); err != nil {
+		return nil, err
+	}
+
+	markUpdatedStatSources(stats, req, models.StatSourceManual)
+
 	// Update stats
 	if err := s.playerStatsRepo.Update(stats); err != nil {
 		return nil, fmt.Errorf("failed to update player stats: %w", err)
@@ -323,6 +415,112 @@ func (s *playerStatsService) DeletePlayerStats(id int) error {
 	return nil
 }
 
+// checkGame verifies that the game of a new stat line exists and, unless skipTeamCheck is set,
+// that the player's team is one of its sides. Players are only linked to their current team,
+// so a player who has changed teams since the game needs the team check skipped.
+func (s *playerStatsService) checkGame(player *models.Player, gameID int, skipTeamCheck bool) error {
+	if skipTeamCheck {
+		return s.verifyGameExists(gameID)
+	}
+
+	game, err := s.gameRepo.GetByID(gameID)
+	if err != nil {
+		return err
+	}
+	if player.TeamID != game.HomeTeamID && player.TeamID != game.AwayTeamID {
+		return fmt.Errorf("validation failed: player %d's team %d is not playing in game %d (set skip_team_check for a player who has changed teams since)", player.ID, player.TeamID, gameID)
+	}
+	return nil
+}
+
+// verifyGameExists rejects a game ID that doesn't match an active game
+func (s *playerStatsService) verifyGameExists(gameID int) error {
+	exists, err := s.gameRepo.Exists(gameID)
+	if err != nil {
+		return fmt.Errorf("failed to verify game existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("game with ID %d not found", gameID)
+	}
+	return nil
+}
+
+// validateForSport checks a stat line against the stat definitions and rules of the player's sport
+func (s *playerStatsService) validateForSport(stats *models.PlayerStats) error {
+	sport, err := s.sportRepo.GetByPlayerID(stats.PlayerID)
+	if err != nil {
+		return err
+	}
+	if err := validateStatLine(sport, statValues(stats)); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
+
+// applyStatProfile checks a stat line against the profile for the player's position.
+// Implausible lines are rejected unless override is set, in which case they are saved
+// flagged with the reasons.
+func (s *playerStatsService) applyStatProfile(position string, stats *models.PlayerStats, override bool) error {
+	problems := checkStatProfile(s.statProfiles, position, stats)
+	if len(problems) == 0 {
+		stats.Flagged = false
+		stats.FlagReason = nil
+		return nil
+	}
+
+	reason := strings.Join(problems, "; ")
+	if !override {
+		return fmt.Errorf("validation failed: %s (set override to save it flagged for review)", reason)
+	}
+
+	stats.Flagged = true
+	stats.FlagReason = &reason
+	return nil
+}
+
+// newPlayerStatsFromRequest builds a stat line from a create request
+func newPlayerStatsFromRequest(req *models.CreatePlayerStatsRequest) *models.PlayerStats {
+	return &models.PlayerStats{
+		PlayerID:               req.PlayerID,
+		GameID:                 req.GameID,
+		PassingAttempts:        req.PassingAttempts,
+		PassingCompletions:     req.PassingCompletions,
+		PassingYards:           req.PassingYards,
+		PassingTouchdowns:      req.PassingTouchdowns,
+		PassingInterceptions:   req.PassingInterceptions,
+		RushingAttempts:        req.RushingAttempts,
+		RushingYards:           req.RushingYards,
+		RushingTouchdowns:      req.RushingTouchdowns,
+		ReceivingTargets:       req.ReceivingTargets,
+		Receptions:             req.Receptions,
+		ReceivingYards:         req.ReceivingYards,
+		ReceivingTouchdowns:    req.ReceivingTouchdowns,
+		Fumbles:                req.Fumbles,
+		FumblesLost:            req.FumblesLost,
+		Tackles:                req.Tackles,
+		SoloTackles:            req.SoloTackles,
+		AssistedTackles:        req.AssistedTackles,
+		Sacks:                  req.Sacks,
+		DefensiveInterceptions: req.DefensiveInterceptions,
+		PassDeflections:        req.PassDeflections,
+		ForcedFumbles:          req.ForcedFumbles,
+		FumbleRecoveries:       req.FumbleRecoveries,
+		DefensiveTouchdowns:    req.DefensiveTouchdowns,
+		FieldGoalsAttempted:    req.FieldGoalsAttempted,
+		FieldGoalsMade:         req.FieldGoalsMade,
+		ExtraPointsAttempted:   req.ExtraPointsAttempted,
+		ExtraPointsMade:        req.ExtraPointsMade,
+		Punts:                  req.Punts,
+		PuntYards:              req.PuntYards,
+		KickReturns:            req.KickReturns,
+		KickReturnYards:        req.KickReturnYards,
+		KickReturnTouchdowns:   req.KickReturnTouchdowns,
+		PuntReturns:            req.PuntReturns,
+		PuntReturnYards:        req.PuntReturnYards,
+		PuntReturnTouchdowns:   req.PuntReturnTouchdowns,
+	}
+}
+
 // validateCreatePlayerStatsRequest validates the create player stats request
 func (s *playerStatsService) validateCreatePlayerStatsRequest(req *models.CreatePlayerStatsRequest) error {
 	if req.PlayerID <= 0 {
@@ -350,11 +548,6 @@ func (s *playerStatsService) validateCreatePlayerStatsRequest(req *models.Create
 		return fmt.Errorf("at least one statistic must be provided")
 	}
 
-	// Validate logical constraints
-	if err := s.validateStatConstraints(req); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -377,186 +570,5 @@ func (s *playerStatsService) validateUpdatePlayerStatsRequest(req *models.Update
 		return fmt.Errorf("at least one field must be provided for update")
 	}
 
-	// Validate logical constraints
-	if err := s.validateUpdateStatConstraints(req); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// validateStatConstraints validates logical constraints for create requests
-func (s *playerStatsService) validateStatConstraints(req *models.CreatePlayerStatsRequest) error {
-	// Passing completions cannot exceed passing attempts
-	if req.PassingCompletions != nil && req.PassingAttempts != nil {
-		if *req.PassingCompletions > *req.PassingAttempts {
-			return fmt.Errorf("passing completions cannot exceed passing attempts")
-		}
-	}
-
-	// Solo tackles + assisted tackles should equal total tackles (if all provided)
-	if req.Tackles != nil && req.SoloTackles != nil && req.AssistedTackles != nil {
-		if *req.Tackles != *req.SoloTackles+*req.AssistedTackles {
-			return fmt.Errorf("total tackles must equal solo tackles plus assisted tackles")
-		}
-	}
-
-	// Field goals made cannot exceed field goals attempted
-	if req.FieldGoalsMade != nil && req.FieldGoalsAttempted != nil {
-		if *req.FieldGoalsMade > *req.FieldGoalsAttempted {
-			return fmt.Errorf("field goals made cannot exceed field goals attempted")
-		}
-	}
-
-	// Extra points made cannot exceed extra points attempted
-	if req.ExtraPointsMade != nil && req.ExtraPointsAttempted != nil {
-		if *req.ExtraPointsMade > *req.ExtraPointsAttempted {
-			return fmt.Errorf("extra points made cannot exceed extra points attempted")
-		}
-	}
-
-	// Fumbles lost cannot exceed total fumbles
-	if req.FumblesLost != nil && req.Fumbles != nil {
-		if *req.FumblesLost > *req.Fumbles {
-			return fmt.Errorf("fumbles lost cannot exceed total fumbles")
-		}
-	}
-
-	// Validate non-negative values
-	nonNegativeFields := []struct {
-		value *int
-		name  string
-	}{
-		{req.PassingAttempts, "passing attempts"},
-		{req.PassingCompletions, "passing completions"},
-		{req.PassingYards, "passing yards"},
-		{req.PassingTouchdowns, "passing touchdowns"},
-		{req.PassingInterceptions, "passing interceptions"},
-		{req.RushingAttempts, "rushing attempts"},
-		{req.RushingYards, "rushing yards"},
-		{req.RushingTouchdowns, "rushing touchdowns"},
-		{req.ReceivingTargets, "receiving targets"},
-		{req.Receptions, "receptions"},
-		{req.ReceivingYards, "receiving yards"},
-		{req.ReceivingTouchdowns, "receiving touchdowns"},
-		{req.Fumbles, "fumbles"},
-		{req.FumblesLost, "fumbles lost"},
-		{req.Tackles, "tackles"},
-		{req.SoloTackles, "solo tackles"},
-		{req.AssistedTackles, "assisted tackles"},
-		{req.Sacks, "sacks"},
-		{req.DefensiveInterceptions, "defensive interceptions"},
-		{req.PassDeflections, "pass deflections"},
-		{req.ForcedFumbles, "forced fumbles"},
-		{req.FumbleRecoveries, "fumble recoveries"},
-		{req.DefensiveTouchdowns, "defensive touchdowns"},
-		{req.FieldGoalsAttempted, "field goals attempted"},
-		{req.FieldGoalsMade, "field goals made"},
-		{req.ExtraPointsAttempted, "extra points attempted"},
-		{req.ExtraPointsMade, "extra points made"},
-		{req.Punts, "punts"},
-		{req.PuntYards, "punt yards"},
-		{req.KickReturns, "kick returns"},
-		{req.KickReturnYards, "kick return yards"},
-		{req.KickReturnTouchdowns, "kick return touchdowns"},
-		{req.PuntReturns, "punt returns"},
-		{req.PuntReturnYards, "punt return yards"},
-		{req.PuntReturnTouchdowns, "punt return touchdowns"},
-	}
-
-	for _, field := range nonNegativeFields {
-		if field.value != nil && *field.value < 0 {
-			return fmt.Errorf("%s cannot be negative", field.name)
-		}
-	}
-
-	return nil
-}
-
-// validateUpdateStatConstraints validates logical constraints for update requests
-func (s *playerStatsService) validateUpdateStatConstraints(req *models.UpdatePlayerStatsRequest) error {
-	// Passing completions cannot exceed passing attempts
-	if req.PassingCompletions != nil && req.PassingAttempts != nil {
-		if *req.PassingCompletions > *req.PassingAttempts {
-			return fmt.Errorf("passing completions cannot exceed passing attempts")
-		}
-	}
-
-	// Solo tackles + assisted tackles should equal total tackles (if all provided)
-	if req.Tackles != nil && req.SoloTackles != nil && req.AssistedTackles != nil {
-		if *req.Tackles != *req.SoloTackles+*req.AssistedTackles {
-			return fmt.Errorf("total tackles must equal solo tackles plus assisted tackles")
-		}
-	}
-
-	// Field goals made cannot exceed field goals attempted
-	if req.FieldGoalsMade != nil && req.FieldGoalsAttempted != nil {
-		if *req.FieldGoalsMade > *req.FieldGoalsAttempted {
-			return fmt.Errorf("field goals made cannot exceed field goals attempted")
-		}
-	}
-
-	// Extra points made cannot exceed extra points attempted
-	if req.ExtraPointsMade != nil && req.ExtraPointsAttempted != nil {
-		if *req.ExtraPointsMade > *req.ExtraPointsAttempted {
-			return fmt.Errorf("extra points made cannot exceed extra points attempted")
-		}
-	}
-
-	// Fumbles lost cannot exceed total fumbles
-	if req.FumblesLost != nil && req.Fumbles != nil {
-		if *req.FumblesLost > *req.Fumbles {
-			return fmt.Errorf("fumbles lost cannot exceed total fumbles")
-		}
-	}
-
-	// Validate non-negative values
-	nonNegativeFields := []struct {
-		value *int
-		name  string
-	}{
-		{req.PassingAttempts, "passing attempts"},
-		{req.PassingCompletions, "passing completions"},
-		{req.PassingYards, "passing yards"},
-		{req.PassingTouchdowns, "passing touchdowns"},
-		{req.PassingInterceptions, "passing interceptions"},
-		{req.RushingAttempts, "rushing attempts"},
-		{req.RushingYards, "rushing yards"},
-		{req.RushingTouchdowns, "rushing touchdowns"},
-		{req.ReceivingTargets, "receiving targets"},
-		{req.Receptions, "receptions"},
-		{req.ReceivingYards, "receiving yards"},
-		{req.ReceivingTouchdowns, "receiving touchdowns"},
-		{req.Fumbles, "fumbles"},
-		{req.FumblesLost, "fumbles lost"},
-		{req.Tackles, "tackles"},
-		{req.SoloTackles, "solo tackles"},
-		{req.AssistedTackles, "assisted tackles"},
-		{req.Sacks, "sacks"},
-		{req.DefensiveInterceptions, "defensive interceptions"},
-		{req.PassDeflections, "pass deflections"},
-		{req.ForcedFumbles, "forced fumbles"},
-		{req.FumbleRecoveries, "fumble recoveries"},
-		{req.DefensiveTouchdowns, "defensive touchdowns"},
-		{req.FieldGoalsAttempted, "field goals attempted"},
-		{req.FieldGoalsMade, "field goals made"},
-		{req.ExtraPointsAttempted, "extra points attempted"},
-		{req.ExtraPointsMade, "extra points made"},
-		{req.Punts, "punts"},
-		{req.PuntYards, "punt yards"},
-		{req.KickReturns, "kick returns"},
-		{req.KickReturnYards, "kick return yards"},
-		{req.KickReturnTouchdowns, "kick return touchdowns"},
-		{req.PuntReturns, "punt returns"},
-		{req.PuntReturnYards, "punt return yards"},
-		{req.PuntReturnTouchdowns, "punt return touchdowns"},
-	}
-
-	for _, field := range nonNegativeFields {
-		if field.value != nil && *field.value < 0 {
-			return fmt.Errorf("%s cannot be negative", field.name)
-		}
-	}
-
 	return nil
 }
@@ -0,0 +1,469 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/projection_service.go -pkg mocks . ProjectionService
+
+// ProjectionService defines the interface for fantasy projection business logic
+type ProjectionService interface {
+	GetPlayerProjections(playerID int, season string, week int, source string) ([]*models.Projection, error)
+	SavePlayerProjection(playerID int, req *models.CreateProjectionRequest) (*models.Projection, bool, error)
+	ImportProjections(reqs []*models.CreateProjectionRequest) (*models.ImportResult, error)
+	DeleteProjection(id int) error
+	GetWeeklyLeaders(season string, week int, source, position string, blend bool, limit int) (*models.ProjectionLeadersResponse, error)
+	GetAccuracy(season string, week int, source, position, groupBy string) (*models.ProjectionAccuracyReport, error)
+}
+
+// validAccuracyGroups are the ways an accuracy report can break down each source's error
+var validAccuracyGroups = []string{"position", "week", "player"}
+
+// minBlendMAE keeps a source with near-perfect early weeks from taking the whole blend
+const minBlendMAE = 1.0
+
+// projectionService implements ProjectionService interface
+type projectionService struct {
+	projectionRepo  repositories.ProjectionRepository
+	playerRepo      repositories.PlayerRepository
+	playerStatsRepo repositories.PlayerStatsRepository
+}
+
+// NewProjectionService creates a new projection service
+func NewProjectionService(projectionRepo repositories.ProjectionRepository, playerRepo repositories.PlayerRepository, playerStatsRepo repositories.PlayerStatsRepository) ProjectionService {
+	return &projectionService{
+		projectionRepo:  projectionRepo,
+		playerRepo:      playerRepo,
+		playerStatsRepo: playerStatsRepo,
+	}
+}
+
+// GetPlayerProjections retrieves a player's projections with the actual stat line and points
+// for each week that has been played
+func (s *projectionService) GetPlayerProjections(playerID int, season string, week int, source string) ([]*models.Projection, error) {
+	if err := s.checkPlayer(playerID); err != nil {
+		return nil, err
+	}
+
+	projections, err := s.projectionRepo.GetByPlayerID(playerID, season, week, strings.ToLower(strings.TrimSpace(source)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projections: %w", err)
+	}
+	if projections == nil {
+		projections = []*models.Projection{}
+	}
+
+	// Several sources usually project the same week, so each stat line is read once
+	actuals := make(map[int]map[string]float64)
+	for _, projection := range projections {
+		if projection.StatsID == nil {
+			continue
+		}
+
+		values, ok := actuals[*projection.StatsID]
+		if !ok {
+			stats, err := s.playerStatsRepo.GetByID(*projection.StatsID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get actual stats: %w", err)
+			}
+			values = statValues(stats)
+			actuals[*projection.StatsID] = values
+		}
+
+		points := fantasyPoints(values)
+		projection.ActualStats = values
+		projection.ActualPoints = &points
+	}
+
+	return projections, nil
+}
+
+// SavePlayerProjection creates or replaces a source's projection of the player for a week.
+// It reports whether the projection was created.
+func (s *projectionService) SavePlayerProjection(playerID int, req *models.CreateProjectionRequest) (*models.Projection, bool, error) {
+	if req.PlayerID != 0 && req.PlayerID != playerID {
+		return nil, false, fmt.Errorf("validation failed: player_id in the body must match the URL")
+	}
+	req.PlayerID = playerID
+
+	if err := validateCreateProjectionRequest(req); err != nil {
+		return nil, false, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if err := s.checkPlayer(playerID); err != nil {
+		return nil, false, err
+	}
+
+	projection := newProjectionFromRequest(req)
+	created, err := s.projectionRepo.Upsert(projection)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to save projection: %w", err)
+	}
+
+	return projection, created, nil
+}
+
+// ImportProjections creates or replaces many projections in one transaction. Nothing is
+// written unless every entry is valid and refers to an existing player.
+func (s *projectionService) ImportProjections(reqs []*models.CreateProjectionRequest) (*models.ImportResult, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("validation failed: at least one projection must be provided")
+	}
+
+	playerIDs := make([]int, len(reqs))
+	projections := make([]*models.Projection, 0, len(reqs))
+	for i, req := range reqs {
+		if req == nil {
+			return nil, fmt.Errorf("validation failed: projection %d is null", i)
+		}
+		if err := validateCreateProjectionRequest(req); err != nil {
+			return nil, fmt.Errorf("validation failed: projection %d: %w", i, err)
+		}
+		playerIDs[i] = req.PlayerID
+		projections = append(projections, newProjectionFromRequest(req))
+	}
+
+	if err := checkPlayersExist(s.playerRepo, playerIDs, "projection"); err != nil {
+		return nil, err
+	}
+
+	created, err := s.projectionRepo.UpsertMany(projections)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import projections: %w", err)
+	}
+
+	return &models.ImportResult{Created: created, Updated: len(projections) - created}, nil
+}
+
+// DeleteProjection deletes a projection
+func (s *projectionService) DeleteProjection(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid projection ID: %d", id)
+	}
+
+	if err := s.projectionRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete projection: %w", err)
+	}
+
+	return nil
+}
+
+// GetWeeklyLeaders ranks the week's players by projected points, with actual points for
+// players whose stat line has been recorded. With blend, sources are weighted by their
+// accuracy over the season's earlier weeks instead of averaged equally.
+func (s *projectionService) GetWeeklyLeaders(season string, week int, source, position string, blend bool, limit int) (*models.ProjectionLeadersResponse, error) {
+	if season == "" {
+		return nil, fmt.Errorf("season cannot be empty")
+	}
+	if week < 1 || week > 22 {
+		return nil, fmt.Errorf("validation failed: week must be between 1 and 22, got %d", week)
+	}
+
+	source = strings.ToLower(strings.TrimSpace(source))
+	if position != "" {
+		code, ok := models.NormalizePosition(position)
+		if !ok {
+			return nil, fmt.Errorf("validation failed: position must be one of: %v", models.Positions)
+		}
+		position = code
+	}
+
+	if limit < 1 || limit > 100 {
+		return nil, fmt.Errorf("validation failed: limit must be between 1 and 100, got %d", limit)
+	}
+
+	if blend && source != "" {
+		return nil, fmt.Errorf("validation failed: blend cannot be combined with a single source")
+	}
+
+	var weights map[string]float64
+	if blend && week > 1 {
+		accuracy, err := s.sourceAccuracy(season, 1, week-1)
+		if err != nil {
+			return nil, err
+		}
+		if len(accuracy) > 0 {
+			weights = make(map[string]float64, len(accuracy))
+			for _, summary := range accuracy {
+				weights[summary.Source] = summary.Weight
+			}
+		}
+	}
+
+	leaders, err := s.projectionRepo.GetLeaders(season, week, source, position, weights, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projection leaders: %w", err)
+	}
+	if leaders == nil {
+		leaders = []*models.ProjectionLeader{}
+	}
+
+	if len(leaders) > 0 {
+		statsList, err := s.playerStatsRepo.GetByWeek(season, week)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get player stats by week: %w", err)
+		}
+
+		// A player with two games in the week, such as after a trade, scores both
+		actual := make(map[int]float64, len(statsList))
+		for _, stats := range statsList {
+			actual[stats.PlayerID] += fantasyPoints(statValues(stats))
+		}
+		for _, leader := range leaders {
+			if points, ok := actual[leader.PlayerID]; ok {
+				leader.ActualPoints = &points
+			}
+		}
+	}
+
+	return &models.ProjectionLeadersResponse{
+		Season:   season,
+		Week:     week,
+		Source:   source,
+		Position: position,
+		Weights:  weights,
+		Leaders:  leaders,
+	}, nil
+}
+
+// GetAccuracy measures each source's projected points against the actual points of the
+// weeks that have been played, for one week or, when week is 0, the whole season, optionally
+// broken down by position, week or player
+func (s *projectionService) GetAccuracy(season string, week int, source, position, groupBy string) (*models.ProjectionAccuracyReport, error) {
+	if season == "" {
+		return nil, fmt.Errorf("season cannot be empty")
+	}
+	if week < 0 || week > 22 {
+		return nil, fmt.Errorf("validation failed: week must be between 1 and 22, or 0 for the whole season, got %d", week)
+	}
+
+	source = strings.ToLower(strings.TrimSpace(source))
+	if position != "" {
+		code, ok := models.NormalizePosition(position)
+		if !ok {
+			return nil, fmt.Errorf("validation failed: position must be one of: %v", models.Positions)
+		}
+		position = code
+	}
+
+	groupBy = strings.ToLower(strings.TrimSpace(groupBy))
+	if groupBy != "" {
+		if err := validateOneOf("group_by", groupBy, validAccuracyGroups); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+	}
+
+	fromWeek, toWeek := 1, 22
+	if week != 0 {
+		fromWeek, toWeek = week, week
+	}
+
+	scored, actual, err := s.scoredProjections(season, fromWeek, toWeek, source, position)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.ProjectionAccuracyReport{
+		Season:   season,
+		Week:     week,
+		Position: position,
+		GroupBy:  groupBy,
+		Sources:  summarizeAccuracy(scored, actual, nil),
+	}
+	setBlendWeights(report.Sources)
+
+	if groupBy != "" {
+		report.Groups = summarizeAccuracy(scored, actual, func(projection *models.ScoredProjection) string {
+			switch groupBy {
+			case "position":
+				return projection.Position
+			case "week":
+				return strconv.Itoa(projection.Week)
+			default:
+				return strconv.Itoa(projection.PlayerID)
+			}
+		})
+	}
+
+	return report, nil
+}
+
+// sourceAccuracy summarizes each source's error over a range of weeks, with blend weights
+func (s *projectionService) sourceAccuracy(season string, fromWeek, toWeek int) ([]*models.ProjectionAccuracy, error) {
+	scored, actual, err := s.scoredProjections(season, fromWeek, toWeek, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := summarizeAccuracy(scored, actual, nil)
+	setBlendWeights(summaries)
+	return summaries, nil
+}
+
+// scoredProjections loads the projections of played weeks and the actual points of the
+// stat lines they are measured against, keyed by stats ID
+func (s *projectionService) scoredProjections(season string, fromWeek, toWeek int, source, position string) ([]*models.ScoredProjection, map[int]float64, error) {
+	scored, err := s.projectionRepo.GetScored(season, fromWeek, toWeek, source, position)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get scored projections: %w", err)
+	}
+
+	actual := make(map[int]float64)
+	loaded := make(map[int]bool)
+	for _, projection := range scored {
+		if loaded[projection.Week] {
+			continue
+		}
+		loaded[projection.Week] = true
+
+		statsList, err := s.playerStatsRepo.GetByWeek(season, projection.Week)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get player stats by week: %w", err)
+		}
+		for _, stats := range statsList {
+			actual[stats.ID] = fantasyPoints(statValues(stats))
+		}
+	}
+
+	return scored, actual, nil
+}
+
+// summarizeAccuracy computes the MAE and bias per source, and per group within each source
+// when group is given, ordered by source and group
+func summarizeAccuracy(scored []*models.ScoredProjection, actual map[int]float64, group func(*models.ScoredProjection) string) []*models.ProjectionAccuracy {
+	type key struct{ source, group string }
+	summaries := make(map[key]*models.ProjectionAccuracy)
+	var keys []key
+
+	for _, projection := range scored {
+		points, ok := actual[projection.StatsID]
+		if !ok {
+			continue
+		}
+
+		k := key{source: projection.Source}
+		if group != nil {
+			k.group = group(projection)
+		}
+		summary, ok := summaries[k]
+		if !ok {
+			summary = &models.ProjectionAccuracy{Source: k.source, Group: k.group}
+			summaries[k] = summary
+			keys = append(keys, k)
+		}
+
+		diff := projection.Points - points
+		summary.Count++
+		summary.MAE += math.Abs(diff)
+		summary.Bias += diff
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].source != keys[j].source {
+			return keys[i].source < keys[j].source
+		}
+		return keys[i].group < keys[j].group
+	})
+
+	result := make([]*models.ProjectionAccuracy, 0, len(keys))
+	for _, k := range keys {
+		summary := summaries[k]
+		summary.MAE = math.Round(summary.MAE/float64(summary.Count)*100) / 100
+		summary.Bias = math.Round(summary.Bias/float64(summary.Count)*100) / 100
+		result = append(result, summary)
+	}
+
+	return result
+}
+
+// setBlendWeights gives each source a share of the blend proportional to its inverse MAE
+func setBlendWeights(summaries []*models.ProjectionAccuracy) {
+	total := 0.0
+	for _, summary := range summaries {
+		total += 1 / math.Max(summary.MAE, minBlendMAE)
+	}
+	for _, summary := range summaries {
+		summary.Weight = math.Round(1/math.Max(summary.MAE, minBlendMAE)/total*1000) / 1000
+	}
+}
+
+// checkPlayer validates the player ID and that the player exists
+func (s *projectionService) checkPlayer(playerID int) error {
+	if playerID <= 0 {
+		return fmt.Errorf("invalid player ID: %d", playerID)
+	}
+
+	exists, err := s.playerRepo.Exists(playerID)
+	if err != nil {
+		return fmt.Errorf("failed to check player existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("player with ID %d not found", playerID)
+	}
+
+	return nil
+}
+
+// newProjectionFromRequest builds a projection from a validated request, scoring the stat
+// line when the source did not provide points
+func newProjectionFromRequest(req *models.CreateProjectionRequest) *models.Projection {
+	stats := make(map[string]float64, len(req.Stats))
+	for name, value := range req.Stats {
+		stats[strings.ToLower(strings.TrimSpace(name))] = value
+	}
+
+	points := fantasyPoints(stats)
+	if req.Points != nil {
+		points = *req.Points
+	}
+
+	return &models.Projection{
+		PlayerID: req.PlayerID,
+		Season:   strings.TrimSpace(req.Season),
+		Week:     req.Week,
+		Source:   strings.ToLower(strings.TrimSpace(req.Source)),
+		Stats:    stats,
+		Points:   points,
+	}
+}
+
+// validateCreateProjectionRequest validates a create projection request
+func validateCreateProjectionRequest(req *models.CreateProjectionRequest) error {
+	if req.PlayerID <= 0 {
+		return fmt.Errorf("player ID is required and must be positive")
+	}
+
+	if strings.TrimSpace(req.Season) == "" {
+		return fmt.Errorf("season is required")
+	}
+
+	if req.Week < 1 || req.Week > 22 {
+		return fmt.Errorf("week must be between 1 and 22")
+	}
+
+	if strings.TrimSpace(req.Source) == "" {
+		return fmt.Errorf("source is required")
+	}
+
+	if len(req.Stats) == 0 && req.Points == nil {
+		return fmt.Errorf("at least one projected stat or points must be provided")
+	}
+
+	for name, value := range req.Stats {
+		if err := validateOneOf("projected stat", strings.ToLower(strings.TrimSpace(name)), models.SeasonStatNames); err != nil {
+			return err
+		}
+		if value < 0 {
+			return fmt.Errorf("projected %s cannot be negative", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/query_metrics_service.go -pkg mocks . QueryMetricsService
+
+// QueryMetricsService defines the interface for repository query metrics
+type QueryMetricsService interface {
+	GetQueryMetrics() *models.QueryMetrics
+}
+
+// queryMetricsService implements QueryMetricsService interface
+type queryMetricsService struct {
+	db *repositories.TimeoutDB
+}
+
+// NewQueryMetricsService creates a new query metrics service
+func NewQueryMetricsService(db *repositories.TimeoutDB) QueryMetricsService {
+	return &queryMetricsService{
+		db: db,
+	}
+}
+
+// GetQueryMetrics reports query counts, durations, slow queries and timeouts since startup
+func (s *queryMetricsService) GetQueryMetrics() *models.QueryMetrics {
+	metrics := s.db.Metrics()
+	if metrics.TimedOutQueries == nil {
+		metrics.TimedOutQueries = []*models.TimedOutQuery{}
+	}
+	if metrics.Statements == nil {
+		metrics.Statements = []*models.QueryStats{}
+	}
+	return metrics
+}
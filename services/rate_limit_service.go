@@ -0,0 +1,207 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+)
+
+//go:generate moq -out mocks/rate_limit_service.go -pkg mocks . RateLimitService
+
+// rateLimitIdle is how long a client goes without requests before it is forgotten. Budgets
+// refill within a minute, so an idle client has its full budget again.
+const rateLimitIdle = time.Minute
+
+// RateLimitService defines the interface for per-client request budgets
+type RateLimitService interface {
+	Enabled() bool
+	Allow(client, class string) *RateLimitDecision
+	GetLimits() *models.RateLimitReport
+	Reset(client string) error
+	ResetAll() int
+}
+
+// RateLimitDecision is whether a request may go ahead, with the budget left for its class
+type RateLimitDecision struct {
+	Allowed    bool
+	Limited    bool // false when the class has no budget
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration // until the next request would be allowed, when refused
+}
+
+// tokenBucket refills at limit tokens a minute, up to limit
+type tokenBucket struct {
+	limit  int
+	tokens float64
+	filled time.Time
+}
+
+// refill adds the tokens earned since the bucket was last filled
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.limit), b.tokens+now.Sub(b.filled).Minutes()*float64(b.limit))
+	b.filled = now
+}
+
+// rateLimitClient holds one client's buckets
+type rateLimitClient struct {
+	buckets   map[string]*tokenBucket
+	throttled int
+	lastSeen  time.Time
+}
+
+// rateLimitService keeps a token bucket per client and class in memory. Budgets are per
+// server process and start full after a restart.
+type rateLimitService struct {
+	limits map[string]int // requests per minute by class; a class without one is not limited
+	clock  clock.Clock
+
+	mu        sync.Mutex
+	clients   map[string]*rateLimitClient
+	lastPrune time.Time
+}
+
+// NewRateLimitService creates a new rate limit service allowing each client readPerMinute
+// reads and writePerMinute writes a minute, with bursts of up to a minute's budget. A budget
+// of 0 leaves that class unlimited.
+func NewRateLimitService(readPerMinute, writePerMinute int, clock clock.Clock) RateLimitService {
+	limits := make(map[string]int)
+	if readPerMinute > 0 {
+		limits[models.RateLimitRead] = readPerMinute
+	}
+	if writePerMinute > 0 {
+		limits[models.RateLimitWrite] = writePerMinute
+	}
+	return &rateLimitService{
+		limits:  limits,
+		clock:   clock,
+		clients: make(map[string]*rateLimitClient),
+	}
+}
+
+// Enabled reports whether any class of request is limited
+func (s *rateLimitService) Enabled() bool {
+	return len(s.limits) > 0
+}
+
+// Allow takes one request of class from client's budget, refusing it when the budget is spent
+func (s *rateLimitService) Allow(client, class string) *RateLimitDecision {
+	limit, ok := s.limits[class]
+	if !ok {
+		return &RateLimitDecision{Allowed: true}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	s.prune(now)
+
+	c, ok := s.clients[client]
+	if !ok {
+		c = &rateLimitClient{buckets: make(map[string]*tokenBucket)}
+		s.clients[client] = c
+	}
+	c.lastSeen = now
+
+	bucket, ok := c.buckets[class]
+	if !ok {
+		bucket = &tokenBucket{limit: limit, tokens: float64(limit), filled: now}
+		c.buckets[class] = bucket
+	}
+	bucket.refill(now)
+
+	decision := &RateLimitDecision{Limited: true, Limit: limit}
+	if bucket.tokens < 1 {
+		c.throttled++
+		decision.RetryAfter = time.Duration((1 - bucket.tokens) / float64(limit) * float64(time.Minute))
+		return decision
+	}
+	bucket.tokens--
+	decision.Allowed = true
+	decision.Remaining = int(bucket.tokens)
+	return decision
+}
+
+// GetLimits reports the budgets of every client seen in the last minute, the most throttled
+// first
+func (s *rateLimitService) GetLimits() *models.RateLimitReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	s.prune(now)
+
+	report := &models.RateLimitReport{
+		Enabled:        s.Enabled(),
+		ReadPerMinute:  s.limits[models.RateLimitRead],
+		WritePerMinute: s.limits[models.RateLimitWrite],
+		Clients:        []*models.ClientRateLimit{},
+	}
+	for address, c := range s.clients {
+		client := &models.ClientRateLimit{Client: address, Throttled: c.throttled, LastSeen: c.lastSeen}
+		for class, limit := range s.limits {
+			bucket, ok := c.buckets[class]
+			remaining := limit
+			if ok {
+				bucket.refill(now)
+				remaining = int(bucket.tokens)
+			}
+			budget := &models.RateLimitBucket{Limit: limit, Remaining: remaining}
+			if class == models.RateLimitRead {
+				client.Read = budget
+			} else {
+				client.Write = budget
+			}
+		}
+		report.Clients = append(report.Clients, client)
+	}
+
+	sort.SliceStable(report.Clients, func(i, j int) bool {
+		if report.Clients[i].Throttled != report.Clients[j].Throttled {
+			return report.Clients[i].Throttled > report.Clients[j].Throttled
+		}
+		return report.Clients[i].Client < report.Clients[j].Client
+	})
+	return report
+}
+
+// Reset restores a client's full budgets
+func (s *rateLimitService) Reset(client string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.clients[client]; !ok {
+		return fmt.Errorf("rate limit client %s not found", client)
+	}
+	delete(s.clients, client)
+	return nil
+}
+
+// ResetAll restores every client's full budgets and returns how many clients were reset
+func (s *rateLimitService) ResetAll() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reset := len(s.clients)
+	s.clients = make(map[string]*rateLimitClient)
+	return reset
+}
+
+// prune forgets idle clients, at most once a minute. The caller must hold s.mu.
+func (s *rateLimitService) prune(now time.Time) {
+	if now.Sub(s.lastPrune) < rateLimitIdle {
+		return
+	}
+	s.lastPrune = now
+	for address, c := range s.clients {
+		if now.Sub(c.lastSeen) >= rateLimitIdle {
+			delete(s.clients, address)
+		}
+	}
+}
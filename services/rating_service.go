@@ -0,0 +1,278 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/rating_service.go -pkg mocks . RatingService
+
+// RatingService defines the interface for Elo team ratings
+type RatingService interface {
+	GetRatings(season string) (*models.TeamRatings, error)
+	GetTeamRatingHistory(teamID int, season string) (*models.TeamRatingHistory, error)
+	GetPredictions(season string, week int) ([]*models.GamePrediction, error)
+	GetWinProbability(gameID int) (*models.WinProbability, error)
+}
+
+// ratingService implements RatingService interface. Ratings are not stored: every request
+// replays the completed games, so corrected scores and deleted games are always reflected.
+type ratingService struct {
+	gameRepo repositories.GameRepository
+	teamRepo repositories.TeamRepository
+	oddsRepo repositories.OddsRepository
+}
+
+// NewRatingService creates a new rating service
+func NewRatingService(gameRepo repositories.GameRepository, teamRepo repositories.TeamRepository, oddsRepo repositories.OddsRepository) RatingService {
+	return &ratingService{
+		gameRepo: gameRepo,
+		teamRepo: teamRepo,
+		oddsRepo: oddsRepo,
+	}
+}
+
+// GetRatings ranks every team by its rating through the games of season played so far, with
+// its record in those games. An empty season uses the latest season.
+func (s *ratingService) GetRatings(season string) (*models.TeamRatings, error) {
+	season, err := s.resolveSeason(season)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := s.gameRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+	teams, err := s.teamRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+
+	elo, _, _ := replayElo(games, season)
+
+	ratings := make([]*models.TeamRating, 0, len(teams))
+	byTeam := make(map[int]*models.TeamRating, len(teams))
+	for _, team := range teams {
+		rating := &models.TeamRating{
+			TeamID:   team.ID,
+			TeamName: team.Name,
+			TeamCity: team.City,
+			Rating:   roundPoints(elo.rating(team.ID, season)),
+		}
+		ratings = append(ratings, rating)
+		byTeam[team.ID] = rating
+	}
+
+	for _, game := range games {
+		if game.Season != season || !eloRated(game) {
+			continue
+		}
+		home, away := byTeam[game.HomeTeamID], byTeam[game.AwayTeamID]
+		for _, rating := range []*models.TeamRating{home, away} {
+			if rating == nil {
+				continue
+			}
+			rating.Games++
+			switch teamResult(gameResult(game), rating.TeamID) {
+			case models.ResultWin:
+				rating.Wins++
+			case models.ResultLoss:
+				rating.Losses++
+			case models.ResultTie:
+				rating.Ties++
+			}
+		}
+	}
+
+	sort.SliceStable(ratings, func(i, j int) bool {
+		if ratings[i].Rating != ratings[j].Rating {
+			return ratings[i].Rating > ratings[j].Rating
+		}
+		return ratings[i].TeamID < ratings[j].TeamID
+	})
+	for i, rating := range ratings {
+		rating.Rank = i + 1
+	}
+
+	return &models.TeamRatings{Season: season, Ratings: ratings}, nil
+}
+
+// GetTeamRatingHistory retrieves the change to a team's rating from each of its completed
+// games, oldest first, optionally limited to one season
+func (s *ratingService) GetTeamRatingHistory(teamID int, season string) (*models.TeamRatingHistory, error) {
+	if teamID <= 0 {
+		return nil, fmt.Errorf("invalid team ID: %d", teamID)
+	}
+
+	exists, err := s.teamRepo.Exists(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify team existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("team with ID %d not found", teamID)
+	}
+
+	games, err := s.gameRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+
+	season = strings.TrimSpace(season)
+	elo, homeChanges, awayChanges := replayElo(games, "")
+
+	history := &models.TeamRatingHistory{
+		TeamID:  teamID,
+		Rating:  roundPoints(elo.rating(teamID, "")),
+		Changes: []*models.RatingChange{},
+	}
+	for i := range homeChanges {
+		// Each side's change names the other team as its opponent
+		var change *models.RatingChange
+		switch teamID {
+		case awayChanges[i].OpponentID:
+			change = homeChanges[i]
+		case homeChanges[i].OpponentID:
+			change = awayChanges[i]
+		}
+		if change == nil || (season != "" && change.Season != season) {
+			continue
+		}
+		history.Changes = append(history.Changes, change)
+	}
+	return history, nil
+}
+
+// GetPredictions gives the win probabilities the current ratings imply for the scheduled games
+// of season, or of one week when week is positive, earliest kickoff first. An empty season uses
+// the latest season.
+func (s *ratingService) GetPredictions(season string, week int) ([]*models.GamePrediction, error) {
+	if week < 0 {
+		return nil, fmt.Errorf("validation failed: week must be positive")
+	}
+
+	season, err := s.resolveSeason(season)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := s.gameRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+
+	elo, _, _ := replayElo(games, season)
+
+	predictions := []*models.GamePrediction{}
+	for _, game := range games {
+		if game.Season != season || game.Status != "scheduled" || (week > 0 && game.Week != week) {
+			continue
+		}
+		probability := roundProbability(elo.homeWinProbability(game))
+		predictions = append(predictions, &models.GamePrediction{
+			GameID:             game.ID,
+			Season:             game.Season,
+			Week:               game.Week,
+			GameDate:           game.GameDate,
+			HomeTeamID:         game.HomeTeamID,
+			AwayTeamID:         game.AwayTeamID,
+			NeutralSite:        game.NeutralSite,
+			HomeRating:         roundPoints(elo.rating(game.HomeTeamID, game.Season)),
+			AwayRating:         roundPoints(elo.rating(game.AwayTeamID, game.Season)),
+			HomeWinProbability: probability,
+			AwayWinProbability: roundProbability(1 - probability),
+		})
+	}
+
+	sort.SliceStable(predictions, func(i, j int) bool {
+		return predictions[i].GameDate.Before(predictions[j].GameDate)
+	})
+	return predictions, nil
+}
+
+// GetWinProbability gives a game's win probabilities from the teams' ratings and home-field
+// advantage, averaged with the probability implied by the latest betting line when there is
+// one. A completed game uses the ratings entering it; any other game uses current ratings.
+func (s *ratingService) GetWinProbability(gameID int) (*models.WinProbability, error) {
+	if gameID <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+
+	game, err := s.gameRepo.GetByID(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := s.gameRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+
+	elo, homeChanges, awayChanges := replayElo(games, game.Season)
+
+	probability := &models.WinProbability{
+		GameID:             game.ID,
+		Season:             game.Season,
+		Week:               game.Week,
+		GameDate:           game.GameDate,
+		Status:             game.Status,
+		HomeTeamID:         game.HomeTeamID,
+		AwayTeamID:         game.AwayTeamID,
+		NeutralSite:        game.NeutralSite,
+		HomeRating:         elo.rating(game.HomeTeamID, game.Season),
+		AwayRating:         elo.rating(game.AwayTeamID, game.Season),
+		HomeFieldAdvantage: eloHomeEdge(game),
+	}
+	for i, change := range homeChanges {
+		if change.GameID == game.ID {
+			probability.HomeRating = change.RatingBefore
+			probability.AwayRating = awayChanges[i].RatingBefore
+			break
+		}
+	}
+	ratingProbability := eloWinProbability(probability.HomeRating + probability.HomeFieldAdvantage - probability.AwayRating)
+	probability.HomeRating = roundPoints(probability.HomeRating)
+	probability.AwayRating = roundPoints(probability.AwayRating)
+	probability.RatingHomeWinProbability = roundProbability(ratingProbability)
+
+	latest, err := s.oddsRepo.GetLatestByGameIDs([]int{game.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest odds: %w", err)
+	}
+
+	home := ratingProbability
+	if odds, ok := latest[game.ID]; ok {
+		if market, ok := marketHomeWinProbability(odds); ok {
+			probability.Market = &models.MarketProbability{
+				Source:             odds.Source,
+				CapturedAt:         odds.CapturedAt,
+				Spread:             odds.Spread,
+				HomeMoneyline:      odds.HomeMoneyline,
+				AwayMoneyline:      odds.AwayMoneyline,
+				HomeWinProbability: roundProbability(market),
+			}
+			home = ratingProbability*(1-marketWeight) + market*marketWeight
+		}
+	}
+	probability.HomeWinProbability = roundProbability(home)
+	probability.AwayWinProbability = roundProbability(1 - home)
+	return probability, nil
+}
+
+// resolveSeason trims season, defaulting to the latest season with games
+func (s *ratingService) resolveSeason(season string) (string, error) {
+	season = strings.TrimSpace(season)
+	if season != "" {
+		return season, nil
+	}
+
+	latest, err := s.gameRepo.GetLatestSeason()
+	if err != nil {
+		return "", fmt.Errorf("failed to get latest season: %w", err)
+	}
+	return latest, nil
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"fmt"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/reconciliation_service.go -pkg mocks . ReconciliationService
+
+// ReconciliationService defines the interface for checking a game's stats for consistency
+type ReconciliationService interface {
+	ReconcileGame(gameID int) (*models.GameReconciliation, error)
+}
+
+// reconciliationService implements ReconciliationService interface
+type reconciliationService struct {
+	gameRepo        repositories.GameRepository
+	playerRepo      repositories.PlayerRepository
+	playerStatsRepo repositories.PlayerStatsRepository
+}
+
+// NewReconciliationService creates a new reconciliation service
+func NewReconciliationService(gameRepo repositories.GameRepository, playerRepo repositories.PlayerRepository, playerStatsRepo repositories.PlayerStatsRepository) ReconciliationService {
+	return &reconciliationService{
+		gameRepo:        gameRepo,
+		playerRepo:      playerRepo,
+		playerStatsRepo: playerStatsRepo,
+	}
+}
+
+// ReconcileGame totals each side's stat lines and reports where they disagree with each other
+// or with the final score. Lines are credited to a side by the player's current team, so lines
+// of players who have changed teams since are listed as unattributed instead.
+func (s *reconciliationService) ReconcileGame(gameID int) (*models.GameReconciliation, error) {
+	if gameID <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+
+	game, err := s.gameRepo.GetByID(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	sides := make(map[int]*models.TeamReconciliation, 2)
+	home := &models.TeamReconciliation{TeamID: game.HomeTeamID, Score: game.HomeScore, Discrepancies: []string{}}
+	away := &models.TeamReconciliation{TeamID: game.AwayTeamID, Score: game.AwayScore, Discrepancies: []string{}}
+	for _, side := range []*models.TeamReconciliation{home, away} {
+		players, err := s.playerRepo.GetByTeamID(side.TeamID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team players: %w", err)
+		}
+		for _, player := range players {
+			sides[player.ID] = side
+		}
+	}
+
+	statsList, err := s.playerStatsRepo.GetByGameID(gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player stats by game: %w", err)
+	}
+
+	reconciliation := &models.GameReconciliation{
+		GameID:       game.ID,
+		Status:       game.Status,
+		Home:         home,
+		Away:         away,
+		Unattributed: []int{},
+	}
+	for _, stats := range statsList {
+		side, ok := sides[stats.PlayerID]
+		if !ok {
+			reconciliation.Unattributed = append(reconciliation.Unattributed, stats.PlayerID)
+			continue
+		}
+		addToReconciliation(side, stats)
+	}
+
+	completed := game.Status == "completed"
+	checkReconciliation(home, completed)
+	checkReconciliation(away, completed)
+	reconciliation.Consistent = len(home.Discrepancies) == 0 && len(away.Discrepancies) == 0
+
+	return reconciliation, nil
+}
+
+// addToReconciliation adds a stat line to its side's totals
+func addToReconciliation(side *models.TeamReconciliation, stats *models.PlayerStats) {
+	side.StatLines++
+	side.PassingYards += intValue(stats.PassingYards)
+	side.ReceivingYards += intValue(stats.ReceivingYards)
+	side.Completions += intValue(stats.PassingCompletions)
+	side.Receptions += intValue(stats.Receptions)
+	side.PassingTouchdowns += intValue(stats.PassingTouchdowns)
+	side.ReceivingTouchdowns += intValue(stats.ReceivingTouchdowns)
+	side.RushingYards += intValue(stats.RushingYards)
+
+	// Passing touchdowns are counted once, as the receiver's
+	touchdowns := intValue(stats.RushingTouchdowns) + intValue(stats.ReceivingTouchdowns) +
+		intValue(stats.KickReturnTouchdowns) + intValue(stats.PuntReturnTouchdowns) +
+		intValue(stats.DefensiveTouchdowns)
+	side.Touchdowns += touchdowns
+	side.ScoringPoints += 6*touchdowns + 3*intValue(stats.FieldGoalsMade) + intValue(stats.ExtraPointsMade)
+}
+
+// checkReconciliation lists a side's discrepancies: passing totals that differ from receiving
+// totals and, once the game is completed, scoring plays that can't make up the final score. A
+// side without stat lines isn't checked.
+func checkReconciliation(side *models.TeamReconciliation, completed bool) {
+	if side.StatLines == 0 {
+		return
+	}
+
+	if side.PassingYards != side.ReceivingYards {
+		side.Discrepancies = append(side.Discrepancies, fmt.Sprintf("%d passing yards but %d receiving yards", side.PassingYards, side.ReceivingYards))
+	}
+	if side.Completions != side.Receptions {
+		side.Discrepancies = append(side.Discrepancies, fmt.Sprintf("%d completions but %d receptions", side.Completions, side.Receptions))
+	}
+	if side.PassingTouchdowns != side.ReceivingTouchdowns {
+		side.Discrepancies = append(side.Discrepancies, fmt.Sprintf("%d passing touchdowns but %d receiving touchdowns", side.PassingTouchdowns, side.ReceivingTouchdowns))
+	}
+
+	if !completed || side.Score == nil {
+		return
+	}
+
+	// What the scoring plays leave of the final score can only be two-point conversions, at
+	// most one a touchdown, and safeties, of which more than one is vanishingly rare
+	unexplained := *side.Score - side.ScoringPoints
+	side.Unexplained = &unexplained
+	switch {
+	case unexplained < 0:
+		side.Discrepancies = append(side.Discrepancies, fmt.Sprintf("scoring plays add up to %d points, more than the final score of %d", side.ScoringPoints, *side.Score))
+	case unexplained%2 != 0:
+		side.Discrepancies = append(side.Discrepancies, fmt.Sprintf("%d points of the final score are not scoring plays and are odd, so they can't be two-point conversions or safeties", unexplained))
+	case unexplained > 2*side.Touchdowns+2:
+		side.Discrepancies = append(side.Discrepancies, fmt.Sprintf("%d points of the final score are not scoring plays, more than two-point conversions after %d touchdowns and a safety can explain", unexplained, side.Touchdowns))
+	}
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/record_service.go -pkg mocks . RecordService
+
+// RecordService defines the interface for the record book
+type RecordService interface {
+	GetRecords() (*models.RecordBook, error)
+}
+
+// recordService implements RecordService interface
+type recordService struct {
+	recordRepo repositories.RecordRepository
+	gameRepo   repositories.GameRepository
+	teamRepo   repositories.TeamRepository
+}
+
+// NewRecordService creates a new record service
+func NewRecordService(recordRepo repositories.RecordRepository, gameRepo repositories.GameRepository, teamRepo repositories.TeamRepository) RecordService {
+	return &recordService{
+		recordRepo: recordRepo,
+		gameRepo:   gameRepo,
+		teamRepo:   teamRepo,
+	}
+}
+
+// GetRecords retrieves the single game and season record for each record stat, and the team
+// records from completed games
+func (s *recordService) GetRecords() (*models.RecordBook, error) {
+	book := &models.RecordBook{}
+
+	var err error
+	if book.SingleGame, err = s.statRecords(models.RecordScopeGame); err != nil {
+		return nil, err
+	}
+	if book.Season, err = s.statRecords(models.RecordScopeSeason); err != nil {
+		return nil, err
+	}
+	if book.Team, err = s.teamRecords(); err != nil {
+		return nil, err
+	}
+
+	return book, nil
+}
+
+// statRecords retrieves a scope's records, labelled and in the order of models.RecordStats
+func (s *recordService) statRecords(scope string) ([]*models.StatRecord, error) {
+	records, err := s.recordRepo.GetStatRecords(scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s records: %w", scope, err)
+	}
+
+	labels := map[string]string{models.RecordFantasyPoints: "Fantasy Points"}
+	for _, definition := range models.FootballStats {
+		labels[definition.Name] = definition.Label
+	}
+	order := make(map[string]int, len(models.RecordStats))
+	for i, stat := range models.RecordStats {
+		order[stat] = i
+	}
+
+	for _, record := range records {
+		record.Label = labels[record.Stat]
+		record.Value = roundPoints(record.Value)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return order[records[i].Stat] < order[records[j].Stat]
+	})
+
+	if records == nil {
+		records = []*models.StatRecord{}
+	}
+	return records, nil
+}
+
+// teamRecords finds the longest winning streak and the most points scored in a game over
+// completed regular season and playoff games. The earliest holder keeps a tied record.
+func (s *recordService) teamRecords() ([]*models.TeamRecord, error) {
+	games, err := s.gameRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+
+	var completed []*models.Game
+	for _, game := range games {
+		if eloRated(game) {
+			completed = append(completed, game)
+		}
+	}
+	sort.SliceStable(completed, func(i, j int) bool {
+		if !completed[i].GameDate.Equal(completed[j].GameDate) {
+			return completed[i].GameDate.Before(completed[j].GameDate)
+		}
+		return completed[i].ID < completed[j].ID
+	})
+
+	type streak struct {
+		length int
+		start  *models.Game
+		end    *models.Game
+	}
+	current := make(map[int]*streak)
+	var longest *models.TeamRecord
+	var mostPoints *models.TeamRecord
+
+	for _, game := range completed {
+		result := gameResult(game)
+		for _, side := range []struct {
+			teamID int
+			score  int
+		}{{game.HomeTeamID, *game.HomeScore}, {game.AwayTeamID, *game.AwayScore}} {
+			if mostPoints == nil || side.score > mostPoints.Value {
+				gameID := game.ID
+				mostPoints = &models.TeamRecord{
+					Record: models.RecordMostPoints, Label: "Most Points in a Game", Value: side.score,
+					TeamID: side.teamID, GameID: &gameID, StartDate: game.GameDate, EndDate: game.GameDate,
+				}
+			}
+
+			run, ok := current[side.teamID]
+			if !ok {
+				run = &streak{}
+				current[side.teamID] = run
+			}
+			if teamResult(result, side.teamID) != models.ResultWin {
+				// A loss or tie ends the streak, including the record one if it was still going
+				run.length = 0
+				if longest != nil && longest.TeamID == side.teamID {
+					longest.Active = false
+				}
+				continue
+			}
+			if run.length == 0 {
+				run.start = game
+			}
+			run.length++
+			run.end = game
+			if longest == nil || run.length > longest.Value {
+				longest = &models.TeamRecord{
+					Record: models.RecordLongestWinStreak, Label: "Longest Winning Streak", Value: run.length,
+					TeamID: side.teamID, StartDate: run.start.GameDate, EndDate: run.end.GameDate, Active: true,
+				}
+			}
+		}
+	}
+
+	records := []*models.TeamRecord{}
+	for _, record := range []*models.TeamRecord{longest, mostPoints} {
+		if record != nil {
+			records = append(records, record)
+		}
+	}
+
+	teamIDs := make([]int, len(records))
+	for i, record := range records {
+		teamIDs[i] = record.TeamID
+	}
+	teams, err := s.teamRepo.GetByIDs(teamIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+	for _, record := range records {
+		if team, ok := teams[record.TeamID]; ok {
+			record.TeamName = team.Name
+			record.TeamCity = team.City
+		}
+	}
+
+	return records, nil
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/schedule_change_service.go -pkg mocks . ScheduleChangeService
+
+// scheduleWeekZone is the timezone league weeks are counted in. A week runs from Tuesday to
+// Monday, so a game can be flexed anywhere from the Thursday night game to Monday night.
+const scheduleWeekZone = "America/New_York"
+
+// ScheduleChangeService defines the interface for moving kickoffs within their week
+type ScheduleChangeService interface {
+	RescheduleGame(gameID int, req *models.RescheduleGameRequest) (*models.ScheduleChange, error)
+	GetGameScheduleChanges(gameID int) ([]*models.ScheduleChange, error)
+	ListScheduleChanges(season string, since *time.Time, limit int) ([]*models.ScheduleChange, error)
+}
+
+// scheduleChangeService implements ScheduleChangeService interface
+type scheduleChangeService struct {
+	scheduleChangeRepo repositories.ScheduleChangeRepository
+	gameRepo           repositories.GameRepository
+	events             EventService
+}
+
+// NewScheduleChangeService creates a new schedule change service
+func NewScheduleChangeService(scheduleChangeRepo repositories.ScheduleChangeRepository, gameRepo repositories.GameRepository, events EventService) ScheduleChangeService {
+	return &scheduleChangeService{
+		scheduleChangeRepo: scheduleChangeRepo,
+		gameRepo:           gameRepo,
+		events:             events,
+	}
+}
+
+// RescheduleGame moves a scheduled game's kickoff within its week, records the previous
+// kickoff and publishes a game.rescheduled event
+func (s *scheduleChangeService) RescheduleGame(gameID int, req *models.RescheduleGameRequest) (*models.ScheduleChange, error) {
+	if gameID <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+	if req.GameDate.IsZero() {
+		return nil, fmt.Errorf("validation failed: game date is required")
+	}
+
+	game, err := s.gameRepo.GetByID(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if game.Status != "scheduled" {
+		return nil, fmt.Errorf("validation failed: only scheduled games can be moved, game %d is %s", gameID, game.Status)
+	}
+	if req.GameDate.Equal(game.GameDate) {
+		return nil, fmt.Errorf("validation failed: game %d already kicks off at %s", gameID, game.GameDate.UTC().Format(time.RFC3339))
+	}
+
+	start, end := scheduleWeek(game.GameDate)
+	if req.GameDate.Before(start) || !req.GameDate.Before(end) {
+		return nil, fmt.Errorf("validation failed: game %d must stay in its week, from %s to %s",
+			gameID, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	var reason *string
+	if trimmed := strings.TrimSpace(req.Reason); trimmed != "" {
+		reason = &trimmed
+	}
+
+	change, err := s.scheduleChangeRepo.Reschedule(gameID, req.GameDate, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(models.EventGameRescheduled, change)
+	return change, nil
+}
+
+// GetGameScheduleChanges retrieves every move of a game's kickoff, newest first
+func (s *scheduleChangeService) GetGameScheduleChanges(gameID int) ([]*models.ScheduleChange, error) {
+	if gameID <= 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+
+	exists, err := s.gameRepo.Exists(gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if game exists: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("game with ID %d not found", gameID)
+	}
+
+	return s.scheduleChangeRepo.GetByGameID(gameID)
+}
+
+// ListScheduleChanges retrieves up to limit recent kickoff moves, newest first, so clients can
+// catch up on changes made while they were not subscribed to events
+func (s *scheduleChangeService) ListScheduleChanges(season string, since *time.Time, limit int) ([]*models.ScheduleChange, error) {
+	if limit < 1 || limit > maxPageSize {
+		return nil, fmt.Errorf("validation failed: limit must be between 1 and %d", maxPageSize)
+	}
+
+	return s.scheduleChangeRepo.List(strings.TrimSpace(season), since, limit)
+}
+
+// scheduleWeek returns the league week containing kickoff, from midnight Tuesday to the next
+// Tuesday in scheduleWeekZone
+func scheduleWeek(kickoff time.Time) (time.Time, time.Time) {
+	location, err := time.LoadLocation(scheduleWeekZone)
+	if err != nil {
+		location = time.FixedZone("EST", -5*60*60)
+	}
+
+	local := kickoff.In(location)
+	daysSinceTuesday := (int(local.Weekday()) - int(time.Tuesday) + 7) % 7
+	start := time.Date(local.Year(), local.Month(), local.Day()-daysSinceTuesday, 0, 0, 0, 0, location)
+	return start, start.AddDate(0, 0, 7)
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/schedule_strength_service.go -pkg mocks . ScheduleStrengthService
+
+// ScheduleStrengthService defines the interface for strength of schedule business logic
+type ScheduleStrengthService interface {
+	GetScheduleStrength(teamID int, season, position string) (*models.ScheduleStrength, error)
+}
+
+// scheduleStrengthService implements ScheduleStrengthService interface
+type scheduleStrengthService struct {
+	scheduleStrengthRepo repositories.ScheduleStrengthRepository
+	teamRepo             repositories.TeamRepository
+	gameRepo             repositories.GameRepository
+}
+
+// NewScheduleStrengthService creates a new schedule strength service
+func NewScheduleStrengthService(scheduleStrengthRepo repositories.ScheduleStrengthRepository, teamRepo repositories.TeamRepository, gameRepo repositories.GameRepository) ScheduleStrengthService {
+	return &scheduleStrengthService{
+		scheduleStrengthRepo: scheduleStrengthRepo,
+		teamRepo:             teamRepo,
+		gameRepo:             gameRepo,
+	}
+}
+
+// GetScheduleStrength rates the team's games of the season that are not yet completed or
+// cancelled by the fantasy points each opponent has allowed per game to the position. An
+// empty season uses the latest season.
+func (s *scheduleStrengthService) GetScheduleStrength(teamID int, season, position string) (*models.ScheduleStrength, error) {
+	if teamID <= 0 {
+		return nil, fmt.Errorf("invalid team ID: %d", teamID)
+	}
+
+	code, ok := models.NormalizePosition(position)
+	if !ok {
+		return nil, fmt.Errorf("validation failed: position must be one of: %v", models.Positions)
+	}
+
+	exists, err := s.teamRepo.Exists(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify team existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("team with ID %d not found", teamID)
+	}
+
+	season = strings.TrimSpace(season)
+	if season == "" {
+		season, err = s.gameRepo.GetLatestSeason()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest season: %w", err)
+		}
+	}
+
+	defenses, err := s.scheduleStrengthRepo.GetPointsAllowed(season, code, defaultScoring)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get points allowed: %w", err)
+	}
+
+	// Rank the defenses that have played by points allowed per game, fewest first
+	perGame := make(map[int]float64)
+	var allowed []float64
+	total := 0.0
+	for _, defense := range defenses {
+		if defense.GamesPlayed == 0 {
+			continue
+		}
+		value := roundPoints(defense.Points / float64(defense.GamesPlayed))
+		perGame[defense.TeamID] = value
+		allowed = append(allowed, value)
+		total += value
+	}
+	sort.Float64s(allowed)
+
+	strength := &models.ScheduleStrength{
+		TeamID:   teamID,
+		Season:   season,
+		Position: code,
+		Games:    []*models.ScheduleStrengthGame{},
+	}
+	if len(allowed) > 0 {
+		strength.LeagueAverage = roundPoints(total / float64(len(allowed)))
+	}
+
+	games, err := s.gameRepo.GetByTeamID(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team games: %w", err)
+	}
+
+	byTeam := make(map[int]*models.DefensePointsAllowed, len(defenses))
+	for _, defense := range defenses {
+		byTeam[defense.TeamID] = defense
+	}
+
+	rated, sum := 0, 0.0
+	for _, game := range games {
+		if game.Season != season || game.GameType != models.GameTypeRegular || game.Status == "completed" || game.Status == "cancelled" {
+			continue
+		}
+
+		entry := &models.ScheduleStrengthGame{
+			GameID:     game.ID,
+			Week:       game.Week,
+			GameDate:   game.GameDate,
+			Home:       game.HomeTeamID == teamID,
+			OpponentID: game.HomeTeamID,
+		}
+		if entry.Home {
+			entry.OpponentID = game.AwayTeamID
+		}
+		if opponent, ok := byTeam[entry.OpponentID]; ok {
+			entry.OpponentName = opponent.TeamName
+			entry.OpponentCity = opponent.TeamCity
+			entry.OpponentGames = opponent.GamesPlayed
+		}
+
+		if value, ok := perGame[entry.OpponentID]; ok {
+			rank := sort.SearchFloat64s(allowed, value) + 1
+			vsAverage := roundPoints(value - strength.LeagueAverage)
+			entry.PointsAllowed = &value
+			entry.Rank = &rank
+			entry.VsAverage = &vsAverage
+			rated++
+			sum += value
+		}
+
+		strength.Games = append(strength.Games, entry)
+	}
+
+	// The team's games come newest first; the schedule reads in order of play
+	sort.SliceStable(strength.Games, func(i, j int) bool {
+		return strength.Games[i].GameDate.Before(strength.Games[j].GameDate)
+	})
+
+	if rated > 0 {
+		average := roundPoints(sum / float64(rated))
+		vsAverage := roundPoints(average - strength.LeagueAverage)
+		strength.AveragePointsAllowed = &average
+		strength.VsAverage = &vsAverage
+	}
+
+	return strength, nil
+}
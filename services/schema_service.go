@@ -0,0 +1,30 @@
+package services
+
+import (
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/schema_service.go -pkg mocks . SchemaService
+
+// SchemaService defines the interface for describing the database schema
+type SchemaService interface {
+	GetSchema() (*models.Schema, error)
+}
+
+// schemaService implements SchemaService interface
+type schemaService struct {
+	schemaRepo repositories.SchemaRepository
+}
+
+// NewSchemaService creates a new schema service
+func NewSchemaService(schemaRepo repositories.SchemaRepository) SchemaService {
+	return &schemaService{
+		schemaRepo: schemaRepo,
+	}
+}
+
+// GetSchema describes the tables of the live database
+func (s *schemaService) GetSchema() (*models.Schema, error) {
+	return s.schemaRepo.GetSchema()
+}
@@ -0,0 +1,557 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"sports-backend/models"
+)
+
+// Scoring expressions compute fantasy points from one stat line. They use the stat names
+// (missing stats are 0), position (the player's position code) and number or string
+// literals, with + - * /, comparisons, && || !, cond ? a : b and the functions min, max,
+// floor, ceil, abs and round. Comparisons and logic give 1 or 0. For example:
+//
+//	rushing_yards >= 100 ? 3 : 0
+//	receptions * (position == "TE" ? 1.5 : 1)
+//	floor(passing_yards / 25)
+
+// scoringEnv is what a compiled expression is evaluated against
+type scoringEnv struct {
+	stats    map[string]float64
+	position string
+}
+
+// A compiled expression is a tree of closures, typed at compile time
+type (
+	numExpr func(env *scoringEnv) float64
+	strExpr func(env *scoringEnv) string
+)
+
+// compiledExpr is either a number or a string expression
+type compiledExpr struct {
+	num numExpr
+	str strExpr
+}
+
+// scoringFunctions are the functions expressions can call, by name and argument count
+var scoringFunctions = map[string]struct {
+	args int
+	call func(args []float64) float64
+}{
+	"min":   {2, func(a []float64) float64 { return math.Min(a[0], a[1]) }},
+	"max":   {2, func(a []float64) float64 { return math.Max(a[0], a[1]) }},
+	"floor": {1, func(a []float64) float64 { return math.Floor(a[0]) }},
+	"ceil":  {1, func(a []float64) float64 { return math.Ceil(a[0]) }},
+	"abs":   {1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"round": {1, func(a []float64) float64 { return math.Round(a[0]) }},
+}
+
+// maxCachedExpressions bounds the compiled expression cache; it is emptied when full
+const maxCachedExpressions = 1000
+
+// expressionCache holds compiled expressions by source text, so rules sent with every
+// request are parsed once
+var expressionCache = struct {
+	mu    sync.Mutex
+	exprs map[string]numExpr
+}{exprs: make(map[string]numExpr)}
+
+// compileScoringExpression parses and type-checks an expression, using the cache. The
+// expression must evaluate to a number.
+func compileScoringExpression(source string) (numExpr, error) {
+	expressionCache.mu.Lock()
+	expr, ok := expressionCache.exprs[source]
+	expressionCache.mu.Unlock()
+	if ok {
+		return expr, nil
+	}
+
+	p := &expressionParser{source: source}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+
+	compiled, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEnd {
+		return nil, p.errorAt(tok, "unexpected "+describeToken(tok))
+	}
+	if compiled.num == nil {
+		return nil, fmt.Errorf("expression must be a number, not a string")
+	}
+
+	expressionCache.mu.Lock()
+	if len(expressionCache.exprs) >= maxCachedExpressions {
+		expressionCache.exprs = make(map[string]numExpr)
+	}
+	expressionCache.exprs[source] = compiled.num
+	expressionCache.mu.Unlock()
+
+	return compiled.num, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenOperator
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	number float64
+	pos    int // byte offset in the source, for error messages
+}
+
+// expressionParser is a recursive descent parser that compiles as it parses
+type expressionParser struct {
+	source string
+	tokens []token
+	next   int
+}
+
+// expressionOperators are matched longest first
+var expressionOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "+", "-", "*", "/", "<", ">", "!", "?", ":", "(", ")", ","}
+
+// tokenize splits the source into tokens
+func (p *expressionParser) tokenize() error {
+	src := p.source
+	i := 0
+	for i < len(src) {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c) || (c == '.' && i+1 < len(src) && unicode.IsDigit(rune(src[i+1]))):
+			start := i
+			for i < len(src) && (unicode.IsDigit(rune(src[i])) || src[i] == '.') {
+				i++
+			}
+			value, err := strconv.ParseFloat(src[start:i], 64)
+			if err != nil {
+				return fmt.Errorf("invalid number %q at position %d", src[start:i], start)
+			}
+			p.tokens = append(p.tokens, token{kind: tokenNumber, text: src[start:i], number: value, pos: start})
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(src) && (src[i] == '_' || unicode.IsLetter(rune(src[i])) || unicode.IsDigit(rune(src[i]))) {
+				i++
+			}
+			p.tokens = append(p.tokens, token{kind: tokenIdent, text: src[start:i], pos: start})
+		case c == '"' || c == '\'':
+			start := i
+			end := strings.IndexByte(src[i+1:], src[i])
+			if end < 0 {
+				return fmt.Errorf("unterminated string at position %d", start)
+			}
+			i += end + 2
+			p.tokens = append(p.tokens, token{kind: tokenString, text: src[start+1 : i-1], pos: start})
+		default:
+			matched := false
+			for _, op := range expressionOperators {
+				if strings.HasPrefix(src[i:], op) {
+					p.tokens = append(p.tokens, token{kind: tokenOperator, text: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return fmt.Errorf("unexpected %q at position %d", string(c), i)
+			}
+		}
+	}
+	p.tokens = append(p.tokens, token{kind: tokenEnd, pos: len(src)})
+	return nil
+}
+
+func (p *expressionParser) peek() token {
+	return p.tokens[p.next]
+}
+
+// accept consumes the next token if it is one of the operators
+func (p *expressionParser) accept(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != tokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.next++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *expressionParser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		tok := p.peek()
+		return p.errorAt(tok, fmt.Sprintf("expected %q but found %s", op, describeToken(tok)))
+	}
+	return nil
+}
+
+// describeToken names a token for error messages
+func describeToken(tok token) string {
+	if tok.kind == tokenEnd {
+		return "end of expression"
+	}
+	return strconv.Quote(tok.text)
+}
+
+func (p *expressionParser) errorAt(tok token, message string) error {
+	return fmt.Errorf("%s at position %d", message, tok.pos)
+}
+
+// number requires a number operand for the operator at tok
+func (p *expressionParser) number(tok token, expr compiledExpr) (numExpr, error) {
+	if expr.num == nil {
+		return nil, p.errorAt(tok, fmt.Sprintf("%q needs a number, not a string", tok.text))
+	}
+	return expr.num, nil
+}
+
+// parseTernary parses cond ? a : b, where both branches have the same type
+func (p *expressionParser) parseTernary() (compiledExpr, error) {
+	cond, err := p.parseOr()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	tok := p.peek()
+	if _, ok := p.accept("?"); !ok {
+		return cond, nil
+	}
+	test, err := p.number(tok, cond)
+	if err != nil {
+		return compiledExpr{}, err
+	}
+
+	then, err := p.parseTernary()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	if err := p.expect(":"); err != nil {
+		return compiledExpr{}, err
+	}
+	otherwise, err := p.parseTernary()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+
+	if (then.num == nil) != (otherwise.num == nil) {
+		return compiledExpr{}, p.errorAt(tok, "both branches of \"?\" must have the same type")
+	}
+	if then.num != nil {
+		return compiledExpr{num: func(env *scoringEnv) float64 {
+			if test(env) != 0 {
+				return then.num(env)
+			}
+			return otherwise.num(env)
+		}}, nil
+	}
+	return compiledExpr{str: func(env *scoringEnv) string {
+		if test(env) != 0 {
+			return then.str(env)
+		}
+		return otherwise.str(env)
+	}}, nil
+}
+
+// parseOr and parseAnd parse the logical operators, which short-circuit
+func (p *expressionParser) parseOr() (compiledExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	for {
+		tok := p.peek()
+		if _, ok := p.accept("||"); !ok {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		l, err := p.number(tok, left)
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		r, err := p.number(tok, right)
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		left = compiledExpr{num: func(env *scoringEnv) float64 { return boolValue(l(env) != 0 || r(env) != 0) }}
+	}
+}
+
+func (p *expressionParser) parseAnd() (compiledExpr, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	for {
+		tok := p.peek()
+		if _, ok := p.accept("&&"); !ok {
+			return left, nil
+		}
+		right, err := p.parseComparison()
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		l, err := p.number(tok, left)
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		r, err := p.number(tok, right)
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		left = compiledExpr{num: func(env *scoringEnv) float64 { return boolValue(l(env) != 0 && r(env) != 0) }}
+	}
+}
+
+// parseComparison parses a single, non-chained comparison. Strings compare only for equality.
+func (p *expressionParser) parseComparison() (compiledExpr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	tok := p.peek()
+	op, ok := p.accept("==", "!=", "<", "<=", ">", ">=")
+	if !ok {
+		return left, nil
+	}
+	right, err := p.parseAdditive()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+
+	if left.str != nil || right.str != nil {
+		if left.str == nil || right.str == nil {
+			return compiledExpr{}, p.errorAt(tok, fmt.Sprintf("%q cannot compare a string with a number", op))
+		}
+		if op != "==" && op != "!=" {
+			return compiledExpr{}, p.errorAt(tok, fmt.Sprintf("%q cannot compare strings", op))
+		}
+		l, r, equal := left.str, right.str, op == "=="
+		return compiledExpr{num: func(env *scoringEnv) float64 {
+			return boolValue(strings.EqualFold(l(env), r(env)) == equal)
+		}}, nil
+	}
+
+	l, r := left.num, right.num
+	var compare func(a, b float64) bool
+	switch op {
+	case "==":
+		compare = func(a, b float64) bool { return a == b }
+	case "!=":
+		compare = func(a, b float64) bool { return a != b }
+	case "<":
+		compare = func(a, b float64) bool { return a < b }
+	case "<=":
+		compare = func(a, b float64) bool { return a <= b }
+	case ">":
+		compare = func(a, b float64) bool { return a > b }
+	default:
+		compare = func(a, b float64) bool { return a >= b }
+	}
+	return compiledExpr{num: func(env *scoringEnv) float64 { return boolValue(compare(l(env), r(env))) }}, nil
+}
+
+func (p *expressionParser) parseAdditive() (compiledExpr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	for {
+		tok := p.peek()
+		op, ok := p.accept("+", "-")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		l, err := p.number(tok, left)
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		r, err := p.number(tok, right)
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		if op == "+" {
+			left = compiledExpr{num: func(env *scoringEnv) float64 { return l(env) + r(env) }}
+		} else {
+			left = compiledExpr{num: func(env *scoringEnv) float64 { return l(env) - r(env) }}
+		}
+	}
+}
+
+// parseMultiplicative parses * and /. Division by zero gives 0 rather than infinity, so a
+// rule such as yards / attempts scores nothing for a player without attempts.
+func (p *expressionParser) parseMultiplicative() (compiledExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	for {
+		tok := p.peek()
+		op, ok := p.accept("*", "/")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		l, err := p.number(tok, left)
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		r, err := p.number(tok, right)
+		if err != nil {
+			return compiledExpr{}, err
+		}
+		if op == "*" {
+			left = compiledExpr{num: func(env *scoringEnv) float64 { return l(env) * r(env) }}
+		} else {
+			left = compiledExpr{num: func(env *scoringEnv) float64 {
+				divisor := r(env)
+				if divisor == 0 {
+					return 0
+				}
+				return l(env) / divisor
+			}}
+		}
+	}
+}
+
+func (p *expressionParser) parseUnary() (compiledExpr, error) {
+	tok := p.peek()
+	op, ok := p.accept("-", "!")
+	if !ok {
+		return p.parsePrimary()
+	}
+	operand, err := p.parseUnary()
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	value, err := p.number(tok, operand)
+	if err != nil {
+		return compiledExpr{}, err
+	}
+	if op == "-" {
+		return compiledExpr{num: func(env *scoringEnv) float64 { return -value(env) }}, nil
+	}
+	return compiledExpr{num: func(env *scoringEnv) float64 { return boolValue(value(env) == 0) }}, nil
+}
+
+// parsePrimary parses literals, stat names, position, function calls and parentheses
+func (p *expressionParser) parsePrimary() (compiledExpr, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenNumber:
+		p.next++
+		value := tok.number
+		return compiledExpr{num: func(*scoringEnv) float64 { return value }}, nil
+
+	case tokenString:
+		p.next++
+		value := tok.text
+		return compiledExpr{str: func(*scoringEnv) string { return value }}, nil
+
+	case tokenIdent:
+		p.next++
+		name := strings.ToLower(tok.text)
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(tok, name)
+		}
+		if name == "position" {
+			return compiledExpr{str: func(env *scoringEnv) string { return env.position }}, nil
+		}
+		for _, stat := range models.SeasonStatNames {
+			if stat == name {
+				return compiledExpr{num: func(env *scoringEnv) float64 { return env.stats[name] }}, nil
+			}
+		}
+		return compiledExpr{}, p.errorAt(tok, fmt.Sprintf("unknown stat %q", tok.text))
+
+	case tokenOperator:
+		if tok.text == "(" {
+			p.next++
+			inner, err := p.parseTernary()
+			if err != nil {
+				return compiledExpr{}, err
+			}
+			if err := p.expect(")"); err != nil {
+				return compiledExpr{}, err
+			}
+			return inner, nil
+		}
+	}
+
+	return compiledExpr{}, p.errorAt(tok, "unexpected "+describeToken(tok))
+}
+
+// parseCall parses the arguments of a function call after the opening parenthesis
+func (p *expressionParser) parseCall(tok token, name string) (compiledExpr, error) {
+	function, ok := scoringFunctions[name]
+	if !ok {
+		return compiledExpr{}, p.errorAt(tok, fmt.Sprintf("unknown function %q", tok.text))
+	}
+
+	var args []numExpr
+	if _, ok := p.accept(")"); !ok {
+		for {
+			arg, err := p.parseTernary()
+			if err != nil {
+				return compiledExpr{}, err
+			}
+			value, err := p.number(tok, arg)
+			if err != nil {
+				return compiledExpr{}, err
+			}
+			args = append(args, value)
+			if _, ok := p.accept(","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return compiledExpr{}, err
+		}
+	}
+
+	if len(args) != function.args {
+		return compiledExpr{}, p.errorAt(tok, fmt.Sprintf("%s takes %d argument(s), got %d", name, function.args, len(args)))
+	}
+
+	return compiledExpr{num: func(env *scoringEnv) float64 {
+		values := make([]float64, len(args))
+		for i, arg := range args {
+			values[i] = arg(env)
+		}
+		return function.call(values)
+	}}, nil
+}
+
+// boolValue is 1 for true and 0 for false
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
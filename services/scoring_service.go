@@ -0,0 +1,135 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/scoring_service.go -pkg mocks . ScoringService
+
+// maxScoringRules bounds how many rules a request can send
+const maxScoringRules = 100
+
+// ScoringService defines the interface for custom scoring business logic
+type ScoringService interface {
+	ValidateRules(rules []models.ScoringRule) (*models.ScoringValidation, error)
+	Evaluate(req *models.ScoringRequest) (*models.ScoringResult, error)
+}
+
+// scoringService implements ScoringService interface
+type scoringService struct {
+	playerStatsRepo repositories.PlayerStatsRepository
+	playerRepo      repositories.PlayerRepository
+}
+
+// NewScoringService creates a new scoring service
+func NewScoringService(playerStatsRepo repositories.PlayerStatsRepository, playerRepo repositories.PlayerRepository) ScoringService {
+	return &scoringService{
+		playerStatsRepo: playerStatsRepo,
+		playerRepo:      playerRepo,
+	}
+}
+
+// ValidateRules compiles every rule and reports each one that does not compile
+func (s *scoringService) ValidateRules(rules []models.ScoringRule) (*models.ScoringValidation, error) {
+	if err := checkRuleCount(rules); err != nil {
+		return nil, err
+	}
+
+	validation := &models.ScoringValidation{Valid: true}
+	for i, rule := range rules {
+		if _, err := compileScoringExpression(rule.Expression); err != nil {
+			validation.Valid = false
+			validation.Errors = append(validation.Errors, &models.ScoringRuleError{Rule: i, Error: err.Error()})
+		}
+	}
+
+	return validation, nil
+}
+
+// Evaluate scores a recorded or inline stat line with the rules
+func (s *scoringService) Evaluate(req *models.ScoringRequest) (*models.ScoringResult, error) {
+	if err := checkRuleCount(req.Rules); err != nil {
+		return nil, err
+	}
+
+	exprs := make([]numExpr, len(req.Rules))
+	for i, rule := range req.Rules {
+		expr, err := compileScoringExpression(rule.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("validation failed: rule %d: %w", i, err)
+		}
+		exprs[i] = expr
+	}
+
+	env, err := s.scoringEnv(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ScoringResult{Position: env.position, Rules: make([]*models.ScoringRulePoints, len(req.Rules))}
+	total := 0.0
+	for i, rule := range req.Rules {
+		points := exprs[i](env)
+		total += points
+		result.Rules[i] = &models.ScoringRulePoints{Name: rule.Name, Expression: rule.Expression, Points: roundPoints(points)}
+	}
+	result.Points = roundPoints(total)
+
+	return result, nil
+}
+
+// scoringEnv loads the stat line to score
+func (s *scoringService) scoringEnv(req *models.ScoringRequest) (*scoringEnv, error) {
+	if req.StatsID != nil {
+		if len(req.Stats) > 0 || req.Position != "" {
+			return nil, fmt.Errorf("validation failed: send either stats_id or stats and position, not both")
+		}
+		if *req.StatsID <= 0 {
+			return nil, fmt.Errorf("invalid player stats ID: %d", *req.StatsID)
+		}
+
+		stats, err := s.playerStatsRepo.GetByID(*req.StatsID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get player stats: %w", err)
+		}
+		player, err := s.playerRepo.GetByID(stats.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get player: %w", err)
+		}
+		return &scoringEnv{stats: statValues(stats), position: player.Position}, nil
+	}
+
+	env := &scoringEnv{stats: make(map[string]float64, len(req.Stats))}
+	for name, value := range req.Stats {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if err := validateOneOf("stat", name, models.SeasonStatNames); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+		env.stats[name] = value
+	}
+
+	if req.Position != "" {
+		code, ok := models.NormalizePosition(req.Position)
+		if !ok {
+			return nil, fmt.Errorf("validation failed: position must be one of: %v", models.Positions)
+		}
+		env.position = code
+	}
+
+	return env, nil
+}
+
+// checkRuleCount requires between one and maxScoringRules rules
+func checkRuleCount(rules []models.ScoringRule) error {
+	if len(rules) == 0 {
+		return fmt.Errorf("validation failed: at least one rule must be provided")
+	}
+	if len(rules) > maxScoringRules {
+		return fmt.Errorf("validation failed: at most %d rules can be provided", maxScoringRules)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/search_service.go -pkg mocks . SearchService
+
+// Relevance scores for search matches, higher is better
+const (
+	searchScoreExact           = 100
+	searchScoreFullNamePrefix  = 90
+	searchScorePrimaryPrefix   = 75
+	searchScoreSecondaryPrefix = 50
+)
+
+// SearchService defines the interface for cross-entity search
+type SearchService interface {
+	Search(query string, limit int) (*models.SearchResponse, error)
+}
+
+// searchService implements SearchService interface
+type searchService struct {
+	playerRepo repositories.PlayerRepository
+	teamRepo   repositories.TeamRepository
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(playerRepo repositories.PlayerRepository, teamRepo repositories.TeamRepository) SearchService {
+	return &searchService{
+		playerRepo: playerRepo,
+		teamRepo:   teamRepo,
+	}
+}
+
+// Search finds players and teams matching the query, grouped by type and ordered by relevance
+func (s *searchService) Search(query string, limit int) (*models.SearchResponse, error) {
+	query = strings.Join(strings.Fields(query), " ")
+	if len(query) < 2 {
+		return nil, fmt.Errorf("validation failed: query must be at least 2 characters")
+	}
+
+	if limit < 1 || limit > 50 {
+		return nil, fmt.Errorf("validation failed: limit must be between 1 and 50, got %d", limit)
+	}
+
+	players, err := s.playerRepo.SearchByName(query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search players: %w", err)
+	}
+
+	teams, err := s.teamRepo.SearchByName(query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search teams: %w", err)
+	}
+
+	playerGroup := &models.SearchGroup{Type: "player", Results: []*models.SearchResult{}}
+	for _, player := range players {
+		fullName := player.FirstName + " " + player.LastName
+		playerGroup.Results = append(playerGroup.Results, &models.SearchResult{
+			Type:     "player",
+			ID:       player.ID,
+			Label:    fullName,
+			Subtitle: player.Position,
+			Score:    scoreMatch(query, fullName, player.LastName, player.FirstName),
+		})
+	}
+
+	teamGroup := &models.SearchGroup{Type: "team", Results: []*models.SearchResult{}}
+	for _, team := range teams {
+		teamGroup.Results = append(teamGroup.Results, &models.SearchResult{
+			Type:     "team",
+			ID:       team.ID,
+			Label:    team.City + " " + team.Name,
+			Subtitle: team.Conference + " " + team.Division,
+			Score:    scoreMatch(query, team.City+" "+team.Name, team.Name, team.City),
+		})
+	}
+
+	groups := []*models.SearchGroup{playerGroup, teamGroup}
+	for _, group := range groups {
+		sortSearchResults(group.Results)
+	}
+
+	// Put the group holding the most relevant match first
+	sort.SliceStable(groups, func(i, j int) bool {
+		return topScore(groups[i]) > topScore(groups[j])
+	})
+
+	return &models.SearchResponse{
+		Query:  query,
+		Groups: groups,
+	}, nil
+}
+
+// scoreMatch ranks how well the query matches an entity's names
+func scoreMatch(query, fullName, primary, secondary string) int {
+	query = strings.ToLower(query)
+
+	switch {
+	case query == strings.ToLower(fullName) || query == strings.ToLower(primary):
+		return searchScoreExact
+	case strings.HasPrefix(strings.ToLower(fullName), query) && strings.Contains(query, " "):
+		return searchScoreFullNamePrefix
+	case strings.HasPrefix(strings.ToLower(primary), query):
+		return searchScorePrimaryPrefix
+	case strings.HasPrefix(strings.ToLower(secondary), query):
+		return searchScoreSecondaryPrefix
+	default:
+		return 0
+	}
+}
+
+// sortSearchResults orders results by score, then alphabetically by label
+func sortSearchResults(results []*models.SearchResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Label < results[j].Label
+	})
+}
+
+// topScore returns the best score in a group, or zero when it is empty
+func topScore(group *models.SearchGroup) int {
+	if len(group.Results) == 0 {
+		return 0
+	}
+	return group.Results[0].Score
+}
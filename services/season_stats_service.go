@@ -0,0 +1,232 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/season_stats_service.go -pkg mocks . SeasonStatsService
+
+// careerMilestoneSteps is the spacing of career milestones for each stat that has them,
+// e.g. every 10,000 passing yards
+var careerMilestoneSteps = map[string]float64{
+	"passing_yards":           10000,
+	"passing_touchdowns":      100,
+	"rushing_yards":           5000,
+	"rushing_touchdowns":      50,
+	"receptions":              500,
+	"receiving_yards":         5000,
+	"receiving_touchdowns":    50,
+	"tackles":                 500,
+	"sacks":                   50,
+	"defensive_interceptions": 25,
+	"field_goals_made":        100,
+}
+
+// SeasonStatsService defines the interface for season totals business logic
+type SeasonStatsService interface {
+	GetPlayerSeasons(playerID int) ([]*models.PlayerSeasonStats, error)
+	GetPlayerSeason(playerID int, season string) (*models.PlayerSeasonStats, error)
+	GetPlayerCareer(playerID int) (*models.PlayerCareer, error)
+	GetSeasonLeaders(season, stat, position string, perGame bool, limit int) (*models.SeasonLeadersResponse, error)
+	RebuildSeasonStats() (*models.SeasonStatsRebuildResult, error)
+}
+
+// seasonStatsService implements SeasonStatsService interface
+type seasonStatsService struct {
+	seasonStatsRepo repositories.SeasonStatsRepository
+	playerRepo      repositories.PlayerRepository
+}
+
+// NewSeasonStatsService creates a new season stats service
+func NewSeasonStatsService(seasonStatsRepo repositories.SeasonStatsRepository, playerRepo repositories.PlayerRepository) SeasonStatsService {
+	return &seasonStatsService{
+		seasonStatsRepo: seasonStatsRepo,
+		playerRepo:      playerRepo,
+	}
+}
+
+// GetPlayerSeasons retrieves a player's totals and per-game averages for every season
+func (s *seasonStatsService) GetPlayerSeasons(playerID int) ([]*models.PlayerSeasonStats, error) {
+	if err := s.checkPlayer(playerID); err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.seasonStatsRepo.GetByPlayerID(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season stats: %w", err)
+	}
+	if seasons == nil {
+		seasons = []*models.PlayerSeasonStats{}
+	}
+
+	for _, season := range seasons {
+		season.PerGame = perGameAverages(season)
+	}
+
+	return seasons, nil
+}
+
+// GetPlayerSeason retrieves a player's totals, per-game averages and ranks for one season
+func (s *seasonStatsService) GetPlayerSeason(playerID int, season string) (*models.PlayerSeasonStats, error) {
+	if err := s.checkPlayer(playerID); err != nil {
+		return nil, err
+	}
+	if season == "" {
+		return nil, fmt.Errorf("season cannot be empty")
+	}
+
+	stats, err := s.seasonStatsRepo.GetByPlayerAndSeason(playerID, season)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season stats: %w", err)
+	}
+
+	ranks, err := s.seasonStatsRepo.GetRanks(playerID, season)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season ranks: %w", err)
+	}
+
+	stats.PerGame = perGameAverages(stats)
+	stats.Ranks = ranks
+
+	return stats, nil
+}
+
+// GetPlayerCareer adds up a player's season totals into career totals and finds the next
+// milestone for each stat, closest first
+func (s *seasonStatsService) GetPlayerCareer(playerID int) (*models.PlayerCareer, error) {
+	seasons, err := s.GetPlayerSeasons(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	career := &models.PlayerCareer{
+		PlayerID:   playerID,
+		Seasons:    len(seasons),
+		Totals:     make(map[string]float64, len(models.SeasonStatNames)),
+		Splits:     seasons,
+		Milestones: []*models.CareerMilestone{},
+	}
+	for _, name := range models.SeasonStatNames {
+		career.Totals[name] = 0
+	}
+	for _, season := range seasons {
+		career.GamesPlayed += season.GamesPlayed
+		for name, total := range season.Totals {
+			career.Totals[name] += total
+		}
+	}
+	career.PerGame = perGameAverages(&models.PlayerSeasonStats{GamesPlayed: career.GamesPlayed, Totals: career.Totals})
+
+	for stat, step := range careerMilestoneSteps {
+		current := career.Totals[stat]
+		if current <= 0 {
+			continue
+		}
+		next := (math.Floor(current/step) + 1) * step
+		career.Milestones = append(career.Milestones, &models.CareerMilestone{
+			Stat:      stat,
+			Milestone: next,
+			Current:   current,
+			Remaining: next - current,
+		})
+	}
+
+	// Closest to the milestone first, measured as a share of the step
+	sort.Slice(career.Milestones, func(i, j int) bool {
+		left := career.Milestones[i].Remaining / careerMilestoneSteps[career.Milestones[i].Stat]
+		right := career.Milestones[j].Remaining / careerMilestoneSteps[career.Milestones[j].Stat]
+		if left != right {
+			return left < right
+		}
+		return career.Milestones[i].Stat < career.Milestones[j].Stat
+	})
+
+	return career, nil
+}
+
+// GetSeasonLeaders ranks the season's players by one stat
+func (s *seasonStatsService) GetSeasonLeaders(season, stat, position string, perGame bool, limit int) (*models.SeasonLeadersResponse, error) {
+	if season == "" {
+		return nil, fmt.Errorf("season cannot be empty")
+	}
+
+	stat = strings.ToLower(strings.TrimSpace(stat))
+	if err := validateOneOf("stat", stat, models.SeasonStatNames); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if position != "" {
+		code, ok := models.NormalizePosition(position)
+		if !ok {
+			return nil, fmt.Errorf("validation failed: position must be one of: %v", models.Positions)
+		}
+		position = code
+	}
+
+	if limit < 1 || limit > 100 {
+		return nil, fmt.Errorf("validation failed: limit must be between 1 and 100, got %d", limit)
+	}
+
+	leaders, err := s.seasonStatsRepo.GetLeaders(season, stat, position, perGame, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season leaders: %w", err)
+	}
+	if leaders == nil {
+		leaders = []*models.SeasonStatLeader{}
+	}
+
+	return &models.SeasonLeadersResponse{
+		Season:   season,
+		Stat:     stat,
+		Position: position,
+		PerGame:  perGame,
+		Leaders:  leaders,
+	}, nil
+}
+
+// RebuildSeasonStats recomputes the season rollup from the stat lines. The rollup is kept
+// current on every write, so this is only needed after editing the database by hand.
+func (s *seasonStatsService) RebuildSeasonStats() (*models.SeasonStatsRebuildResult, error) {
+	rows, err := s.seasonStatsRepo.Rebuild()
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild season stats: %w", err)
+	}
+
+	return &models.SeasonStatsRebuildResult{Rows: rows}, nil
+}
+
+// checkPlayer validates the player ID and that the player exists
+func (s *seasonStatsService) checkPlayer(playerID int) error {
+	if playerID <= 0 {
+		return fmt.Errorf("invalid player ID: %d", playerID)
+	}
+
+	exists, err := s.playerRepo.Exists(playerID)
+	if err != nil {
+		return fmt.Errorf("failed to check player existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("player with ID %d not found", playerID)
+	}
+
+	return nil
+}
+
+// perGameAverages divides each season total by the games played, rounded to two decimals
+func perGameAverages(stats *models.PlayerSeasonStats) map[string]float64 {
+	averages := make(map[string]float64, len(stats.Totals))
+	for name, total := range stats.Totals {
+		if stats.GamesPlayed > 0 {
+			averages[name] = math.Round(total/float64(stats.GamesPlayed)*100) / 100
+		} else {
+			averages[name] = 0
+		}
+	}
+	return averages
+}
@@ -0,0 +1,106 @@
+package services
+
+import "time"
+
+// seedTeam is one of the 32 NFL teams loaded by the seed command
+type seedTeam struct {
+	name, city, conference, division string
+}
+
+// seedTeams lists the NFL teams by conference and division
+var seedTeams = []seedTeam{
+	{"Bills", "Buffalo", "AFC", "East"},
+	{"Dolphins", "Miami", "AFC", "East"},
+	{"Patriots", "New England", "AFC", "East"},
+	{"Jets", "New York", "AFC", "East"},
+	{"Ravens", "Baltimore", "AFC", "North"},
+	{"Bengals", "Cincinnati", "AFC", "North"},
+	{"Browns", "Cleveland", "AFC", "North"},
+	{"Steelers", "Pittsburgh", "AFC", "North"},
+	{"Texans", "Houston", "AFC", "South"},
+	{"Colts", "Indianapolis", "AFC", "South"},
+	{"Jaguars", "Jacksonville", "AFC", "South"},
+	{"Titans", "Tennessee", "AFC", "South"},
+	{"Broncos", "Denver", "AFC", "West"},
+	{"Chiefs", "Kansas City", "AFC", "West"},
+	{"Raiders", "Las Vegas", "AFC", "West"},
+	{"Chargers", "Los Angeles", "AFC", "West"},
+	{"Cowboys", "Dallas", "NFC", "East"},
+	{"Giants", "New York", "NFC", "East"},
+	{"Eagles", "Philadelphia", "NFC", "East"},
+	{"Commanders", "Washington", "NFC", "East"},
+	{"Bears", "Chicago", "NFC", "North"},
+	{"Lions", "Detroit", "NFC", "North"},
+	{"Packers", "Green Bay", "NFC", "North"},
+	{"Vikings", "Minnesota", "NFC", "North"},
+	{"Falcons", "Atlanta", "NFC", "South"},
+	{"Panthers", "Carolina", "NFC", "South"},
+	{"Saints", "New Orleans", "NFC", "South"},
+	{"Buccaneers", "Tampa Bay", "NFC", "South"},
+	{"Cardinals", "Arizona", "NFC", "West"},
+	{"Rams", "Los Angeles", "NFC", "West"},
+	{"49ers", "San Francisco", "NFC", "West"},
+	{"Seahawks", "Seattle", "NFC", "West"},
+}
+
+// Roster slots
+const (
+	slotQB = iota
+	slotRB
+	slotWR1
+	slotWR2
+	slotTE
+	slotK
+	slotLB
+	slotCB
+)
+
+// seedRosterSlot is one position on a sample roster. Jersey numbers are drawn from the slot's
+// range, and the ranges don't overlap so numbers never collide within a team.
+type seedRosterSlot struct {
+	position             string
+	minJersey, maxJersey int
+}
+
+// seedRoster is the sample roster of every team, indexed by the slot constants
+var seedRoster = []seedRosterSlot{
+	slotQB:  {"QB", 10, 19},
+	slotRB:  {"RB", 30, 39},
+	slotWR1: {"WR", 80, 84},
+	slotWR2: {"WR", 85, 89},
+	slotTE:  {"TE", 40, 49},
+	slotK:   {"K", 1, 9},
+	slotLB:  {"LB", 50, 59},
+	slotCB:  {"CB", 20, 29},
+}
+
+// Name pools for the generated, fictional players
+var (
+	seedFirstNames = []string{
+		"Aaron", "Andre", "Brandon", "Caleb", "Cameron", "Chris", "Darius", "David", "DeShawn", "Derek",
+		"Devin", "Dylan", "Elijah", "Ethan", "Isaiah", "Jalen", "Jamal", "Jason", "Jordan", "Josh",
+		"Justin", "Kevin", "Kyle", "Logan", "Malik", "Marcus", "Mason", "Matt", "Michael", "Nate",
+		"Noah", "Patrick", "Quentin", "Ryan", "Sam", "Terrell", "Trevor", "Tyler", "Victor", "Zach",
+	}
+	seedLastNames = []string{
+		"Adams", "Allen", "Bailey", "Baker", "Brooks", "Brown", "Carter", "Coleman", "Collins", "Davis",
+		"Edwards", "Evans", "Foster", "Green", "Hall", "Harris", "Hayes", "Henderson", "Hill", "Jackson",
+		"Johnson", "Jones", "King", "Lewis", "Martin", "Mitchell", "Moore", "Morgan", "Murphy", "Nelson",
+		"Parker", "Perry", "Reed", "Robinson", "Russell", "Sanders", "Scott", "Simmons", "Thomas", "Turner",
+		"Walker", "Ward", "Washington", "Watson", "White", "Williams", "Wilson", "Wright", "Young",
+	}
+)
+
+const (
+	// seedSeason is the season the sample schedule is generated for
+	seedSeason = "2026"
+	// seedWeeks is the length of the sample regular season
+	seedWeeks = 17
+	// seedCompletedWeeks is how many weeks are played, with final scores and box scores
+	seedCompletedWeeks = 3
+	// seedRandomSeed fixes the generated names, schedule and stats, so every seeded database is identical
+	seedRandomSeed = 2026
+)
+
+// seedFirstSunday is the kickoff of week 1, 1pm Eastern on the season's first Sunday
+var seedFirstSunday = time.Date(2026, time.September, 13, 17, 0, 0, 0, time.UTC)
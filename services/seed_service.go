@@ -0,0 +1,265 @@
+package services
+
+import (
+	"fmt"
+	"math/rand"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/seed_service.go -pkg mocks . SeedService
+
+// SeedService defines the interface for loading sample data into an empty database
+type SeedService interface {
+	Seed() (*models.SeedResult, error)
+}
+
+// seedService implements SeedService interface
+type seedService struct {
+	teamRepo        repositories.TeamRepository
+	playerRepo      repositories.PlayerRepository
+	gameRepo        repositories.GameRepository
+	playerStatsRepo repositories.PlayerStatsRepository
+}
+
+// NewSeedService creates a new seed service
+func NewSeedService(teamRepo repositories.TeamRepository, playerRepo repositories.PlayerRepository, gameRepo repositories.GameRepository, playerStatsRepo repositories.PlayerStatsRepository) SeedService {
+	return &seedService{
+		teamRepo:        teamRepo,
+		playerRepo:      playerRepo,
+		gameRepo:        gameRepo,
+		playerStatsRepo: playerStatsRepo,
+	}
+}
+
+// Seed loads the 32 NFL teams, a generated roster for each, a full regular season schedule and
+// box scores for its first weeks. The data is generated from a fixed seed, so it is the same
+// every time. It only runs on a database without teams, so it never mixes with real data.
+func (s *seedService) Seed() (*models.SeedResult, error) {
+	existing, err := s.teamRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for existing teams: %w", err)
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("validation failed: sample data can only be loaded into an empty database, found %d teams", len(existing))
+	}
+
+	rng := rand.New(rand.NewSource(seedRandomSeed))
+	result := &models.SeedResult{Season: seedSeason}
+
+	teams := make([]*models.Team, len(seedTeams))
+	for i, seed := range seedTeams {
+		team := &models.Team{
+			Name:       seed.name,
+			City:       seed.city,
+			Conference: seed.conference,
+			Division:   seed.division,
+			Sport:      models.SportFootball,
+		}
+		if err := s.teamRepo.Create(team); err != nil {
+			return nil, fmt.Errorf("failed to seed team %s: %w", seed.name, err)
+		}
+		teams[i] = team
+		result.Teams++
+	}
+
+	rosters := make(map[int][]*models.Player, len(teams))
+	for _, team := range teams {
+		for _, slot := range seedRoster {
+			jersey := slot.minJersey + rng.Intn(slot.maxJersey-slot.minJersey+1)
+			player := &models.Player{
+				TeamID:       team.ID,
+				FirstName:    seedFirstNames[rng.Intn(len(seedFirstNames))],
+				LastName:     seedLastNames[rng.Intn(len(seedLastNames))],
+				Position:     slot.position,
+				JerseyNumber: &jersey,
+			}
+			if err := s.playerRepo.Create(player); err != nil {
+				return nil, fmt.Errorf("failed to seed player for the %s: %w", team.Name, err)
+			}
+			rosters[team.ID] = append(rosters[team.ID], player)
+			result.Players++
+		}
+	}
+
+	for week, matchups := range seedSchedule(teams) {
+		for _, matchup := range matchups {
+			game := &models.Game{
+				HomeTeamID: matchup[0].ID,
+				AwayTeamID: matchup[1].ID,
+				Season:     seedSeason,
+				Week:       week + 1,
+				GameType:   models.GameTypeRegular,
+				GameDate:   seedFirstSunday.AddDate(0, 0, 7*week),
+				Status:     "scheduled",
+			}
+
+			var boxScore []*models.PlayerStats
+			if week < seedCompletedWeeks {
+				homeStats, homeScore := seedBoxScore(rng, rosters[game.HomeTeamID])
+				awayStats, awayScore := seedBoxScore(rng, rosters[game.AwayTeamID])
+				boxScore = append(homeStats, awayStats...)
+				game.Status = "completed"
+				game.HomeScore, game.AwayScore = &homeScore, &awayScore
+			}
+
+			if err := s.gameRepo.Create(game); err != nil {
+				return nil, fmt.Errorf("failed to seed week %d game: %w", game.Week, err)
+			}
+			result.Games++
+
+			if game.Status != "completed" {
+				continue
+			}
+			result.CompletedGames++
+			for _, stats := range boxScore {
+				stats.GameID = game.ID
+				if err := s.playerStatsRepo.Create(stats); err != nil {
+					return nil, fmt.Errorf("failed to seed player stats: %w", err)
+				}
+				result.StatLines++
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// seedSchedule pairs the teams for each week with the circle method: one team stays put while
+// the rest rotate, so no two teams meet twice. Each matchup lists the home team first, and
+// every team plays eight or nine home games.
+func seedSchedule(teams []*models.Team) [][][2]*models.Team {
+	n := len(teams)
+	rotation := append([]*models.Team(nil), teams...)
+
+	schedule := make([][][2]*models.Team, seedWeeks)
+	for week := range schedule {
+		for i := 0; i < n/2; i++ {
+			// The fixed team alternates home and away; the rotating teams change slots each
+			// week, so swapping alternate slots balances their home games too
+			home, away := rotation[i], rotation[n-1-i]
+			if (i == 0 && week%2 == 1) || (i > 0 && i%2 == 1) {
+				home, away = away, home
+			}
+			schedule[week] = append(schedule[week], [2]*models.Team{home, away})
+		}
+
+		// Keep the first team fixed and rotate the others one place
+		last := rotation[n-1]
+		copy(rotation[2:], rotation[1:n-1])
+		rotation[1] = last
+	}
+	return schedule
+}
+
+// seedBoxScore generates one team's stat lines for a game from its roster, ordered by the
+// seedRoster slots, and returns them with the points they add up to. The lines are consistent
+// with each other: receptions, receiving yards and receiving touchdowns add up to the
+// quarterback's completions, passing yards and passing touchdowns, and the kicker's extra
+// point attempts match the touchdowns scored.
+func seedBoxScore(rng *rand.Rand, roster []*models.Player) ([]*models.PlayerStats, int) {
+	lines := make([]*models.PlayerStats, len(roster))
+	for i, player := range roster {
+		lines[i] = &models.PlayerStats{PlayerID: player.ID}
+	}
+	qb, rb, k, lb, cb := lines[slotQB], lines[slotRB], lines[slotK], lines[slotLB], lines[slotCB]
+
+	// Spread the completions over the receivers by target share
+	receivers := []struct {
+		line           *models.PlayerStats
+		share          int // percent of completions
+		minYPC, maxYPC int
+	}{
+		{lines[slotWR1], 35, 9, 15},
+		{lines[slotWR2], 25, 9, 15},
+		{lines[slotTE], 20, 7, 12},
+		{rb, 20, 5, 9},
+	}
+	attempts := 25 + rng.Intn(21)
+	completions := attempts * (55 + rng.Intn(18)) / 100
+	passingYards, caught := 0, 0
+	for i, receiver := range receivers {
+		receptions := completions * receiver.share / 100
+		if i == len(receivers)-1 {
+			receptions = completions - caught
+		}
+		caught += receptions
+		yards := receptions * (receiver.minYPC + rng.Intn(receiver.maxYPC-receiver.minYPC+1))
+		passingYards += yards
+
+		receiver.line.Receptions = seedInt(receptions)
+		receiver.line.ReceivingTargets = seedInt(receptions + rng.Intn(4))
+		receiver.line.ReceivingYards = seedInt(yards)
+		receiver.line.ReceivingTouchdowns = seedInt(0)
+	}
+
+	passingTouchdowns := 0
+	for i := rng.Intn(4); i > 0; i-- {
+		receiver := receivers[rng.Intn(len(receivers))].line
+		if *receiver.Receptions > 0 {
+			*receiver.ReceivingTouchdowns++
+			passingTouchdowns++
+		}
+	}
+
+	qb.PassingAttempts = seedInt(attempts)
+	qb.PassingCompletions = seedInt(completions)
+	qb.PassingYards = seedInt(passingYards)
+	qb.PassingTouchdowns = seedInt(passingTouchdowns)
+	qb.PassingInterceptions = seedInt(rng.Intn(3))
+	qbRushes := rng.Intn(6)
+	qb.RushingAttempts = seedInt(qbRushes)
+	qb.RushingYards = seedInt(qbRushes * rng.Intn(6))
+	qb.RushingTouchdowns = seedInt(0)
+	if qbRushes > 0 && rng.Intn(6) == 0 {
+		*qb.RushingTouchdowns = 1
+	}
+
+	rbRushes := 10 + rng.Intn(14)
+	rb.RushingAttempts = seedInt(rbRushes)
+	rb.RushingYards = seedInt(rbRushes * (2 + rng.Intn(5)))
+	rb.RushingTouchdowns = seedInt(rng.Intn(2))
+	fumbles := 0
+	if rng.Intn(8) == 0 {
+		fumbles = 1
+	}
+	rb.Fumbles = seedInt(fumbles)
+	rb.FumblesLost = seedInt(fumbles * rng.Intn(2))
+
+	touchdowns := passingTouchdowns + *qb.RushingTouchdowns + *rb.RushingTouchdowns
+	fieldGoalsAttempted := rng.Intn(4)
+	fieldGoalsMade := fieldGoalsAttempted
+	if fieldGoalsAttempted > 0 && rng.Intn(4) == 0 {
+		fieldGoalsMade--
+	}
+	extraPointsMade := touchdowns
+	if touchdowns > 0 && rng.Intn(8) == 0 {
+		extraPointsMade--
+	}
+	k.FieldGoalsAttempted = seedInt(fieldGoalsAttempted)
+	k.FieldGoalsMade = seedInt(fieldGoalsMade)
+	k.ExtraPointsAttempted = seedInt(touchdowns)
+	k.ExtraPointsMade = seedInt(extraPointsMade)
+
+	solo, assisted := 3+rng.Intn(6), rng.Intn(5)
+	lb.SoloTackles, lb.AssistedTackles, lb.Tackles = seedInt(solo), seedInt(assisted), seedInt(solo+assisted)
+	sacks := float64(rng.Intn(3)) / 2
+	lb.Sacks = &sacks
+
+	solo, assisted = 2+rng.Intn(4), rng.Intn(3)
+	cb.SoloTackles, cb.AssistedTackles, cb.Tackles = seedInt(solo), seedInt(assisted), seedInt(solo+assisted)
+	cb.PassDeflections = seedInt(rng.Intn(3))
+	cb.DefensiveInterceptions = seedInt(0)
+	if rng.Intn(5) == 0 {
+		*cb.DefensiveInterceptions = 1
+	}
+
+	points := 6*touchdowns + extraPointsMade + 3*fieldGoalsMade
+	return lines, points
+}
+
+// seedInt returns a pointer to v for a generated stat
+func seedInt(v int) *int {
+	return &v
+}
@@ -0,0 +1,395 @@
+package services
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/simulation_service.go -pkg mocks . SimulationService
+
+const (
+	// defaultSimulationIterations is the number of seasons simulated when none is requested
+	defaultSimulationIterations = 10000
+	// maxSimulationIterations bounds the work one request can ask for
+	maxSimulationIterations = 100000
+	// maxCachedSimulations bounds the simulation cache; it is emptied when full
+	maxCachedSimulations = 100
+)
+
+// SimulationService defines the interface for season simulations
+type SimulationService interface {
+	SimulateSeason(season string, req *models.SimulateSeasonRequest) (*models.SeasonSimulation, error)
+}
+
+// cachedSimulation is a simulation with the fingerprint of the teams and games it was run on
+type cachedSimulation struct {
+	fingerprint uint64
+	result      *models.SeasonSimulation
+}
+
+// simulationService implements SimulationService interface
+type simulationService struct {
+	gameRepo repositories.GameRepository
+	teamRepo repositories.TeamRepository
+	clock    clock.Clock
+
+	mu    sync.Mutex
+	cache map[string]*cachedSimulation
+}
+
+// NewSimulationService creates a new simulation service
+func NewSimulationService(gameRepo repositories.GameRepository, teamRepo repositories.TeamRepository, clock clock.Clock) SimulationService {
+	return &simulationService{
+		gameRepo: gameRepo,
+		teamRepo: teamRepo,
+		clock:    clock,
+		cache:    make(map[string]*cachedSimulation),
+	}
+}
+
+// simulatedTeam is a team's record going into the simulation and its tallies across runs
+type simulatedTeam struct {
+	team                 *models.Team
+	wins, losses, ties   int
+	games                int // played and remaining
+	simulatedWins        int
+	playoffs, divisions  int
+	seedCounts           []int
+	points               int     // 2 per win and 1 per tie, in the current run
+	tiebreak             float64 // drawn each run
+	conference, division string
+}
+
+// simulatedGame is a remaining game and the home team's chance of winning it. Teams that are
+// no longer active have index -1.
+type simulatedGame struct {
+	home, away int
+	homeWin    float64
+}
+
+// conferenceSeeding lists the teams of a conference by division, for seeding each run
+type conferenceSeeding struct {
+	seeds     int
+	teams     []int
+	divisions [][]int
+}
+
+// SimulateSeason plays out the season's remaining regular season games the requested number of
+// times, each won with the probability the teams' current ratings give, and seeds each
+// conference's playoff field from the final records. Results are cached until a team or game
+// changes; a request without a seed reuses the last unseeded run.
+func (s *simulationService) SimulateSeason(season string, req *models.SimulateSeasonRequest) (*models.SeasonSimulation, error) {
+	season = strings.TrimSpace(season)
+	if season == "" {
+		return nil, fmt.Errorf("validation failed: season is required")
+	}
+
+	iterations := req.Iterations
+	if iterations == 0 {
+		iterations = defaultSimulationIterations
+	}
+	if iterations < 1 || iterations > maxSimulationIterations {
+		return nil, fmt.Errorf("validation failed: iterations must be between 1 and %d", maxSimulationIterations)
+	}
+
+	games, err := s.gameRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+	teams, err := s.teamRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+
+	var seasonGames []*models.Game
+	for _, game := range games {
+		if game.Season == season && game.GameType == models.GameTypeRegular {
+			seasonGames = append(seasonGames, game)
+		}
+	}
+	if len(seasonGames) == 0 {
+		return nil, fmt.Errorf("regular season games for season %s not found", season)
+	}
+
+	key := fmt.Sprintf("%s|%d|", season, iterations)
+	if req.Seed != nil {
+		key += fmt.Sprint(*req.Seed)
+	}
+	fingerprint := simulationFingerprint(games, teams)
+
+	s.mu.Lock()
+	cached, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && cached.fingerprint == fingerprint {
+		result := *cached.result
+		result.Cached = true
+		return &result, nil
+	}
+
+	seed := time.Now().UnixNano()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+	elo, _, _ := replayElo(games, season)
+	result, err := simulateSeason(season, seasonGames, teams, elo, iterations, rand.New(rand.NewSource(seed)))
+	if err != nil {
+		return nil, err
+	}
+	result.Seed = req.Seed
+	result.SimulatedAt = s.clock.Now().UTC()
+
+	s.mu.Lock()
+	if len(s.cache) >= maxCachedSimulations {
+		s.cache = make(map[string]*cachedSimulation)
+	}
+	s.cache[key] = &cachedSimulation{fingerprint: fingerprint, result: result}
+	s.mu.Unlock()
+
+	return result, nil
+}
+
+// simulateSeason runs the simulation over the season's regular season games
+func simulateSeason(season string, games []*models.Game, teams []*models.Team, elo *eloRatings, iterations int, rng *rand.Rand) (*models.SeasonSimulation, error) {
+	simulated := make([]*simulatedTeam, len(teams))
+	index := make(map[int]int, len(teams))
+	for i, team := range teams {
+		simulated[i] = &simulatedTeam{team: team, conference: team.Conference, division: team.Division}
+		index[team.ID] = i
+	}
+	lookup := func(teamID int) int {
+		if i, ok := index[teamID]; ok {
+			return i
+		}
+		return -1
+	}
+
+	result := &models.SeasonSimulation{
+		Season:     season,
+		Iterations: iterations,
+	}
+
+	var remaining []simulatedGame
+	for _, game := range games {
+		home, away := lookup(game.HomeTeamID), lookup(game.AwayTeamID)
+		switch {
+		case game.Status == "cancelled":
+			continue
+		case eloRated(game):
+			result.GamesPlayed++
+			outcome := gameResult(game)
+			for _, i := range []int{home, away} {
+				if i < 0 {
+					continue
+				}
+				team := simulated[i]
+				team.games++
+				switch teamResult(outcome, team.team.ID) {
+				case models.ResultWin:
+					team.wins++
+				case models.ResultLoss:
+					team.losses++
+				case models.ResultTie:
+					team.ties++
+				}
+			}
+		default:
+			result.GamesRemaining++
+			remaining = append(remaining, simulatedGame{home: home, away: away, homeWin: elo.homeWinProbability(game)})
+			for _, i := range []int{home, away} {
+				if i >= 0 {
+					simulated[i].games++
+				}
+			}
+		}
+	}
+
+	conferences, err := seedingGroups(simulated)
+	if err != nil {
+		return nil, err
+	}
+
+	for run := 0; run < iterations; run++ {
+		for _, team := range simulated {
+			team.points = 2*team.wins + team.ties
+			team.tiebreak = rng.Float64()
+		}
+		for _, game := range remaining {
+			winner := game.away
+			if rng.Float64() < game.homeWin {
+				winner = game.home
+			}
+			if winner >= 0 {
+				simulated[winner].points += 2
+				simulated[winner].simulatedWins++
+			}
+		}
+		for _, conference := range conferences {
+			seedConference(simulated, conference)
+		}
+	}
+
+	runs := float64(iterations)
+	result.Teams = make([]*models.TeamSimulation, 0, len(simulated))
+	for _, team := range simulated {
+		expectedWins := float64(team.wins) + float64(team.simulatedWins)/runs
+		entry := &models.TeamSimulation{
+			TeamID:              team.team.ID,
+			TeamName:            team.team.Name,
+			TeamCity:            team.team.City,
+			Conference:          team.conference,
+			Division:            team.division,
+			Rating:              roundPoints(elo.rating(team.team.ID, season)),
+			Wins:                team.wins,
+			Losses:              team.losses,
+			Ties:                team.ties,
+			ExpectedWins:        roundPoints(expectedWins),
+			ExpectedLosses:      roundPoints(float64(team.games-team.ties) - expectedWins),
+			PlayoffProbability:  roundProbability(float64(team.playoffs) / runs),
+			DivisionProbability: roundProbability(float64(team.divisions) / runs),
+			SeedProbabilities:   make([]float64, len(team.seedCounts)),
+		}
+		for i, count := range team.seedCounts {
+			entry.SeedProbabilities[i] = roundProbability(float64(count) / runs)
+		}
+		if len(entry.SeedProbabilities) > 0 {
+			entry.TopSeedProbability = entry.SeedProbabilities[0]
+		}
+		result.Teams = append(result.Teams, entry)
+	}
+
+	sort.SliceStable(result.Teams, func(i, j int) bool {
+		a, b := result.Teams[i], result.Teams[j]
+		if a.Conference != b.Conference {
+			return a.Conference < b.Conference
+		}
+		if a.PlayoffProbability != b.PlayoffProbability {
+			return a.PlayoffProbability > b.PlayoffProbability
+		}
+		if a.ExpectedWins != b.ExpectedWins {
+			return a.ExpectedWins > b.ExpectedWins
+		}
+		return a.TeamID < b.TeamID
+	})
+
+	return result, nil
+}
+
+// seedingGroups groups the teams by conference and division, with each conference's number of
+// playoff seeds from its sport's rules
+func seedingGroups(teams []*simulatedTeam) ([]*conferenceSeeding, error) {
+	var conferences []*conferenceSeeding
+	byConference := make(map[string]*conferenceSeeding)
+	divisions := make(map[string]int)
+	for i, team := range teams {
+		key := team.team.Sport + "|" + team.conference
+		conference, ok := byConference[key]
+		if !ok {
+			rules, err := rulesForSport(team.team.Sport)
+			if err != nil {
+				return nil, fmt.Errorf("failed to seed team %d: %w", team.team.ID, err)
+			}
+			conference = &conferenceSeeding{seeds: rules.PlayoffSeeds()}
+			byConference[key] = conference
+			conferences = append(conferences, conference)
+		}
+		conference.teams = append(conference.teams, i)
+
+		divisionKey := key + "|" + team.division
+		division, ok := divisions[divisionKey]
+		if !ok {
+			division = len(conference.divisions)
+			divisions[divisionKey] = division
+			conference.divisions = append(conference.divisions, nil)
+		}
+		conference.divisions[division] = append(conference.divisions[division], i)
+	}
+
+	for _, conference := range conferences {
+		if conference.seeds > len(conference.teams) {
+			conference.seeds = len(conference.teams)
+		}
+		for _, i := range conference.teams {
+			teams[i].seedCounts = make([]int, conference.seeds)
+		}
+	}
+	return conferences, nil
+}
+
+// seedConference seeds one run's playoff field: the division winners first, then the best of
+// the rest, each ordered by winning percentage with ties broken at random
+func seedConference(teams []*simulatedTeam, conference *conferenceSeeding) {
+	better := func(a, b int) bool {
+		pa, pb := teams[a].winningPercentage(), teams[b].winningPercentage()
+		if pa != pb {
+			return pa > pb
+		}
+		return teams[a].tiebreak > teams[b].tiebreak
+	}
+
+	winners := make([]int, 0, len(conference.divisions))
+	won := make(map[int]bool, len(conference.divisions))
+	for _, division := range conference.divisions {
+		best := division[0]
+		for _, i := range division[1:] {
+			if better(i, best) {
+				best = i
+			}
+		}
+		winners = append(winners, best)
+		won[best] = true
+		teams[best].divisions++
+	}
+
+	rest := make([]int, 0, len(conference.teams)-len(winners))
+	for _, i := range conference.teams {
+		if !won[i] {
+			rest = append(rest, i)
+		}
+	}
+	sort.Slice(winners, func(a, b int) bool { return better(winners[a], winners[b]) })
+	sort.Slice(rest, func(a, b int) bool { return better(rest[a], rest[b]) })
+
+	for seed, i := range append(winners, rest...) {
+		if seed >= conference.seeds {
+			break
+		}
+		teams[i].playoffs++
+		teams[i].seedCounts[seed]++
+	}
+}
+
+// winningPercentage counts ties as half a win; a team without games has 0
+func (t *simulatedTeam) winningPercentage() float64 {
+	if t.games == 0 {
+		return 0
+	}
+	return float64(t.points) / float64(2*t.games)
+}
+
+// simulationFingerprint hashes everything a simulation depends on, so a cached result is
+// reused only while the teams and games are unchanged
+func simulationFingerprint(games []*models.Game, teams []*models.Team) uint64 {
+	hash := fnv.New64a()
+	for _, team := range teams {
+		fmt.Fprintf(hash, "t%d|%s|%s|%s;", team.ID, team.Sport, team.Conference, team.Division)
+	}
+	for _, game := range games {
+		fmt.Fprintf(hash, "g%d|%d|%d|%s|%s|%d|%s|%t|%s|",
+			game.ID, game.HomeTeamID, game.AwayTeamID, game.Season, game.GameType,
+			game.GameDate.Unix(), game.Status, game.NeutralSite, game.UpdatedAt.Format(time.RFC3339Nano))
+		if game.HomeScore != nil && game.AwayScore != nil {
+			fmt.Fprintf(hash, "%d-%d", *game.HomeScore, *game.AwayScore)
+		}
+		hash.Write([]byte{';'})
+	}
+	return hash.Sum64()
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+)
+
+// sportRules holds the validation a sport needs beyond its stat definitions. Supporting
+// another sport means adding its rows to sports and stat_definitions and registering its
+// rules here.
+type sportRules interface {
+	// Conferences and Divisions list the values a team in the sport may belong to
+	Conferences() []string
+	Divisions() []string
+	// ValidateStatLine checks relationships between the stats in a line, such as makes
+	// never exceeding attempts. Stats missing from values were not recorded.
+	ValidateStatLine(values map[string]float64) error
+	// RegulationPeriods is the number of periods in a game; any more are overtime
+	RegulationPeriods() int
+	// PlayoffSeeds is the number of teams from each conference that make the playoffs, the
+	// division winners seeded first
+	PlayoffSeeds() int
+}
+
+// sportRulesByCode maps each supported sport code to its rules
+var sportRulesByCode = map[string]sportRules{
+	models.SportFootball: footballRules{},
+}
+
+// rulesForSport looks up the rules for a sport code
+func rulesForSport(code string) (sportRules, error) {
+	rules, ok := sportRulesByCode[code]
+	if !ok {
+		return nil, fmt.Errorf("sport must be one of: %v", supportedSports())
+	}
+	return rules, nil
+}
+
+// supportedSports lists the codes of the sports with registered rules
+func supportedSports() []string {
+	codes := make([]string, 0, len(sportRulesByCode))
+	for code := range sportRulesByCode {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// validateStatLine checks a stat line against the sport's rules and stat definitions
+func validateStatLine(sport *models.Sport, values map[string]float64) error {
+	rules, err := rulesForSport(sport.Code)
+	if err != nil {
+		return err
+	}
+	if err := rules.ValidateStatLine(values); err != nil {
+		return err
+	}
+
+	defined := make(map[string]bool, len(sport.Stats))
+	for _, definition := range sport.Stats {
+		defined[definition.Name] = true
+
+		value, ok := values[definition.Name]
+		if !ok {
+			continue
+		}
+		label := strings.ToLower(definition.Label)
+		if definition.MinValue != nil && value < *definition.MinValue {
+			if *definition.MinValue == 0 {
+				return fmt.Errorf("%s cannot be negative", label)
+			}
+			return fmt.Errorf("%s cannot be less than %g", label, *definition.MinValue)
+		}
+		if definition.Step > 0 && math.Mod(value/definition.Step, 1) != 0 {
+			return fmt.Errorf("%s must be a multiple of %g", label, definition.Step)
+		}
+	}
+
+	var undefined []string
+	for name := range values {
+		if !defined[name] {
+			undefined = append(undefined, name)
+		}
+	}
+	if len(undefined) > 0 {
+		sort.Strings(undefined)
+		return fmt.Errorf("stats not recorded in %s: %v", sport.Name, undefined)
+	}
+
+	return nil
+}
+
+// footballRules are the rules for American football
+type footballRules struct{}
+
+func (footballRules) Conferences() []string {
+	return []string{"AFC", "NFC"}
+}
+
+func (footballRules) Divisions() []string {
+	return []string{"North", "South", "East", "West"}
+}
+
+func (footballRules) RegulationPeriods() int {
+	return 4
+}
+
+func (footballRules) PlayoffSeeds() int {
+	return 7
+}
+
+func (footballRules) ValidateStatLine(values map[string]float64) error {
+	// Passing completions cannot exceed passing attempts
+	if err := checkNotGreater(values, "passing_completions", "passing_attempts", "passing completions cannot exceed passing attempts"); err != nil {
+		return err
+	}
+
+	// Solo tackles + assisted tackles should equal total tackles (if all provided)
+	tackles, hasTackles := values["tackles"]
+	solo, hasSolo := values["solo_tackles"]
+	assisted, hasAssisted := values["assisted_tackles"]
+	if hasTackles && hasSolo && hasAssisted && tackles != solo+assisted {
+		return fmt.Errorf("total tackles must equal solo tackles plus assisted tackles")
+	}
+
+	pairs := []struct{ part, whole, message string }{
+		{"field_goals_made", "field_goals_attempted", "field goals made cannot exceed field goals attempted"},
+		{"extra_points_made", "extra_points_attempted", "extra points made cannot exceed extra points attempted"},
+		{"fumbles_lost", "fumbles", "fumbles lost cannot exceed total fumbles"},
+	}
+	for _, pair := range pairs {
+		if err := checkNotGreater(values, pair.part, pair.whole, pair.message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkNotGreater fails with the message when both stats were recorded and part is greater than whole
+func checkNotGreater(values map[string]float64, part, whole, message string) error {
+	partValue, hasPart := values[part]
+	wholeValue, hasWhole := values[whole]
+	if hasPart && hasWhole && partValue > wholeValue {
+		return fmt.Errorf("%s", message)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/sport_service.go -pkg mocks . SportService
+
+// SportService defines the interface for sport business logic
+type SportService interface {
+	GetSports() ([]*models.Sport, error)
+	GetSport(code string) (*models.Sport, error)
+	GetStatMetadata(code string) (*models.StatMetadataResponse, error)
+}
+
+// sportService implements SportService interface
+type sportService struct {
+	sportRepo repositories.SportRepository
+}
+
+// NewSportService creates a new sport service
+func NewSportService(sportRepo repositories.SportRepository) SportService {
+	return &sportService{
+		sportRepo: sportRepo,
+	}
+}
+
+// GetSports retrieves every sport
+func (s *sportService) GetSports() ([]*models.Sport, error) {
+	sports, err := s.sportRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	if sports == nil {
+		sports = []*models.Sport{}
+	}
+	return sports, nil
+}
+
+// GetSport retrieves a sport with the definitions of the stats it records
+func (s *sportService) GetSport(code string) (*models.Sport, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if code == "" {
+		return nil, fmt.Errorf("sport code cannot be empty")
+	}
+	return s.sportRepo.GetByCode(code)
+}
+
+// GetStatMetadata retrieves the definitions of every stat a sport records, defaulting to football
+func (s *sportService) GetStatMetadata(code string) (*models.StatMetadataResponse, error) {
+	if strings.TrimSpace(code) == "" {
+		code = models.SportFootball
+	}
+
+	sport, err := s.GetSport(code)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := sport.Stats
+	if stats == nil {
+		stats = []*models.StatDefinition{}
+	}
+	return &models.StatMetadataResponse{Sport: sport.Code, Stats: stats}, nil
+}
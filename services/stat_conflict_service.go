@@ -0,0 +1,55 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/stat_conflict_service.go -pkg mocks . StatConflictService
+
+// StatConflictService defines the interface for reporting stat values kept out by precedence
+type StatConflictService interface {
+	GetConflicts(gameID int, source string, limit int) (*models.StatConflictReport, error)
+}
+
+// statConflictService implements StatConflictService interface
+type statConflictService struct {
+	conflictRepo repositories.StatConflictRepository
+	gameRepo     repositories.GameRepository
+	precedence   *StatPrecedence
+}
+
+// NewStatConflictService creates a new stat conflict service reporting under precedence
+func NewStatConflictService(conflictRepo repositories.StatConflictRepository, gameRepo repositories.GameRepository, precedence *StatPrecedence) StatConflictService {
+	return &statConflictService{
+		conflictRepo: conflictRepo,
+		gameRepo:     gameRepo,
+		precedence:   precedence,
+	}
+}
+
+// GetConflicts retrieves up to limit open conflicts, most recently detected first, optionally
+// only those of one game or of values one source reported, with the precedence that kept them out
+func (s *statConflictService) GetConflicts(gameID int, source string, limit int) (*models.StatConflictReport, error) {
+	if limit < 1 || limit > maxPageSize {
+		return nil, fmt.Errorf("validation failed: limit must be between 1 and %d", maxPageSize)
+	}
+	if gameID < 0 {
+		return nil, fmt.Errorf("invalid game ID: %d", gameID)
+	}
+	if gameID > 0 {
+		if _, err := s.gameRepo.GetByID(gameID); err != nil {
+			return nil, err
+		}
+	}
+
+	conflicts, err := s.conflictRepo.List(gameID, strings.ToLower(strings.TrimSpace(source)), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stat conflicts: %w", err)
+	}
+
+	return &models.StatConflictReport{Precedence: s.precedence.Tiers(), Conflicts: conflicts}, nil
+}
@@ -0,0 +1,262 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"sports-backend/models"
+)
+
+// statGroups maps each stat group to the stats it contains, from the categories in the stat registry
+var statGroups = groupStats(models.FootballStats)
+
+// alwaysAllowedStatGroups are plausible for every position: anyone can fumble or make a tackle on special teams
+var alwaysAllowedStatGroups = []string{"fumbles", "tackling"}
+
+// DefaultStatProfiles returns the built-in per-position stat profiles
+func DefaultStatProfiles() map[string]*models.StatProfile {
+	lineman := &models.StatProfile{
+		Groups: []string{"receiving", "rushing"},
+		Caps:   map[string]int{"receiving_yards": 50, "rushing_yards": 50},
+	}
+	defender := &models.StatProfile{Groups: []string{"defense", "returns"}}
+	kicker := &models.StatProfile{
+		Groups: []string{"kicking", "punting", "passing", "rushing"},
+		Caps:   map[string]int{"passing_yards": 100, "rushing_yards": 100},
+	}
+
+	return map[string]*models.StatProfile{
+		"QB": {
+			Groups: []string{"passing", "rushing", "receiving"},
+			Caps:   map[string]int{"passing_yards": 700, "rushing_yards": 300},
+		},
+		"RB": {
+			Groups: []string{"rushing", "receiving", "returns", "passing"},
+			Caps:   map[string]int{"passing_yards": 150, "rushing_yards": 400},
+		},
+		"FB": {
+			Groups: []string{"rushing", "receiving", "returns"},
+			Caps:   map[string]int{"rushing_yards": 250},
+		},
+		"WR": {
+			Groups: []string{"receiving", "rushing", "returns", "passing"},
+			Caps:   map[string]int{"passing_yards": 150, "receiving_yards": 400},
+		},
+		"TE": {
+			Groups: []string{"receiving", "rushing", "returns", "passing"},
+			Caps:   map[string]int{"passing_yards": 100, "receiving_yards": 300},
+		},
+		"OL": lineman, "OT": lineman, "OG": lineman, "C": lineman,
+		"DL": defender, "DE": defender, "DT": defender, "NT": defender,
+		"LB": defender, "ILB": defender, "OLB": defender, "MLB": defender,
+		"DB": defender, "CB": defender, "S": defender, "FS": defender, "SS": defender,
+		"K":  kicker,
+		"P":  kicker,
+		"LS": {Groups: []string{"defense"}},
+	}
+}
+
+// LoadStatProfiles reads per-position profiles from a JSON file shaped like
+// {"QB": {"groups": ["passing", "rushing"], "caps": {"passing_yards": 700}}}.
+// Positions in the file replace the matching built-in profiles; the rest keep their defaults.
+func LoadStatProfiles(path string) (map[string]*models.StatProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stat profiles: %w", err)
+	}
+
+	var overrides map[string]*models.StatProfile
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse stat profiles: %w", err)
+	}
+
+	profiles := DefaultStatProfiles()
+	for position, profile := range overrides {
+		if profile == nil {
+			return nil, fmt.Errorf("stat profile for %s is empty", position)
+		}
+		for _, group := range profile.Groups {
+			if _, ok := statGroups[group]; !ok {
+				return nil, fmt.Errorf("stat profile for %s has unknown group %q", position, group)
+			}
+		}
+		for stat, limit := range profile.Caps {
+			if statGroupOf(stat) == "" {
+				return nil, fmt.Errorf("stat profile for %s caps unknown stat %q", position, stat)
+			}
+			if limit < 0 {
+				return nil, fmt.Errorf("stat profile for %s caps %s below zero", position, stat)
+			}
+		}
+		profiles[strings.ToUpper(strings.TrimSpace(position))] = profile
+	}
+
+	return profiles, nil
+}
+
+// groupStats maps each category to the names of its stats, in registry order
+func groupStats(definitions []*models.StatDefinition) map[string][]string {
+	groups := make(map[string][]string)
+	for _, definition := range definitions {
+		groups[definition.Category] = append(groups[definition.Category], definition.Name)
+	}
+	return groups
+}
+
+// statGroupOf returns the group a stat belongs to, or "" when the stat is unknown
+func statGroupOf(stat string) string {
+	for group, stats := range statGroups {
+		for _, candidate := range stats {
+			if candidate == stat {
+				return group
+			}
+		}
+	}
+	return ""
+}
+
+// checkStatProfile lists the ways a stat line looks implausible for the position.
+// Positions without a profile are not checked.
+func checkStatProfile(profiles map[string]*models.StatProfile, position string, stats *models.PlayerStats) []string {
+	profile, ok := profiles[strings.ToUpper(strings.TrimSpace(position))]
+	if !ok {
+		return nil
+	}
+
+	allowed := make(map[string]bool)
+	for _, group := range append(profile.Groups, alwaysAllowedStatGroups...) {
+		allowed[group] = true
+	}
+
+	values := statValues(stats)
+	var problems []string
+	for group, names := range statGroups {
+		if allowed[group] {
+			continue
+		}
+		var recorded []string
+		for _, name := range names {
+			if values[name] != 0 {
+				recorded = append(recorded, name)
+			}
+		}
+		if len(recorded) > 0 {
+			problems = append(problems, fmt.Sprintf("%s stats are not expected for a %s (%s)", group, position, strings.Join(recorded, ", ")))
+		}
+	}
+	for name, limit := range profile.Caps {
+		if value := values[name]; value > float64(limit) {
+			problems = append(problems, fmt.Sprintf("%s of %g exceeds the %s cap of %d", name, value, position, limit))
+		}
+	}
+
+	sort.Strings(problems)
+	return problems
+}
+
+// statValues indexes the recorded stats of a line by name, leaving out stats that are not set
+func statValues(stats *models.PlayerStats) map[string]float64 {
+	values := make(map[string]float64)
+	counts := map[string]*int{
+		"passing_attempts":        stats.PassingAttempts,
+		"passing_completions":     stats.PassingCompletions,
+		"passing_yards":           stats.PassingYards,
+		"passing_touchdowns":      stats.PassingTouchdowns,
+		"passing_interceptions":   stats.PassingInterceptions,
+		"rushing_attempts":        stats.RushingAttempts,
+		"rushing_yards":           stats.RushingYards,
+		"rushing_touchdowns":      stats.RushingTouchdowns,
+		"receiving_targets":       stats.ReceivingTargets,
+		"receptions":              stats.Receptions,
+		"receiving_yards":         stats.ReceivingYards,
+		"receiving_touchdowns":    stats.ReceivingTouchdowns,
+		"fumbles":                 stats.Fumbles,
+		"fumbles_lost":            stats.FumblesLost,
+		"tackles":                 stats.Tackles,
+		"solo_tackles":            stats.SoloTackles,
+		"assisted_tackles":        stats.AssistedTackles,
+		"defensive_interceptions": stats.DefensiveInterceptions,
+		"pass_deflections":        stats.PassDeflections,
+		"forced_fumbles":          stats.ForcedFumbles,
+		"fumble_recoveries":       stats.FumbleRecoveries,
+		"defensive_touchdowns":    stats.DefensiveTouchdowns,
+		"field_goals_attempted":   stats.FieldGoalsAttempted,
+		"field_goals_made":        stats.FieldGoalsMade,
+		"extra_points_attempted":  stats.ExtraPointsAttempted,
+		"extra_points_made":       stats.ExtraPointsMade,
+		"punts":                   stats.Punts,
+		"punt_yards":              stats.PuntYards,
+		"kick_returns":            stats.KickReturns,
+		"kick_return_yards":       stats.KickReturnYards,
+		"kick_return_touchdowns":  stats.KickReturnTouchdowns,
+		"punt_returns":            stats.PuntReturns,
+		"punt_return_yards":       stats.PuntReturnYards,
+		"punt_return_touchdowns":  stats.PuntReturnTouchdowns,
+	}
+	for name, value := range counts {
+		if value != nil {
+			values[name] = float64(*value)
+		}
+	}
+	if stats.Sacks != nil {
+		values["sacks"] = *stats.Sacks
+	}
+	return values
+}
+
+// setStat sets a stat of a line by field name, rounding counts to whole numbers. It reports
+// whether the name is a player stats field.
+func setStat(stats *models.PlayerStats, name string, value float64) bool {
+	if name == "sacks" {
+		stats.Sacks = &value
+		return true
+	}
+
+	counts := map[string]**int{
+		"passing_attempts":        &stats.PassingAttempts,
+		"passing_completions":     &stats.PassingCompletions,
+		"passing_yards":           &stats.PassingYards,
+		"passing_touchdowns":      &stats.PassingTouchdowns,
+		"passing_interceptions":   &stats.PassingInterceptions,
+		"rushing_attempts":        &stats.RushingAttempts,
+		"rushing_yards":           &stats.RushingYards,
+		"rushing_touchdowns":      &stats.RushingTouchdowns,
+		"receiving_targets":       &stats.ReceivingTargets,
+		"receptions":              &stats.Receptions,
+		"receiving_yards":         &stats.ReceivingYards,
+		"receiving_touchdowns":    &stats.ReceivingTouchdowns,
+		"fumbles":                 &stats.Fumbles,
+		"fumbles_lost":            &stats.FumblesLost,
+		"tackles":                 &stats.Tackles,
+		"solo_tackles":            &stats.SoloTackles,
+		"assisted_tackles":        &stats.AssistedTackles,
+		"defensive_interceptions": &stats.DefensiveInterceptions,
+		"pass_deflections":        &stats.PassDeflections,
+		"forced_fumbles":          &stats.ForcedFumbles,
+		"fumble_recoveries":       &stats.FumbleRecoveries,
+		"defensive_touchdowns":    &stats.DefensiveTouchdowns,
+		"field_goals_attempted":   &stats.FieldGoalsAttempted,
+		"field_goals_made":        &stats.FieldGoalsMade,
+		"extra_points_attempted":  &stats.ExtraPointsAttempted,
+		"extra_points_made":       &stats.ExtraPointsMade,
+		"punts":                   &stats.Punts,
+		"punt_yards":              &stats.PuntYards,
+		"kick_returns":            &stats.KickReturns,
+		"kick_return_yards":       &stats.KickReturnYards,
+		"kick_return_touchdowns":  &stats.KickReturnTouchdowns,
+		"punt_returns":            &stats.PuntReturns,
+		"punt_return_yards":       &stats.PuntReturnYards,
+		"punt_return_touchdowns":  &stats.PuntReturnTouchdowns,
+	}
+	field, ok := counts[name]
+	if !ok {
+		return false
+	}
+	count := int(math.Round(value))
+	*field = &count
+	return true
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+// DefaultStatSourcePrecedence ranks manual corrections over nflverse's official play-by-play,
+// and both over the live providers, which share a tier so any of them can take over from another
+const DefaultStatSourcePrecedence = "manual>nflverse>espn,sleeper,gsis,pfr"
+
+// StatPrecedence ranks the sources that write stats. A source may overwrite a stat last set by a
+// source of its own tier or below; a different value for a stat set by a higher tier is kept out
+// and reported as a conflict. Sources not listed rank below every listed one, and stats without a
+// recorded source can be overwritten by any source. Manual writes through the API always apply,
+// so a manual correction is only protected from the sources ranked below manual.
+type StatPrecedence struct {
+	tiers [][]string
+	ranks map[string]int
+}
+
+// ParseStatPrecedence reads tiers of sources, highest first, separated by ">", with the sources
+// of a tier separated by ",", such as "manual>nflverse>espn,sleeper"
+func ParseStatPrecedence(spec string) (*StatPrecedence, error) {
+	precedence := &StatPrecedence{ranks: make(map[string]int)}
+	for rank, tier := range strings.Split(spec, ">") {
+		var sources []string
+		for _, source := range strings.Split(tier, ",") {
+			source = strings.ToLower(strings.TrimSpace(source))
+			if source == "" {
+				return nil, fmt.Errorf("tier %d has an empty source", rank+1)
+			}
+			if _, ok := precedence.ranks[source]; ok {
+				return nil, fmt.Errorf("source %q is listed more than once", source)
+			}
+			precedence.ranks[source] = rank
+			sources = append(sources, source)
+		}
+		precedence.tiers = append(precedence.tiers, sources)
+	}
+	return precedence, nil
+}
+
+// Tiers returns the tiers of sources, highest first
+func (p *StatPrecedence) Tiers() [][]string {
+	return p.tiers
+}
+
+// rank returns the tier of a source, lower ranking higher
+func (p *StatPrecedence) rank(source string) int {
+	if rank, ok := p.ranks[source]; ok {
+		return rank
+	}
+	if source == "" {
+		return len(p.tiers) + 1
+	}
+	return len(p.tiers)
+}
+
+// merge writes the values a source reported onto a stat line and records the source of each
+// stat it sets, except where a higher-ranked source set the stat. Those values are returned as
+// conflicts when they differ from the stat's. Names that aren't stat line fields are ignored. It
+// also reports whether the line changed.
+func (p *StatPrecedence) merge(stats *models.PlayerStats, values map[string]float64, source string) (bool, []*models.StatConflict) {
+	current := statValues(stats)
+	changed := false
+	var conflicts []*models.StatConflict
+	for name, value := range values {
+		if !setStat(&models.PlayerStats{}, name, 0) {
+			continue
+		}
+		// Counts are stored as whole numbers, so values are compared as they would be stored
+		if name != "sacks" {
+			value = math.Round(value)
+		}
+		kept, set := current[name]
+		keptSource := stats.Sources[name]
+
+		if set && p.rank(keptSource) < p.rank(source) {
+			if value != kept {
+				conflicts = append(conflicts, &models.StatConflict{
+					StatsID:        stats.ID,
+					PlayerID:       stats.PlayerID,
+					GameID:         stats.GameID,
+					Stat:           name,
+					KeptValue:      kept,
+					KeptSource:     keptSource,
+					RejectedValue:  value,
+					RejectedSource: source,
+				})
+			}
+			continue
+		}
+		if set && value == kept && keptSource == source {
+			continue
+		}
+
+		setStat(stats, name, value)
+		if stats.Sources == nil {
+			stats.Sources = models.StatSources{}
+		}
+		stats.Sources[name] = source
+		changed = true
+	}
+	return changed, conflicts
+}
+
+// markStatSources records source as the source of every stat set on a line, for writes that
+// replace the whole line
+func markStatSources(stats *models.PlayerStats, source string) {
+	stats.Sources = nil
+	for name := range statValues(stats) {
+		if stats.Sources == nil {
+			stats.Sources = models.StatSources{}
+		}
+		stats.Sources[name] = source
+	}
+}
+
+// markUpdatedStatSources records source as the source of the stats an update request sets
+func markUpdatedStatSources(stats *models.PlayerStats, req *models.UpdatePlayerStatsRequest, source string) {
+	// The request's stat fields have the line's JSON names, and fields it doesn't set are left out
+	data, err := json.Marshal(req)
+	if err != nil {
+		return
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return
+	}
+
+	for name := range fields {
+		if !setStat(&models.PlayerStats{}, name, 0) {
+			continue
+		}
+		if stats.Sources == nil {
+			stats.Sources = models.StatSources{}
+		}
+		stats.Sources[name] = source
+	}
+}
+
+// recordStatConflicts brings a source's open conflicts on the stat lines it reported up to date:
+// conflicts that are new or whose values changed are saved, and those the source no longer
+// reports are resolved. Nothing is written when they are unchanged, so a source reporting the
+// same values again leaves the detection times as they were.
+func recordStatConflicts(repo repositories.StatConflictRepository, source string, statsIDs []int, conflicts []*models.StatConflict) error {
+	open, err := repo.GetBySource(source, statsIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get stat conflicts: %w", err)
+	}
+
+	type key struct {
+		statsID int
+		stat    string
+	}
+	existing := make(map[key]*models.StatConflict, len(open))
+	for _, conflict := range open {
+		existing[key{conflict.StatsID, conflict.Stat}] = conflict
+	}
+
+	var changed []*models.StatConflict
+	for _, conflict := range conflicts {
+		k := key{conflict.StatsID, conflict.Stat}
+		previous, ok := existing[k]
+		delete(existing, k)
+		if ok && previous.KeptValue == conflict.KeptValue && previous.KeptSource == conflict.KeptSource && previous.RejectedValue == conflict.RejectedValue {
+			continue
+		}
+		changed = append(changed, conflict)
+	}
+	var resolved []int
+	for _, conflict := range existing {
+		resolved = append(resolved, conflict.ID)
+	}
+
+	if len(changed) == 0 && len(resolved) == 0 {
+		return nil
+	}
+	if err := repo.Save(changed, resolved); err != nil {
+		return fmt.Errorf("failed to save stat conflicts: %w", err)
+	}
+	return nil
+}
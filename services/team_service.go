@@ -8,6 +8,8 @@ import (
 	"sports-backend/repositories"
 )
 
+//go:generate moq -out mocks/team_service.go -pkg mocks . TeamService
+
 // TeamService defines the interface for team business logic
 type TeamService interface {
 	GetTeam(id int) (*models.Team, error)
@@ -17,17 +19,21 @@ type TeamService interface {
 	CreateTeam(req *models.CreateTeamRequest) (*models.Team, error)
 	UpdateTeam(id int, req *models.UpdateTeamRequest) (*models.Team, error)
 	DeleteTeam(id int) error
+	RestoreTeam(id int) (*models.Team, error)
+	PurgeTeam(id int) error
 }
 
 // teamService implements TeamService interface
 type teamService struct {
-	teamRepo repositories.TeamRepository
+	teamRepo       repositories.TeamRepository
+	externalIDRepo repositories.ExternalIDRepository
 }
 
 // NewTeamService creates a new team service
-func NewTeamService(teamRepo repositories.TeamRepository) TeamService {
+func NewTeamService(teamRepo repositories.TeamRepository, externalIDRepo repositories.ExternalIDRepository) TeamService {
 	return &teamService{
-		teamRepo: teamRepo,
+		teamRepo:       teamRepo,
+		externalIDRepo: externalIDRepo,
 	}
 }
 
@@ -42,6 +48,10 @@ func (s *teamService) GetTeam(id int) (*models.Team, error) {
 		return nil, fmt.Errorf("failed to get team: %w", err)
 	}
 
+	if err := s.attachExternalIDs([]*models.Team{team}); err != nil {
+		return nil, err
+	}
+
 	return team, nil
 }
 
@@ -52,6 +62,10 @@ func (s *teamService) GetAllTeams() ([]*models.Team, error) {
 		return nil, fmt.Errorf("failed to get teams: %w", err)
 	}
 
+	if err := s.attachExternalIDs(teams); err != nil {
+		return nil, err
+	}
+
 	return teams, nil
 }
 
@@ -66,6 +80,10 @@ func (s *teamService) GetTeamsByConference(conference string) ([]*models.Team, e
 		return nil, fmt.Errorf("failed to get teams by conference: %w", err)
 	}
 
+	if err := s.attachExternalIDs(teams); err != nil {
+		return nil, err
+	}
+
 	return teams, nil
 }
 
@@ -80,6 +98,10 @@ func (s *teamService) GetTeamsByDivision(division string) ([]*models.Team, error
 		return nil, fmt.Errorf("failed to get teams by division: %w", err)
 	}
 
+	if err := s.attachExternalIDs(teams); err != nil {
+		return nil, err
+	}
+
 	return teams, nil
 }
 
@@ -89,18 +111,31 @@ func (s *teamService) CreateTeam(req *models.CreateTeamRequest) (*models.Team, e
 	if err := s.validateCreateTeamRequest(req); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	if err := validateExternalIDs(req.ExternalIDs); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Provider IDs that are already mapped mean the team was imported before
+	if err := checkExternalIDsUnclaimed(s.externalIDRepo, entityTypeTeam, 0, req.ExternalIDs); err != nil {
+		return nil, err
+	}
 
 	// Create team
 	team := &models.Team{
-		Name:       strings.TrimSpace(req.Name),
-		City:       strings.TrimSpace(req.City),
-		Conference: strings.TrimSpace(req.Conference),
-		Division:   strings.TrimSpace(req.Division),
+		Name:        strings.TrimSpace(req.Name),
+		City:        strings.TrimSpace(req.City),
+		Conference:  strings.TrimSpace(req.Conference),
+		Division:    strings.TrimSpace(req.Division),
+		Sport:       teamSport(req.Sport),
+		ExternalIDs: normalizedExternalIDs(req.ExternalIDs),
 	}
 
 	if err := s.teamRepo.Create(team); err != nil {
 		return nil, fmt.Errorf("failed to create team: %w", err)
 	}
+	if err := s.attachExternalIDs([]*models.Team{team}); err != nil {
+		return nil, err
+	}
 
 	return team, nil
 }
@@ -122,6 +157,21 @@ func (s *teamService) UpdateTeam(id int, req *models.UpdateTeamRequest) (*models
 		return nil, fmt.Errorf("failed to get team: %w", err)
 	}
 
+	rules, err := rulesForSport(team.Sport)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if req.Conference != nil {
+		if err := validateOneOf("conference", *req.Conference, rules.Conferences()); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+	}
+	if req.Division != nil {
+		if err := validateOneOf("division", *req.Division, rules.Divisions()); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+	}
+
 	// Update fields if provided
 	if req.Name != nil {
 		team.Name = strings.TrimSpace(*req.Name)
@@ -159,9 +209,21 @@ func (s *teamService) DeleteTeam(id int) error {
 		return fmt.Errorf("team with ID %d not found", id)
 	}
 
-	// TODO: Add business logic here if needed
-	// For example: check if team has players, prevent deletion if they do
-	// For example: check if team has games, prevent deletion if they do
+	hasPlayers, err := s.teamRepo.HasPlayers(id)
+	if err != nil {
+		return fmt.Errorf("failed to check team players: %w", err)
+	}
+	if hasPlayers {
+		return fmt.Errorf("team with ID %d cannot be deleted because it still has players; move or delete them first", id)
+	}
+
+	hasGames, err := s.teamRepo.HasGames(id)
+	if err != nil {
+		return fmt.Errorf("failed to check team games: %w", err)
+	}
+	if hasGames {
+		return fmt.Errorf("team with ID %d cannot be deleted because it still has games; delete them first", id)
+	}
 
 	if err := s.teamRepo.Delete(id); err != nil {
 		return fmt.Errorf("failed to delete team: %w", err)
@@ -170,6 +232,46 @@ func (s *teamService) DeleteTeam(id int) error {
 	return nil
 }
 
+// RestoreTeam brings back a soft-deleted team
+func (s *teamService) RestoreTeam(id int) (*models.Team, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid team ID: %d", id)
+	}
+
+	if err := s.teamRepo.Restore(id); err != nil {
+		return nil, err
+	}
+
+	return s.GetTeam(id)
+}
+
+// PurgeTeam permanently removes a team that has already been deleted
+func (s *teamService) PurgeTeam(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid team ID: %d", id)
+	}
+
+	return s.teamRepo.Purge(id)
+}
+
+// attachExternalIDs sets the provider IDs of each team
+func (s *teamService) attachExternalIDs(teams []*models.Team) error {
+	teamIDs := make([]int, len(teams))
+	for i, team := range teams {
+		teamIDs[i] = team.ID
+	}
+
+	externalIDs, err := s.externalIDRepo.GetByEntityIDs(entityTypeTeam, teamIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get external IDs: %w", err)
+	}
+	for _, team := range teams {
+		team.ExternalIDs = externalIDs[team.ID]
+	}
+
+	return nil
+}
+
 // validateCreateTeamRequest validates the create team request
 func (s *teamService) validateCreateTeamRequest(req *models.CreateTeamRequest) error {
 	if strings.TrimSpace(req.Name) == "" {
@@ -188,36 +290,18 @@ func (s *teamService) validateCreateTeamRequest(req *models.CreateTeamRequest) e
 		return fmt.Errorf("division is required")
 	}
 
-	// Validate conference and division values
-	validConferences := []string{"AFC", "NFC"}
-	validDivisions := []string{"North", "South", "East", "West"}
-
-	conferenceValid := false
-	for _, validConf := range validConferences {
-		if strings.EqualFold(req.Conference, validConf) {
-			conferenceValid = true
-			break
-		}
-	}
-	if !conferenceValid {
-		return fmt.Errorf("conference must be one of: %v", validConferences)
-	}
-
-	divisionValid := false
-	for _, validDiv := range validDivisions {
-		if strings.EqualFold(req.Division, validDiv) {
-			divisionValid = true
-			break
-		}
+	rules, err := rulesForSport(teamSport(req.Sport))
+	if err != nil {
+		return err
 	}
-	if !divisionValid {
-		return fmt.Errorf("division must be one of: %v", validDivisions)
+	if err := validateOneOf("conference", req.Conference, rules.Conferences()); err != nil {
+		return err
 	}
-
-	return nil
+	return validateOneOf("division", req.Division, rules.Divisions())
 }
 
-// validateUpdateTeamRequest validates the update team request
+// validateUpdateTeamRequest validates the update team request. Conference and division are
+// checked against the team's sport once the team is loaded.
 func (s *teamService) validateUpdateTeamRequest(req *models.UpdateTeamRequest) error {
 	// Check if at least one field is being updated
 	if req.Name == nil && req.City == nil && req.Conference == nil &&
@@ -242,34 +326,13 @@ func (s *teamService) validateUpdateTeamRequest(req *models.UpdateTeamRequest) e
 		return fmt.Errorf("division cannot be empty")
 	}
 
-	// Validate conference and division values if provided
-	if req.Conference != nil {
-		validConferences := []string{"AFC", "NFC"}
-		conferenceValid := false
-		for _, validConf := range validConferences {
-			if strings.EqualFold(*req.Conference, validConf) {
-				conferenceValid = true
-				break
-			}
-		}
-		if !conferenceValid {
-			return fmt.Errorf("conference must be one of: %v", validConferences)
-		}
-	}
+	return nil
+}
 
-	if req.Division != nil {
-		validDivisions := []string{"North", "South", "East", "West"}
-		divisionValid := false
-		for _, validDiv := range validDivisions {
-			if strings.EqualFold(*req.Division, validDiv) {
-				divisionValid = true
-				break
-			}
-		}
-		if !divisionValid {
-			return fmt.Errorf("division must be one of: %v", validDivisions)
-		}
+// teamSport returns the lowercase sport code from a request, defaulting to football
+func teamSport(sport string) string {
+	if sport = strings.ToLower(strings.TrimSpace(sport)); sport == "" {
+		return models.SportFootball
 	}
-
-	return nil
+	return sport
 }
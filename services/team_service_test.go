@@ -0,0 +1,199 @@
+package services_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"sports-backend/models"
+	"sports-backend/repositories/mocks"
+	"sports-backend/services"
+)
+
+// noExternalIDs is an external ID repository holding no mappings
+func noExternalIDs() *mocks.ExternalIDRepositoryMock {
+	return &mocks.ExternalIDRepositoryMock{
+		GetByEntityIDsFunc: func(entityType string, entityIDs []int) (map[int]map[string]string, error) {
+			return map[int]map[string]string{}, nil
+		},
+		LookupFunc: func(entityType, provider, externalID string) (*models.ExternalID, error) {
+			return nil, fmt.Errorf("%s with %s ID %s not found", entityType, provider, externalID)
+		},
+	}
+}
+
+func TestGetTeamRejectsInvalidID(t *testing.T) {
+	// Calling either repository would panic, as no functions are set
+	service := services.NewTeamService(&mocks.TeamRepositoryMock{}, &mocks.ExternalIDRepositoryMock{})
+
+	if _, err := service.GetTeam(0); err == nil || !strings.Contains(err.Error(), "invalid team ID") {
+		t.Fatalf("GetTeam(0) error = %v, want invalid team ID", err)
+	}
+}
+
+func TestGetTeamAttachesExternalIDs(t *testing.T) {
+	teams := &mocks.TeamRepositoryMock{
+		GetByIDFunc: func(id int) (*models.Team, error) {
+			return &models.Team{ID: id, Name: "Chiefs"}, nil
+		},
+	}
+	externalIDs := &mocks.ExternalIDRepositoryMock{
+		GetByEntityIDsFunc: func(entityType string, entityIDs []int) (map[int]map[string]string, error) {
+			return map[int]map[string]string{7: {"espn": "12"}}, nil
+		},
+	}
+	service := services.NewTeamService(teams, externalIDs)
+
+	team, err := service.GetTeam(7)
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+	if team.ExternalIDs["espn"] != "12" {
+		t.Errorf("ExternalIDs = %v, want espn 12", team.ExternalIDs)
+	}
+	if calls := externalIDs.GetByEntityIDsCalls(); len(calls) != 1 || calls[0].EntityType != "team" {
+		t.Errorf("GetByEntityIDs calls = %+v, want one for teams", calls)
+	}
+}
+
+func TestCreateTeamValidation(t *testing.T) {
+	valid := models.CreateTeamRequest{Name: "Chiefs", City: "Kansas City", Conference: "AFC", Division: "West"}
+
+	tests := []struct {
+		name   string
+		modify func(*models.CreateTeamRequest)
+		want   string
+	}{
+		{"missing name", func(r *models.CreateTeamRequest) { r.Name = " " }, "team name is required"},
+		{"missing city", func(r *models.CreateTeamRequest) { r.City = "" }, "city is required"},
+		{"unknown conference", func(r *models.CreateTeamRequest) { r.Conference = "XFL" }, "conference must be one of"},
+		{"unknown division", func(r *models.CreateTeamRequest) { r.Division = "Central-West" }, "division must be one of"},
+		{"unknown provider", func(r *models.CreateTeamRequest) { r.ExternalIDs = map[string]string{"yahoo": "1"} }, "provider must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := services.NewTeamService(&mocks.TeamRepositoryMock{}, &mocks.ExternalIDRepositoryMock{})
+			req := valid
+			tt.modify(&req)
+
+			_, err := service.CreateTeam(&req)
+			if err == nil || !strings.Contains(err.Error(), "validation failed") || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("CreateTeam error = %v, want validation failure containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateTeamTrimsAndSavesExternalIDs(t *testing.T) {
+	var created map[string]string
+	teams := &mocks.TeamRepositoryMock{
+		CreateFunc: func(team *models.Team) error {
+			team.ID = 3
+			created = team.ExternalIDs
+			return nil
+		},
+	}
+	externalIDs := noExternalIDs()
+	service := services.NewTeamService(teams, externalIDs)
+
+	team, err := service.CreateTeam(&models.CreateTeamRequest{
+		Name: " Chiefs ", City: "Kansas City ", Conference: "AFC", Division: "West",
+		ExternalIDs: map[string]string{"ESPN": " 12 "},
+	})
+	if err != nil {
+		t.Fatalf("CreateTeam: %v", err)
+	}
+
+	if team.ID != 3 || team.Name != "Chiefs" || team.City != "Kansas City" || team.Sport != models.SportFootball {
+		t.Errorf("team = %+v, want ID 3, trimmed names and the football sport", team)
+	}
+	// The IDs are written with the team, in its transaction, not separately afterwards
+	if len(created) != 1 || created["espn"] != "12" {
+		t.Errorf("external IDs created with the team = %v, want espn 12", created)
+	}
+	if calls := externalIDs.CreateCalls(); len(calls) != 0 {
+		t.Errorf("external ID Create calls = %d, want none", len(calls))
+	}
+}
+
+func TestCreateTeamRefusesClaimedExternalID(t *testing.T) {
+	teams := &mocks.TeamRepositoryMock{}
+	externalIDs := &mocks.ExternalIDRepositoryMock{
+		LookupFunc: func(entityType, provider, externalID string) (*models.ExternalID, error) {
+			return &models.ExternalID{EntityType: entityType, EntityID: 9, Provider: provider, ExternalID: externalID}, nil
+		},
+	}
+	service := services.NewTeamService(teams, externalIDs)
+
+	_, err := service.CreateTeam(&models.CreateTeamRequest{
+		Name: "Chiefs", City: "Kansas City", Conference: "AFC", Division: "West",
+		ExternalIDs: map[string]string{"espn": "12"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "already exists with ID 9") {
+		t.Fatalf("CreateTeam error = %v, want the existing team's ID", err)
+	}
+	if len(teams.CreateCalls()) != 0 {
+		t.Error("team was created despite the claimed provider ID")
+	}
+}
+
+func TestDeleteTeamRefusesTeamInUse(t *testing.T) {
+	tests := []struct {
+		name       string
+		hasPlayers bool
+		hasGames   bool
+		want       string
+	}{
+		{"players", true, false, "still has players"},
+		{"games", false, true, "still has games"},
+		{"unused", false, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams := &mocks.TeamRepositoryMock{
+				ExistsFunc:     func(id int) (bool, error) { return true, nil },
+				HasPlayersFunc: func(id int) (bool, error) { return tt.hasPlayers, nil },
+				HasGamesFunc:   func(id int) (bool, error) { return tt.hasGames, nil },
+				DeleteFunc:     func(id int) error { return nil },
+			}
+			service := services.NewTeamService(teams, &mocks.ExternalIDRepositoryMock{})
+
+			err := service.DeleteTeam(4)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("DeleteTeam: %v", err)
+				}
+				if len(teams.DeleteCalls()) != 1 {
+					t.Errorf("Delete calls = %d, want 1", len(teams.DeleteCalls()))
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("DeleteTeam error = %v, want %q", err, tt.want)
+			}
+			if len(teams.DeleteCalls()) != 0 {
+				t.Error("team in use was deleted")
+			}
+		})
+	}
+}
+
+func TestUpdateTeamChecksDivisionAgainstSport(t *testing.T) {
+	teams := &mocks.TeamRepositoryMock{
+		GetByIDFunc: func(id int) (*models.Team, error) {
+			return &models.Team{ID: id, Name: "Chiefs", Conference: "AFC", Division: "West", Sport: models.SportFootball}, nil
+		},
+	}
+	service := services.NewTeamService(teams, &mocks.ExternalIDRepositoryMock{})
+
+	division := "Atlantic"
+	_, err := service.UpdateTeam(1, &models.UpdateTeamRequest{Division: &division})
+	if err == nil || !strings.Contains(err.Error(), "division must be one of") {
+		t.Fatalf("UpdateTeam error = %v, want a division validation failure", err)
+	}
+	if len(teams.UpdateCalls()) != 0 {
+		t.Error("team was updated with an invalid division")
+	}
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+//go:generate moq -out mocks/venue_service.go -pkg mocks . VenueService
+
+var (
+	validSurfaces  = []string{"grass", "turf", "hybrid"}
+	validRoofTypes = []string{"open", "dome", "retractable"}
+)
+
+// VenueService defines the interface for venue business logic
+type VenueService interface {
+	GetVenue(id int) (*models.Venue, error)
+	GetAllVenues() ([]*models.Venue, error)
+	CreateVenue(req *models.CreateVenueRequest) (*models.Venue, error)
+	UpdateVenue(id int, req *models.UpdateVenueRequest) (*models.Venue, error)
+	DeleteVenue(id int) error
+}
+
+// venueService implements VenueService interface
+type venueService struct {
+	venueRepo repositories.VenueRepository
+}
+
+// NewVenueService creates a new venue service
+func NewVenueService(venueRepo repositories.VenueRepository) VenueService {
+	return &venueService{
+		venueRepo: venueRepo,
+	}
+}
+
+// GetVenue retrieves a venue by ID
+func (s *venueService) GetVenue(id int) (*models.Venue, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid venue ID: %d", id)
+	}
+
+	venue, err := s.venueRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+
+	return venue, nil
+}
+
+// GetAllVenues retrieves all venues
+func (s *venueService) GetAllVenues() ([]*models.Venue, error) {
+	venues, err := s.venueRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venues: %w", err)
+	}
+
+	return venues, nil
+}
+
+// CreateVenue creates a new venue
+func (s *venueService) CreateVenue(req *models.CreateVenueRequest) (*models.Venue, error) {
+	// Validate request
+	if err := s.validateCreateVenueRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	country := strings.TrimSpace(req.Country)
+	if country == "" {
+		country = "USA"
+	}
+
+	venue := &models.Venue{
+		Name:      strings.TrimSpace(req.Name),
+		City:      strings.TrimSpace(req.City),
+		Country:   country,
+		Surface:   strings.ToLower(strings.TrimSpace(req.Surface)),
+		RoofType:  strings.ToLower(strings.TrimSpace(req.RoofType)),
+		Capacity:  req.Capacity,
+		Timezone:  strings.TrimSpace(req.Timezone),
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	}
+
+	if err := s.venueRepo.Create(venue); err != nil {
+		return nil, fmt.Errorf("failed to create venue: %w", err)
+	}
+
+	return venue, nil
+}
+
+// UpdateVenue updates an existing venue
+func (s *venueService) UpdateVenue(id int, req *models.UpdateVenueRequest) (*models.Venue, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid venue ID: %d", id)
+	}
+
+	// Validate request
+	if err := s.validateUpdateVenueRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Get existing venue
+	venue, err := s.venueRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+
+	// Update fields if provided
+	if req.Name != nil {
+		venue.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.City != nil {
+		venue.City = strings.TrimSpace(*req.City)
+	}
+	if req.Country != nil {
+		venue.Country = strings.TrimSpace(*req.Country)
+	}
+	if req.Surface != nil {
+		venue.Surface = strings.ToLower(strings.TrimSpace(*req.Surface))
+	}
+	if req.RoofType != nil {
+		venue.RoofType = strings.ToLower(strings.TrimSpace(*req.RoofType))
+	}
+	if req.Capacity != nil {
+		venue.Capacity = req.Capacity
+	}
+	if req.Timezone != nil {
+		venue.Timezone = strings.TrimSpace(*req.Timezone)
+	}
+	if req.Latitude != nil {
+		venue.Latitude = req.Latitude
+	}
+	if req.Longitude != nil {
+		venue.Longitude = req.Longitude
+	}
+
+	if err := s.venueRepo.Update(venue); err != nil {
+		return nil, fmt.Errorf("failed to update venue: %w", err)
+	}
+
+	return venue, nil
+}
+
+// DeleteVenue deletes a venue that no games reference
+func (s *venueService) DeleteVenue(id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid venue ID: %d", id)
+	}
+
+	// Check if venue exists
+	exists, err := s.venueRepo.Exists(id)
+	if err != nil {
+		return fmt.Errorf("failed to check venue existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("venue with ID %d not found", id)
+	}
+
+	hasGames, err := s.venueRepo.HasGames(id)
+	if err != nil {
+		return fmt.Errorf("failed to check venue games: %w", err)
+	}
+	if hasGames {
+		return fmt.Errorf("venue with ID %d cannot be deleted because games are scheduled there", id)
+	}
+
+	if err := s.venueRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete venue: %w", err)
+	}
+
+	return nil
+}
+
+// validateCreateVenueRequest validates the create venue request
+func (s *venueService) validateCreateVenueRequest(req *models.CreateVenueRequest) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return fmt.Errorf("venue name is required")
+	}
+
+	if strings.TrimSpace(req.City) == "" {
+		return fmt.Errorf("city is required")
+	}
+
+	if err := validateOneOf("surface", req.Surface, validSurfaces); err != nil {
+		return err
+	}
+
+	if err := validateOneOf("roof type", req.RoofType, validRoofTypes); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(req.Timezone) == "" {
+		return fmt.Errorf("timezone is required")
+	}
+
+	return validateVenueDetails(req.Capacity, &req.Timezone, req.Latitude, req.Longitude)
+}
+
+// validateUpdateVenueRequest validates the update venue request
+func (s *venueService) validateUpdateVenueRequest(req *models.UpdateVenueRequest) error {
+	// Check if at least one field is being updated
+	if req.Name == nil && req.City == nil && req.Country == nil && req.Surface == nil &&
+		req.RoofType == nil && req.Capacity == nil && req.Timezone == nil &&
+		req.Latitude == nil && req.Longitude == nil {
+		return fmt.Errorf("at least one field must be provided for update")
+	}
+
+	if req.Name != nil && strings.TrimSpace(*req.Name) == "" {
+		return fmt.Errorf("venue name cannot be empty")
+	}
+
+	if req.City != nil && strings.TrimSpace(*req.City) == "" {
+		return fmt.Errorf("city cannot be empty")
+	}
+
+	if req.Country != nil && strings.TrimSpace(*req.Country) == "" {
+		return fmt.Errorf("country cannot be empty")
+	}
+
+	if req.Surface != nil {
+		if err := validateOneOf("surface", *req.Surface, validSurfaces); err != nil {
+			return err
+		}
+	}
+
+	if req.RoofType != nil {
+		if err := validateOneOf("roof type", *req.RoofType, validRoofTypes); err != nil {
+			return err
+		}
+	}
+
+	return validateVenueDetails(req.Capacity, req.Timezone, req.Latitude, req.Longitude)
+}
+
+// validateVenueDetails validates the optional numeric and timezone fields of a venue
+func validateVenueDetails(capacity *int, timezone *string, latitude, longitude *float64) error {
+	if capacity != nil && *capacity <= 0 {
+		return fmt.Errorf("capacity must be positive")
+	}
+
+	if timezone != nil {
+		if _, err := time.LoadLocation(strings.TrimSpace(*timezone)); err != nil || strings.TrimSpace(*timezone) == "" {
+			return fmt.Errorf("timezone must be a valid IANA time zone name such as America/Chicago")
+		}
+	}
+
+	if latitude != nil && (*latitude < -90 || *latitude > 90) {
+		return fmt.Errorf("latitude must be between -90 and 90")
+	}
+
+	if longitude != nil && (*longitude < -180 || *longitude > 180) {
+		return fmt.Errorf("longitude must be between -180 and 180")
+	}
+
+	return nil
+}
+
+// validateOneOf checks that value case-insensitively matches one of the allowed values
+func validateOneOf(field, value string, allowed []string) error {
+	for _, candidate := range allowed {
+		if strings.EqualFold(strings.TrimSpace(value), candidate) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must be one of: %v", field, allowed)
+}
@@ -0,0 +1,43 @@
+// Package storage keeps files such as backups, exports and uploads outside the container
+// filesystem, on local disk or in an S3-compatible object store.
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when no object has the key
+var ErrNotFound = errors.New("object not found")
+
+// Storage stores objects by key. Keys are slash-separated relative paths such as
+// backups/sports-20261015T020000.000Z.db.
+type Storage interface {
+	// Put stores size bytes read from body under key, replacing any object already there
+	Put(key string, body io.Reader, size int64, contentType string) error
+	// Get opens the object under key; the caller must close it
+	Get(key string) (io.ReadCloser, error)
+	// Delete removes the object under key; removing a missing object is not an error
+	Delete(key string) error
+	// List lists the objects whose keys start with prefix, in key order
+	List(prefix string) ([]*Object, error)
+}
+
+// Object describes a stored object
+type Object struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// validateKey rejects keys that are empty, absolute, or would escape the store's root
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("invalid storage key %q", key)
+	}
+	return nil
+}
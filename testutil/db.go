@@ -0,0 +1,43 @@
+// Package testutil provides fixtures for tests that run against a real SQLite database:
+// a throwaway database with every migration applied, a factory for the records most tests
+// need, and golden file comparison of JSON responses.
+package testutil
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"sports-backend/database"
+	"sports-backend/repositories"
+)
+
+// queryTimeout bounds fixture queries generously so a hung query fails the test instead of stalling it
+const queryTimeout = 30 * time.Second
+
+// NewDB opens an empty SQLite database in the test's temp directory, runs the migrations and
+// returns the handle repositories are built on. The database is closed when the test ends.
+//
+// Migrations run against the package-level database.DB, so NewDB points it at the new database
+// for the duration of the test. Tests using NewDB must not call t.Parallel.
+func NewDB(t testing.TB) *repositories.TimeoutDB {
+	t.Helper()
+
+	db, err := database.Open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+
+	previous := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previous
+		db.Close()
+	})
+
+	if err := database.RunMigrations(); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	return repositories.NewTimeoutDB(db, queryTimeout, queryTimeout, 0, 0)
+}
@@ -0,0 +1,134 @@
+package testutil
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"sports-backend/clock"
+	"sports-backend/models"
+	"sports-backend/repositories"
+)
+
+// Factory inserts teams, players, games and stat lines with valid defaults, so a test only
+// sets the fields it cares about. Names and game dates come from a counter, so records made
+// by one factory never collide on a unique constraint. Any failure to insert fails the test.
+type Factory struct {
+	t        testing.TB
+	teams    repositories.TeamRepository
+	players  repositories.PlayerRepository
+	games    repositories.GameRepository
+	stats    repositories.PlayerStatsRepository
+	sequence int
+}
+
+// NewFactory creates a factory writing to db, usually from NewDB
+func NewFactory(t testing.TB, db *repositories.TimeoutDB) *Factory {
+	return &Factory{
+		t:       t,
+		teams:   repositories.NewTeamRepository(db, clock.System),
+		players: repositories.NewPlayerRepository(db, clock.System),
+		games:   repositories.NewGameRepository(db, clock.System),
+		stats:   repositories.NewPlayerStatsRepository(db, clock.System),
+	}
+}
+
+// Team inserts a football team, applying the overrides to the defaults first
+func (f *Factory) Team(overrides ...func(*models.Team)) *models.Team {
+	f.t.Helper()
+	n := f.next()
+	team := &models.Team{
+		Name:       fmt.Sprintf("Team %d", n),
+		City:       fmt.Sprintf("City %d", n),
+		Conference: "AFC",
+		Division:   "East",
+		Sport:      models.SportFootball,
+	}
+	for _, override := range overrides {
+		override(team)
+	}
+
+	if err := f.teams.Create(team); err != nil {
+		f.t.Fatalf("failed to create team fixture: %v", err)
+	}
+	return team
+}
+
+// Player inserts a quarterback without a jersey number on the team, applying the overrides
+// to the defaults first
+func (f *Factory) Player(teamID int, overrides ...func(*models.Player)) *models.Player {
+	f.t.Helper()
+	n := f.next()
+	player := &models.Player{
+		TeamID:    teamID,
+		FirstName: "Player",
+		LastName:  fmt.Sprintf("Number %d", n),
+		Position:  "QB",
+	}
+	for _, override := range overrides {
+		override(player)
+	}
+
+	if err := f.players.Create(player); err != nil {
+		f.t.Fatalf("failed to create player fixture: %v", err)
+	}
+	return player
+}
+
+// Game inserts a scheduled week 1 regular season game of 2026 between the teams, applying the
+// overrides to the defaults first. Each game is a day after the previous one.
+func (f *Factory) Game(homeTeamID, awayTeamID int, overrides ...func(*models.Game)) *models.Game {
+	f.t.Helper()
+	n := f.next()
+	game := &models.Game{
+		HomeTeamID: homeTeamID,
+		AwayTeamID: awayTeamID,
+		Season:     "2026",
+		Week:       1,
+		GameType:   models.GameTypeRegular,
+		GameDate:   time.Date(2026, time.September, 10, 20, 0, 0, 0, time.UTC).AddDate(0, 0, n),
+		Status:     "scheduled",
+	}
+	for _, override := range overrides {
+		override(game)
+	}
+
+	if err := f.games.Create(game); err != nil {
+		f.t.Fatalf("failed to create game fixture: %v", err)
+	}
+	return game
+}
+
+// Stats inserts the player's stat line for the game with no stats recorded, applying the
+// overrides first. Use Int and Float to set stats inline.
+func (f *Factory) Stats(playerID, gameID int, overrides ...func(*models.PlayerStats)) *models.PlayerStats {
+	f.t.Helper()
+	stats := &models.PlayerStats{
+		PlayerID: playerID,
+		GameID:   gameID,
+	}
+	for _, override := range overrides {
+		override(stats)
+	}
+
+	if err := f.stats.Create(stats); err != nil {
+		f.t.Fatalf("failed to create player stats fixture: %v", err)
+	}
+	return stats
+}
+
+// next advances the factory's counter
+func (f *Factory) next() int {
+	f.sequence++
+	return f.sequence
+}
+
+// Int returns a pointer to v, for optional model fields
+func Int(v int) *int {
+	return &v
+}
+
+// Float returns a pointer to v, for optional model fields
+func Float(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,92 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// update rewrites golden files with the responses tests receive instead of comparing them
+var update = flag.Bool("update", false, "rewrite golden files with the current responses")
+
+// masked replaces the values of volatile fields in golden files
+const masked = "<masked>"
+
+// Golden compares a JSON response body with testdata/<name>.golden in the test's package,
+// failing the test on any difference. The values of the named fields are masked at any depth
+// first, for fields such as created_at that change from run to run. Run the tests with
+// -update to write the golden files from the current responses.
+func Golden(t testing.TB, name string, body []byte, mask ...string) {
+	t.Helper()
+
+	got, err := canonicalJSON(body, mask)
+	if err != nil {
+		t.Fatalf("response for %s is not JSON: %v\n%s", name, err, body)
+	}
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create golden directory: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("response for %s does not match %s\ngot:\n%s\nwant:\n%s", name, path, got, want)
+	}
+}
+
+// canonicalJSON indents body with sorted keys and the masked fields replaced, so golden files
+// diff cleanly
+func canonicalJSON(body []byte, mask []string) ([]byte, error) {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]bool, len(mask))
+	for _, field := range mask {
+		fields[field] = true
+	}
+	value = maskFields(value, fields)
+
+	// Maps encode with sorted keys
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// maskFields replaces the values of the named fields in every object within value
+func maskFields(value interface{}, fields map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if fields[key] && field != nil {
+				v[key] = masked
+				continue
+			}
+			v[key] = maskFields(field, fields)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = maskFields(item, fields)
+		}
+	}
+	return value
+}